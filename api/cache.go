@@ -4,18 +4,30 @@ import (
 	"bufio"
 	"bytes"
 	"crypto/sha256"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 )
 
+// maxCacheSize bounds the total size of the on-disk HTTP response cache. Once a write would
+// push the cache over this size, the least-recently-written entries are evicted first.
+const maxCacheSize int64 = 50 * 1024 * 1024 // 50MiB
+
+// staleCacheHeader is set on responses that are served from the on-disk cache past their TTL
+// because GH_OFFLINE is set and no live request could be attempted.
+const staleCacheHeader = "X-Gh-Cache-Stale"
+
+// offlineEnvVar disables all network access: cacheable requests are served from the cache
+// regardless of staleness, and any request that isn't cacheable fails immediately.
+const offlineEnvVar = "GH_OFFLINE"
+
 func NewCachedClient(httpClient *http.Client, cacheTTL time.Duration) *http.Client {
 	cacheDir := filepath.Join(os.TempDir(), "gh-cli-cache")
 	return &http.Client{
@@ -23,6 +35,12 @@ func NewCachedClient(httpClient *http.Client, cacheTTL time.Duration) *http.Clie
 	}
 }
 
+// IsStaleCachedResponse reports whether res was served from the HTTP cache past its TTL because
+// GH_OFFLINE is set, so that callers can warn users that the data might be out of date.
+func IsStaleCachedResponse(res *http.Response) bool {
+	return res != nil && res.Header.Get(staleCacheHeader) != ""
+}
+
 func isCacheableRequest(req *http.Request) bool {
 	if strings.EqualFold(req.Method, "GET") || strings.EqualFold(req.Method, "HEAD") {
 		return true
@@ -39,33 +57,75 @@ func isCacheableResponse(res *http.Response) bool {
 	return res.StatusCode < 500 && res.StatusCode != 403
 }
 
-// CacheResponse produces a RoundTripper that caches HTTP responses to disk for a specified amount of time
+// CacheResponse produces a RoundTripper that caches HTTP responses to disk for a specified amount
+// of time. Cached entries past their TTL are revalidated with the origin using a conditional
+// request (If-None-Match) when an ETag is available, so a 304 response can reuse the cached body
+// without consuming as much of the rate limit. When GH_OFFLINE is set, cacheable requests are
+// always served from the cache (however stale) and all other requests fail without touching the
+// network.
 func CacheResponse(ttl time.Duration, dir string) ClientOption {
 	fs := fileStorage{
 		dir: dir,
 		ttl: ttl,
 		mu:  &sync.RWMutex{},
 	}
+	offline := os.Getenv(offlineEnvVar) != ""
 
 	return func(tr http.RoundTripper) http.RoundTripper {
 		return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
 			if !isCacheableRequest(req) {
+				if offline {
+					return nil, fmt.Errorf("%s is set: refusing to make a %s request to %s", offlineEnvVar, req.Method, req.URL)
+				}
 				return tr.RoundTrip(req)
 			}
 
 			key, keyErr := cacheKey(req)
+			var cached *http.Response
+			var stale bool
 			if keyErr == nil {
-				if res, err := fs.read(key); err == nil {
-					res.Request = req
-					return res, nil
+				if res, isStale, err := fs.read(key); err == nil {
+					cached, stale = res, isStale
+				}
+			}
+
+			if cached != nil && !stale {
+				cached.Request = req
+				return cached, nil
+			}
+
+			if offline {
+				if cached == nil {
+					return nil, fmt.Errorf("%s is set and no cached response is available for %s", offlineEnvVar, req.URL)
+				}
+				cached.Header.Set(staleCacheHeader, "true")
+				cached.Request = req
+				return cached, nil
+			}
+
+			if cached != nil {
+				if etag := cached.Header.Get("ETag"); etag != "" {
+					req.Header.Set("If-None-Match", etag)
 				}
 			}
 
 			res, err := tr.RoundTrip(req)
-			if err == nil && keyErr == nil && isCacheableResponse(res) {
-				_ = fs.store(key, res)
+			if err != nil {
+				return nil, err
+			}
+
+			if cached != nil && res.StatusCode == http.StatusNotModified {
+				_ = fs.touch(key)
+				cached.Request = req
+				return cached, nil
 			}
-			return res, err
+
+			if keyErr == nil && isCacheableResponse(res) {
+				if err := fs.store(key, res); err == nil {
+					fs.purge(maxCacheSize)
+				}
+			}
+			return res, nil
 		}}
 	}
 }
@@ -117,7 +177,8 @@ func (fs *fileStorage) filePath(key string) string {
 	return filepath.Join(fs.dir, key)
 }
 
-func (fs *fileStorage) read(key string) (*http.Response, error) {
+// read returns the cached response for key along with whether it is past its TTL.
+func (fs *fileStorage) read(key string) (*http.Response, bool, error) {
 	cacheFile := fs.filePath(key)
 
 	fs.mu.RLock()
@@ -125,28 +186,33 @@ func (fs *fileStorage) read(key string) (*http.Response, error) {
 
 	f, err := os.Open(cacheFile)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	defer f.Close()
 
 	stat, err := f.Stat()
 	if err != nil {
-		return nil, err
-	}
-
-	age := time.Since(stat.ModTime())
-	if age > fs.ttl {
-		return nil, errors.New("cache expired")
+		return nil, false, err
 	}
+	stale := time.Since(stat.ModTime()) > fs.ttl
 
 	body := &bytes.Buffer{}
-	_, err = io.Copy(body, f)
-	if err != nil {
-		return nil, err
+	if _, err = io.Copy(body, f); err != nil {
+		return nil, false, err
 	}
 
 	res, err := http.ReadResponse(bufio.NewReader(body), nil)
-	return res, err
+	return res, stale, err
+}
+
+// touch resets the cache entry's modification time to now, keeping it fresh after it was
+// revalidated with a conditional request.
+func (fs *fileStorage) touch(key string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	now := time.Now()
+	return os.Chtimes(fs.filePath(key), now, now)
 }
 
 func (fs *fileStorage) store(key string, res *http.Response) error {
@@ -177,3 +243,42 @@ func (fs *fileStorage) store(key string, res *http.Response) error {
 	}
 	return err
 }
+
+// purge deletes the least-recently-written cache entries under fs.dir until its total size is
+// within maxSize, keeping the on-disk cache bounded.
+func (fs *fileStorage) purge(maxSize int64) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	type entry struct {
+		path string
+		info os.FileInfo
+	}
+	var entries []entry
+	var total int64
+	_ = filepath.Walk(fs.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return nil
+		}
+		entries = append(entries, entry{path, info})
+		total += info.Size()
+		return nil
+	})
+
+	if total <= maxSize {
+		return
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].info.ModTime().Before(entries[j].info.ModTime())
+	})
+
+	for _, e := range entries {
+		if total <= maxSize {
+			break
+		}
+		if err := os.Remove(e.path); err == nil {
+			total -= e.info.Size()
+		}
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -87,3 +90,123 @@ func Test_CacheResponse(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "7: GET http://example.com/error", res)
 }
+
+func Test_CacheResponse_conditionalRequest(t *testing.T) {
+	counter := 0
+	var lastReq *http.Request
+	fakeHTTP := funcTripper{
+		roundTrip: func(req *http.Request) (*http.Response, error) {
+			counter += 1
+			lastReq = req
+			if req.Header.Get("If-None-Match") == "etag1" {
+				return &http.Response{StatusCode: 304, Body: http.NoBody}, nil
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{"Etag": {"etag1"}},
+				Body:       ioutil.NopCloser(bytes.NewBufferString(fmt.Sprintf("%d", counter))),
+			}, nil
+		},
+	}
+
+	cacheDir := filepath.Join(t.TempDir(), "gh-cli-cache")
+	httpClient := NewHTTPClient(ReplaceTripper(fakeHTTP), CacheResponse(0, cacheDir))
+
+	req, err := http.NewRequest("GET", "http://example.com/path", nil)
+	require.NoError(t, err)
+
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(body))
+	assert.Equal(t, 1, counter)
+
+	req2, err := http.NewRequest("GET", "http://example.com/path", nil)
+	require.NoError(t, err)
+	res2, err := httpClient.Do(req2)
+	require.NoError(t, err)
+	body2, err := ioutil.ReadAll(res2.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(body2), "expected the cached body to be reused after a 304")
+	assert.Equal(t, 2, counter, "expected a conditional request to have been made")
+	assert.Equal(t, "etag1", lastReq.Header.Get("If-None-Match"))
+}
+
+func Test_CacheResponse_offline(t *testing.T) {
+	counter := 0
+	fakeHTTP := funcTripper{
+		roundTrip: func(req *http.Request) (*http.Response, error) {
+			counter += 1
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(fmt.Sprintf("%d", counter))),
+			}, nil
+		},
+	}
+
+	cacheDir := filepath.Join(t.TempDir(), "gh-cli-cache")
+	httpClient := NewHTTPClient(ReplaceTripper(fakeHTTP), CacheResponse(time.Minute, cacheDir))
+
+	req, err := http.NewRequest("GET", "http://example.com/path", nil)
+	require.NoError(t, err)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	assert.False(t, IsStaleCachedResponse(res))
+
+	t.Setenv("GH_OFFLINE", "1")
+	offlineClient := NewHTTPClient(ReplaceTripper(fakeHTTP), CacheResponse(time.Minute, cacheDir))
+
+	req2, err := http.NewRequest("GET", "http://example.com/path", nil)
+	require.NoError(t, err)
+	res2, err := offlineClient.Do(req2)
+	require.NoError(t, err)
+	body2, err := ioutil.ReadAll(res2.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(body2))
+	assert.Equal(t, 1, counter, "expected no network request while offline")
+
+	req3, err := http.NewRequest("GET", "http://example.com/uncached", nil)
+	require.NoError(t, err)
+	_, err = offlineClient.Do(req3)
+	assert.ErrorContains(t, err, "GH_OFFLINE is set and no cached response is available for http://example.com/uncached")
+
+	req4, err := http.NewRequest("POST", "http://example.com/repos/owner/repo/issues", bytes.NewBufferString(`{}`))
+	require.NoError(t, err)
+	_, err = offlineClient.Do(req4)
+	assert.ErrorContains(t, err, "GH_OFFLINE is set: refusing to make a POST request to http://example.com/repos/owner/repo/issues")
+}
+
+func Test_fileStorage_purge(t *testing.T) {
+	dir := t.TempDir()
+	fs := fileStorage{dir: dir, ttl: time.Minute, mu: &sync.RWMutex{}}
+
+	res := &http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Repeat("x", 100))),
+	}
+	require.NoError(t, fs.store("key1", res))
+
+	time.Sleep(5 * time.Millisecond)
+	res2 := &http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Repeat("y", 100))),
+	}
+	require.NoError(t, fs.store("key2", res2))
+
+	var total int64
+	_ = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			total += info.Size()
+		}
+		return nil
+	})
+	require.Greater(t, total, int64(0))
+
+	fs.purge(total - 1)
+
+	_, _, err := fs.read("key1")
+	assert.Error(t, err, "expected the older entry to have been evicted")
+	_, _, err = fs.read("key2")
+	assert.NoError(t, err, "expected the newer entry to survive")
+}
@@ -10,6 +10,8 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/cli/cli/v2/internal/ghinstance"
 	graphql "github.com/cli/shurcooL-graphql"
@@ -71,8 +73,20 @@ func AddHeaderFunc(name string, getValue func(*http.Request) (string, error)) Cl
 	}
 }
 
-// VerboseLog enables request/response logging within a RoundTripper
-func VerboseLog(out io.Writer, logTraffic bool, colorize bool) ClientOption {
+// VerboseLog enables request/response logging within a RoundTripper. logTraffic additionally
+// logs headers and bodies (up to maxBodyBytes each, with credentials redacted); without it, only
+// the request line, status, and timing are logged. When jsonFormat is set, one JSON object is
+// logged per request instead of httpretty's human-readable format, which is easier to grep or
+// attach to a bug report.
+func VerboseLog(out io.Writer, logTraffic bool, colorize bool, jsonFormat bool, maxBodyBytes int64) ClientOption {
+	if maxBodyBytes <= 0 {
+		maxBodyBytes = 10000
+	}
+
+	if jsonFormat {
+		return jsonVerboseLog(out, logTraffic, maxBodyBytes)
+	}
+
 	logger := &httpretty.Logger{
 		Time:            true,
 		TLS:             false,
@@ -82,7 +96,8 @@ func VerboseLog(out io.Writer, logTraffic bool, colorize bool) ClientOption {
 		ResponseHeader:  logTraffic,
 		ResponseBody:    logTraffic,
 		Formatters:      []httpretty.Formatter{&httpretty.JSONFormatter{}},
-		MaxResponseBody: 10000,
+		MaxRequestBody:  maxBodyBytes,
+		MaxResponseBody: maxBodyBytes,
 	}
 	logger.SetOutput(out)
 	logger.SetBodyFilter(func(h http.Header) (skip bool, err error) {
@@ -91,6 +106,95 @@ func VerboseLog(out io.Writer, logTraffic bool, colorize bool) ClientOption {
 	return logger.RoundTripper
 }
 
+// jsonLogEntry is one line of the GH_DEBUG=json log: a single HTTP request/response pair.
+type jsonLogEntry struct {
+	Time            string            `json:"time"`
+	Method          string            `json:"method"`
+	URL             string            `json:"url"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	RequestBody     string            `json:"request_body,omitempty"`
+	Status          int               `json:"status,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    string            `json:"response_body,omitempty"`
+	DurationMS      int64             `json:"duration_ms"`
+	Error           string            `json:"error,omitempty"`
+}
+
+func jsonVerboseLog(out io.Writer, logTraffic bool, maxBodyBytes int64) ClientOption {
+	enc := json.NewEncoder(out)
+	var mu sync.Mutex
+
+	return func(tr http.RoundTripper) http.RoundTripper {
+		return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+			entry := jsonLogEntry{
+				Time:   time.Now().Format(time.RFC3339),
+				Method: req.Method,
+				URL:    req.URL.String(),
+			}
+			if logTraffic {
+				entry.RequestHeaders = redactedHeaders(req.Header)
+				entry.RequestBody = truncatedBody(&req.Body, req.Header.Get("Content-Type"), maxBodyBytes)
+			}
+
+			start := time.Now()
+			res, err := tr.RoundTrip(req)
+			entry.DurationMS = time.Since(start).Milliseconds()
+
+			if err != nil {
+				entry.Error = err.Error()
+			} else {
+				entry.Status = res.StatusCode
+				if logTraffic {
+					entry.ResponseHeaders = redactedHeaders(res.Header)
+					entry.ResponseBody = truncatedBody(&res.Body, res.Header.Get("Content-Type"), maxBodyBytes)
+				}
+			}
+
+			mu.Lock()
+			_ = enc.Encode(entry)
+			mu.Unlock()
+
+			return res, err
+		}}
+	}
+}
+
+// redactedHeaders flattens a http.Header into a map suitable for JSON logging, masking the value
+// of any header that commonly carries credentials.
+func redactedHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for name, values := range h {
+		if strings.EqualFold(name, "Authorization") || strings.EqualFold(name, "Cookie") {
+			out[name] = "REDACTED"
+			continue
+		}
+		out[name] = strings.Join(values, ", ")
+	}
+	return out
+}
+
+// truncatedBody reads all of *body, replacing it with an equivalent copy so the rest of the round
+// trip can still read it in full, and returns up to maxBytes of it for logging. Non-inspectable
+// content types (e.g. binary payloads) are left alone and logged as empty.
+func truncatedBody(body *io.ReadCloser, contentType string, maxBytes int64) string {
+	if *body == nil || !inspectableMIMEType(contentType) {
+		return ""
+	}
+
+	b, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		*body = ioutil.NopCloser(bytes.NewReader(nil))
+		return ""
+	}
+	*body = ioutil.NopCloser(bytes.NewReader(b))
+
+	if int64(len(b)) > maxBytes {
+		return string(b[:maxBytes]) + "...(truncated)"
+	}
+	return string(b)
+}
+
 // ReplaceTripper substitutes the underlying RoundTripper with a custom one
 func ReplaceTripper(tr http.RoundTripper) ClientOption {
 	return func(http.RoundTripper) http.RoundTripper {
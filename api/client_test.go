@@ -2,13 +2,16 @@ package api
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGraphQL(t *testing.T) {
@@ -223,3 +226,62 @@ func TestHTTPError_ScopesSuggestion(t *testing.T) {
 		})
 	}
 }
+
+func TestVerboseLog_json(t *testing.T) {
+	fakeHTTP := funcTripper{
+		roundTrip: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"ok":true}`)),
+			}, nil
+		},
+	}
+
+	var buf bytes.Buffer
+	httpClient := NewHTTPClient(ReplaceTripper(fakeHTTP), VerboseLog(&buf, true, false, true, 10000))
+
+	req, err := http.NewRequest("POST", "https://api.github.com/graphql", bytes.NewBufferString(`{"query":""}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "token SECRET")
+
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"ok":true}`, string(body), "response body must still be readable by the caller")
+
+	var entry jsonLogEntry
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "POST", entry.Method)
+	assert.Equal(t, 200, entry.Status)
+	assert.Equal(t, "REDACTED", entry.RequestHeaders["Authorization"])
+	assert.Equal(t, `{"query":""}`, entry.RequestBody)
+	assert.Equal(t, `{"ok":true}`, entry.ResponseBody)
+}
+
+func TestVerboseLog_maxBody(t *testing.T) {
+	fakeHTTP := funcTripper{
+		roundTrip: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"a":1}`)),
+			}, nil
+		},
+	}
+
+	var buf bytes.Buffer
+	httpClient := NewHTTPClient(ReplaceTripper(fakeHTTP), VerboseLog(&buf, true, false, true, 4))
+
+	req, err := http.NewRequest("GET", "https://api.github.com/gists", nil)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	_, err = httpClient.Do(req)
+	require.NoError(t, err)
+
+	var entry jsonLogEntry
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.True(t, strings.HasSuffix(entry.ResponseBody, "...(truncated)"), "expected body to be truncated: %q", entry.ResponseBody)
+}
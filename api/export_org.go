@@ -0,0 +1,32 @@
+package api
+
+func (o *OrganizationProfile) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "login":
+			data[f] = o.Login
+		case "name":
+			data[f] = o.Name
+		case "description":
+			data[f] = o.Description
+		case "location":
+			data[f] = o.Location
+		case "email":
+			data[f] = o.Email
+		case "websiteUrl":
+			data[f] = o.WebsiteURL
+		case "createdAt":
+			data[f] = o.CreatedAt
+		case "membersCount":
+			data[f] = o.MembersWithRole.TotalCount
+		case "pinnedItems":
+			var repos []string
+			for _, item := range o.PinnedItems.Nodes {
+				repos = append(repos, item.Repository.NameWithOwner)
+			}
+			data[f] = repos
+		}
+	}
+	return data
+}
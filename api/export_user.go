@@ -0,0 +1,38 @@
+package api
+
+func (u *UserProfile) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "login":
+			data[f] = u.Login
+		case "name":
+			data[f] = u.Name
+		case "bio":
+			data[f] = u.Bio
+		case "company":
+			data[f] = u.Company
+		case "location":
+			data[f] = u.Location
+		case "email":
+			data[f] = u.Email
+		case "websiteUrl":
+			data[f] = u.WebsiteURL
+		case "createdAt":
+			data[f] = u.CreatedAt
+		case "followers":
+			data[f] = u.Followers.TotalCount
+		case "following":
+			data[f] = u.Following.TotalCount
+		case "pinnedItems":
+			var repos []string
+			for _, item := range u.PinnedItems.Nodes {
+				repos = append(repos, item.Repository.NameWithOwner)
+			}
+			data[f] = repos
+		case "contributionsCount":
+			data[f] = u.ContributionsCollection.ContributionCalendar.TotalContributions
+		}
+	}
+	return data
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultPoolConcurrency is used by NewPool when a command has no more specific reason to
+// pick a different limit. It's deliberately conservative: high enough to make bulk operations
+// feel parallel, low enough that a command iterating hundreds of items doesn't look like abuse
+// to the REST and GraphQL rate limiters.
+const DefaultPoolConcurrency = 4
+
+// Pool runs work items with a bounded number running at once, so that commands doing bulk API
+// work (upgrading many extensions, editing many issues, exporting many repos) share one place
+// to cap concurrency instead of each spinning up their own unbounded goroutine-per-item loop.
+type Pool struct {
+	eg  *errgroup.Group
+	sem chan struct{}
+}
+
+// NewPool returns a Pool that runs at most maxConcurrency queued functions at the same time,
+// and a context that's canceled as soon as any of them returns an error.
+func NewPool(ctx context.Context, maxConcurrency int) (*Pool, context.Context) {
+	if maxConcurrency <= 0 {
+		maxConcurrency = DefaultPoolConcurrency
+	}
+	eg, ctx := errgroup.WithContext(ctx)
+	return &Pool{eg: eg, sem: make(chan struct{}, maxConcurrency)}, ctx
+}
+
+// Go queues fn to run as soon as a concurrency slot is free. It blocks if the pool is already
+// running maxConcurrency functions.
+func (p *Pool) Go(fn func() error) {
+	p.sem <- struct{}{}
+	p.eg.Go(func() error {
+		defer func() { <-p.sem }()
+		return fn()
+	})
+}
+
+// Wait blocks until every queued function has returned, and returns the first non-nil error
+// any of them returned, if any.
+func (p *Pool) Wait() error {
+	return p.eg.Wait()
+}
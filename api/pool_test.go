@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPool_limitsConcurrency(t *testing.T) {
+	pool, ctx := NewPool(context.Background(), 2)
+	_ = ctx
+
+	var current, max int32
+	for i := 0; i < 10; i++ {
+		pool.Go(func() error {
+			n := atomic.AddInt32(&current, 1)
+			defer atomic.AddInt32(&current, -1)
+			for {
+				m := atomic.LoadInt32(&max)
+				if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+					break
+				}
+			}
+			return nil
+		})
+	}
+
+	if err := pool.Wait(); err != nil {
+		t.Fatalf("Wait() = %v", err)
+	}
+	if max > 2 {
+		t.Errorf("observed %d tasks running at once, want at most 2", max)
+	}
+}
+
+func TestPool_returnsFirstError(t *testing.T) {
+	pool, _ := NewPool(context.Background(), 2)
+	wantErr := errors.New("boom")
+
+	pool.Go(func() error { return nil })
+	pool.Go(func() error { return wantErr })
+
+	if err := pool.Wait(); err != wantErr {
+		t.Errorf("Wait() = %v, want %v", err, wantErr)
+	}
+}
+
+func TestNewPool_defaultsInvalidConcurrency(t *testing.T) {
+	pool, _ := NewPool(context.Background(), 0)
+	if cap(pool.sem) != DefaultPoolConcurrency {
+		t.Errorf("cap(sem) = %d, want %d", cap(pool.sem), DefaultPoolConcurrency)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 )
 
@@ -156,56 +157,55 @@ type IssueStatusOptions struct {
 	Fields   []string
 }
 
+// IssueStatus fetches issues assigned to, mentioning, and opened by options.Username in a single
+// GraphQL request, aliasing one Search API query per list the same way PullRequestStatus aliases
+// "viewerCreated" and "reviewRequested". Search, unlike the repository "issues" connection,
+// understands the literal "@me" token, so on github.com this avoids ever having to resolve the
+// caller's username first; only Enterprise hosts, which may predate that token, pay for a
+// separate CurrentLoginName lookup.
 func IssueStatus(client *Client, repo ghrepo.Interface, options IssueStatusOptions) (*IssuesPayload, error) {
+	type edges struct {
+		TotalCount int
+		Edges      []struct{ Node Issue }
+	}
+
 	type response struct {
 		Repository struct {
-			Assigned struct {
-				TotalCount int
-				Nodes      []Issue
-			}
-			Mentioned struct {
-				TotalCount int
-				Nodes      []Issue
-			}
-			Authored struct {
-				TotalCount int
-				Nodes      []Issue
-			}
 			HasIssuesEnabled bool
 		}
+		Assigned  edges
+		Mentioned edges
+		Authored  edges
 	}
 
+	currentUsername := options.Username
+	if currentUsername == "@me" && ghinstance.IsEnterprise(repo.RepoHost()) {
+		var err error
+		currentUsername, err = CurrentLoginName(client, repo.RepoHost())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	repoFilter := fmt.Sprintf("repo:%s state:open type:issue", ghrepo.FullName(repo))
+
+	sb := &SearchBatch{}
+	sb.Add("assigned", fmt.Sprintf("%s assignee:%s", repoFilter, currentUsername), "issue")
+	sb.Add("mentioned", fmt.Sprintf("%s mentions:%s", repoFilter, currentUsername), "issue")
+	sb.Add("authored", fmt.Sprintf("%s author:%s", repoFilter, currentUsername), "issue")
+
 	fragments := fmt.Sprintf("fragment issue on Issue{%s}", PullRequestGraphQL(options.Fields))
 	query := fragments + `
-	query IssueStatus($owner: String!, $repo: String!, $viewer: String!, $per_page: Int = 10) {
+	query IssueStatus($owner: String!, $repo: String!, $assignedQuery: String!, $mentionedQuery: String!, $authoredQuery: String!, $per_page: Int = 10) {
 		repository(owner: $owner, name: $repo) {
 			hasIssuesEnabled
-			assigned: issues(filterBy: {assignee: $viewer, states: OPEN}, first: $per_page, orderBy: {field: UPDATED_AT, direction: DESC}) {
-				totalCount
-				nodes {
-					...issue
-				}
-			}
-			mentioned: issues(filterBy: {mentioned: $viewer, states: OPEN}, first: $per_page, orderBy: {field: UPDATED_AT, direction: DESC}) {
-				totalCount
-				nodes {
-					...issue
-				}
-			}
-			authored: issues(filterBy: {createdBy: $viewer, states: OPEN}, first: $per_page, orderBy: {field: UPDATED_AT, direction: DESC}) {
-				totalCount
-				nodes {
-					...issue
-				}
-			}
 		}
+	` + sb.Fields("per_page") + `
     }`
 
-	variables := map[string]interface{}{
-		"owner":  repo.RepoOwner(),
-		"repo":   repo.RepoName(),
-		"viewer": options.Username,
-	}
+	variables := sb.Variables()
+	variables["owner"] = repo.RepoOwner()
+	variables["repo"] = repo.RepoName()
 
 	var resp response
 	err := client.GraphQL(repo.RepoHost(), query, variables, &resp)
@@ -217,18 +217,26 @@ func IssueStatus(client *Client, repo ghrepo.Interface, options IssueStatusOptio
 		return nil, fmt.Errorf("the '%s' repository has disabled issues", ghrepo.FullName(repo))
 	}
 
+	toIssues := func(e edges) []Issue {
+		issues := make([]Issue, len(e.Edges))
+		for i, edge := range e.Edges {
+			issues[i] = edge.Node
+		}
+		return issues
+	}
+
 	payload := IssuesPayload{
 		Assigned: IssuesAndTotalCount{
-			Issues:     resp.Repository.Assigned.Nodes,
-			TotalCount: resp.Repository.Assigned.TotalCount,
+			Issues:     toIssues(resp.Assigned),
+			TotalCount: resp.Assigned.TotalCount,
 		},
 		Mentioned: IssuesAndTotalCount{
-			Issues:     resp.Repository.Mentioned.Nodes,
-			TotalCount: resp.Repository.Mentioned.TotalCount,
+			Issues:     toIssues(resp.Mentioned),
+			TotalCount: resp.Mentioned.TotalCount,
 		},
 		Authored: IssuesAndTotalCount{
-			Issues:     resp.Repository.Authored.Nodes,
-			TotalCount: resp.Repository.Authored.TotalCount,
+			Issues:     toIssues(resp.Authored),
+			TotalCount: resp.Authored.TotalCount,
 		},
 	}
 
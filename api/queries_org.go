@@ -2,11 +2,50 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/shurcooL/githubv4"
 )
 
+// OrganizationProfile contains the profile information shown by `gh org view`.
+type OrganizationProfile struct {
+	Login           string
+	Name            string
+	Description     string
+	Location        string
+	Email           string
+	WebsiteURL      string
+	CreatedAt       time.Time
+	MembersWithRole struct{ TotalCount int }
+	PinnedItems     struct {
+		Nodes []OrganizationProfilePinnedItem
+	} `graphql:"pinnedItems(first: 6, types: REPOSITORY)"`
+}
+
+type OrganizationProfilePinnedItem struct {
+	Repository struct {
+		NameWithOwner  string
+		Description    string
+		StargazerCount int
+	} `graphql:"... on Repository"`
+}
+
+// OrganizationProfileByLogin fetches the public profile of the organization with the given login.
+func OrganizationProfileByLogin(client *Client, hostname, login string) (*OrganizationProfile, error) {
+	var query struct {
+		Organization OrganizationProfile `graphql:"organization(login: $login)"`
+	}
+	variables := map[string]interface{}{
+		"login": githubv4.String(login),
+	}
+	gql := graphQLClient(client.http, hostname)
+	if err := gql.QueryNamed(context.Background(), "OrganizationProfile", &query, variables); err != nil {
+		return nil, err
+	}
+	return &query.Organization, nil
+}
+
 // OrganizationProjects fetches all open projects for an organization
 func OrganizationProjects(client *Client, repo ghrepo.Interface) ([]RepoProject, error) {
 	type responseData struct {
@@ -66,9 +66,13 @@ type PullRequest struct {
 		BranchProtectionRule struct {
 			RequiresStrictStatusChecks   bool
 			RequiredApprovingReviewCount int
+			RequiresMergeQueue           bool
 		}
 	}
 
+	IsInMergeQueue  bool
+	MergeQueueEntry *PullRequestMergeQueueEntry
+
 	ReviewDecision string
 
 	Commits struct {
@@ -102,6 +106,13 @@ type PullRequest struct {
 	ReviewRequests ReviewRequests
 }
 
+// PullRequestMergeQueueEntry describes a pull request's position and status within its base
+// branch's merge queue.
+type PullRequestMergeQueueEntry struct {
+	Position int
+	State    string
+}
+
 type CheckContext struct {
 	TypeName    string    `json:"__typename"`
 	Name        string    `json:"name"`
@@ -384,8 +395,10 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 		}
 	}
 
+	sb := &SearchBatch{}
+
 	queryPrefix := `
-	query PullRequestStatus($owner: String!, $repo: String!, $headRefName: String!, $viewerQuery: String!, $reviewerQuery: String!, $per_page: Int = 10) {
+	query PullRequestStatus($owner: String!, $repo: String!, $headRefName: String!, $viewerCreatedQuery: String!, $reviewRequestedQuery: String!, $per_page: Int = 10) {
 		repository(owner: $owner, name: $repo) {
 			defaultBranchRef {
 				name
@@ -402,7 +415,7 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 	`
 	if options.CurrentPR > 0 {
 		queryPrefix = `
-		query PullRequestStatus($owner: String!, $repo: String!, $number: Int!, $viewerQuery: String!, $reviewerQuery: String!, $per_page: Int = 10) {
+		query PullRequestStatus($owner: String!, $repo: String!, $number: Int!, $viewerCreatedQuery: String!, $reviewRequestedQuery: String!, $per_page: Int = 10) {
 			repository(owner: $owner, name: $repo) {
 				defaultBranchRef {
 					name
@@ -419,26 +432,6 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 		`
 	}
 
-	query := fragments + queryPrefix + `
-      viewerCreated: search(query: $viewerQuery, type: ISSUE, first: $per_page) {
-       totalCount: issueCount
-        edges {
-          node {
-            ...prWithReviews
-          }
-        }
-      }
-      reviewRequested: search(query: $reviewerQuery, type: ISSUE, first: $per_page) {
-        totalCount: issueCount
-        edges {
-          node {
-            ...pr
-          }
-        }
-      }
-    }
-	`
-
 	currentUsername := options.Username
 	if currentUsername == "@me" && ghinstance.IsEnterprise(repo.RepoHost()) {
 		var err error
@@ -448,8 +441,10 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 		}
 	}
 
-	viewerQuery := fmt.Sprintf("repo:%s state:open is:pr author:%s", ghrepo.FullName(repo), currentUsername)
-	reviewerQuery := fmt.Sprintf("repo:%s state:open review-requested:%s", ghrepo.FullName(repo), currentUsername)
+	sb.Add("viewerCreated", fmt.Sprintf("repo:%s state:open is:pr author:%s", ghrepo.FullName(repo), currentUsername), "prWithReviews")
+	sb.Add("reviewRequested", fmt.Sprintf("repo:%s state:open review-requested:%s", ghrepo.FullName(repo), currentUsername), "pr")
+
+	query := fragments + queryPrefix + sb.Fields("per_page") + "\n}"
 
 	currentPRHeadRef := options.HeadRef
 	branchWithoutOwner := currentPRHeadRef
@@ -457,14 +452,11 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 		branchWithoutOwner = currentPRHeadRef[idx+1:]
 	}
 
-	variables := map[string]interface{}{
-		"viewerQuery":   viewerQuery,
-		"reviewerQuery": reviewerQuery,
-		"owner":         repo.RepoOwner(),
-		"repo":          repo.RepoName(),
-		"headRefName":   branchWithoutOwner,
-		"number":        options.CurrentPR,
-	}
+	variables := sb.Variables()
+	variables["owner"] = repo.RepoOwner()
+	variables["repo"] = repo.RepoName()
+	variables["headRefName"] = branchWithoutOwner
+	variables["number"] = options.CurrentPR
 
 	var resp response
 	err := client.GraphQL(repo.RepoHost(), query, variables, &resp)
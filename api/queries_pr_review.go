@@ -17,8 +17,17 @@ const (
 )
 
 type PullRequestReviewInput struct {
-	Body  string
-	State PullRequestReviewState
+	Body     string
+	State    PullRequestReviewState
+	Comments []PullRequestReviewInlineComment
+}
+
+// PullRequestReviewInlineComment is a single inline comment to leave as part of a
+// pull request review, anchored to a line in a file in the pull request's diff.
+type PullRequestReviewInlineComment struct {
+	Path string
+	Line int
+	Body string
 }
 
 type PullRequestReviews struct {
@@ -57,12 +66,26 @@ func AddReview(client *Client, repo ghrepo.Interface, pr *PullRequest, input *Pu
 	}
 
 	body := githubv4.String(input.Body)
+	addInput := githubv4.AddPullRequestReviewInput{
+		PullRequestID: pr.ID,
+		Event:         &state,
+		Body:          &body,
+	}
+
+	if len(input.Comments) > 0 {
+		threads := make([]*githubv4.DraftPullRequestReviewThread, len(input.Comments))
+		for i, c := range input.Comments {
+			threads[i] = &githubv4.DraftPullRequestReviewThread{
+				Path: githubv4.String(c.Path),
+				Line: githubv4.Int(c.Line),
+				Body: githubv4.String(c.Body),
+			}
+		}
+		addInput.Threads = &threads
+	}
+
 	variables := map[string]interface{}{
-		"input": githubv4.AddPullRequestReviewInput{
-			PullRequestID: pr.ID,
-			Event:         &state,
-			Body:          &body,
-		},
+		"input": addInput,
 	}
 
 	gql := graphQLClient(client.http, repo.RepoHost())
@@ -0,0 +1,297 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ProjectV2 is a GitHub Projects (the ProjectsV2-based boards) item.
+type ProjectV2 struct {
+	ID               string
+	Number           int
+	Title            string
+	URL              string
+	Closed           bool
+	ShortDescription string
+}
+
+type ProjectsV2Payload struct {
+	Projects   []ProjectV2
+	TotalCount int
+}
+
+// ProjectsV2ForOwner fetches the projects belonging to login, or to the authenticated user when
+// login is "@me". Projects can belong to either a user or an organization, so the lookup goes
+// through the ProjectV2Owner interface rather than separate user/organization queries.
+func ProjectsV2ForOwner(client *Client, hostname, login string, limit int) (*ProjectsV2Payload, error) {
+	type projectsConnection struct {
+		TotalCount int
+		Nodes      []ProjectV2
+	}
+
+	variables := map[string]interface{}{"limit": limit}
+
+	var query string
+	if login == "" || login == "@me" {
+		query = `
+		query ProjectList($limit: Int!) {
+			viewer {
+				projectsV2(first: $limit, orderBy: {field: TITLE, direction: ASC}) {
+					totalCount
+					nodes { id number title url closed shortDescription }
+				}
+			}
+		}`
+	} else {
+		query = `
+		query ProjectList($login: String!, $limit: Int!) {
+			repositoryOwner(login: $login) {
+				... on ProjectV2Owner {
+					projectsV2(first: $limit, orderBy: {field: TITLE, direction: ASC}) {
+						totalCount
+						nodes { id number title url closed shortDescription }
+					}
+				}
+			}
+		}`
+		variables["login"] = login
+	}
+
+	var resp struct {
+		Viewer struct {
+			ProjectsV2 projectsConnection
+		}
+		RepositoryOwner struct {
+			ProjectsV2 projectsConnection
+		}
+	}
+	if err := client.GraphQL(hostname, query, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	conn := resp.RepositoryOwner.ProjectsV2
+	if login == "" || login == "@me" {
+		conn = resp.Viewer.ProjectsV2
+	}
+
+	return &ProjectsV2Payload{Projects: conn.Nodes, TotalCount: conn.TotalCount}, nil
+}
+
+// ProjectV2View is one of a project's saved views: the filter, grouping, and visible fields a
+// team has agreed a particular board or table should show.
+type ProjectV2View struct {
+	ID     string
+	Name   string
+	Layout string
+	Filter string
+	Fields struct {
+		Nodes []struct{ Name string }
+	}
+	GroupByFields struct {
+		Nodes []struct{ Name string }
+	}
+}
+
+// VisibleFieldNames returns the names of the fields this view shows, in the order the view shows
+// them.
+func (v ProjectV2View) VisibleFieldNames() []string {
+	names := make([]string, len(v.Fields.Nodes))
+	for i, f := range v.Fields.Nodes {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// GroupByFieldNames returns the names of the fields this view groups its items by, if any.
+func (v ProjectV2View) GroupByFieldNames() []string {
+	names := make([]string, len(v.GroupByFields.Nodes))
+	for i, f := range v.GroupByFields.Nodes {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// ProjectV2ItemFieldValue is one field's value on a project item, flattened out of whichever of
+// ProjectV2ItemFieldTextValue/SingleSelectValue/DateValue/NumberValue GraphQL actually returned.
+type ProjectV2ItemFieldValue struct {
+	FieldName string
+	Value     string
+}
+
+// ProjectV2Item is a single row of a project: the issue, pull request, or draft issue it tracks,
+// plus the value it has for each of the project's custom fields.
+type ProjectV2Item struct {
+	ID          string
+	Title       string
+	Number      int
+	URL         string
+	State       string
+	Closed      bool
+	ClosedAt    *time.Time
+	FieldValues []ProjectV2ItemFieldValue
+}
+
+// ProjectV2Detail is a project's own metadata together with its saved views and the current
+// value of every field on its items, i.e. everything a saved view's export needs without
+// re-deriving it from a series of separate requests.
+type ProjectV2Detail struct {
+	ID     string
+	Number int
+	Title  string
+	Views  []ProjectV2View
+	Items  []ProjectV2Item
+}
+
+// ProjectV2DetailForOwner fetches the project numbered `number` belonging to login (or to the
+// authenticated user when login is "@me"), its saved views, and up to itemLimit of its items
+// with their field values.
+func ProjectV2DetailForOwner(client *Client, hostname, login string, number, itemLimit int) (*ProjectV2Detail, error) {
+	type fieldValueNode struct {
+		Text   *string
+		Name   *string
+		Date   *string
+		Number *float64
+		Field  struct{ Name string }
+	}
+
+	type itemNode struct {
+		ID      string
+		Content struct {
+			Title    string
+			Number   int
+			URL      string
+			State    string
+			Closed   bool
+			ClosedAt *time.Time
+		}
+		FieldValues struct {
+			Nodes []fieldValueNode
+		}
+	}
+
+	type project struct {
+		ID     string
+		Number int
+		Title  string
+		Views  struct{ Nodes []ProjectV2View }
+		Items  struct{ Nodes []itemNode }
+	}
+
+	viewsAndItemsFields := `
+		id
+		number
+		title
+		views(first: 20) {
+			nodes {
+				id
+				name
+				layout
+				filter
+				fields(first: 20) { nodes { ... on ProjectV2FieldCommon { name } } }
+				groupByFields(first: 20) { nodes { ... on ProjectV2FieldCommon { name } } }
+			}
+		}
+		items(first: $itemLimit) {
+			nodes {
+				id
+				content {
+					... on Issue { title number url state closed closedAt }
+					... on PullRequest { title number url state closed closedAt }
+					... on DraftIssue { title }
+				}
+				fieldValues(first: 20) {
+					nodes {
+						... on ProjectV2ItemFieldTextValue { text field { ... on ProjectV2FieldCommon { name } } }
+						... on ProjectV2ItemFieldSingleSelectValue { name field { ... on ProjectV2FieldCommon { name } } }
+						... on ProjectV2ItemFieldDateValue { date field { ... on ProjectV2FieldCommon { name } } }
+						... on ProjectV2ItemFieldNumberValue { number field { ... on ProjectV2FieldCommon { name } } }
+					}
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{"number": number, "itemLimit": itemLimit}
+
+	var query string
+	if login == "" || login == "@me" {
+		query = `
+		query ProjectViewData($number: Int!, $itemLimit: Int!) {
+			viewer {
+				projectV2(number: $number) {` + viewsAndItemsFields + `
+				}
+			}
+		}`
+	} else {
+		query = `
+		query ProjectViewData($login: String!, $number: Int!, $itemLimit: Int!) {
+			repositoryOwner(login: $login) {
+				... on ProjectV2Owner {
+					projectV2(number: $number) {` + viewsAndItemsFields + `
+					}
+				}
+			}
+		}`
+		variables["login"] = login
+	}
+
+	var resp struct {
+		Viewer struct {
+			ProjectV2 *project
+		}
+		RepositoryOwner struct {
+			ProjectV2 *project
+		}
+	}
+	if err := client.GraphQL(hostname, query, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	p := resp.RepositoryOwner.ProjectV2
+	if login == "" || login == "@me" {
+		p = resp.Viewer.ProjectV2
+	}
+	if p == nil {
+		return nil, fmt.Errorf("no project %d found for %s", number, login)
+	}
+
+	items := make([]ProjectV2Item, len(p.Items.Nodes))
+	for i, n := range p.Items.Nodes {
+		values := make([]ProjectV2ItemFieldValue, 0, len(n.FieldValues.Nodes))
+		for _, fv := range n.FieldValues.Nodes {
+			value := ""
+			switch {
+			case fv.Text != nil:
+				value = *fv.Text
+			case fv.Name != nil:
+				value = *fv.Name
+			case fv.Date != nil:
+				value = *fv.Date
+			case fv.Number != nil:
+				value = strconv.FormatFloat(*fv.Number, 'f', -1, 64)
+			default:
+				continue
+			}
+			values = append(values, ProjectV2ItemFieldValue{FieldName: fv.Field.Name, Value: value})
+		}
+
+		items[i] = ProjectV2Item{
+			ID:          n.ID,
+			Title:       n.Content.Title,
+			Number:      n.Content.Number,
+			URL:         n.Content.URL,
+			State:       n.Content.State,
+			Closed:      n.Content.Closed,
+			ClosedAt:    n.Content.ClosedAt,
+			FieldValues: values,
+		}
+	}
+
+	return &ProjectV2Detail{
+		ID:     p.ID,
+		Number: p.Number,
+		Title:  p.Title,
+		Views:  p.Views.Nodes,
+		Items:  items,
+	}, nil
+}
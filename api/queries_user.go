@@ -2,8 +2,56 @@ package api
 
 import (
 	"context"
+	"time"
+
+	"github.com/shurcooL/githubv4"
 )
 
+// UserProfile contains the profile information shown by `gh user view`.
+type UserProfile struct {
+	Login       string
+	Name        string
+	Bio         string
+	Company     string
+	Location    string
+	Email       string
+	WebsiteURL  string
+	CreatedAt   time.Time
+	Followers   struct{ TotalCount int }
+	Following   struct{ TotalCount int }
+	PinnedItems struct {
+		Nodes []UserProfilePinnedItem
+	} `graphql:"pinnedItems(first: 6, types: REPOSITORY)"`
+	ContributionsCollection struct {
+		ContributionCalendar struct {
+			TotalContributions int
+		}
+	}
+}
+
+type UserProfilePinnedItem struct {
+	Repository struct {
+		NameWithOwner  string
+		Description    string
+		StargazerCount int
+	} `graphql:"... on Repository"`
+}
+
+// UserProfileByLogin fetches the public profile of the user with the given login.
+func UserProfileByLogin(client *Client, hostname, login string) (*UserProfile, error) {
+	var query struct {
+		User UserProfile `graphql:"user(login: $login)"`
+	}
+	variables := map[string]interface{}{
+		"login": githubv4.String(login),
+	}
+	gql := graphQLClient(client.http, hostname)
+	if err := gql.QueryNamed(context.Background(), "UserProfile", &query, variables); err != nil {
+		return nil, err
+	}
+	return &query.User, nil
+}
+
 func CurrentLoginName(client *Client, hostname string) (string, error) {
 	var query struct {
 		Viewer struct {
@@ -0,0 +1,52 @@
+package api
+
+import "fmt"
+
+// SearchBatch combines several named Search API queries into the aliased fields of a single
+// GraphQL request, so callers that used to issue one `search` request per list (assigned issues,
+// mentioned issues, reviewer-requested pull requests, ...) can issue them all in one round trip
+// instead. This is the same aliasing PullRequestStatus already used by hand to combine
+// "viewerCreated" and "reviewRequested" into one query; pulling it out here lets other
+// status-style commands (gh issue status, gh dash) share it instead of re-deriving it.
+type SearchBatch struct {
+	names     []string
+	fragments []string
+	variables map[string]interface{}
+}
+
+// Add queues a search to include in the batch under name, which becomes both its GraphQL alias
+// and the name of the "$<name>Query" variable that carries its search string. fragment is the
+// name of the fragment (already declared by the caller) used to select fields from each matching
+// node, allowing different searches in the same batch to request different amounts of detail.
+func (b *SearchBatch) Add(name, searchQuery, fragment string) {
+	b.names = append(b.names, name)
+	b.fragments = append(b.fragments, fragment)
+	if b.variables == nil {
+		b.variables = map[string]interface{}{}
+	}
+	b.variables[name+"Query"] = searchQuery
+}
+
+// Fields returns the GraphQL selection for every queued search, ready to interpolate into a
+// query alongside a "$per_page: Int" variable of the given name.
+func (b *SearchBatch) Fields(perPageVar string) string {
+	var fields string
+	for i, name := range b.names {
+		fields += fmt.Sprintf(`
+		%[1]s: search(query: $%[1]sQuery, type: ISSUE, first: $%[2]s) {
+			totalCount: issueCount
+			edges {
+				node {
+					...%[3]s
+				}
+			}
+		}`, name, perPageVar, b.fragments[i])
+	}
+	return fields
+}
+
+// Variables returns the "$<name>Query" variables for every queued search, to merge into the
+// request's variables alongside whatever else the query needs.
+func (b *SearchBatch) Variables() map[string]interface{} {
+	return b.variables
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchBatch(t *testing.T) {
+	sb := &SearchBatch{}
+	sb.Add("assigned", "repo:cli/cli assignee:monalisa", "issue")
+	sb.Add("authored", "repo:cli/cli author:monalisa", "issue")
+
+	wantFields := `
+		assigned: search(query: $assignedQuery, type: ISSUE, first: $per_page) {
+			totalCount: issueCount
+			edges {
+				node {
+					...issue
+				}
+			}
+		}
+		authored: search(query: $authoredQuery, type: ISSUE, first: $per_page) {
+			totalCount: issueCount
+			edges {
+				node {
+					...issue
+				}
+			}
+		}`
+	if got := sb.Fields("per_page"); got != wantFields {
+		t.Errorf("Fields() = %v, want %v", got, wantFields)
+	}
+
+	wantVariables := map[string]interface{}{
+		"assignedQuery": "repo:cli/cli assignee:monalisa",
+		"authoredQuery": "repo:cli/cli author:monalisa",
+	}
+	if got := sb.Variables(); !reflect.DeepEqual(got, wantVariables) {
+		t.Errorf("Variables() = %v, want %v", got, wantVariables)
+	}
+}
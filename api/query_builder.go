@@ -254,6 +254,10 @@ func PullRequestGraphQL(fields []string) string {
 			q = append(q, `commits{totalCount}`)
 		case "requiresStrictStatusChecks": // pseudo-field
 			q = append(q, `baseRef{branchProtectionRule{requiresStrictStatusChecks}}`)
+		case "mergeQueueEnabled": // pseudo-field
+			q = append(q, `baseRef{branchProtectionRule{requiresMergeQueue}}`)
+		case "mergeQueueEntry":
+			q = append(q, `mergeQueueEntry{position,state}`)
 		case "statusCheckRollup":
 			q = append(q, StatusCheckRollupGraphQL(""))
 		default:
@@ -18,7 +18,9 @@ import (
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/i18n"
 	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/internal/telemetry"
 	"github.com/cli/cli/v2/internal/update"
 	"github.com/cli/cli/v2/pkg/cmd/alias/expand"
 	"github.com/cli/cli/v2/pkg/cmd/factory"
@@ -36,11 +38,17 @@ var updaterEnabled = ""
 
 type exitCode int
 
+// Exit codes are part of gh's interface to scripts: a script can branch on the
+// exit code instead of parsing error text. Once assigned, a code's meaning
+// should not change.
 const (
-	exitOK     exitCode = 0
-	exitError  exitCode = 1
-	exitCancel exitCode = 2
-	exitAuth   exitCode = 4
+	exitOK         exitCode = 0
+	exitError      exitCode = 1
+	exitCancel     exitCode = 2
+	exitNotFound   exitCode = 3
+	exitAuth       exitCode = 4
+	exitValidation exitCode = 5
+	exitRateLimit  exitCode = 6
 )
 
 func main() {
@@ -60,6 +68,13 @@ func mainRun() exitCode {
 
 	hasDebug, _ := utils.IsDebugEnabled()
 
+	// --log-file has to be special-cased here, ahead of cobra's flag parsing, since the HTTP
+	// client that honors it is built before the command tree runs; GH_LOG_FILE is what it
+	// actually reads.
+	if logFile := logFileFlagValue(os.Args[1:]); logFile != "" {
+		os.Setenv("GH_LOG_FILE", logFile)
+	}
+
 	cmdFactory := factory.New(buildVersion)
 	stderr := cmdFactory.IOStreams.ErrOut
 
@@ -168,31 +183,70 @@ func mainRun() exitCode {
 
 	// provide completions for aliases and extensions
 	rootCmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		var results []string
+		if len(args) == 0 {
+			var results []string
+			if aliases, err := cfg.Aliases(); err == nil {
+				for aliasName := range aliases.All() {
+					if strings.HasPrefix(aliasName, toComplete) {
+						results = append(results, aliasName)
+					}
+				}
+			}
+			for _, ext := range cmdFactory.ExtensionManager.List(false) {
+				if strings.HasPrefix(ext.Name(), toComplete) {
+					results = append(results, ext.Name())
+				}
+			}
+			return results, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		name := args[0]
+
+		// an alias expands to a real `gh` subcommand (or extension); delegate
+		// completion to whatever that subcommand would offer for the remaining args.
 		if aliases, err := cfg.Aliases(); err == nil {
-			for aliasName := range aliases.All() {
-				if strings.HasPrefix(aliasName, toComplete) {
-					results = append(results, aliasName)
+			if _, ok := aliases.Get(name); ok {
+				expandedArgs, isShell, err := expand.ExpandAlias(cfg, append([]string{"gh"}, args...), nil)
+				if err == nil && !isShell && len(expandedArgs) > 0 {
+					if target, remaining, err := rootCmd.Find(expandedArgs); err == nil && target != rootCmd {
+						if target.ValidArgsFunction != nil {
+							return target.ValidArgsFunction(target, remaining, toComplete)
+						}
+						return nil, cobra.ShellCompDirectiveDefault
+					}
 				}
 			}
 		}
+
+		// extensions may implement the `__complete` convention themselves; dispatch to
+		// them so that e.g. `gh my-extension <tab>` can offer the extension's own args.
 		for _, ext := range cmdFactory.ExtensionManager.List(false) {
-			if strings.HasPrefix(ext.Name(), toComplete) {
-				results = append(results, ext.Name())
+			if ext.Name() != name {
+				continue
 			}
+			results, err := cmdFactory.ExtensionManager.Complete(ext.Name(), args[1:], toComplete)
+			if err != nil || results == nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return results, cobra.ShellCompDirectiveNoFileComp
 		}
-		return results, cobra.ShellCompDirectiveNoFileComp
+
+		return nil, cobra.ShellCompDirectiveDefault
 	}
 
 	cs := cmdFactory.IOStreams.ColorScheme()
 
 	authError := errors.New("authError")
 	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if quiet, err := cmd.Flags().GetBool("quiet"); err == nil && quiet {
+			cmdFactory.IOStreams.SetQuiet(true)
+		}
+
 		// require that the user is authenticated before running most commands
 		if cmdutil.IsAuthCheckEnabled(cmd) && !cmdutil.CheckAuth(cfg) {
-			fmt.Fprintln(stderr, cs.Bold("Welcome to GitHub CLI!"))
+			fmt.Fprintln(stderr, cs.Bold(i18n.T("Welcome to GitHub CLI!")))
 			fmt.Fprintln(stderr)
-			fmt.Fprintln(stderr, "To authenticate, please run `gh auth login`.")
+			fmt.Fprintln(stderr, i18n.T("To authenticate, please run `gh auth login`."))
 			return authError
 		}
 
@@ -201,7 +255,30 @@ func mainRun() exitCode {
 
 	rootCmd.SetArgs(expandedArgs)
 
-	if cmd, err := rootCmd.ExecuteC(); err != nil {
+	telemetryEndpoint, _ := cfg.GetOrDefault("", "telemetry_endpoint")
+	startTime := time.Now()
+	cmd, err := rootCmd.ExecuteC()
+	if telemetryEndpoint != "" {
+		// Record in the background so a slow sink can't hold up printing the
+		// command's own output, but wait (briefly) for it to land before the
+		// process exits, since os.Exit right after mainRun returns would
+		// otherwise kill the goroutine mid-flight on every invocation.
+		recorded := make(chan struct{})
+		go func() {
+			telemetry.Record(telemetryEndpoint, telemetry.Event{
+				Command:  cmd.CommandPath(),
+				Duration: time.Since(startTime).Milliseconds(),
+				Success:  err == nil,
+			})
+			close(recorded)
+		}()
+		select {
+		case <-recorded:
+		case <-time.After(3 * time.Second):
+		}
+	}
+
+	if err != nil {
 		var pagerPipeError *iostreams.ErrClosedPagerPipe
 		if err == cmdutil.SilentError {
 			return exitError
@@ -226,6 +303,11 @@ func mainRun() exitCode {
 			return exitError
 		}
 
+		var flagError *cmdutil.FlagError
+		if errors.As(err, &flagError) {
+			return exitValidation
+		}
+
 		var httpErr api.HTTPError
 		if errors.As(err, &httpErr) && httpErr.StatusCode == 401 {
 			fmt.Fprintln(stderr, "Try authenticating with:  gh auth login")
@@ -236,6 +318,15 @@ func mainRun() exitCode {
 			fmt.Fprintln(stderr, msg)
 		}
 
+		if errors.As(err, &httpErr) {
+			switch {
+			case httpErr.StatusCode == 404:
+				return exitNotFound
+			case httpErr.StatusCode == 429 || (httpErr.StatusCode == 403 && strings.Contains(strings.ToLower(httpErr.Message), "rate limit")):
+				return exitRateLimit
+			}
+		}
+
 		return exitError
 	}
 	if root.HasFailed() {
@@ -243,7 +334,7 @@ func mainRun() exitCode {
 	}
 
 	newRelease := <-updateMessageChan
-	if newRelease != nil {
+	if newRelease != nil && !cmdFactory.IOStreams.GetQuiet() {
 		isHomebrew := isUnderHomebrew(cmdFactory.Executable())
 		if isHomebrew && isRecentRelease(newRelease.PublishedAt) {
 			// do not notify Homebrew users before the version bump had a chance to get merged into homebrew-core
@@ -269,6 +360,23 @@ func hasCommand(rootCmd *cobra.Command, args []string) bool {
 	return err == nil && c != rootCmd
 }
 
+// logFileFlagValue extracts the value of a top-level --log-file flag from args, in either
+// "--log-file PATH" or "--log-file=PATH" form, without doing a full flag parse.
+func logFileFlagValue(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			return ""
+		}
+		if arg == "--log-file" && i+1 < len(args) {
+			return args[i+1]
+		}
+		if val := strings.TrimPrefix(arg, "--log-file="); val != arg {
+			return val
+		}
+	}
+	return ""
+}
+
 func printError(out io.Writer, err error, cmd *cobra.Command, debug bool) {
 	var dnsError *net.DNSError
 	if errors.As(err, &dnsError) {
@@ -328,9 +436,18 @@ func checkForUpdate(currentVersion string) (*update.ReleaseInfo, error) {
 func basicClient(currentVersion string) (*api.Client, error) {
 	var opts []api.ClientOption
 	if isVerbose, debugValue := utils.IsDebugEnabled(); isVerbose {
-		colorize := utils.IsTerminal(os.Stderr)
 		logTraffic := strings.Contains(debugValue, "api")
-		opts = append(opts, api.VerboseLog(colorable.NewColorable(os.Stderr), logTraffic, colorize))
+		jsonFormat := strings.Contains(debugValue, "json")
+		logOut, err := utils.DebugLogFile(os.Stderr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to open log file: %s\n", err)
+			logOut = os.Stderr
+		}
+		colorize := logOut == os.Stderr && utils.IsTerminal(os.Stderr)
+		if colorize {
+			logOut = colorable.NewColorable(os.Stderr)
+		}
+		opts = append(opts, api.VerboseLog(logOut, logTraffic, colorize, jsonFormat, utils.DebugLogMaxBodyBytes()))
 	}
 	opts = append(opts, api.AddHeader("User-Agent", fmt.Sprintf("GitHub CLI %s", currentVersion)))
 
@@ -76,3 +76,23 @@ check your internet connection or https://githubstatus.com
 		})
 	}
 }
+
+func Test_logFileFlagValue(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "not present", args: []string{"issue", "list"}, want: ""},
+		{name: "space separated", args: []string{"issue", "list", "--log-file", "out.log"}, want: "out.log"},
+		{name: "equals form", args: []string{"--log-file=out.log", "issue", "list"}, want: "out.log"},
+		{name: "after end of flags marker", args: []string{"--", "--log-file", "out.log"}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := logFileFlagValue(tt.args); got != tt.want {
+				t.Errorf("logFileFlagValue() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -116,6 +116,15 @@ func Config(name string) (string, error) {
 
 }
 
+// SetGlobalConfig sets name to value in the user's global git config.
+func SetGlobalConfig(name, value string) error {
+	configCmd, err := GitCommand("config", "--global", name, value)
+	if err != nil {
+		return err
+	}
+	return run.PrepareCmd(configCmd).Run()
+}
+
 type NotInstalled struct {
 	message string
 	error
@@ -382,6 +391,36 @@ func AddUpstreamRemote(upstreamURL, cloneDir string, branches []string) error {
 	return run.PrepareCmd(cloneCmd).Run()
 }
 
+// PartialCloneArgs builds the git clone flags needed for a shallow, blobless,
+// and/or sparse clone, to be combined with any user-supplied clone arguments.
+// A non-empty sparse value only adds the cone-mode --sparse flag; populating the
+// actual sparse-checkout path list requires a follow-up call to SparseCheckoutSet.
+func PartialCloneArgs(depth int, filter string, sparse bool) []string {
+	var args []string
+	if depth > 0 {
+		args = append(args, fmt.Sprintf("--depth=%d", depth))
+	}
+	if filter != "" {
+		args = append(args, fmt.Sprintf("--filter=%s", filter))
+	}
+	if sparse {
+		args = append(args, "--sparse")
+	}
+	return args
+}
+
+// SparseCheckoutSet limits a sparse clone's checkout to the given paths.
+func SparseCheckoutSet(dir string, paths []string) error {
+	args := append([]string{"-C", dir, "sparse-checkout", "set"}, paths...)
+	cmd, err := GitCommand(args...)
+	if err != nil {
+		return err
+	}
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return run.PrepareCmd(cmd).Run()
+}
+
 func isFilesystemPath(p string) bool {
 	return p == "." || strings.HasPrefix(p, "./") || strings.HasPrefix(p, "/")
 }
@@ -57,7 +57,14 @@ func authFlow(oauthHost string, IO *iostreams.IOStreams, notice string, addition
 	debugEnabled, debugValue := utils.IsDebugEnabled()
 	if debugEnabled {
 		logTraffic := strings.Contains(debugValue, "api")
-		httpClient.Transport = api.VerboseLog(IO.ErrOut, logTraffic, IO.ColorEnabled())(httpClient.Transport)
+		jsonFormat := strings.Contains(debugValue, "json")
+		logOut, err := utils.DebugLogFile(IO.ErrOut)
+		if err != nil {
+			fmt.Fprintf(IO.ErrOut, "failed to open log file: %s\n", err)
+			logOut = IO.ErrOut
+		}
+		colorize := logOut == IO.ErrOut && IO.ColorEnabled()
+		httpClient.Transport = api.VerboseLog(logOut, logTraffic, colorize, jsonFormat, utils.DebugLogMaxBodyBytes())(httpClient.Transport)
 	}
 
 	minimumScopes := []string{"repo", "read:org", "gist"}
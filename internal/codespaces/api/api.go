@@ -433,6 +433,35 @@ func (a *API) StopCodespace(ctx context.Context, codespaceName string) error {
 	return nil
 }
 
+// RebuildCodespace requests that a codespace's container be rebuilt from its devcontainer
+// configuration. If full is true, the rebuild discards any cached container layers.
+func (a *API) RebuildCodespace(ctx context.Context, codespaceName string, full bool) error {
+	requestBody, err := json.Marshal(struct {
+		Full bool `json:"full"`
+	}{Full: full})
+	if err != nil {
+		return fmt.Errorf("error marshaling request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, a.githubAPI+"/user/codespaces/"+codespaceName+"/rebuild", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, "/user/codespaces/*/rebuild")
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
+
 type Machine struct {
 	Name                 string `json:"name"`
 	DisplayName          string `json:"display_name"`
@@ -727,9 +756,10 @@ func (a *API) DeleteCodespace(ctx context.Context, codespaceName string) error {
 }
 
 type EditCodespaceParams struct {
-	DisplayName        string `json:"display_name,omitempty"`
-	IdleTimeoutMinutes int    `json:"idle_timeout_minutes,omitempty"`
-	Machine            string `json:"machine,omitempty"`
+	DisplayName            string `json:"display_name,omitempty"`
+	IdleTimeoutMinutes     int    `json:"idle_timeout_minutes,omitempty"`
+	Machine                string `json:"machine,omitempty"`
+	RetentionPeriodMinutes int    `json:"retention_period_minutes,omitempty"`
 }
 
 func (a *API) EditCodespace(ctx context.Context, codespaceName string, params *EditCodespaceParams) (*Codespace, error) {
@@ -782,6 +812,163 @@ func (a *API) EditCodespace(ctx context.Context, codespaceName string, params *E
 	return &response, nil
 }
 
+// PrebuildConfiguration describes a prebuild configuration for a repository,
+// as surfaced by the codespaces prebuilds REST API.
+type PrebuildConfiguration struct {
+	ID                int64  `json:"id"`
+	Branch            string `json:"branch"`
+	DevcontainerPath  string `json:"devcontainer_path"`
+	Region            string `json:"region"`
+	PrebuildScheduled bool   `json:"prebuild_scheduled"`
+}
+
+// PrebuildRun describes the status of a single execution of a prebuild configuration.
+type PrebuildRun struct {
+	ID        int64  `json:"id"`
+	State     string `json:"state"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ListPrebuildConfigurations lists the prebuild configurations defined for a repository.
+func (a *API) ListPrebuildConfigurations(ctx context.Context, nwo string) ([]*PrebuildConfiguration, error) {
+	req, err := http.NewRequest(http.MethodGet, a.githubAPI+"/repos/"+nwo+"/codespaces/prebuildConfigurations", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, "/repos/*/codespaces/prebuildConfigurations")
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	var response []*PrebuildConfiguration
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+	return response, nil
+}
+
+// CreatePrebuildConfiguration creates a new prebuild configuration for a repository.
+func (a *API) CreatePrebuildConfiguration(ctx context.Context, nwo string, params *PrebuildConfiguration) (*PrebuildConfiguration, error) {
+	requestBody, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, a.githubAPI+"/repos/"+nwo+"/codespaces/prebuildConfigurations", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, "/repos/*/codespaces/prebuildConfigurations")
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	var response PrebuildConfiguration
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+	return &response, nil
+}
+
+// DeletePrebuildConfiguration deletes a prebuild configuration by ID.
+func (a *API) DeletePrebuildConfiguration(ctx context.Context, nwo string, id int64) error {
+	req, err := http.NewRequest(http.MethodDelete, a.githubAPI+"/repos/"+nwo+"/codespaces/prebuildConfigurations/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, "/repos/*/codespaces/prebuildConfigurations/*")
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return api.HandleHTTPError(resp)
+	}
+	return nil
+}
+
+// ListPrebuildRuns lists the run history of a prebuild configuration.
+func (a *API) ListPrebuildRuns(ctx context.Context, nwo string, id int64) ([]*PrebuildRun, error) {
+	req, err := http.NewRequest(http.MethodGet, a.githubAPI+"/repos/"+nwo+"/codespaces/prebuildConfigurations/"+strconv.FormatInt(id, 10)+"/runs", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, "/repos/*/codespaces/prebuildConfigurations/*/runs")
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	var response []*PrebuildRun
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+	return response, nil
+}
+
+// TriggerPrebuildRun triggers a new run of a prebuild configuration.
+func (a *API) TriggerPrebuildRun(ctx context.Context, nwo string, id int64) (*PrebuildRun, error) {
+	req, err := http.NewRequest(http.MethodPost, a.githubAPI+"/repos/"+nwo+"/codespaces/prebuildConfigurations/"+strconv.FormatInt(id, 10)+"/runs", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, "/repos/*/codespaces/prebuildConfigurations/*/runs")
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	var response PrebuildRun
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+	return &response, nil
+}
+
 func (a *API) checkForPendingOperation(ctx context.Context, codespaceName string) (bool, string, error) {
 	codespace, err := a.GetCodespace(ctx, codespaceName, false)
 	if err != nil {
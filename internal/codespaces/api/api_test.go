@@ -473,3 +473,51 @@ func TestAPI_EditCodespacePendingOperation(t *testing.T) {
 		t.Errorf("Expected pending operation error, but got %v", err)
 	}
 }
+
+func TestAPI_ListPrebuildConfigurations(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/monalisa/spoon-knife/codespaces/prebuildConfigurations" {
+			t.Fatal("Incorrect path")
+		}
+		data, _ := json.Marshal([]*PrebuildConfiguration{
+			{ID: 1, Branch: "main", DevcontainerPath: ".devcontainer/devcontainer.json"},
+		})
+		fmt.Fprint(w, string(data))
+	}))
+	defer svr.Close()
+
+	a := &API{
+		client:    &http.Client{},
+		githubAPI: svr.URL,
+	}
+
+	configs, err := a.ListPrebuildConfigurations(context.Background(), "monalisa/spoon-knife")
+	if err != nil {
+		t.Fatalf("ListPrebuildConfigurations() error = %v", err)
+	}
+	if len(configs) != 1 || configs[0].Branch != "main" {
+		t.Errorf("unexpected prebuild configurations: %+v", configs)
+	}
+}
+
+func TestAPI_RebuildCodespace(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/codespaces/monalisa-spoonknife-abcd/rebuild" {
+			t.Fatal("Incorrect path")
+		}
+		if r.Method != http.MethodPost {
+			t.Fatal("Incorrect method")
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer svr.Close()
+
+	a := &API{
+		client:    &http.Client{},
+		githubAPI: svr.URL,
+	}
+
+	if err := a.RebuildCodespace(context.Background(), "monalisa-spoonknife-abcd", true); err != nil {
+		t.Fatalf("RebuildCodespace() error = %v", err)
+	}
+}
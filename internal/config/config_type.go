@@ -19,6 +19,7 @@ type Config interface {
 	DefaultHost() (string, error)
 	DefaultHostWithSource() (string, string, error)
 	Aliases() (*AliasConfig, error)
+	Queries() (*QueriesConfig, error)
 	CheckWriteable(string, string) error
 	Write() error
 }
@@ -63,6 +64,11 @@ var configOptions = []ConfigOption{
 		Description:  "the web browser to use for opening URLs",
 		DefaultValue: "",
 	},
+	{
+		Key:          "telemetry_endpoint",
+		Description:  "a URL to send anonymized command-usage and latency events to; leave blank to disable",
+		DefaultValue: "",
+	},
 }
 
 func ConfigOptions() []ConfigOption {
@@ -210,6 +216,15 @@ func NewBlankRoot() *yaml.Node {
 						Kind:  yaml.ScalarNode,
 						Value: "",
 					},
+					{
+						HeadComment: "A URL to send anonymized command-usage and latency events to. If blank, no telemetry is sent.",
+						Kind:        yaml.ScalarNode,
+						Value:       "telemetry_endpoint",
+					},
+					{
+						Kind:  yaml.ScalarNode,
+						Value: "",
+					},
 				},
 			},
 		},
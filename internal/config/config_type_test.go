@@ -54,6 +54,8 @@ func Test_defaultConfig(t *testing.T) {
 		http_unix_socket:
 		# What web browser gh should use when opening URLs. If blank, will refer to environment.
 		browser:
+		# A URL to send anonymized command-usage and latency events to. If blank, no telemetry is sent.
+		telemetry_endpoint:
 	`)
 	assert.Equal(t, expected, mainBuf.String())
 	assert.Equal(t, "", hostsBuf.String())
@@ -75,6 +77,10 @@ func Test_defaultConfig(t *testing.T) {
 	browser, err := cfg.Get("", "browser")
 	assert.NoError(t, err)
 	assert.Equal(t, "", browser)
+
+	telemetryEndpoint, err := cfg.Get("", "telemetry_endpoint")
+	assert.NoError(t, err)
+	assert.Equal(t, "", telemetryEndpoint)
 }
 
 func Test_ValidateValue(t *testing.T) {
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SavedQuery is a named GitHub search query, persisted under the "queries" config section so it
+// can be reused across "gh search" and the "--filter" flags of "gh issue list"/"gh pr list".
+type SavedQuery struct {
+	Type  string `json:"type"`
+	Query string `json:"query"`
+}
+
+type QueriesConfig struct {
+	ConfigMap
+	Parent Config
+}
+
+func (q *QueriesConfig) Get(name string) (*SavedQuery, bool) {
+	if q.Empty() {
+		return nil, false
+	}
+	value, _ := q.GetStringValue(name)
+	if value == "" {
+		return nil, false
+	}
+
+	var sq SavedQuery
+	if err := json.Unmarshal([]byte(value), &sq); err != nil {
+		return nil, false
+	}
+	return &sq, true
+}
+
+func (q *QueriesConfig) Add(name, queryType, query string) error {
+	data, err := json.Marshal(SavedQuery{Type: queryType, Query: query})
+	if err != nil {
+		return err
+	}
+
+	if err := q.SetStringValue(name, string(data)); err != nil {
+		return fmt.Errorf("failed to update config: %w", err)
+	}
+
+	if err := q.Parent.Write(); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+
+	return nil
+}
+
+func (q *QueriesConfig) Delete(name string) error {
+	q.RemoveEntry(name)
+
+	if err := q.Parent.Write(); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+
+	return nil
+}
+
+func (q *QueriesConfig) All() map[string]*SavedQuery {
+	out := map[string]*SavedQuery{}
+
+	if q.Empty() {
+		return out
+	}
+
+	for i := 0; i < len(q.Root.Content)-1; i += 2 {
+		name := q.Root.Content[i].Value
+		value := q.Root.Content[i+1].Value
+		var sq SavedQuery
+		if err := json.Unmarshal([]byte(value), &sq); err != nil {
+			continue
+		}
+		out[name] = &sq
+	}
+
+	return out
+}
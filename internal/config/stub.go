@@ -51,6 +51,10 @@ func (c ConfigStub) Aliases() (*AliasConfig, error) {
 	return nil, nil
 }
 
+func (c ConfigStub) Queries() (*QueriesConfig, error) {
+	return nil, nil
+}
+
 func (c ConfigStub) Hosts() ([]string, error) {
 	return nil, nil
 }
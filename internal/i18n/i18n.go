@@ -0,0 +1,85 @@
+// Package i18n provides a minimal message catalog for translating gh's human-readable prompts
+// and help text. It intentionally only covers messages that are safe to localize: --json output
+// and machine-readable error identifiers must stay in English so scripts can keep relying on
+// them, and are never passed through T.
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// catalogs maps a locale code to a table of English source strings and their translations. New
+// languages are added here as they're contributed; a source string with no entry for the current
+// locale, or a locale with no catalog at all, falls back to the untranslated English text.
+var catalogs = map[string]map[string]string{
+	"es": {
+		"GitHub CLI": "CLI de GitHub",
+		"Work seamlessly with GitHub from the command line.": "Trabaja sin problemas con GitHub desde la línea de comandos.",
+		"Welcome to GitHub CLI!":                             "¡Bienvenido a GitHub CLI!",
+		"To authenticate, please run `gh auth login`.":       "Para autenticarte, ejecuta `gh auth login`.",
+	},
+	"fr": {
+		"GitHub CLI": "CLI GitHub",
+		"Work seamlessly with GitHub from the command line.": "Travaillez avec GitHub directement depuis la ligne de commande.",
+		"Welcome to GitHub CLI!":                             "Bienvenue dans GitHub CLI !",
+		"To authenticate, please run `gh auth login`.":       "Pour vous authentifier, exécutez `gh auth login`.",
+	},
+}
+
+var (
+	localeOnce sync.Once
+	locale     string
+)
+
+// Locale returns the language gh should use for translated output: GH_LANG if set, otherwise the
+// first of LC_ALL, LC_MESSAGES, LANG, and LANGUAGE that's set, otherwise "en". The result is
+// cached for the life of the process, matching the other environment-derived settings gh reads
+// once at startup (see GH_FORCE_TTY in cmd/gh/main.go).
+func Locale() string {
+	localeOnce.Do(func() {
+		locale = detectLocale()
+	})
+	return locale
+}
+
+func detectLocale() string {
+	if lang := os.Getenv("GH_LANG"); lang != "" {
+		return normalize(lang)
+	}
+	for _, envVar := range []string{"LC_ALL", "LC_MESSAGES", "LANG", "LANGUAGE"} {
+		if v := os.Getenv(envVar); v != "" {
+			return normalize(v)
+		}
+	}
+	return "en"
+}
+
+// normalize reduces a POSIX-style locale value such as "fr_FR.UTF-8" or "pt-BR" down to its
+// base language code, e.g. "fr".
+func normalize(v string) string {
+	v = strings.SplitN(v, ".", 2)[0]
+	fields := strings.FieldsFunc(v, func(r rune) bool { return r == '_' || r == '-' })
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToLower(fields[0])
+}
+
+// T translates English source text into the current locale, if a translation is available, and
+// formats the result with fmt.Sprintf. source doubles as the catalog key and the English fallback,
+// so call sites read naturally even when no translation exists yet.
+func T(source string, args ...interface{}) string {
+	text := source
+	if catalog, ok := catalogs[Locale()]; ok {
+		if translated, ok := catalog[source]; ok {
+			text = translated
+		}
+	}
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
@@ -0,0 +1,109 @@
+package i18n
+
+import (
+	"os"
+	"sync"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"fr", "fr"},
+		{"fr_FR.UTF-8", "fr"},
+		{"pt-BR", "pt"},
+		{"C", "c"},
+		{"_", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := normalize(tt.in); got != tt.want {
+				t.Errorf("normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectLocale(t *testing.T) {
+	for _, v := range []string{"GH_LANG", "LC_ALL", "LC_MESSAGES", "LANG", "LANGUAGE"} {
+		old, ok := os.LookupEnv(v)
+		os.Unsetenv(v)
+		if ok {
+			defer os.Setenv(v, old)
+		}
+	}
+
+	if got := detectLocale(); got != "en" {
+		t.Errorf("detectLocale() with nothing set = %q, want %q", got, "en")
+	}
+
+	os.Setenv("LANG", "fr_FR.UTF-8")
+	defer os.Unsetenv("LANG")
+	if got := detectLocale(); got != "fr" {
+		t.Errorf("detectLocale() with LANG set = %q, want %q", got, "fr")
+	}
+
+	os.Setenv("GH_LANG", "es")
+	defer os.Unsetenv("GH_LANG")
+	if got := detectLocale(); got != "es" {
+		t.Errorf("detectLocale() with GH_LANG set = %q, want %q", got, "es")
+	}
+}
+
+func TestT(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale string
+		source string
+		args   []interface{}
+		want   string
+	}{
+		{
+			name:   "translated",
+			locale: "fr",
+			source: "Welcome to GitHub CLI!",
+			want:   "Bienvenue dans GitHub CLI !",
+		},
+		{
+			name:   "missing translation falls back to English",
+			locale: "fr",
+			source: "some untranslated string",
+			want:   "some untranslated string",
+		},
+		{
+			name:   "unsupported locale falls back to English",
+			locale: "xx",
+			source: "GitHub CLI",
+			want:   "GitHub CLI",
+		},
+		{
+			name:   "formats with args",
+			locale: "en",
+			source: "hello %s",
+			args:   []interface{}{"world"},
+			want:   "hello world",
+		},
+	}
+
+	old, hadGHLang := os.LookupEnv("GH_LANG")
+	defer func() {
+		if hadGHLang {
+			os.Setenv("GH_LANG", old)
+		} else {
+			os.Unsetenv("GH_LANG")
+		}
+		localeOnce = sync.Once{}
+	}()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv("GH_LANG", tt.locale)
+			localeOnce = sync.Once{}
+			if got := T(tt.source, tt.args...); got != tt.want {
+				t.Errorf("T(%q) = %q, want %q", tt.source, got, tt.want)
+			}
+		})
+	}
+}
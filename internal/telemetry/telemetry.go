@@ -0,0 +1,46 @@
+// Package telemetry sends anonymized command-usage events to a
+// user-configured endpoint. It is disabled unless the telemetry_endpoint
+// config key is set, and never includes anything beyond the invoked command
+// path, its outcome, and how long it took to run.
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Event describes a single command invocation.
+type Event struct {
+	Command  string `json:"command"`
+	Duration int64  `json:"duration_ms"`
+	Success  bool   `json:"success"`
+}
+
+// Record sends ev to endpoint as a JSON POST body. Failures to deliver the
+// event are silently ignored; telemetry must never be the reason a command
+// fails or hangs.
+func Record(endpoint string, ev Event) {
+	if endpoint == "" {
+		return
+	}
+
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return
+	}
+
+	client := &http.Client{Timeout: 3 * time.Second}
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,45 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord(t *testing.T) {
+	var gotBody []byte
+	var gotMethod, gotContentType string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotContentType = r.Header.Get("Content-Type")
+		gotBody, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	Record(ts.URL, Event{Command: "pr create", Duration: 42, Success: true})
+
+	assert.Equal(t, "POST", gotMethod)
+	assert.Equal(t, "application/json", gotContentType)
+
+	var ev Event
+	require.NoError(t, json.Unmarshal(gotBody, &ev))
+	assert.Equal(t, Event{Command: "pr create", Duration: 42, Success: true}, ev)
+}
+
+func TestRecord_noEndpoint(t *testing.T) {
+	called := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer ts.Close()
+
+	Record("", Event{Command: "pr create", Duration: 42, Success: true})
+
+	assert.False(t, called)
+}
@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	configCmd "github.com/cli/cli/v2/pkg/cmd/admin/config"
+	licenseCmd "github.com/cli/cli/v2/pkg/cmd/admin/license"
+	maintenanceCmd "github.com/cli/cli/v2/pkg/cmd/admin/maintenance"
+	userCmd "github.com/cli/cli/v2/pkg/cmd/admin/user"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdAdmin(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin <command>",
+		Short: "Administer a GitHub Enterprise Server instance",
+		Long: heredoc.Doc(`
+			Work with a GitHub Enterprise Server instance's maintenance mode,
+			configuration status, user suspension, and license.
+
+			These commands only work against a GitHub Enterprise Server
+			instance, not github.com or GitHub Enterprise Cloud. Maintenance
+			mode, configuration status, and license commands talk to the
+			instance's Manage API over port 8443 and require the management
+			console password, not your regular authentication token.
+		`),
+		Example: heredoc.Doc(`
+			$ gh admin maintenance status github.example.com
+			$ gh admin config status github.example.com
+			$ gh admin user suspend github.example.com monalisa
+			$ gh admin license github.example.com
+		`),
+	}
+
+	cmd.AddCommand(maintenanceCmd.NewCmdMaintenance(f))
+	cmd.AddCommand(configCmd.NewCmdConfig(f))
+	cmd.AddCommand(userCmd.NewCmdUser(f))
+	cmd.AddCommand(licenseCmd.NewCmdLicense(f, nil))
+
+	return cmd
+}
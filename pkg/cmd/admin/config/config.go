@@ -0,0 +1,18 @@
+package config
+
+import (
+	statusCmd "github.com/cli/cli/v2/pkg/cmd/admin/config/status"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdConfig(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config <command>",
+		Short: "Check configuration status on a GitHub Enterprise Server instance",
+	}
+
+	cmd.AddCommand(statusCmd.NewCmdStatus(f, nil))
+
+	return cmd
+}
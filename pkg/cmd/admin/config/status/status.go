@@ -0,0 +1,79 @@
+package status
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/pkg/cmd/admin/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type StatusOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+
+	Hostname string
+	Password string
+}
+
+type configApplyStatus struct {
+	Status    string `json:"status"`
+	Progress  int    `json:"progress"`
+	RunNumber int    `json:"run_number"`
+}
+
+func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
+	opts := &StatusOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "status <hostname>",
+		Short: "Show the status of the most recent configuration run",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Hostname = args[0]
+
+			if err := shared.CheckEnterprise(opts.Hostname); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return statusRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Password, "password", "", "Management console `password` (prompts if not specified)")
+
+	return cmd
+}
+
+func statusRun(opts *StatusOptions) error {
+	password, err := shared.ManagementPassword(opts.IO, opts.Password)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	var cs configApplyStatus
+	if err := shared.ManageRequest(httpClient, opts.Hostname, password, "GET", "config/apply", nil, &cs); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Configuration run #%d: %s (%d%%)\n", cs.RunNumber, cs.Status, cs.Progress)
+
+	if cs.Status == "failed" {
+		return cmdutil.SilentError
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package license
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/pkg/cmd/admin/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type LicenseOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Exporter   cmdutil.Exporter
+
+	Hostname string
+	Password string
+}
+
+type license struct {
+	SeatsUsed      int    `json:"seatsUsed"`
+	SeatsAvailable int    `json:"seatsAvailable"`
+	ExpireAt       string `json:"expireAt"`
+}
+
+func (l *license) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{
+		"seatsUsed":      l.SeatsUsed,
+		"seatsAvailable": l.SeatsAvailable,
+		"expireAt":       l.ExpireAt,
+	}
+	result := map[string]interface{}{}
+	for _, f := range fields {
+		result[f] = data[f]
+	}
+	return result
+}
+
+var licenseFields = []string{"seatsUsed", "seatsAvailable", "expireAt"}
+
+func NewCmdLicense(f *cmdutil.Factory, runF func(*LicenseOptions) error) *cobra.Command {
+	opts := &LicenseOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "license <hostname>",
+		Short: "Show license seat usage and expiration",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Hostname = args[0]
+
+			if err := shared.CheckEnterprise(opts.Hostname); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return licenseRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Password, "password", "", "Management console `password` (prompts if not specified)")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, licenseFields)
+
+	return cmd
+}
+
+func licenseRun(opts *LicenseOptions) error {
+	password, err := shared.ManagementPassword(opts.IO, opts.Password)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	var l license
+	if err := shared.ManageRequest(httpClient, opts.Hostname, password, "GET", "license", nil, &l); err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, &l)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Seats used:      %d\n", l.SeatsUsed)
+	fmt.Fprintf(opts.IO.Out, "Seats available: %d\n", l.SeatsAvailable)
+	fmt.Fprintf(opts.IO.Out, "Expires:         %s\n", l.ExpireAt)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package disable
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/pkg/cmd/admin/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DisableOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+
+	Hostname string
+	Password string
+}
+
+func NewCmdDisable(f *cmdutil.Factory, runF func(*DisableOptions) error) *cobra.Command {
+	opts := &DisableOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "disable <hostname>",
+		Short: "Disable maintenance mode",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Hostname = args[0]
+
+			if err := shared.CheckEnterprise(opts.Hostname); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return disableRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Password, "password", "", "Management console `password` (prompts if not specified)")
+
+	return cmd
+}
+
+func disableRun(opts *DisableOptions) error {
+	password, err := shared.ManagementPassword(opts.IO, opts.Password)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{"enabled": false}
+	if err := shared.ManageRequest(httpClient, opts.Hostname, password, "PUT", "maintenance", body, nil); err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s Maintenance mode disabled on %s\n", cs.SuccessIcon(), opts.Hostname)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package enable
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/admin/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type EnableOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+
+	Hostname string
+	Password string
+	When     string
+}
+
+func NewCmdEnable(f *cmdutil.Factory, runF func(*EnableOptions) error) *cobra.Command {
+	opts := &EnableOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "enable <hostname>",
+		Short: "Enable maintenance mode",
+		Long: heredoc.Doc(`
+			Put a GitHub Enterprise Server instance into maintenance mode,
+			which blocks normal traffic while keeping it reachable for admins.
+		`),
+		Example: heredoc.Doc(`
+			# Enable maintenance mode immediately
+			$ gh admin maintenance enable github.example.com
+
+			# Schedule maintenance mode to begin at a specific time
+			$ gh admin maintenance enable github.example.com --when "2023-06-01 02:00:00 UTC"
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Hostname = args[0]
+
+			if err := shared.CheckEnterprise(opts.Hostname); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return enableRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Password, "password", "", "Management console `password` (prompts if not specified)")
+	cmd.Flags().StringVar(&opts.When, "when", "now", "When to enable maintenance mode (\"now\" or a timestamp)")
+
+	return cmd
+}
+
+func enableRun(opts *EnableOptions) error {
+	password, err := shared.ManagementPassword(opts.IO, opts.Password)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"enabled": true,
+		"when":    opts.When,
+	}
+	if err := shared.ManageRequest(httpClient, opts.Hostname, password, "PUT", "maintenance", body, nil); err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s Maintenance mode scheduled (%s) on %s\n", cs.SuccessIcon(), opts.When, opts.Hostname)
+
+	return nil
+}
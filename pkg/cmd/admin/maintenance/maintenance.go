@@ -0,0 +1,22 @@
+package maintenance
+
+import (
+	disableCmd "github.com/cli/cli/v2/pkg/cmd/admin/maintenance/disable"
+	enableCmd "github.com/cli/cli/v2/pkg/cmd/admin/maintenance/enable"
+	statusCmd "github.com/cli/cli/v2/pkg/cmd/admin/maintenance/status"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdMaintenance(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance <command>",
+		Short: "Manage maintenance mode on a GitHub Enterprise Server instance",
+	}
+
+	cmd.AddCommand(statusCmd.NewCmdStatus(f, nil))
+	cmd.AddCommand(enableCmd.NewCmdEnable(f, nil))
+	cmd.AddCommand(disableCmd.NewCmdDisable(f, nil))
+
+	return cmd
+}
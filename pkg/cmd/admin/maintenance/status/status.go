@@ -0,0 +1,82 @@
+package status
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/pkg/cmd/admin/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type StatusOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+
+	Hostname string
+	Password string
+}
+
+type maintenanceStatus struct {
+	Enabled       bool   `json:"enabled"`
+	ScheduledTime string `json:"scheduled_time,omitempty"`
+	IPExceptions  string `json:"ip_exception_list,omitempty"`
+}
+
+func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
+	opts := &StatusOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "status <hostname>",
+		Short: "Show whether maintenance mode is enabled",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Hostname = args[0]
+
+			if err := shared.CheckEnterprise(opts.Hostname); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return statusRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Password, "password", "", "Management console `password` (prompts if not specified)")
+
+	return cmd
+}
+
+func statusRun(opts *StatusOptions) error {
+	password, err := shared.ManagementPassword(opts.IO, opts.Password)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	var ms maintenanceStatus
+	if err := shared.ManageRequest(httpClient, opts.Hostname, password, "GET", "maintenance", nil, &ms); err != nil {
+		return err
+	}
+
+	if ms.Enabled {
+		fmt.Fprintf(opts.IO.Out, "Maintenance mode is enabled on %s\n", opts.Hostname)
+		if ms.ScheduledTime != "" {
+			fmt.Fprintf(opts.IO.Out, "Scheduled: %s\n", ms.ScheduledTime)
+		}
+	} else {
+		fmt.Fprintf(opts.IO.Out, "Maintenance mode is disabled on %s\n", opts.Hostname)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package status
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_statusRun(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "manage/v1/maintenance"),
+		httpmock.StringResponse(`{"enabled": true, "scheduled_time": "now"}`))
+
+	err := statusRun(&StatusOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Hostname: "github.example.com",
+		Password: "hunter2",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Maintenance mode is enabled on github.example.com\nScheduled: now\n", stdout.String())
+}
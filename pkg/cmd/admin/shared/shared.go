@@ -0,0 +1,92 @@
+// Package shared holds helpers for talking to a GitHub Enterprise Server
+// instance's Manage API, which is used by the `gh admin` command group.
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+)
+
+// CheckEnterprise returns an error if hostname does not look like a GitHub
+// Enterprise Server instance, since the admin command group only applies to
+// self-hosted instances.
+func CheckEnterprise(hostname string) error {
+	if !ghinstance.IsEnterprise(hostname) {
+		return fmt.Errorf("gh admin commands only work against a GitHub Enterprise Server instance, got %q", hostname)
+	}
+	return nil
+}
+
+// ManagePrefix returns the base URL for a GitHub Enterprise Server
+// instance's Manage API.
+func ManagePrefix(hostname string) string {
+	return fmt.Sprintf("https://%s:8443/manage/v1/", hostname)
+}
+
+// ManagementPassword resolves the Manage API password from the --password
+// flag, falling back to an interactive prompt.
+func ManagementPassword(io *iostreams.IOStreams, flagValue string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+
+	if !io.CanPrompt() {
+		return "", fmt.Errorf("--password required when not running interactively")
+	}
+
+	var password string
+	err := prompt.SurveyAskOne(&survey.Password{
+		Message: "Management console password",
+	}, &password)
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprintln(io.Out)
+	return password, nil
+}
+
+// ManageRequest makes an authenticated request against a GitHub Enterprise
+// Server instance's Manage API, which uses HTTP basic auth with the
+// management console password rather than a token.
+func ManageRequest(httpClient *http.Client, hostname, password, method, path string, body, out interface{}) error {
+	var reqBody *bytes.Buffer
+	if body != nil {
+		reqBody = &bytes.Buffer{}
+		if err := json.NewEncoder(reqBody).Encode(body); err != nil {
+			return err
+		}
+	} else {
+		reqBody = &bytes.Buffer{}
+	}
+
+	url := ManagePrefix(hostname) + path
+	req, err := http.NewRequest(method, url, reqBody)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth("api_key", password)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	if out != nil {
+		return json.NewDecoder(resp.Body).Decode(out)
+	}
+	return nil
+}
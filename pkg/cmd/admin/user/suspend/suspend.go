@@ -0,0 +1,90 @@
+package suspend
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/pkg/cmd/admin/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type SuspendOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+
+	Hostname string
+	Username string
+	Reason   string
+}
+
+func NewCmdSuspend(f *cmdutil.Factory, runF func(*SuspendOptions) error) *cobra.Command {
+	opts := &SuspendOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "suspend <hostname> <username>",
+		Short: "Suspend a user",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Hostname = args[0]
+			opts.Username = args[1]
+
+			if err := shared.CheckEnterprise(opts.Hostname); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return suspendRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Reason, "reason", "", "Reason for the suspension")
+
+	return cmd
+}
+
+func suspendRun(opts *SuspendOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	body := &bytes.Buffer{}
+	if opts.Reason != "" {
+		if err := json.NewEncoder(body).Encode(map[string]string{"reason": opts.Reason}); err != nil {
+			return err
+		}
+	}
+
+	url := fmt.Sprintf("%susers/%s/suspended", ghinstance.RESTPrefix(opts.Hostname), opts.Username)
+	req, err := http.NewRequest("PUT", url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s Suspended %s on %s\n", cs.SuccessIcon(), opts.Username, opts.Hostname)
+
+	return nil
+}
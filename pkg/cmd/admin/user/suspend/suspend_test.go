@@ -0,0 +1,32 @@
+package suspend
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_suspendRun(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("PUT", "api/v3/users/monalisa/suspended"),
+		httpmock.StringResponse(``))
+
+	err := suspendRun(&SuspendOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Hostname: "github.example.com",
+		Username: "monalisa",
+		Reason:   "policy violation",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Suspended monalisa on github.example.com\n", stdout.String())
+}
@@ -0,0 +1,77 @@
+package unsuspend
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/pkg/cmd/admin/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UnsuspendOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+
+	Hostname string
+	Username string
+}
+
+func NewCmdUnsuspend(f *cmdutil.Factory, runF func(*UnsuspendOptions) error) *cobra.Command {
+	opts := &UnsuspendOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unsuspend <hostname> <username>",
+		Short: "Unsuspend a user",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Hostname = args[0]
+			opts.Username = args[1]
+
+			if err := shared.CheckEnterprise(opts.Hostname); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return unsuspendRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func unsuspendRun(opts *UnsuspendOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%susers/%s/suspended", ghinstance.RESTPrefix(opts.Hostname), opts.Username)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s Unsuspended %s on %s\n", cs.SuccessIcon(), opts.Username, opts.Hostname)
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package user
+
+import (
+	suspendCmd "github.com/cli/cli/v2/pkg/cmd/admin/user/suspend"
+	unsuspendCmd "github.com/cli/cli/v2/pkg/cmd/admin/user/unsuspend"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdUser(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user <command>",
+		Short: "Suspend or unsuspend users on a GitHub Enterprise Server instance",
+	}
+
+	cmd.AddCommand(suspendCmd.NewCmdSuspend(f, nil))
+	cmd.AddCommand(unsuspendCmd.NewCmdUnsuspend(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdInstall "github.com/cli/cli/v2/pkg/cmd/app/install"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/app/list"
+	cmdUninstall "github.com/cli/cli/v2/pkg/cmd/app/uninstall"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/app/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdApp(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "app <command>",
+		Short: "Inspect and manage installed GitHub Apps",
+		Long:  "Review which GitHub Apps are installed in an organization, what they can access, and manage their repository access.",
+		Example: heredoc.Doc(`
+			$ gh app list --org my-org
+			$ gh app view --org my-org my-app
+			$ gh app install --org my-org my-app --repo owner/repo
+		`),
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdInstall.NewCmdInstall(f, nil))
+	cmd.AddCommand(cmdUninstall.NewCmdUninstall(f, nil))
+
+	return cmd
+}
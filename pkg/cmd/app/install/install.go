@@ -0,0 +1,116 @@
+package install
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/app/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type InstallOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org  string
+	App  string
+	Repo string
+}
+
+func NewCmdInstall(f *cmdutil.Factory, runF func(*InstallOptions) error) *cobra.Command {
+	opts := &InstallOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "install <app-slug>",
+		Short: "Grant a GitHub App access to a repository",
+		Long: heredoc.Doc(`
+			Grant a GitHub App installed in an organization access to a repository.
+
+			This only works for apps whose installation is already scoped to
+			"selected repositories"; GitHub's API has no way to install an app
+			across an entire organization on your behalf.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.App = args[0]
+
+			if opts.Org == "" {
+				return cmdutil.FlagErrorf("`--org` is required")
+			}
+			if opts.Repo == "" {
+				return cmdutil.FlagErrorf("`--repo` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return installRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Org, "org", "", "The organization the app is installed in")
+	cmd.Flags().StringVar(&opts.Repo, "repo", "", "The `owner/repo` to grant the app access to")
+
+	return cmd
+}
+
+func installRun(opts *InstallOptions) error {
+	repo, err := ghrepo.FromFullName(opts.Repo)
+	if err != nil {
+		return cmdutil.FlagErrorf("argument error: %w", err)
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	installations, err := shared.ListInstallations(httpClient, host, opts.Org)
+	if err != nil {
+		return fmt.Errorf("failed to list installed apps: %w", err)
+	}
+
+	installation, err := shared.FindInstallation(installations, opts.Org, opts.App)
+	if err != nil {
+		return err
+	}
+	if installation.RepositorySelection != "selected" {
+		return fmt.Errorf("app %q is installed with %q repository access; its access can't be changed through this API", opts.App, installation.RepositorySelection)
+	}
+
+	repositoryID, err := shared.RepositoryDatabaseID(httpClient, host, repo)
+	if err != nil {
+		return err
+	}
+
+	if err := shared.SetRepositoryAccess(httpClient, host, installation.ID, repositoryID, true); err != nil {
+		return fmt.Errorf("failed to grant %s access to %s: %w", opts.App, ghrepo.FullName(repo), err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Granted %s access to %s\n", cs.SuccessIcon(), opts.App, ghrepo.FullName(repo))
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package install
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstallRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       InstallOptions
+		httpStubs  func(t *testing.T, reg *httpmock.Registry)
+		wantStdout string
+		wantErr    string
+	}{
+		{
+			name: "grants access",
+			opts: InstallOptions{Org: "my-org", App: "code-scanner", Repo: "my-org/repo1"},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/installations"),
+					httpmock.StringResponse(`{"installations": [
+						{"id": 99, "app_slug": "code-scanner", "repository_selection": "selected"}
+					]}`),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/my-org/repo1"),
+					httpmock.StringResponse(`{"id": 1234}`),
+				)
+				reg.Register(
+					httpmock.REST("PUT", "user/installations/99/repositories/1234"),
+					httpmock.StringResponse(``),
+				)
+			},
+			wantStdout: "✓ Granted code-scanner access to my-org/repo1\n",
+		},
+		{
+			name: "app installed org-wide",
+			opts: InstallOptions{Org: "my-org", App: "dependabot", Repo: "my-org/repo1"},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/installations"),
+					httpmock.StringResponse(`{"installations": [
+						{"id": 1, "app_slug": "dependabot", "repository_selection": "all"}
+					]}`),
+				)
+			},
+			wantErr: `app "dependabot" is installed with "all" repository access; its access can't be changed through this API`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(true)
+
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+			defer reg.Verify(t)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HTTPClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.Config = func() (config.Config, error) { return config.NewBlankConfig(), nil }
+
+			err := installRun(&opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
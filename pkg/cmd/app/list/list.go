@@ -0,0 +1,120 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/app/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	Exporter   cmdutil.Exporter
+
+	Org string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List GitHub Apps installed in an organization",
+		Long: heredoc.Doc(`
+			List the GitHub Apps installed in an organization, along with the scope of
+			repository access each one was granted.
+
+			Requires an access token with admin rights to the organization.
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Org == "" {
+				return cmdutil.FlagErrorf("`--org` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Org, "org", "", "List apps installed in this organization")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.InstallationFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	installations, err := shared.ListInstallations(httpClient, host, opts.Org)
+	if err != nil {
+		return fmt.Errorf("failed to list installed apps: %w", err)
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, installations)
+	}
+
+	if len(installations) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "No apps installed in %s\n", opts.Org)
+		}
+		return cmdutil.SilentError
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, installation := range installations {
+		tp.AddField(installation.AppSlug, nil, cs.Bold)
+		tp.AddField(installation.RepositorySelection, nil, nil)
+		tp.AddField(permissionsSummary(installation.Permissions), nil, cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func permissionsSummary(permissions map[string]string) string {
+	names := make([]string, 0, len(permissions))
+	for name := range permissions {
+		names = append(names, fmt.Sprintf("%s:%s", name, permissions[name]))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,170 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type Installation struct {
+	ID                  int64             `json:"id"`
+	AppID               int64             `json:"app_id"`
+	AppSlug             string            `json:"app_slug"`
+	Account             InstallationUser  `json:"account"`
+	RepositorySelection string            `json:"repository_selection"`
+	Permissions         map[string]string `json:"permissions"`
+	HTMLURL             string            `json:"html_url"`
+}
+
+type InstallationUser struct {
+	Login string `json:"login"`
+}
+
+func (i Installation) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "id":
+			data[f] = i.ID
+		case "appId":
+			data[f] = i.AppID
+		case "appSlug":
+			data[f] = i.AppSlug
+		case "account":
+			data[f] = i.Account.Login
+		case "repositorySelection":
+			data[f] = i.RepositorySelection
+		case "permissions":
+			data[f] = i.Permissions
+		case "url":
+			data[f] = i.HTMLURL
+		}
+	}
+	return data
+}
+
+var InstallationFields = []string{"id", "appId", "appSlug", "account", "repositorySelection", "permissions", "url"}
+
+// ListInstallations fetches every GitHub App installed in an organization.
+func ListInstallations(httpClient *http.Client, host, org string) ([]Installation, error) {
+	url := ghinstance.RESTPrefix(host) + fmt.Sprintf("orgs/%s/installations?per_page=100", org)
+
+	var installations []Installation
+	for url != "" {
+		page, next, err := getInstallationsPage(httpClient, url)
+		if err != nil {
+			return nil, err
+		}
+		installations = append(installations, page...)
+		url = next
+	}
+	return installations, nil
+}
+
+func getInstallationsPage(httpClient *http.Client, url string) ([]Installation, string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, "", api.HandleHTTPError(resp)
+	}
+
+	var page struct {
+		Installations []Installation `json:"installations"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, "", err
+	}
+
+	return page.Installations, findNextPage(resp.Header.Get("Link")), nil
+}
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func findNextPage(link string) string {
+	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+// FindInstallation returns the installation for the app with the given slug, or a
+// descriptive error if it's not installed in the organization.
+func FindInstallation(installations []Installation, org, slug string) (*Installation, error) {
+	for i := range installations {
+		if installations[i].AppSlug == slug {
+			return &installations[i], nil
+		}
+	}
+	return nil, fmt.Errorf("app %q is not installed in %s", slug, org)
+}
+
+// SetRepositoryAccess adds or removes a repository from an app installation's access list.
+// This only works for installations with RepositorySelection == "selected"; GitHub has no API
+// to install an app org-wide or change its selection mode on behalf of the user.
+func SetRepositoryAccess(httpClient *http.Client, host string, installationID, repositoryID int64, grant bool) error {
+	method := "PUT"
+	if !grant {
+		method = "DELETE"
+	}
+
+	url := fmt.Sprintf("%suser/installations/%d/repositories/%d", ghinstance.RESTPrefix(host), installationID, repositoryID)
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+	return nil
+}
+
+// RepositoryDatabaseID looks up a repository's numeric (REST) ID, which the app installation
+// endpoints require instead of the GraphQL node ID.
+func RepositoryDatabaseID(httpClient *http.Client, host string, repo ghrepo.Interface) (int64, error) {
+	url := fmt.Sprintf("%srepos/%s/%s", ghinstance.RESTPrefix(host), repo.RepoOwner(), repo.RepoName())
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return 0, api.HandleHTTPError(resp)
+	}
+
+	var result struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+	return result.ID, nil
+}
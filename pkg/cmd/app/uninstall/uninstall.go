@@ -0,0 +1,115 @@
+package uninstall
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/app/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UninstallOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org  string
+	App  string
+	Repo string
+}
+
+func NewCmdUninstall(f *cmdutil.Factory, runF func(*UninstallOptions) error) *cobra.Command {
+	opts := &UninstallOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "uninstall <app-slug>",
+		Short: "Revoke a GitHub App's access to a repository",
+		Long: heredoc.Doc(`
+			Revoke a GitHub App installed in an organization's access to a repository.
+
+			This only works for apps whose installation is scoped to "selected
+			repositories"; it does not remove the app's installation itself.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.App = args[0]
+
+			if opts.Org == "" {
+				return cmdutil.FlagErrorf("`--org` is required")
+			}
+			if opts.Repo == "" {
+				return cmdutil.FlagErrorf("`--repo` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return uninstallRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Org, "org", "", "The organization the app is installed in")
+	cmd.Flags().StringVar(&opts.Repo, "repo", "", "The `owner/repo` to revoke the app's access to")
+
+	return cmd
+}
+
+func uninstallRun(opts *UninstallOptions) error {
+	repo, err := ghrepo.FromFullName(opts.Repo)
+	if err != nil {
+		return cmdutil.FlagErrorf("argument error: %w", err)
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	installations, err := shared.ListInstallations(httpClient, host, opts.Org)
+	if err != nil {
+		return fmt.Errorf("failed to list installed apps: %w", err)
+	}
+
+	installation, err := shared.FindInstallation(installations, opts.Org, opts.App)
+	if err != nil {
+		return err
+	}
+	if installation.RepositorySelection != "selected" {
+		return fmt.Errorf("app %q is installed with %q repository access; its access can't be changed through this API", opts.App, installation.RepositorySelection)
+	}
+
+	repositoryID, err := shared.RepositoryDatabaseID(httpClient, host, repo)
+	if err != nil {
+		return err
+	}
+
+	if err := shared.SetRepositoryAccess(httpClient, host, installation.ID, repositoryID, false); err != nil {
+		return fmt.Errorf("failed to revoke %s's access to %s: %w", opts.App, ghrepo.FullName(repo), err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Revoked %s's access to %s\n", cs.SuccessIcon(), opts.App, ghrepo.FullName(repo))
+	}
+
+	return nil
+}
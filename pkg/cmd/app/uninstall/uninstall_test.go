@@ -0,0 +1,49 @@
+package uninstall
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUninstallRun(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/installations"),
+		httpmock.StringResponse(`{"installations": [
+			{"id": 99, "app_slug": "code-scanner", "repository_selection": "selected"}
+		]}`),
+	)
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/repo1"),
+		httpmock.StringResponse(`{"id": 1234}`),
+	)
+	reg.Register(
+		httpmock.REST("DELETE", "user/installations/99/repositories/1234"),
+		httpmock.StringResponse(``),
+	)
+
+	opts := UninstallOptions{
+		Org:  "my-org",
+		App:  "code-scanner",
+		Repo: "my-org/repo1",
+		IO:   io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) { return config.NewBlankConfig(), nil },
+	}
+
+	err := uninstallRun(&opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Revoked code-scanner's access to my-org/repo1\n", stdout.String())
+}
@@ -0,0 +1,54 @@
+package view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+// installationRepos lists the repositories an installation has access to. GitHub only exposes
+// this over the "list repositories accessible to the app installation" endpoint, which requires
+// a user access token belonging to whoever installed the app; fine-grained org tokens and most
+// installation tokens get a 403/404, which we treat as "can't tell" rather than a hard failure.
+func installationRepos(httpClient *http.Client, host string, installationID int64) ([]string, error) {
+	url := ghinstance.RESTPrefix(host) + "user/installations/" + strconv.FormatInt(installationID, 10) + "/repositories?per_page=100"
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		err := api.HandleHTTPError(resp)
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && (httpErr.StatusCode == 403 || httpErr.StatusCode == 404) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var page struct {
+		Repositories []struct {
+			FullName string `json:"full_name"`
+		} `json:"repositories"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(page.Repositories))
+	for i, r := range page.Repositories {
+		names[i] = r.FullName
+	}
+	return names, nil
+}
@@ -0,0 +1,120 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/app/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org string
+	App string
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <app-slug>",
+		Short: "View a GitHub App installed in an organization",
+		Long: heredoc.Doc(`
+			View the permissions and repository access granted to a GitHub App
+			installed in an organization.
+
+			Requires an access token with admin rights to the organization.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.App = args[0]
+
+			if opts.Org == "" {
+				return cmdutil.FlagErrorf("`--org` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Org, "org", "", "The organization the app is installed in")
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	installations, err := shared.ListInstallations(httpClient, host, opts.Org)
+	if err != nil {
+		return fmt.Errorf("failed to list installed apps: %w", err)
+	}
+
+	installation, err := shared.FindInstallation(installations, opts.Org, opts.App)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s\n", cs.Bold(installation.AppSlug))
+	fmt.Fprintf(out, "Installation ID: %d\n", installation.ID)
+	fmt.Fprintf(out, "Repository access: %s\n", installation.RepositorySelection)
+	fmt.Fprintf(out, "URL: %s\n\n", installation.HTMLURL)
+
+	fmt.Fprintln(out, cs.Bold("Permissions"))
+	permissionNames := make([]string, 0, len(installation.Permissions))
+	for name := range installation.Permissions {
+		permissionNames = append(permissionNames, name)
+	}
+	sort.Strings(permissionNames)
+	for _, name := range permissionNames {
+		fmt.Fprintf(out, "  %s: %s\n", name, installation.Permissions[name])
+	}
+
+	if installation.RepositorySelection == "selected" {
+		repos, err := installationRepos(httpClient, host, installation.ID)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, cs.Bold("\nRepositories"))
+		if len(repos) == 0 {
+			fmt.Fprintln(out, "  (repository list unavailable with this token)")
+		}
+		for _, repo := range repos {
+			fmt.Fprintf(out, "  %s\n", repo)
+		}
+	}
+
+	return nil
+}
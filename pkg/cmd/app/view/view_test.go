@@ -0,0 +1,77 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ViewOptions
+		httpStubs  func(t *testing.T, reg *httpmock.Registry)
+		wantStdout string
+		wantErr    string
+	}{
+		{
+			name: "selected repos",
+			opts: ViewOptions{Org: "my-org", App: "code-scanner"},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/installations"),
+					httpmock.StringResponse(`{"installations": [
+						{"id": 99, "app_slug": "code-scanner", "repository_selection": "selected", "html_url": "https://github.com/organizations/my-org/settings/installations/99", "permissions": {"contents": "read"}}
+					]}`),
+				)
+				reg.Register(
+					httpmock.REST("GET", "user/installations/99/repositories"),
+					httpmock.StringResponse(`{"repositories": [{"full_name": "my-org/repo1"}]}`),
+				)
+			},
+			wantStdout: "code-scanner\nInstallation ID: 99\nRepository access: selected\nURL: https://github.com/organizations/my-org/settings/installations/99\n\nPermissions\n  contents: read\n\nRepositories\n  my-org/repo1\n",
+		},
+		{
+			name: "not installed",
+			opts: ViewOptions{Org: "my-org", App: "nope"},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/installations"),
+					httpmock.StringResponse(`{"installations": []}`),
+				)
+			},
+			wantErr: `app "nope" is not installed in my-org`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, _ := iostreams.Test()
+
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+			defer reg.Verify(t)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HTTPClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.Config = func() (config.Config, error) { return config.NewBlankConfig(), nil }
+
+			err := viewRun(&opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
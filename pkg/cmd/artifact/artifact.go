@@ -0,0 +1,27 @@
+package artifact
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdDelete "github.com/cli/cli/v2/pkg/cmd/artifact/delete"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/artifact/list"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdArtifact(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "artifact <command>",
+		Short: "Work with GitHub Actions artifacts",
+		Long: heredoc.Doc(`
+			List and delete Actions artifacts for a repository or an organization,
+			to keep track of and control storage usage.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,158 @@
+package delete
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/artifact/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	OrgName     string
+	MinSize     int64
+	OlderThan   string
+	SkipConfirm bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete Actions artifacts in bulk",
+		Long: heredoc.Doc(`
+			Delete Actions artifacts for a repository, or for every repository in an
+			organization with '--org', filtered by '--min-size' and/or '--older-than'.
+
+			At least one of '--min-size' or '--older-than' is required, so that the
+			command can't accidentally delete every artifact in scope.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.MinSize == 0 && opts.OlderThan == "" {
+				return cmdutil.FlagErrorf("specify at least one of `--min-size` or `--older-than`")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "Delete artifacts for an organization")
+	cmd.Flags().Int64Var(&opts.MinSize, "min-size", 0, "Only delete artifacts at least this many bytes")
+	cmd.Flags().StringVar(&opts.OlderThan, "older-than", "", "Only delete artifacts created before this long ago, e.g. \"720h\"")
+	cmd.Flags().BoolVarP(&opts.SkipConfirm, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var cutoff time.Time
+	if opts.OlderThan != "" {
+		d, err := time.ParseDuration(opts.OlderThan)
+		if err != nil {
+			return fmt.Errorf("invalid --older-than duration: %w", err)
+		}
+		cutoff = time.Now().Add(-d)
+	}
+
+	var repos []ghrepo.Interface
+	if opts.OrgName != "" {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		host, err := cfg.DefaultHost()
+		if err != nil {
+			return err
+		}
+		repos, err = shared.OrgRepos(httpClient, host, opts.OrgName)
+		if err != nil {
+			return fmt.Errorf("failed to list repositories for %s: %w", opts.OrgName, err)
+		}
+	} else {
+		baseRepo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		repos = []ghrepo.Interface{baseRepo}
+	}
+
+	var artifacts []shared.RepoArtifact
+	for _, repo := range repos {
+		repoArtifacts, err := shared.ListRepoArtifacts(httpClient, repo)
+		if err != nil {
+			return fmt.Errorf("failed to list artifacts for %s: %w", ghrepo.FullName(repo), err)
+		}
+		for _, a := range repoArtifacts {
+			artifacts = append(artifacts, shared.RepoArtifact{Artifact: a, Repo: repo})
+		}
+	}
+
+	artifacts = shared.FilterArtifacts(artifacts, opts.MinSize, cutoff)
+
+	if len(artifacts) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "no artifacts match the given filters")
+		return nil
+	}
+
+	var totalSize uint64
+	for _, a := range artifacts {
+		totalSize += a.Size
+	}
+
+	if !opts.SkipConfirm && opts.IO.CanPrompt() {
+		var confirmed bool
+		err := prompt.SurveyAskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Delete %d artifact(s) totaling %d bytes?", len(artifacts), totalSize),
+			Default: true,
+		}, &confirmed)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
+	cs := opts.IO.ColorScheme()
+	for _, a := range artifacts {
+		if err := shared.DeleteArtifact(httpClient, a.Repo, a.ID); err != nil {
+			return fmt.Errorf("failed to delete artifact %q: %w", a.Name, err)
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Deleted artifact %s\n", cs.SuccessIconWithColor(cs.Red), a.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package delete
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		isTTY   bool
+		want    DeleteOptions
+		wantErr string
+	}{
+		{
+			name:  "with min-size",
+			args:  "--min-size 1024 -y",
+			isTTY: true,
+			want: DeleteOptions{
+				MinSize:     1024,
+				SkipConfirm: true,
+			},
+		},
+		{
+			name:    "no filters",
+			args:    "",
+			isTTY:   true,
+			wantErr: "specify at least one of `--min-size` or `--older-than`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *DeleteOptions
+			cmd := NewCmdDelete(f, func(o *DeleteOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.MinSize, opts.MinSize)
+			assert.Equal(t, tt.want.SkipConfirm, opts.SkipConfirm)
+		})
+	}
+}
+
+func Test_deleteRun(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/actions/artifacts"), httpmock.StringResponse(`{
+		"total_count": 2,
+		"artifacts": [
+			{ "id": 1, "name": "small", "size_in_bytes": 100, "created_at": "2021-01-01T00:00:00Z" },
+			{ "id": 2, "name": "big", "size_in_bytes": 5000, "created_at": "2021-01-01T00:00:00Z" }
+		]
+	}`))
+	fakeHTTP.Register(httpmock.REST("DELETE", "repos/OWNER/REPO/actions/artifacts/2"), httpmock.StatusStringResponse(204, ""))
+
+	opts := &DeleteOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		MinSize:     1000,
+		SkipConfirm: true,
+	}
+
+	err := deleteRun(opts)
+	require.NoError(t, err)
+
+	assert.Contains(t, stdout.String(), "Deleted artifact big")
+	assert.NotContains(t, stdout.String(), "small")
+}
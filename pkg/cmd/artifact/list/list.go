@@ -0,0 +1,143 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/artifact/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	OrgName   string
+	MinSize   int64
+	OlderThan string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List Actions artifacts and their total storage usage",
+		Long: heredoc.Doc(`
+			List Actions artifacts for a repository, or for every repository in an
+			organization with '--org', along with their total storage usage.
+
+			Results can be narrowed down with '--min-size' and '--older-than'.
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "List artifacts for an organization")
+	cmd.Flags().Int64Var(&opts.MinSize, "min-size", 0, "Only list artifacts at least this many bytes")
+	cmd.Flags().StringVar(&opts.OlderThan, "older-than", "", "Only list artifacts created before this long ago, e.g. \"720h\"")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var cutoff time.Time
+	if opts.OlderThan != "" {
+		d, err := time.ParseDuration(opts.OlderThan)
+		if err != nil {
+			return fmt.Errorf("invalid --older-than duration: %w", err)
+		}
+		cutoff = time.Now().Add(-d)
+	}
+
+	var repos []ghrepo.Interface
+	if opts.OrgName != "" {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		host, err := cfg.DefaultHost()
+		if err != nil {
+			return err
+		}
+		repos, err = shared.OrgRepos(httpClient, host, opts.OrgName)
+		if err != nil {
+			return fmt.Errorf("failed to list repositories for %s: %w", opts.OrgName, err)
+		}
+	} else {
+		baseRepo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		repos = []ghrepo.Interface{baseRepo}
+	}
+
+	var artifacts []shared.RepoArtifact
+	for _, repo := range repos {
+		repoArtifacts, err := shared.ListRepoArtifacts(httpClient, repo)
+		if err != nil {
+			return fmt.Errorf("failed to list artifacts for %s: %w", ghrepo.FullName(repo), err)
+		}
+		for _, a := range repoArtifacts {
+			artifacts = append(artifacts, shared.RepoArtifact{Artifact: a, Repo: repo})
+		}
+	}
+
+	artifacts = shared.FilterArtifacts(artifacts, opts.MinSize, cutoff)
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	table := utils.NewTablePrinter(opts.IO)
+	var totalSize uint64
+	for _, a := range artifacts {
+		table.AddField(a.Name, nil, nil)
+		if opts.OrgName != "" {
+			table.AddField(ghrepo.FullName(a.Repo), nil, nil)
+		}
+		table.AddField(utils.FuzzyAgo(time.Since(a.CreatedAt)), nil, cs.Gray)
+		table.AddField(fmt.Sprintf("%d bytes", a.Size), nil, nil)
+		table.EndRow()
+		totalSize += a.Size
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\nTotal storage usage: %d bytes across %d artifact(s)\n", totalSize, len(artifacts))
+	}
+
+	return nil
+}
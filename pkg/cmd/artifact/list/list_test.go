@@ -0,0 +1,112 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdList(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		isTTY   bool
+		want    ListOptions
+		wantErr string
+	}{
+		{
+			name:  "no flags",
+			args:  "",
+			isTTY: true,
+			want:  ListOptions{},
+		},
+		{
+			name:  "with filters",
+			args:  "--org cli --min-size 1024 --older-than 720h",
+			isTTY: true,
+			want: ListOptions{
+				OrgName:   "cli",
+				MinSize:   1024,
+				OlderThan: "720h",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *ListOptions
+			cmd := NewCmdList(f, func(o *ListOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.OrgName, opts.OrgName)
+			assert.Equal(t, tt.want.MinSize, opts.MinSize)
+			assert.Equal(t, tt.want.OlderThan, opts.OlderThan)
+		})
+	}
+}
+
+func Test_listRun(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/actions/artifacts"), httpmock.StringResponse(`{
+		"total_count": 2,
+		"artifacts": [
+			{ "id": 1, "name": "small", "size_in_bytes": 100, "created_at": "2021-01-01T00:00:00Z" },
+			{ "id": 2, "name": "big", "size_in_bytes": 5000, "created_at": "2021-01-01T00:00:00Z" }
+		]
+	}`))
+
+	opts := &ListOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		MinSize: 1000,
+	}
+
+	err := listRun(opts)
+	require.NoError(t, err)
+
+	assert.Contains(t, stdout.String(), "big")
+	assert.NotContains(t, stdout.String(), "small")
+}
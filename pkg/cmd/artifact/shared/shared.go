@@ -0,0 +1,89 @@
+// Package shared holds helpers for working with Actions artifacts across a repository
+// or an entire organization, used by the leaf commands under `gh artifact`.
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	runshared "github.com/cli/cli/v2/pkg/cmd/run/shared"
+)
+
+// ListRepoArtifacts returns every artifact stored for a repository, following
+// pagination to completion.
+func ListRepoArtifacts(httpClient *http.Client, repo ghrepo.Interface) ([]runshared.Artifact, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/actions/artifacts?per_page=100", repo.RepoOwner(), repo.RepoName())
+
+	var artifacts []runshared.Artifact
+	for path != "" {
+		var page struct {
+			Artifacts []runshared.Artifact `json:"artifacts"`
+		}
+		nextPath, err := apiClient.RESTWithNext(repo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, page.Artifacts...)
+		path = nextPath
+	}
+
+	return artifacts, nil
+}
+
+// OrgRepos returns the repositories belonging to an organization.
+func OrgRepos(httpClient *http.Client, hostname, org string) ([]ghrepo.Interface, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("orgs/%s/repos?per_page=100", org)
+
+	var repos []ghrepo.Interface
+	for path != "" {
+		var page []struct {
+			Name string `json:"name"`
+		}
+		nextPath, err := apiClient.RESTWithNext(hostname, "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page {
+			repos = append(repos, ghrepo.NewWithHost(org, r.Name, hostname))
+		}
+		path = nextPath
+	}
+
+	return repos, nil
+}
+
+// RepoArtifact pairs an artifact with the repository it belongs to, so that
+// artifacts gathered across several repositories (via --org) can still be
+// attributed to their source.
+type RepoArtifact struct {
+	runshared.Artifact
+	Repo ghrepo.Interface
+}
+
+// FilterArtifacts returns the artifacts that are at least minSize bytes and,
+// if cutoff is non-zero, were created before cutoff.
+func FilterArtifacts(artifacts []RepoArtifact, minSize int64, cutoff time.Time) []RepoArtifact {
+	var filtered []RepoArtifact
+	for _, a := range artifacts {
+		if minSize > 0 && int64(a.Size) < minSize {
+			continue
+		}
+		if !cutoff.IsZero() && !a.CreatedAt.Before(cutoff) {
+			continue
+		}
+		filtered = append(filtered, a)
+	}
+	return filtered
+}
+
+// DeleteArtifact deletes a single artifact from its owning repository.
+func DeleteArtifact(httpClient *http.Client, repo ghrepo.Interface, artifactID int64) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/actions/artifacts/%d", repo.RepoOwner(), repo.RepoName(), artifactID)
+	return apiClient.REST(repo.RepoHost(), "DELETE", path, nil, nil)
+}
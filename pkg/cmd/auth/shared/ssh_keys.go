@@ -115,5 +115,5 @@ func sshKeyUpload(httpClient *http.Client, hostname, keyFile string) error {
 	}
 	defer f.Close()
 
-	return add.SSHKeyUpload(httpClient, hostname, f, "GitHub CLI")
+	return add.SSHKeyUpload(httpClient, hostname, f, "GitHub CLI", "authentication")
 }
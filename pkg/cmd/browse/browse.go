@@ -33,12 +33,15 @@ type BrowseOptions struct {
 
 	SelectorArg string
 
-	Branch        string
-	CommitFlag    bool
-	ProjectsFlag  bool
-	SettingsFlag  bool
-	WikiFlag      bool
-	NoBrowserFlag bool
+	Branch         string
+	CommitFlag     bool
+	ProjectsFlag   bool
+	SettingsFlag   bool
+	WikiFlag       bool
+	NoBrowserFlag  bool
+	RunFlag        string
+	ReleaseFlag    string
+	DiscussionFlag bool
 }
 
 func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Command {
@@ -70,6 +73,12 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 
 			$ gh browse main.go --branch main
 			#=> Open main.go in the main branch
+
+			$ gh browse --run 1234567
+			#=> Open workflow run 1234567
+
+			$ gh browse --release v1.2.0
+			#=> Open the release page for tag v1.2.0
 		`),
 		Annotations: map[string]string{
 			"IsCore": "true",
@@ -77,6 +86,9 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 				A browser location can be specified using arguments in the following format:
 				- by number for issue or pull request, e.g. "123"; or
 				- by path for opening folders and files, e.g. "cmd/gh/main.go"
+
+				Use "--discussion" to open a number as a discussion instead of an issue or
+				pull request.
 			`),
 			"help:environment": heredoc.Doc(`
 				To configure a web browser other than the default, use the BROWSER environment variable.
@@ -90,12 +102,15 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 			}
 
 			if err := cmdutil.MutuallyExclusive(
-				"specify only one of `--branch`, `--commit`, `--projects`, `--wiki`, or `--settings`",
+				"specify only one of `--branch`, `--commit`, `--projects`, `--wiki`, `--settings`, `--run`, `--release`, or `--discussion`",
 				opts.Branch != "",
 				opts.CommitFlag,
 				opts.WikiFlag,
 				opts.SettingsFlag,
 				opts.ProjectsFlag,
+				opts.RunFlag != "",
+				opts.ReleaseFlag != "",
+				opts.DiscussionFlag,
 			); err != nil {
 				return err
 			}
@@ -115,8 +130,13 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&opts.WikiFlag, "wiki", "w", false, "Open repository wiki")
 	cmd.Flags().BoolVarP(&opts.SettingsFlag, "settings", "s", false, "Open repository settings")
 	cmd.Flags().BoolVarP(&opts.NoBrowserFlag, "no-browser", "n", false, "Print destination URL instead of opening the browser")
+	cmd.Flags().BoolVar(&opts.NoBrowserFlag, "print", false, "Print destination URL instead of opening the browser")
+	_ = cmd.Flags().MarkDeprecated("no-browser", "use `--print` instead")
 	cmd.Flags().BoolVarP(&opts.CommitFlag, "commit", "c", false, "Open the last commit")
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Select another branch by passing in the branch name")
+	cmd.Flags().StringVar(&opts.RunFlag, "run", "", "Open a workflow run by ID")
+	cmd.Flags().StringVar(&opts.ReleaseFlag, "release", "", "Open the release page for a tag")
+	cmd.Flags().BoolVarP(&opts.DiscussionFlag, "discussion", "d", false, "Open a discussion by number")
 
 	return cmd
 }
@@ -160,12 +180,22 @@ func parseSection(baseRepo ghrepo.Interface, opts *BrowseOptions) (string, error
 			return "settings", nil
 		} else if opts.WikiFlag {
 			return "wiki", nil
-		} else if opts.Branch == "" {
+		} else if opts.Branch == "" && opts.RunFlag == "" && opts.ReleaseFlag == "" {
 			return "", nil
 		}
 	}
 
+	if opts.RunFlag != "" {
+		return fmt.Sprintf("actions/runs/%s", opts.RunFlag), nil
+	}
+	if opts.ReleaseFlag != "" {
+		return fmt.Sprintf("releases/tag/%s", escapePath(opts.ReleaseFlag)), nil
+	}
+
 	if isNumber(opts.SelectorArg) {
+		if opts.DiscussionFlag {
+			return fmt.Sprintf("discussions/%s", opts.SelectorArg), nil
+		}
 		return fmt.Sprintf("issues/%s", opts.SelectorArg), nil
 	}
 
@@ -114,6 +114,48 @@ func TestNewCmdBrowse(t *testing.T) {
 			},
 			wantsErr: false,
 		},
+		{
+			name: "run flag",
+			cli:  "--run 1234567",
+			wants: BrowseOptions{
+				RunFlag: "1234567",
+			},
+			wantsErr: false,
+		},
+		{
+			name: "release flag",
+			cli:  "--release v1.2.0",
+			wants: BrowseOptions{
+				ReleaseFlag: "v1.2.0",
+			},
+			wantsErr: false,
+		},
+		{
+			name: "discussion flag",
+			cli:  "42 --discussion",
+			wants: BrowseOptions{
+				SelectorArg:    "42",
+				DiscussionFlag: true,
+			},
+			wantsErr: false,
+		},
+		{
+			name: "print flag",
+			cli:  "--print",
+			wants: BrowseOptions{
+				NoBrowserFlag: true,
+			},
+			wantsErr: false,
+		},
+		{
+			name: "combination: run release",
+			cli:  "--run 1 --release v1",
+			wants: BrowseOptions{
+				RunFlag:     "1",
+				ReleaseFlag: "v1",
+			},
+			wantsErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -144,6 +186,9 @@ func TestNewCmdBrowse(t *testing.T) {
 			assert.Equal(t, tt.wants.NoBrowserFlag, opts.NoBrowserFlag)
 			assert.Equal(t, tt.wants.SettingsFlag, opts.SettingsFlag)
 			assert.Equal(t, tt.wants.CommitFlag, opts.CommitFlag)
+			assert.Equal(t, tt.wants.RunFlag, opts.RunFlag)
+			assert.Equal(t, tt.wants.ReleaseFlag, opts.ReleaseFlag)
+			assert.Equal(t, tt.wants.DiscussionFlag, opts.DiscussionFlag)
 		})
 	}
 }
@@ -404,6 +449,31 @@ func Test_runBrowse(t *testing.T) {
 			expectedURL:   "https://github.com/bchadwic/gh-graph/tree/trunk/pkg/cmd/pr",
 			wantsErr:      false,
 		},
+		{
+			name: "run flag",
+			opts: BrowseOptions{
+				RunFlag: "1234567",
+			},
+			baseRepo:    ghrepo.New("kevin", "MinTy"),
+			expectedURL: "https://github.com/kevin/MinTy/actions/runs/1234567",
+		},
+		{
+			name: "release flag",
+			opts: BrowseOptions{
+				ReleaseFlag: "v1.2.0",
+			},
+			baseRepo:    ghrepo.New("kevin", "MinTy"),
+			expectedURL: "https://github.com/kevin/MinTy/releases/tag/v1.2.0",
+		},
+		{
+			name: "discussion flag",
+			opts: BrowseOptions{
+				SelectorArg:    "217",
+				DiscussionFlag: true,
+			},
+			baseRepo:    ghrepo.New("kevin", "MinTy"),
+			expectedURL: "https://github.com/kevin/MinTy/discussions/217",
+		},
 		{
 			name: "use special characters in selector arg",
 			opts: BrowseOptions{
@@ -0,0 +1,30 @@
+package cache
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdDelete "github.com/cli/cli/v2/pkg/cmd/cache/delete"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/cache/list"
+	cmdPrune "github.com/cli/cli/v2/pkg/cmd/cache/prune"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCache(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache <command>",
+		Short: "Manage Actions caches",
+		Long: heredoc.Doc(`
+			List, delete, and prune the Actions caches for a repository, to keep
+			track of and control storage usage without resorting to raw 'gh api'
+			calls.
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdPrune.NewCmdPrune(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,90 @@
+package delete
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Selector string
+	Ref      string
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete {<cache-id> | <cache-key>}",
+		Short: "Delete an Actions cache",
+		Long: heredoc.Doc(`
+			Delete a single Actions cache, identified either by the numeric ID shown
+			by 'gh cache list' or by its exact key.
+
+			When deleting by key, pass '--ref' to disambiguate if the same key was
+			saved from more than one branch.
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot delete: cache-id or cache-key required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+			opts.Selector = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Ref, "ref", "", "Only delete a cache for this branch or tag when deleting by key")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if cacheID, err := strconv.ParseInt(opts.Selector, 10, 64); err == nil {
+		if err := shared.DeleteCacheByID(httpClient, baseRepo, cacheID); err != nil {
+			return fmt.Errorf("failed to delete cache %d: %w", cacheID, err)
+		}
+	} else {
+		deleted, err := shared.DeleteCachesByKey(httpClient, baseRepo, opts.Selector, opts.Ref)
+		if err != nil {
+			return fmt.Errorf("failed to delete cache %q: %w", opts.Selector, err)
+		}
+		if deleted == 0 {
+			return fmt.Errorf("no cache found matching key %q", opts.Selector)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() && !opts.IO.GetQuiet() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Deleted cache %s\n", cs.SuccessIconWithColor(cs.Red), opts.Selector)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package delete
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		isTTY   bool
+		want    DeleteOptions
+		wantErr string
+	}{
+		{
+			name:  "by id",
+			args:  "123",
+			isTTY: true,
+			want: DeleteOptions{
+				Selector: "123",
+			},
+		},
+		{
+			name:  "by key with ref",
+			args:  "linux-node-abc --ref main",
+			isTTY: true,
+			want: DeleteOptions{
+				Selector: "linux-node-abc",
+				Ref:      "main",
+			},
+		},
+		{
+			name:    "no selector",
+			args:    "",
+			isTTY:   true,
+			wantErr: "cannot delete: cache-id or cache-key required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *DeleteOptions
+			cmd := NewCmdDelete(f, func(o *DeleteOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.Selector, opts.Selector)
+			assert.Equal(t, tt.want.Ref, opts.Ref)
+		})
+	}
+}
+
+func Test_deleteRun_byID(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	fakeHTTP.Register(httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/123"), httpmock.StatusStringResponse(204, ""))
+
+	opts := &DeleteOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		Selector: "123",
+	}
+
+	err := deleteRun(opts)
+	require.NoError(t, err)
+
+	assert.Contains(t, stdout.String(), "Deleted cache 123")
+}
+
+func Test_deleteRun_byKey(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	fakeHTTP.Register(httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches"), httpmock.StringResponse(`{"total_count": 1}`))
+
+	opts := &DeleteOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		Selector: "linux-node-abc",
+	}
+
+	err := deleteRun(opts)
+	require.NoError(t, err)
+
+	assert.Contains(t, stdout.String(), "Deleted cache linux-node-abc")
+}
+
+func Test_deleteRun_byKeyNotFound(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	fakeHTTP.Register(httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches"), httpmock.StringResponse(`{"total_count": 0}`))
+
+	opts := &DeleteOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		Selector: "no-such-key",
+	}
+
+	err := deleteRun(opts)
+	require.EqualError(t, err, `no cache found matching key "no-such-key"`)
+}
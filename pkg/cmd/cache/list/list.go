@@ -0,0 +1,106 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Key     string
+	Ref     string
+	MinSize int64
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List Actions caches",
+		Long: heredoc.Doc(`
+			List Actions caches for a repository, along with their size and last
+			accessed time.
+
+			Results can be narrowed down with '--key', '--ref', and '--min-size'.
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Key, "key", "", "Only list caches whose key starts with this prefix")
+	cmd.Flags().StringVar(&opts.Ref, "ref", "", "Only list caches for this branch or tag")
+	cmd.Flags().Int64Var(&opts.MinSize, "min-size", 0, "Only list caches at least this many bytes")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	caches, err := shared.ListRepoCaches(httpClient, baseRepo, opts.Key, opts.Ref)
+	if err != nil {
+		return fmt.Errorf("failed to list caches for %s: %w", ghrepo.FullName(baseRepo), err)
+	}
+
+	caches = shared.FilterBySize(caches, opts.MinSize)
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	table := utils.NewTablePrinter(opts.IO)
+	var totalSize int64
+	for _, c := range caches {
+		table.AddField(c.Key, nil, nil)
+		table.AddField(c.Ref, nil, nil)
+		table.AddField(fmt.Sprintf("%d bytes", c.SizeInBytes), nil, nil)
+		table.AddField(utils.FuzzyAgo(time.Since(c.LastAccessedAt)), nil, cs.Gray)
+		table.EndRow()
+		totalSize += c.SizeInBytes
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() && !opts.IO.GetQuiet() {
+		fmt.Fprintf(opts.IO.Out, "\nTotal storage usage: %d bytes across %d cache(s)\n", totalSize, len(caches))
+	}
+
+	return nil
+}
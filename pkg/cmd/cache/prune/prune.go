@@ -0,0 +1,151 @@
+package prune
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type PruneOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Key         string
+	OlderThan   string
+	KeepLatest  int
+	SkipConfirm bool
+}
+
+func NewCmdPrune(f *cmdutil.Factory, runF func(*PruneOptions) error) *cobra.Command {
+	opts := &PruneOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete old Actions caches in bulk",
+		Long: heredoc.Doc(`
+			Delete Actions caches for a repository that are older than '--older-than'
+			and/or beyond the '--keep-latest' most recently created, optionally
+			narrowed to caches whose key starts with '--key'.
+
+			At least one of '--older-than' or '--keep-latest' is required, so that
+			the command can't accidentally prune every cache in the repository.
+
+			'--older-than' accepts a duration such as "7d" or "12h".
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.OlderThan == "" && opts.KeepLatest == 0 {
+				return cmdutil.FlagErrorf("specify at least one of `--older-than` or `--keep-latest`")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return pruneRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Key, "key", "", "Only prune caches whose key starts with this prefix")
+	cmd.Flags().StringVar(&opts.OlderThan, "older-than", "", "Only prune caches created before this long ago, e.g. \"7d\"")
+	cmd.Flags().IntVar(&opts.KeepLatest, "keep-latest", 0, "Always keep this many of the most recently created matching caches")
+	cmd.Flags().BoolVarP(&opts.SkipConfirm, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func pruneRun(opts *PruneOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	caches, err := shared.ListRepoCaches(httpClient, baseRepo, opts.Key, "")
+	if err != nil {
+		return fmt.Errorf("failed to list caches for %s: %w", ghrepo.FullName(baseRepo), err)
+	}
+
+	sort.Slice(caches, func(i, j int) bool { return caches[i].CreatedAt.After(caches[j].CreatedAt) })
+
+	if opts.KeepLatest > 0 {
+		if opts.KeepLatest >= len(caches) {
+			caches = nil
+		} else {
+			caches = caches[opts.KeepLatest:]
+		}
+	}
+
+	if opts.OlderThan != "" {
+		olderThan, err := shared.ParseOlderThan(opts.OlderThan)
+		if err != nil {
+			return fmt.Errorf("invalid --older-than duration: %w", err)
+		}
+		cutoff := time.Now().Add(-olderThan)
+
+		var filtered []shared.Cache
+		for _, c := range caches {
+			if c.CreatedAt.Before(cutoff) {
+				filtered = append(filtered, c)
+			}
+		}
+		caches = filtered
+	}
+
+	if len(caches) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "no caches match the given filters")
+		return nil
+	}
+
+	var totalSize int64
+	for _, c := range caches {
+		totalSize += c.SizeInBytes
+	}
+
+	if !opts.SkipConfirm && opts.IO.CanPrompt() {
+		var confirmed bool
+		err := prompt.SurveyAskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Prune %d cache(s) totaling %d bytes?", len(caches), totalSize),
+			Default: true,
+		}, &confirmed)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
+	cs := opts.IO.ColorScheme()
+	for _, c := range caches {
+		if err := shared.DeleteCacheByID(httpClient, baseRepo, c.ID); err != nil {
+			return fmt.Errorf("failed to delete cache %q: %w", c.Key, err)
+		}
+		if opts.IO.IsStdoutTTY() && !opts.IO.GetQuiet() {
+			fmt.Fprintf(opts.IO.Out, "%s Pruned cache %s\n", cs.SuccessIconWithColor(cs.Red), c.Key)
+		}
+	}
+
+	return nil
+}
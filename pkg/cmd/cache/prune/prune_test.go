@@ -0,0 +1,143 @@
+package prune
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdPrune(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		isTTY   bool
+		want    PruneOptions
+		wantErr string
+	}{
+		{
+			name:  "with filters",
+			args:  "--older-than 7d --keep-latest 3 -y",
+			isTTY: true,
+			want: PruneOptions{
+				OlderThan:   "7d",
+				KeepLatest:  3,
+				SkipConfirm: true,
+			},
+		},
+		{
+			name:    "no filters",
+			args:    "",
+			isTTY:   true,
+			wantErr: "specify at least one of `--older-than` or `--keep-latest`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *PruneOptions
+			cmd := NewCmdPrune(f, func(o *PruneOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.OlderThan, opts.OlderThan)
+			assert.Equal(t, tt.want.KeepLatest, opts.KeepLatest)
+			assert.Equal(t, tt.want.SkipConfirm, opts.SkipConfirm)
+		})
+	}
+}
+
+func Test_pruneRun_keepLatest(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"), httpmock.StringResponse(`{
+		"total_count": 3,
+		"actions_caches": [
+			{ "id": 1, "key": "oldest", "created_at": "2021-01-01T00:00:00Z" },
+			{ "id": 2, "key": "middle", "created_at": "2021-01-02T00:00:00Z" },
+			{ "id": 3, "key": "newest", "created_at": "2021-01-03T00:00:00Z" }
+		]
+	}`))
+	fakeHTTP.Register(httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/1"), httpmock.StatusStringResponse(204, ""))
+
+	opts := &PruneOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		KeepLatest:  2,
+		SkipConfirm: true,
+	}
+
+	err := pruneRun(opts)
+	require.NoError(t, err)
+
+	assert.Contains(t, stdout.String(), "Pruned cache oldest")
+	assert.NotContains(t, stdout.String(), "Pruned cache middle")
+	assert.NotContains(t, stdout.String(), "Pruned cache newest")
+}
+
+func Test_pruneRun_noMatches(t *testing.T) {
+	io, _, _, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"), httpmock.StringResponse(`{
+		"total_count": 1,
+		"actions_caches": [
+			{ "id": 1, "key": "only-cache", "created_at": "2021-01-01T00:00:00Z" }
+		]
+	}`))
+
+	opts := &PruneOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		KeepLatest:  5,
+		SkipConfirm: true,
+	}
+
+	err := pruneRun(opts)
+	require.NoError(t, err)
+	assert.Contains(t, stderr.String(), "no caches match the given filters")
+}
@@ -0,0 +1,108 @@
+// Package shared holds helpers for working with Actions caches for a repository, used by the
+// leaf commands under `gh cache`.
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// Cache is a single Actions cache entry for a repository.
+type Cache struct {
+	ID             int64     `json:"id"`
+	Ref            string    `json:"ref"`
+	Key            string    `json:"key"`
+	Version        string    `json:"version"`
+	SizeInBytes    int64     `json:"size_in_bytes"`
+	CreatedAt      time.Time `json:"created_at"`
+	LastAccessedAt time.Time `json:"last_accessed_at"`
+}
+
+// ListRepoCaches returns the Actions caches for a repository, optionally narrowed by a key
+// prefix and/or a ref, following pagination to completion.
+func ListRepoCaches(httpClient *http.Client, repo ghrepo.Interface, keyPrefix, ref string) ([]Cache, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/actions/caches?per_page=100&sort=created_at&direction=desc", repo.RepoOwner(), repo.RepoName())
+	if keyPrefix != "" {
+		path += "&key=" + url.QueryEscape(keyPrefix)
+	}
+	if ref != "" {
+		path += "&ref=" + url.QueryEscape(ref)
+	}
+
+	var caches []Cache
+	for path != "" {
+		var page struct {
+			ActionsCaches []Cache `json:"actions_caches"`
+		}
+		nextPath, err := apiClient.RESTWithNext(repo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		caches = append(caches, page.ActionsCaches...)
+		path = nextPath
+	}
+
+	return caches, nil
+}
+
+// FilterBySize returns the caches that are at least minSize bytes. A non-positive minSize
+// returns caches unfiltered.
+func FilterBySize(caches []Cache, minSize int64) []Cache {
+	if minSize <= 0 {
+		return caches
+	}
+	var filtered []Cache
+	for _, c := range caches {
+		if c.SizeInBytes >= minSize {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// DeleteCacheByID deletes a single cache by its numeric ID.
+func DeleteCacheByID(httpClient *http.Client, repo ghrepo.Interface, cacheID int64) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/actions/caches/%d", repo.RepoOwner(), repo.RepoName(), cacheID)
+	return apiClient.REST(repo.RepoHost(), "DELETE", path, nil, nil)
+}
+
+// DeleteCachesByKey deletes every cache with an exact match on key, optionally scoped to ref,
+// and reports how many caches were deleted.
+func DeleteCachesByKey(httpClient *http.Client, repo ghrepo.Interface, key, ref string) (int, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/actions/caches?key=%s", repo.RepoOwner(), repo.RepoName(), url.QueryEscape(key))
+	if ref != "" {
+		path += "&ref=" + url.QueryEscape(ref)
+	}
+
+	var resp struct {
+		TotalCount int `json:"total_count"`
+	}
+	if err := apiClient.REST(repo.RepoHost(), "DELETE", path, nil, &resp); err != nil {
+		return 0, err
+	}
+	return resp.TotalCount, nil
+}
+
+// ParseOlderThan parses a duration that, in addition to the units time.ParseDuration accepts,
+// allows a trailing "d" for days, since cache age is usually reasoned about in days rather than
+// hours (e.g. "7d").
+func ParseOlderThan(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.ParseFloat(strings.TrimSuffix(s, "d"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(days * 24 * float64(time.Hour)), nil
+	}
+	return time.ParseDuration(s)
+}
@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOlderThan(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "7d", want: 7 * 24 * time.Hour},
+		{in: "0.5d", want: 12 * time.Hour},
+		{in: "12h", want: 12 * time.Hour},
+		{in: "not-a-duration", wantErr: true},
+		{in: "1xd", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseOlderThan(tt.in)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFilterBySize(t *testing.T) {
+	caches := []Cache{
+		{Key: "small", SizeInBytes: 100},
+		{Key: "big", SizeInBytes: 5000},
+	}
+
+	assert.Equal(t, caches, FilterBySize(caches, 0))
+
+	filtered := FilterBySize(caches, 1000)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "big", filtered[0].Key)
+}
@@ -0,0 +1,22 @@
+package checkrun
+
+import (
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/check-run/create"
+	cmdUpdate "github.com/cli/cli/v2/pkg/cmd/check-run/update"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCheckRun(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-run <command>",
+		Short: "Create and update check runs",
+		Long:  "Report check runs on GitHub, for use by GitHub Apps with the \"checks:write\" permission.",
+	}
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+	cmd.AddCommand(cmdUpdate.NewCmdUpdate(f, nil))
+
+	return cmd
+}
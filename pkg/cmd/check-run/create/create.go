@@ -0,0 +1,135 @@
+package create
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Name       string
+	HeadSHA    string
+	Status     string
+	Conclusion string
+	DetailsURL string
+	Summary    string
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <sha>",
+		Short: "Create a check run",
+		Long: heredoc.Doc(`
+			Create a check run on GitHub for a commit.
+
+			Note that the Checks API only accepts requests authenticated as a
+			GitHub App with the "checks:write" permission; creating a check run
+			with a personal access token or OAuth token will fail with a 403. Use
+			"gh commit-status create" instead if you're reporting from a regular
+			user-authenticated script.
+		`),
+		Example: heredoc.Doc(`
+			$ gh check-run create abc123 --name lint --status completed --conclusion success
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot create a check run: sha argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.HeadSHA = args[0]
+
+			if opts.Status == "completed" && opts.Conclusion == "" {
+				return cmdutil.FlagErrorf("`--conclusion` is required when `--status` is \"completed\"")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Name, "name", "", "Name of the check (required)")
+	_ = cmd.MarkFlagRequired("name")
+	cmdutil.StringEnumFlag(cmd, &opts.Status, "status", "", "queued", []string{"queued", "in_progress", "completed"}, "Current status of the check run")
+	cmdutil.StringEnumFlag(cmd, &opts.Conclusion, "conclusion", "", "", []string{"action_required", "cancelled", "failure", "neutral", "success", "skipped", "stale", "timed_out"}, "Conclusion of the check run, required when --status is \"completed\"")
+	cmd.Flags().StringVar(&opts.DetailsURL, "details-url", "", "URL for the full details of the check run, shown on GitHub")
+	cmd.Flags().StringVar(&opts.Summary, "summary", "", "Summary of the check run's output")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	checkRunID, err := createCheckRun(httpClient, baseRepo, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created check run %q (id %d) for %s\n", cs.SuccessIcon(), opts.Name, checkRunID, opts.HeadSHA)
+	} else {
+		fmt.Fprintln(opts.IO.Out, checkRunID)
+	}
+
+	return nil
+}
+
+func createCheckRun(client *http.Client, repo ghrepo.Interface, opts *CreateOptions) (int64, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/%s/check-runs", repo.RepoOwner(), repo.RepoName())
+
+	params := map[string]interface{}{
+		"name":     opts.Name,
+		"head_sha": opts.HeadSHA,
+		"status":   opts.Status,
+	}
+	if opts.Conclusion != "" {
+		params["conclusion"] = opts.Conclusion
+	}
+	if opts.DetailsURL != "" {
+		params["details_url"] = opts.DetailsURL
+	}
+	if opts.Summary != "" {
+		params["output"] = map[string]string{
+			"title":   opts.Name,
+			"summary": opts.Summary,
+		}
+	}
+
+	requestByte, err := json.Marshal(params)
+	if err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		ID int64 `json:"id"`
+	}
+	err = apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), &result)
+	return result.ID, err
+}
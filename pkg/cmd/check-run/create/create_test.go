@@ -0,0 +1,139 @@
+package create
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  CreateOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no argument",
+			input:   "",
+			wantErr: true,
+			errMsg:  "cannot create a check run: sha argument required",
+		},
+		{
+			name:    "no name",
+			input:   "abc123",
+			wantErr: true,
+			errMsg:  `required flag(s) "name" not set`,
+		},
+		{
+			name:  "defaults",
+			input: "abc123 --name lint",
+			output: CreateOptions{
+				HeadSHA: "abc123",
+				Name:    "lint",
+				Status:  "queued",
+			},
+		},
+		{
+			name:    "completed without conclusion",
+			input:   "abc123 --name lint --status completed",
+			wantErr: true,
+			errMsg:  "`--conclusion` is required when `--status` is \"completed\"",
+		},
+		{
+			name:  "completed with conclusion",
+			input: "abc123 --name lint --status completed --conclusion success --details-url https://ci.example.com/1 --summary ok",
+			output: CreateOptions{
+				HeadSHA:    "abc123",
+				Name:       "lint",
+				Status:     "completed",
+				Conclusion: "success",
+				DetailsURL: "https://ci.example.com/1",
+				Summary:    "ok",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *CreateOptions
+			cmd := NewCmdCreate(f, func(opts *CreateOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.HeadSHA, gotOpts.HeadSHA)
+			assert.Equal(t, tt.output.Name, gotOpts.Name)
+			assert.Equal(t, tt.output.Status, gotOpts.Status)
+			assert.Equal(t, tt.output.Conclusion, gotOpts.Conclusion)
+			assert.Equal(t, tt.output.DetailsURL, gotOpts.DetailsURL)
+			assert.Equal(t, tt.output.Summary, gotOpts.Summary)
+		})
+	}
+}
+
+func TestCreateRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/check-runs"),
+		httpmock.StatusStringResponse(201, `{"id": 42}`),
+	)
+	defer reg.Verify(t)
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &CreateOptions{
+		HeadSHA: "abc123",
+		Name:    "lint",
+		Status:  "queued",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := createRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Created check run \"lint\" (id 42) for abc123\n", stdout.String())
+
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[0].Body)
+	reqBody := map[string]string{}
+	err = json.Unmarshal(bodyBytes, &reqBody)
+	assert.NoError(t, err)
+	assert.Equal(t, "lint", reqBody["name"])
+	assert.Equal(t, "abc123", reqBody["head_sha"])
+	assert.Equal(t, "queued", reqBody["status"])
+}
@@ -0,0 +1,126 @@
+package update
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UpdateOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	CheckRunID string
+	Status     string
+	Conclusion string
+	DetailsURL string
+	Summary    string
+}
+
+func NewCmdUpdate(f *cmdutil.Factory, runF func(*UpdateOptions) error) *cobra.Command {
+	opts := &UpdateOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update <check-run-id>",
+		Short: "Update a check run",
+		Long: heredoc.Doc(`
+			Update an existing check run on GitHub, for example to move it from
+			"in_progress" to "completed" once the work it represents has finished.
+
+			As with "gh check-run create", this requires authenticating as a
+			GitHub App with the "checks:write" permission.
+		`),
+		Example: heredoc.Doc(`
+			$ gh check-run update 123456789 --status completed --conclusion success
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot update a check run: check-run-id argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.CheckRunID = args[0]
+
+			if opts.Status == "" && opts.Conclusion == "" && opts.DetailsURL == "" && opts.Summary == "" {
+				return cmdutil.FlagErrorf("specify at least one of `--status`, `--conclusion`, `--details-url`, or `--summary`")
+			}
+			if opts.Status == "completed" && opts.Conclusion == "" {
+				return cmdutil.FlagErrorf("`--conclusion` is required when `--status` is \"completed\"")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return updateRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Status, "status", "", "", []string{"queued", "in_progress", "completed"}, "New status of the check run")
+	cmdutil.StringEnumFlag(cmd, &opts.Conclusion, "conclusion", "", "", []string{"action_required", "cancelled", "failure", "neutral", "success", "skipped", "stale", "timed_out"}, "Conclusion of the check run, required when --status is \"completed\"")
+	cmd.Flags().StringVar(&opts.DetailsURL, "details-url", "", "URL for the full details of the check run, shown on GitHub")
+	cmd.Flags().StringVar(&opts.Summary, "summary", "", "Summary of the check run's output")
+
+	return cmd
+}
+
+func updateRun(opts *UpdateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	err = updateCheckRun(httpClient, baseRepo, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Updated check run %s\n", cs.SuccessIcon(), opts.CheckRunID)
+	}
+
+	return nil
+}
+
+func updateCheckRun(client *http.Client, repo ghrepo.Interface, opts *UpdateOptions) error {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/%s/check-runs/%s", repo.RepoOwner(), repo.RepoName(), opts.CheckRunID)
+
+	params := map[string]interface{}{}
+	if opts.Status != "" {
+		params["status"] = opts.Status
+	}
+	if opts.Conclusion != "" {
+		params["conclusion"] = opts.Conclusion
+	}
+	if opts.DetailsURL != "" {
+		params["details_url"] = opts.DetailsURL
+	}
+	if opts.Summary != "" {
+		params["output"] = map[string]string{
+			"title":   "Update",
+			"summary": opts.Summary,
+		}
+	}
+
+	requestByte, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	return apiClient.REST(repo.RepoHost(), "PATCH", path, bytes.NewReader(requestByte), nil)
+}
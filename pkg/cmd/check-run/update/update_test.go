@@ -0,0 +1,123 @@
+package update
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  UpdateOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no argument",
+			input:   "",
+			wantErr: true,
+			errMsg:  "cannot update a check run: check-run-id argument required",
+		},
+		{
+			name:    "no flags",
+			input:   "123",
+			wantErr: true,
+			errMsg:  "specify at least one of `--status`, `--conclusion`, `--details-url`, or `--summary`",
+		},
+		{
+			name:    "completed without conclusion",
+			input:   "123 --status completed",
+			wantErr: true,
+			errMsg:  "`--conclusion` is required when `--status` is \"completed\"",
+		},
+		{
+			name:  "completed with conclusion",
+			input: "123 --status completed --conclusion success",
+			output: UpdateOptions{
+				CheckRunID: "123",
+				Status:     "completed",
+				Conclusion: "success",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *UpdateOptions
+			cmd := NewCmdUpdate(f, func(opts *UpdateOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.CheckRunID, gotOpts.CheckRunID)
+			assert.Equal(t, tt.output.Status, gotOpts.Status)
+			assert.Equal(t, tt.output.Conclusion, gotOpts.Conclusion)
+		})
+	}
+}
+
+func TestUpdateRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO/check-runs/123"),
+		httpmock.StatusStringResponse(200, "{}"),
+	)
+	defer reg.Verify(t)
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &UpdateOptions{
+		CheckRunID: "123",
+		Status:     "completed",
+		Conclusion: "success",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := updateRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Updated check run 123\n", stdout.String())
+
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[0].Body)
+	reqBody := map[string]string{}
+	err = json.Unmarshal(bodyBytes, &reqBody)
+	assert.NoError(t, err)
+	assert.Equal(t, "completed", reqBody["status"])
+	assert.Equal(t, "success", reqBody["conclusion"])
+}
@@ -0,0 +1,20 @@
+package checks
+
+import (
+	cmdRerequest "github.com/cli/cli/v2/pkg/cmd/checks/rerequest"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdChecks(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checks <command>",
+		Short: "Manage check suites",
+		Long:  "Work with check suites reported against a commit, independently of any pull request.",
+	}
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdRerequest.NewCmdRerequest(f, nil))
+
+	return cmd
+}
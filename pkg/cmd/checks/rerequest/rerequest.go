@@ -0,0 +1,147 @@
+package rerequest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RerequestOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	SHA     string
+	AppSlug string
+}
+
+func NewCmdRerequest(f *cmdutil.Factory, runF func(*RerequestOptions) error) *cobra.Command {
+	opts := &RerequestOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rerequest",
+		Short: "Re-request check suites for a commit",
+		Long: heredoc.Doc(`
+			Re-request the check suites reported against a commit, without
+			needing an associated pull request.
+
+			This is useful when a GitHub App's checks got stuck or were never
+			delivered. Use "--app" to only re-request check suites belonging to
+			a particular GitHub App.
+		`),
+		Example: heredoc.Doc(`
+			$ gh checks rerequest --sha abc123
+			$ gh checks rerequest --sha abc123 --app my-ci-app
+		`),
+		Args: cmdutil.NoArgsQuoteReminder,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.SHA == "" {
+				return cmdutil.FlagErrorf("`--sha` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return rerequestRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.SHA, "sha", "", "The commit SHA to re-request check suites for (required)")
+	cmd.Flags().StringVar(&opts.AppSlug, "app", "", "Only re-request check suites belonging to this GitHub App")
+
+	return cmd
+}
+
+func rerequestRun(opts *RerequestOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	suites, err := checkSuitesForRef(apiClient, baseRepo, opts.SHA, opts.AppSlug)
+	if err != nil {
+		return err
+	}
+	if len(suites) == 0 {
+		return fmt.Errorf("no check suites found for %s", opts.SHA)
+	}
+
+	for _, suite := range suites {
+		if err := rerequestCheckSuite(apiClient, baseRepo, suite.ID); err != nil {
+			return fmt.Errorf("error re-requesting check suite %d: %w", suite.ID, err)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Re-requested %d check suite(s) for %s\n", cs.SuccessIcon(), len(suites), opts.SHA)
+	}
+
+	return nil
+}
+
+type checkSuite struct {
+	ID  int64 `json:"id"`
+	App struct {
+		Slug string `json:"slug"`
+	} `json:"app"`
+}
+
+func checkSuitesForRef(apiClient *api.Client, repo ghrepo.Interface, sha, appSlug string) ([]checkSuite, error) {
+	query := url.Values{}
+	if appSlug != "" {
+		appID, err := appIDForSlug(apiClient, repo.RepoHost(), appSlug)
+		if err != nil {
+			return nil, err
+		}
+		query.Set("app_id", fmt.Sprintf("%d", appID))
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/commits/%s/check-suites", repo.RepoOwner(), repo.RepoName(), sha)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var result struct {
+		CheckSuites []checkSuite `json:"check_suites"`
+	}
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.CheckSuites, nil
+}
+
+func appIDForSlug(apiClient *api.Client, hostname, slug string) (int64, error) {
+	var result struct {
+		ID int64 `json:"id"`
+	}
+	if err := apiClient.REST(hostname, "GET", fmt.Sprintf("apps/%s", slug), nil, &result); err != nil {
+		return 0, fmt.Errorf("could not find GitHub App %q: %w", slug, err)
+	}
+	return result.ID, nil
+}
+
+func rerequestCheckSuite(apiClient *api.Client, repo ghrepo.Interface, checkSuiteID int64) error {
+	path := fmt.Sprintf("repos/%s/%s/check-suites/%d/rerequest", repo.RepoOwner(), repo.RepoName(), checkSuiteID)
+	return apiClient.REST(repo.RepoHost(), "POST", path, nil, nil)
+}
@@ -0,0 +1,177 @@
+package rerequest
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdRerequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  RerequestOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no sha",
+			input:   "",
+			wantErr: true,
+			errMsg:  "`--sha` is required",
+		},
+		{
+			name:  "sha only",
+			input: "--sha abc123",
+			output: RerequestOptions{
+				SHA: "abc123",
+			},
+		},
+		{
+			name:  "sha and app",
+			input: "--sha abc123 --app my-ci-app",
+			output: RerequestOptions{
+				SHA:     "abc123",
+				AppSlug: "my-ci-app",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *RerequestOptions
+			cmd := NewCmdRerequest(f, func(opts *RerequestOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.SHA, gotOpts.SHA)
+			assert.Equal(t, tt.output.AppSlug, gotOpts.AppSlug)
+		})
+	}
+}
+
+func TestRerequestRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/abc123/check-suites"),
+		httpmock.StatusStringResponse(200, `{"check_suites": [{"id": 1}, {"id": 2}]}`),
+	)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/check-suites/1/rerequest"),
+		httpmock.StatusStringResponse(201, `{}`),
+	)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/check-suites/2/rerequest"),
+		httpmock.StatusStringResponse(201, `{}`),
+	)
+	defer reg.Verify(t)
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &RerequestOptions{
+		SHA: "abc123",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := rerequestRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Re-requested 2 check suite(s) for abc123\n", stdout.String())
+}
+
+func TestRerequestRun_withApp(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "apps/my-ci-app"),
+		httpmock.StatusStringResponse(200, `{"id": 99}`),
+	)
+	reg.Register(
+		httpmock.QueryMatcher("GET", "repos/OWNER/REPO/commits/abc123/check-suites", url.Values{"app_id": []string{"99"}}),
+		httpmock.StatusStringResponse(200, `{"check_suites": [{"id": 1}]}`),
+	)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/check-suites/1/rerequest"),
+		httpmock.StatusStringResponse(201, `{}`),
+	)
+	defer reg.Verify(t)
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &RerequestOptions{
+		SHA:     "abc123",
+		AppSlug: "my-ci-app",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := rerequestRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Re-requested 1 check suite(s) for abc123\n", stdout.String())
+}
+
+func TestRerequestRun_noSuites(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/abc123/check-suites"),
+		httpmock.StatusStringResponse(200, `{"check_suites": []}`),
+	)
+	defer reg.Verify(t)
+
+	io, _, _, _ := iostreams.Test()
+
+	opts := &RerequestOptions{
+		SHA: "abc123",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := rerequestRun(opts)
+	assert.EqualError(t, err, "no check suites found for abc123")
+}
@@ -0,0 +1,33 @@
+package codescanning
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdDismiss "github.com/cli/cli/v2/pkg/cmd/codescanning/dismiss"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/codescanning/list"
+	cmdUpload "github.com/cli/cli/v2/pkg/cmd/codescanning/upload"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/codescanning/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCodeScanning(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-scanning <command>",
+		Short: "List, view, and manage code scanning alerts",
+		Long:  "Triage code scanning alerts: list and filter them by severity or rule, inspect a single alert, dismiss one with a reason, or upload a SARIF file with new results.",
+		Example: heredoc.Doc(`
+			$ gh code-scanning list --severity=critical
+			$ gh code-scanning view 123
+			$ gh code-scanning dismiss 123 --reason="won't fix"
+			$ gh code-scanning upload results.sarif --commit=HEAD --ref=refs/heads/main
+		`),
+	}
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdDismiss.NewCmdDismiss(f, nil))
+	cmd.AddCommand(cmdUpload.NewCmdUpload(f, nil))
+
+	return cmd
+}
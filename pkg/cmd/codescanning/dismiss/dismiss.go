@@ -0,0 +1,87 @@
+package dismiss
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/codescanning/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DismissOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	AlertNumber int
+	Reason      string
+}
+
+func NewCmdDismiss(f *cmdutil.Factory, runF func(*DismissOptions) error) *cobra.Command {
+	opts := &DismissOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "dismiss <alert-number>",
+		Short: "Dismiss a code scanning alert",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ gh code-scanning dismiss 123 --reason="won't fix"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			alertNumber, err := strconv.Atoi(args[0])
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid alert number: %q", args[0])
+			}
+			opts.AlertNumber = alertNumber
+
+			if opts.Reason == "" {
+				return cmdutil.FlagErrorf("`--reason` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return dismissRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Reason, "reason", "", "", shared.DismissReasons, "Reason for dismissing the alert")
+
+	return cmd
+}
+
+func dismissRun(opts *DismissOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	alert, err := shared.DismissAlert(httpClient, baseRepo, opts.AlertNumber, opts.Reason)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Dismissed alert #%d (%s)\n", cs.SuccessIcon(), alert.Number, opts.Reason)
+	}
+
+	return nil
+}
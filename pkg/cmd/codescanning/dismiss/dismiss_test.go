@@ -0,0 +1,111 @@
+package dismiss
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdDismiss(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  DismissOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no argument",
+			input:   "--reason=\"won't fix\"",
+			wantErr: true,
+			errMsg:  "accepts 1 arg(s), received 0",
+		},
+		{
+			name:    "no reason",
+			input:   "123",
+			wantErr: true,
+			errMsg:  "`--reason` is required",
+		},
+		{
+			name:    "invalid alert number",
+			input:   "abc --reason=\"won't fix\"",
+			wantErr: true,
+			errMsg:  `invalid alert number: "abc"`,
+		},
+		{
+			name:  "valid",
+			input: "123 --reason=\"won't fix\"",
+			output: DismissOptions{
+				AlertNumber: 123,
+				Reason:      "won't fix",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *DismissOptions
+			cmd := NewCmdDismiss(f, func(opts *DismissOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.AlertNumber, gotOpts.AlertNumber)
+			assert.Equal(t, tt.output.Reason, gotOpts.Reason)
+		})
+	}
+}
+
+func TestDismissRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO/code-scanning/alerts/123"),
+		httpmock.StringResponse(`{"number":123,"state":"dismissed","dismissed_reason":"won't fix"}`),
+	)
+	defer reg.Verify(t)
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &DismissOptions{
+		AlertNumber: 123,
+		Reason:      "won't fix",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := dismissRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Dismissed alert #123 (won't fix)\n", stdout.String())
+}
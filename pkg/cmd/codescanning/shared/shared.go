@@ -0,0 +1,210 @@
+// Package shared contains helpers for working with code scanning alerts that are
+// reused across the `gh code-scanning` leaf commands.
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// Alert is a single code scanning alert, as returned by the code scanning REST API.
+type Alert struct {
+	Number    int       `json:"number"`
+	State     string    `json:"state"`
+	CreatedAt time.Time `json:"created_at"`
+	URL       string    `json:"html_url"`
+	Rule      struct {
+		ID          string `json:"id"`
+		Severity    string `json:"severity"`
+		Description string `json:"description"`
+	} `json:"rule"`
+	Tool struct {
+		Name string `json:"name"`
+	} `json:"tool"`
+	MostRecentInstance struct {
+		Ref      string `json:"ref"`
+		Location struct {
+			Path string `json:"path"`
+		} `json:"location"`
+	} `json:"most_recent_instance"`
+	DismissedReason string `json:"dismissed_reason"`
+}
+
+// Fields are the field names accepted by the `--json` flag for `gh code-scanning list`
+// and `gh code-scanning view`.
+var Fields = []string{
+	"number", "state", "severity", "rule", "description", "tool", "path", "ref",
+	"createdAt", "url", "dismissedReason",
+}
+
+// ExportData implements cmdutil.Exporter for a code scanning alert.
+func (a *Alert) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "number":
+			data[f] = a.Number
+		case "state":
+			data[f] = a.State
+		case "severity":
+			data[f] = a.Rule.Severity
+		case "rule":
+			data[f] = a.Rule.ID
+		case "description":
+			data[f] = a.Rule.Description
+		case "tool":
+			data[f] = a.Tool.Name
+		case "path":
+			data[f] = a.MostRecentInstance.Location.Path
+		case "ref":
+			data[f] = a.MostRecentInstance.Ref
+		case "createdAt":
+			data[f] = a.CreatedAt
+		case "url":
+			data[f] = a.URL
+		case "dismissedReason":
+			data[f] = a.DismissedReason
+		}
+	}
+	return data
+}
+
+// ListOptions configures a call to ListAlerts.
+type ListOptions struct {
+	State    string
+	Severity string
+	Rule     string
+	Ref      string
+	Limit    int
+}
+
+// ListAlerts lists code scanning alerts for a repository, most recently created first,
+// filtering by state and severity on the server and by rule ID locally, since the REST
+// API has no query parameter for it.
+func ListAlerts(httpClient *http.Client, repo ghrepo.Interface, opts ListOptions) ([]Alert, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	query := url.Values{}
+	query.Set("per_page", fmt.Sprintf("%d", opts.Limit))
+	if opts.State != "" {
+		query.Set("state", opts.State)
+	}
+	if opts.Severity != "" {
+		query.Set("severity", opts.Severity)
+	}
+	if opts.Ref != "" {
+		query.Set("ref", opts.Ref)
+	}
+	path := fmt.Sprintf("repos/%s/%s/code-scanning/alerts?%s", repo.RepoOwner(), repo.RepoName(), query.Encode())
+
+	var alerts []Alert
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &alerts); err != nil {
+		return nil, err
+	}
+
+	if opts.Rule == "" {
+		return alerts, nil
+	}
+
+	filtered := alerts[:0]
+	for _, a := range alerts {
+		if a.Rule.ID == opts.Rule {
+			filtered = append(filtered, a)
+		}
+	}
+	return filtered, nil
+}
+
+// ListOrgAlerts lists code scanning alerts across every repository in an organization.
+func ListOrgAlerts(httpClient *http.Client, hostname, org string, opts ListOptions) ([]Alert, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	query := url.Values{}
+	query.Set("per_page", fmt.Sprintf("%d", opts.Limit))
+	if opts.State != "" {
+		query.Set("state", opts.State)
+	}
+	if opts.Severity != "" {
+		query.Set("severity", opts.Severity)
+	}
+	path := fmt.Sprintf("orgs/%s/code-scanning/alerts?%s", org, query.Encode())
+
+	var alerts []Alert
+	if err := apiClient.REST(hostname, "GET", path, nil, &alerts); err != nil {
+		return nil, err
+	}
+
+	if opts.Rule == "" {
+		return alerts, nil
+	}
+
+	filtered := alerts[:0]
+	for _, a := range alerts {
+		if a.Rule.ID == opts.Rule {
+			filtered = append(filtered, a)
+		}
+	}
+	return filtered, nil
+}
+
+// AlertByNumber fetches a single code scanning alert by its number.
+func AlertByNumber(httpClient *http.Client, repo ghrepo.Interface, alertNumber int) (*Alert, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var alert Alert
+	path := fmt.Sprintf("repos/%s/%s/code-scanning/alerts/%d", repo.RepoOwner(), repo.RepoName(), alertNumber)
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// DismissReasons are the values GitHub accepts for a dismissed alert's reason.
+var DismissReasons = []string{"false positive", "won't fix", "used in tests"}
+
+// DismissAlert marks a code scanning alert as dismissed, recording why it was dismissed.
+func DismissAlert(httpClient *http.Client, repo ghrepo.Interface, alertNumber int, reason string) (*Alert, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	params := map[string]string{
+		"state":            "dismissed",
+		"dismissed_reason": reason,
+	}
+	requestByte, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var alert Alert
+	path := fmt.Sprintf("repos/%s/%s/code-scanning/alerts/%d", repo.RepoOwner(), repo.RepoName(), alertNumber)
+	if err := apiClient.REST(repo.RepoHost(), "PATCH", path, bytes.NewReader(requestByte), &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// UploadSarif uploads a SARIF file's contents, gzip-compressed and base64-encoded as
+// required by the code scanning API, as an analysis for the given commit and ref.
+func UploadSarif(httpClient *http.Client, repo ghrepo.Interface, commitSHA, ref, sarif string) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	params := map[string]string{
+		"commit_sha": commitSHA,
+		"ref":        ref,
+		"sarif":      sarif,
+	}
+	requestByte, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/code-scanning/sarifs", repo.RepoOwner(), repo.RepoName())
+	return apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), nil)
+}
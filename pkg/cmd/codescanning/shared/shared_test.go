@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListAlerts(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	stub := func() {
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/code-scanning/alerts"),
+			httpmock.StringResponse(`[
+				{"number":1,"state":"open","rule":{"id":"js/sql-injection","severity":"critical","description":"SQL injection"}},
+				{"number":2,"state":"open","rule":{"id":"js/trivial-conditional","severity":"low","description":"Trivial conditional"}}
+			]`))
+	}
+	stub()
+
+	alerts, err := ListAlerts(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), ListOptions{Limit: 30})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(alerts))
+
+	stub()
+	alerts, err = ListAlerts(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), ListOptions{Limit: 30, Rule: "js/sql-injection"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(alerts))
+	assert.Equal(t, "SQL injection", alerts[0].Rule.Description)
+}
+
+func Test_AlertByNumber(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/code-scanning/alerts/1"),
+		httpmock.StringResponse(`{"number":1,"state":"open","rule":{"id":"js/sql-injection","severity":"critical","description":"SQL injection"}}`))
+
+	alert, err := AlertByNumber(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "js/sql-injection", alert.Rule.ID)
+}
+
+func Test_DismissAlert(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO/code-scanning/alerts/1"),
+		httpmock.StringResponse(`{"number":1,"state":"dismissed","dismissed_reason":"won't fix"}`))
+
+	alert, err := DismissAlert(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), 1, "won't fix")
+	require.NoError(t, err)
+	assert.Equal(t, "dismissed", alert.State)
+	assert.Equal(t, "won't fix", alert.DismissedReason)
+}
@@ -0,0 +1,117 @@
+package upload
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/codescanning/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UploadOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	SarifFile string
+	CommitSHA string
+	Ref       string
+}
+
+func NewCmdUpload(f *cmdutil.Factory, runF func(*UploadOptions) error) *cobra.Command {
+	opts := &UploadOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "upload <sarif-file>",
+		Short: "Upload a SARIF file of code scanning results",
+		Args:  cobra.ExactArgs(1),
+		Long: heredoc.Doc(`
+			Upload a SARIF file containing code scanning results for a specific commit and ref.
+		`),
+		Example: heredoc.Doc(`
+			$ gh code-scanning upload results.sarif --commit=$(git rev-parse HEAD) --ref=refs/heads/main
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.SarifFile = args[0]
+
+			if opts.CommitSHA == "" {
+				return cmdutil.FlagErrorf("`--commit` is required")
+			}
+			if opts.Ref == "" {
+				return cmdutil.FlagErrorf("`--ref` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return uploadRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.CommitSHA, "commit", "", "The `SHA` of the commit the results are for")
+	cmd.Flags().StringVar(&opts.Ref, "ref", "", "The `ref` the results are for, e.g. refs/heads/main")
+
+	return cmd
+}
+
+func uploadRun(opts *UploadOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	contents, err := os.ReadFile(opts.SarifFile)
+	if err != nil {
+		return fmt.Errorf("could not read %s: %w", opts.SarifFile, err)
+	}
+
+	sarif, err := compressAndEncode(contents)
+	if err != nil {
+		return fmt.Errorf("could not compress %s: %w", opts.SarifFile, err)
+	}
+
+	opts.IO.StartProgressIndicator()
+	err = shared.UploadSarif(httpClient, baseRepo, opts.CommitSHA, opts.Ref, sarif)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Uploaded %s\n", cs.SuccessIcon(), opts.SarifFile)
+	}
+
+	return nil
+}
+
+// compressAndEncode gzip-compresses then base64-encodes SARIF file contents, as
+// required by the code scanning "Upload an analysis as SARIF data" API.
+func compressAndEncode(contents []byte) (string, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(contents); err != nil {
+		return "", err
+	}
+	if err := gz.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
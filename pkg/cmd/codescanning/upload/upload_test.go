@@ -0,0 +1,121 @@
+package upload
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdUpload(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  UploadOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no argument",
+			input:   "--commit=SHA --ref=refs/heads/main",
+			wantErr: true,
+			errMsg:  "accepts 1 arg(s), received 0",
+		},
+		{
+			name:    "no commit",
+			input:   "results.sarif --ref=refs/heads/main",
+			wantErr: true,
+			errMsg:  "`--commit` is required",
+		},
+		{
+			name:    "no ref",
+			input:   "results.sarif --commit=SHA",
+			wantErr: true,
+			errMsg:  "`--ref` is required",
+		},
+		{
+			name:  "valid",
+			input: "results.sarif --commit=SHA --ref=refs/heads/main",
+			output: UploadOptions{
+				SarifFile: "results.sarif",
+				CommitSHA: "SHA",
+				Ref:       "refs/heads/main",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *UploadOptions
+			cmd := NewCmdUpload(f, func(opts *UploadOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.SarifFile, gotOpts.SarifFile)
+			assert.Equal(t, tt.output.CommitSHA, gotOpts.CommitSHA)
+			assert.Equal(t, tt.output.Ref, gotOpts.Ref)
+		})
+	}
+}
+
+func TestUploadRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/code-scanning/sarifs"),
+		httpmock.StatusStringResponse(202, "{}"),
+	)
+	defer reg.Verify(t)
+
+	sarifFile, err := os.CreateTemp(t.TempDir(), "results-*.sarif")
+	assert.NoError(t, err)
+	_, err = sarifFile.WriteString(`{"version": "2.1.0"}`)
+	assert.NoError(t, err)
+	assert.NoError(t, sarifFile.Close())
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &UploadOptions{
+		SarifFile: sarifFile.Name(),
+		CommitSHA: "SHA",
+		Ref:       "refs/heads/main",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err = uploadRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Uploaded "+sarifFile.Name()+"\n", stdout.String())
+}
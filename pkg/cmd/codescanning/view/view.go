@@ -0,0 +1,97 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/codescanning/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	AlertNumber int
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <alert-number>",
+		Short: "View a code scanning alert",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ gh code-scanning view 123
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			alertNumber, err := strconv.Atoi(args[0])
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid alert number: %q", args[0])
+			}
+			opts.AlertNumber = alertNumber
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.Fields)
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	alert, err := shared.AlertByNumber(httpClient, baseRepo, opts.AlertNumber)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, alert)
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s %s\n", cs.Bold(fmt.Sprintf("#%d", alert.Number)), alert.Rule.Description)
+	fmt.Fprintf(out, "%s: %s\n", cs.Bold("Rule"), alert.Rule.ID)
+	fmt.Fprintf(out, "%s: %s\n", cs.Bold("Severity"), alert.Rule.Severity)
+	fmt.Fprintf(out, "%s: %s\n", cs.Bold("State"), alert.State)
+	fmt.Fprintf(out, "%s: %s\n", cs.Bold("Tool"), alert.Tool.Name)
+	fmt.Fprintf(out, "%s: %s\n", cs.Bold("Path"), alert.MostRecentInstance.Location.Path)
+	fmt.Fprintf(out, "%s: %s\n", cs.Bold("Ref"), alert.MostRecentInstance.Ref)
+	if alert.DismissedReason != "" {
+		fmt.Fprintf(out, "%s: %s\n", cs.Bold("Dismissed reason"), alert.DismissedReason)
+	}
+	fmt.Fprintf(out, "%s: %s\n", cs.Bold("URL"), alert.URL)
+
+	return nil
+}
@@ -74,6 +74,12 @@ type apiClient interface {
 	GetCodespacesMachines(ctx context.Context, repoID int, branch, location string) ([]*api.Machine, error)
 	GetCodespaceRepositoryContents(ctx context.Context, codespace *api.Codespace, path string) ([]byte, error)
 	GetCodespaceRepoSuggestions(ctx context.Context, partialSearch string, params api.RepoSearchParameters) ([]string, error)
+	ListPrebuildConfigurations(ctx context.Context, nwo string) ([]*api.PrebuildConfiguration, error)
+	CreatePrebuildConfiguration(ctx context.Context, nwo string, params *api.PrebuildConfiguration) (*api.PrebuildConfiguration, error)
+	DeletePrebuildConfiguration(ctx context.Context, nwo string, id int64) error
+	ListPrebuildRuns(ctx context.Context, nwo string, id int64) ([]*api.PrebuildRun, error)
+	TriggerPrebuildRun(ctx context.Context, nwo string, id int64) (*api.PrebuildRun, error)
+	RebuildCodespace(ctx context.Context, codespaceName string, full bool) error
 }
 
 var errNoCodespaces = errors.New("you have no codespaces")
@@ -17,6 +17,7 @@ import (
 
 type createOptions struct {
 	repo              string
+	template          string
 	branch            string
 	location          string
 	machine           string
@@ -32,12 +33,22 @@ func newCreateCmd(app *App) *cobra.Command {
 		Use:   "create",
 		Short: "Create a codespace",
 		Args:  noArgsConstraint,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if opts.repo != "" && opts.template != "" {
+				return cmdutil.FlagErrorf("cannot use both --repo and --template")
+			}
+			if opts.template != "" {
+				opts.repo = opts.template
+			}
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return app.Create(cmd.Context(), opts)
 		},
 	}
 
 	createCmd.Flags().StringVarP(&opts.repo, "repo", "r", "", "repository name with owner: user/repo")
+	createCmd.Flags().StringVarP(&opts.template, "template", "", "", "template repository published with \"gh codespace template create\", e.g. user/templates")
 	createCmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "repository branch")
 	createCmd.Flags().StringVarP(&opts.location, "location", "l", "", "location: {EastUs|SouthEastAsia|WestEurope|WestUs2} (determined automatically if not provided)")
 	createCmd.Flags().StringVarP(&opts.machine, "machine", "m", "", "hardware specifications for the VM")
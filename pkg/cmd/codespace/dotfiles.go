@@ -0,0 +1,140 @@
+package codespace
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+
+	"github.com/cli/cli/v2/internal/codespaces"
+	"github.com/cli/cli/v2/pkg/liveshare"
+	"github.com/spf13/cobra"
+)
+
+// dotfilesRepoRE matches the "OWNER/REPO" shape only, so opts.repo is safe to
+// interpolate into the remote shell command below; see the cp command's
+// handling of "remote:" arguments in ssh.go for the same class of concern
+// (https://lwn.net/Articles/835962/).
+var dotfilesRepoRE = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*/[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+func newDotfilesCmd(app *App) *cobra.Command {
+	dotfilesCmd := &cobra.Command{
+		Use:   "dotfiles",
+		Short: "Manage dotfiles in codespaces",
+	}
+
+	dotfilesCmd.AddCommand(newDotfilesSyncCmd(app))
+
+	return dotfilesCmd
+}
+
+type dotfilesSyncOptions struct {
+	codespaceName string
+	repo          string
+}
+
+func newDotfilesSyncCmd(app *App) *cobra.Command {
+	opts := dotfilesSyncOptions{}
+
+	syncCmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Push updated dotfiles into a running codespace and re-run the install script",
+		Args:  noArgsConstraint,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.SyncDotfiles(cmd.Context(), opts)
+		},
+	}
+
+	syncCmd.Flags().StringVarP(&opts.codespaceName, "codespace", "c", "", "Name of the codespace")
+	syncCmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Dotfiles repository, e.g. \"me/dotfiles\" (required)")
+	_ = syncCmd.MarkFlagRequired("repo")
+
+	return syncCmd
+}
+
+// SyncDotfiles clones or pulls the given dotfiles repository inside the
+// codespace and re-runs its install script, so changes pushed to the
+// dotfiles repo after the codespace was created take effect immediately.
+func (a *App) SyncDotfiles(ctx context.Context, opts dotfilesSyncOptions) (err error) {
+	// Ensure all child tasks (port forwarding, remote exec) terminate before return.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	apiCodespace, err := getOrChooseCodespace(ctx, a.apiClient, opts.codespaceName)
+	if err != nil {
+		return err
+	}
+	cs := codespace{apiCodespace}
+	if !cs.running() {
+		return fmt.Errorf("codespace %q is not running", apiCodespace.Name)
+	}
+
+	if !dotfilesRepoRE.MatchString(opts.repo) {
+		return fmt.Errorf("invalid dotfiles repository %q: expected the \"OWNER/REPO\" format", opts.repo)
+	}
+
+	authkeys := make(chan error, 1)
+	go func() {
+		authkeys <- checkAuthorizedKeys(ctx, a.apiClient)
+	}()
+
+	session, err := codespaces.ConnectToLiveshare(ctx, a, noopLogger(), a.apiClient, apiCodespace)
+	if err != nil {
+		return fmt.Errorf("connecting to codespace: %w", err)
+	}
+	defer safeClose(session, &err)
+
+	if err := <-authkeys; err != nil {
+		return err
+	}
+
+	listen, err := net.Listen("tcp", "127.0.0.1:0") // arbitrary port
+	if err != nil {
+		return err
+	}
+	defer listen.Close()
+	localPort := listen.Addr().(*net.TCPAddr).Port
+
+	a.StartProgressIndicatorWithLabel("Fetching SSH Details")
+	remoteSSHServerPort, sshUser, err := session.StartSSHServer(ctx)
+	a.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("error getting ssh server details: %w", err)
+	}
+
+	const remoteDotfilesDir = "~/dotfiles"
+	syncScript := fmt.Sprintf(
+		`if [ -d %[1]s/.git ]; then git -C %[1]s pull --ff-only; else git clone --depth=1 https://github.com/%[2]s.git %[1]s; fi; `+
+			`cd %[1]s && (./install.sh || ./install || ./bootstrap.sh || true)`,
+		remoteDotfilesDir, opts.repo,
+	)
+
+	dst := fmt.Sprintf("%s@localhost", sshUser)
+	cmd, err := codespaces.NewRemoteCommand(ctx, localPort, dst, syncScript)
+	if err != nil {
+		return fmt.Errorf("remote command: %w", err)
+	}
+	cmd.Stdout = a.io.Out
+	cmd.Stderr = a.io.ErrOut
+
+	tunnelClosed := make(chan error, 1)
+	go func() {
+		fwd := liveshare.NewPortForwarder(session, "sshd", remoteSSHServerPort, false)
+		tunnelClosed <- fwd.ForwardToListener(ctx, listen) // error is non-nil
+	}()
+
+	cmdDone := make(chan error, 1)
+	go func() {
+		cmdDone <- cmd.Run()
+	}()
+
+	select {
+	case err := <-tunnelClosed:
+		return fmt.Errorf("connection closed: %w", err)
+	case err := <-cmdDone:
+		if err != nil {
+			return fmt.Errorf("error syncing dotfiles: %w", err)
+		}
+		return nil // success
+	}
+}
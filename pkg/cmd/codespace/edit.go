@@ -3,6 +3,9 @@ package codespace
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cli/cli/v2/internal/codespaces/api"
 	"github.com/spf13/cobra"
@@ -12,6 +15,8 @@ type editOptions struct {
 	codespaceName string
 	displayName   string
 	machine       string
+	idleTimeout   time.Duration
+	retention     time.Duration
 }
 
 func newEditCmd(app *App) *cobra.Command {
@@ -28,26 +33,34 @@ func newEditCmd(app *App) *cobra.Command {
 
 	editCmd.Flags().StringVarP(&opts.codespaceName, "codespace", "c", "", "Name of the codespace")
 	editCmd.Flags().StringVarP(&opts.displayName, "displayName", "d", "", "display name")
-	editCmd.Flags().StringVarP(&opts.machine, "machine", "m", "", "hardware specifications for the VM")
+	editCmd.Flags().StringVarP(&opts.machine, "machine", "m", "", "hardware specifications for the VM; omit to choose interactively from the available machine types")
+	editCmd.Flags().DurationVar(&opts.idleTimeout, "idle-timeout", 0, "allowed inactivity before codespace is stopped, e.g. \"10m\", \"1h\"")
+	editCmd.Flags().Var(newDayAwareDurationValue(&opts.retention), "retention", "time codespace is retained after stopping, e.g. \"30m\", \"7d\"")
 
 	return editCmd
 }
 
 // Edits a codespace
 func (a *App) Edit(ctx context.Context, opts editOptions) error {
-	userInputs := struct {
-		CodespaceName string
-		DisplayName   string
-		SKU           string
-	}{
-		CodespaceName: opts.codespaceName,
-		DisplayName:   opts.displayName,
-		SKU:           opts.machine,
+	codespace, err := getOrChooseCodespace(ctx, a.apiClient, opts.codespaceName)
+	if err != nil {
+		return err
+	}
+
+	machine := opts.machine
+	if machine == "" && opts.displayName == "" && opts.idleTimeout == 0 && opts.retention == 0 {
+		machine, err = getMachineName(ctx, a.apiClient, codespace.Repository.ID, "", codespace.GitStatus.Ref, codespace.VSCSTarget)
+		if err != nil {
+			return fmt.Errorf("error getting machine type: %w", err)
+		}
 	}
+
 	a.StartProgressIndicatorWithLabel("Editing codespace")
-	_, err := a.apiClient.EditCodespace(ctx, userInputs.CodespaceName, &api.EditCodespaceParams{
-		DisplayName: userInputs.DisplayName,
-		Machine:     userInputs.SKU,
+	_, err = a.apiClient.EditCodespace(ctx, codespace.Name, &api.EditCodespaceParams{
+		DisplayName:            opts.displayName,
+		Machine:                machine,
+		IdleTimeoutMinutes:     int(opts.idleTimeout.Minutes()),
+		RetentionPeriodMinutes: int(opts.retention.Minutes()),
 	})
 	a.StopProgressIndicator()
 	if err != nil {
@@ -56,3 +69,37 @@ func (a *App) Edit(ctx context.Context, opts editOptions) error {
 
 	return nil
 }
+
+// dayAwareDurationValue is a pflag.Value that parses durations the same way
+// time.ParseDuration does, but also accepts a "d" (day) unit, since retention
+// periods are typically expressed in days (e.g. "7d").
+type dayAwareDurationValue time.Duration
+
+func newDayAwareDurationValue(d *time.Duration) *dayAwareDurationValue {
+	return (*dayAwareDurationValue)(d)
+}
+
+func (d *dayAwareDurationValue) Set(s string) error {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.ParseFloat(strings.TrimSuffix(s, "d"), 64)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", s)
+		}
+		*d = dayAwareDurationValue(time.Duration(days * 24 * float64(time.Hour)))
+		return nil
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = dayAwareDurationValue(v)
+	return nil
+}
+
+func (d *dayAwareDurationValue) String() string {
+	return time.Duration(*d).String()
+}
+
+func (d *dayAwareDurationValue) Type() string {
+	return "duration"
+}
@@ -71,15 +71,21 @@ func (a *App) Logs(ctx context.Context, codespaceName string, follow bool) (err
 		return fmt.Errorf("error getting ssh server details: %w", err)
 	}
 
-	cmdType := "cat"
+	// creationLogPath covers provisioning of the codespace itself; postCreateLogPath
+	// covers the devcontainer's postCreateCommand output, which is where most
+	// broken devcontainer setups actually fail.
+	const creationLogPath = "/workspaces/.codespaces/.persistedshare/creation.log"
+	const postCreateLogPath = "/workspaces/.codespaces/shared/postCreate.log"
+
+	cmdType := fmt.Sprintf("cat %s %s", creationLogPath, postCreateLogPath)
 	if follow {
-		cmdType = "tail -f"
+		// tail -F keeps watching even if the postCreate log doesn't exist yet
+		// when provisioning starts.
+		cmdType = fmt.Sprintf("tail -F %s %s", creationLogPath, postCreateLogPath)
 	}
 
 	dst := fmt.Sprintf("%s@localhost", sshUser)
-	cmd, err := codespaces.NewRemoteCommand(
-		ctx, localPort, dst, fmt.Sprintf("%s /workspaces/.codespaces/.persistedshare/creation.log", cmdType),
-	)
+	cmd, err := codespaces.NewRemoteCommand(ctx, localPort, dst, cmdType)
 	if err != nil {
 		return fmt.Errorf("remote command: %w", err)
 	}
@@ -71,9 +71,15 @@ type apiClientMock struct {
 	// CreateCodespaceFunc mocks the CreateCodespace method.
 	CreateCodespaceFunc func(ctx context.Context, params *api.CreateCodespaceParams) (*api.Codespace, error)
 
+	// CreatePrebuildConfigurationFunc mocks the CreatePrebuildConfiguration method.
+	CreatePrebuildConfigurationFunc func(ctx context.Context, nwo string, params *api.PrebuildConfiguration) (*api.PrebuildConfiguration, error)
+
 	// DeleteCodespaceFunc mocks the DeleteCodespace method.
 	DeleteCodespaceFunc func(ctx context.Context, name string) error
 
+	// DeletePrebuildConfigurationFunc mocks the DeletePrebuildConfiguration method.
+	DeletePrebuildConfigurationFunc func(ctx context.Context, nwo string, id int64) error
+
 	// EditCodespaceFunc mocks the EditCodespace method.
 	EditCodespaceFunc func(ctx context.Context, codespaceName string, params *api.EditCodespaceParams) (*api.Codespace, error)
 
@@ -101,12 +107,24 @@ type apiClientMock struct {
 	// ListCodespacesFunc mocks the ListCodespaces method.
 	ListCodespacesFunc func(ctx context.Context, limit int) ([]*api.Codespace, error)
 
+	// ListPrebuildConfigurationsFunc mocks the ListPrebuildConfigurations method.
+	ListPrebuildConfigurationsFunc func(ctx context.Context, nwo string) ([]*api.PrebuildConfiguration, error)
+
+	// ListPrebuildRunsFunc mocks the ListPrebuildRuns method.
+	ListPrebuildRunsFunc func(ctx context.Context, nwo string, id int64) ([]*api.PrebuildRun, error)
+
 	// StartCodespaceFunc mocks the StartCodespace method.
 	StartCodespaceFunc func(ctx context.Context, name string) error
 
 	// StopCodespaceFunc mocks the StopCodespace method.
 	StopCodespaceFunc func(ctx context.Context, name string) error
 
+	// TriggerPrebuildRunFunc mocks the TriggerPrebuildRun method.
+	TriggerPrebuildRunFunc func(ctx context.Context, nwo string, id int64) (*api.PrebuildRun, error)
+
+	// RebuildCodespaceFunc mocks the RebuildCodespace method.
+	RebuildCodespaceFunc func(ctx context.Context, codespaceName string, full bool) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AuthorizedKeys holds details about calls to the AuthorizedKeys method.
@@ -123,6 +141,15 @@ type apiClientMock struct {
 			// Params is the params argument value.
 			Params *api.CreateCodespaceParams
 		}
+		// CreatePrebuildConfiguration holds details about calls to the CreatePrebuildConfiguration method.
+		CreatePrebuildConfiguration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Nwo is the nwo argument value.
+			Nwo string
+			// Params is the params argument value.
+			Params *api.PrebuildConfiguration
+		}
 		// DeleteCodespace holds details about calls to the DeleteCodespace method.
 		DeleteCodespace []struct {
 			// Ctx is the ctx argument value.
@@ -130,6 +157,15 @@ type apiClientMock struct {
 			// Name is the name argument value.
 			Name string
 		}
+		// DeletePrebuildConfiguration holds details about calls to the DeletePrebuildConfiguration method.
+		DeletePrebuildConfiguration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Nwo is the nwo argument value.
+			Nwo string
+			// ID is the id argument value.
+			ID int64
+		}
 		// EditCodespace holds details about calls to the EditCodespace method.
 		EditCodespace []struct {
 			// Ctx is the ctx argument value.
@@ -201,6 +237,31 @@ type apiClientMock struct {
 			// Limit is the limit argument value.
 			Limit int
 		}
+		// ListPrebuildConfigurations holds details about calls to the ListPrebuildConfigurations method.
+		ListPrebuildConfigurations []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Nwo is the nwo argument value.
+			Nwo string
+		}
+		// ListPrebuildRuns holds details about calls to the ListPrebuildRuns method.
+		ListPrebuildRuns []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Nwo is the nwo argument value.
+			Nwo string
+			// ID is the id argument value.
+			ID int64
+		}
+		// TriggerPrebuildRun holds details about calls to the TriggerPrebuildRun method.
+		TriggerPrebuildRun []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Nwo is the nwo argument value.
+			Nwo string
+			// ID is the id argument value.
+			ID int64
+		}
 		// StartCodespace holds details about calls to the StartCodespace method.
 		StartCodespace []struct {
 			// Ctx is the ctx argument value.
@@ -215,10 +276,21 @@ type apiClientMock struct {
 			// Name is the name argument value.
 			Name string
 		}
+		// RebuildCodespace holds details about calls to the RebuildCodespace method.
+		RebuildCodespace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CodespaceName is the codespaceName argument value.
+			CodespaceName string
+			// Full is the full argument value.
+			Full bool
+		}
 	}
 	lockAuthorizedKeys                 sync.RWMutex
 	lockCreateCodespace                sync.RWMutex
+	lockCreatePrebuildConfiguration    sync.RWMutex
 	lockDeleteCodespace                sync.RWMutex
+	lockDeletePrebuildConfiguration    sync.RWMutex
 	lockEditCodespace                  sync.RWMutex
 	lockGetCodespace                   sync.RWMutex
 	lockGetCodespaceRegionLocation     sync.RWMutex
@@ -228,8 +300,12 @@ type apiClientMock struct {
 	lockGetRepository                  sync.RWMutex
 	lockGetUser                        sync.RWMutex
 	lockListCodespaces                 sync.RWMutex
+	lockListPrebuildConfigurations     sync.RWMutex
+	lockListPrebuildRuns               sync.RWMutex
 	lockStartCodespace                 sync.RWMutex
 	lockStopCodespace                  sync.RWMutex
+	lockTriggerPrebuildRun             sync.RWMutex
+	lockRebuildCodespace               sync.RWMutex
 }
 
 // AuthorizedKeys calls AuthorizedKeysFunc.
@@ -737,3 +813,233 @@ func (mock *apiClientMock) StopCodespaceCalls() []struct {
 	mock.lockStopCodespace.RUnlock()
 	return calls
 }
+
+// CreatePrebuildConfiguration calls CreatePrebuildConfigurationFunc.
+func (mock *apiClientMock) CreatePrebuildConfiguration(ctx context.Context, nwo string, params *api.PrebuildConfiguration) (*api.PrebuildConfiguration, error) {
+	if mock.CreatePrebuildConfigurationFunc == nil {
+		panic("apiClientMock.CreatePrebuildConfigurationFunc: method is nil but apiClient.CreatePrebuildConfiguration was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Nwo    string
+		Params *api.PrebuildConfiguration
+	}{
+		Ctx:    ctx,
+		Nwo:    nwo,
+		Params: params,
+	}
+	mock.lockCreatePrebuildConfiguration.Lock()
+	mock.calls.CreatePrebuildConfiguration = append(mock.calls.CreatePrebuildConfiguration, callInfo)
+	mock.lockCreatePrebuildConfiguration.Unlock()
+	return mock.CreatePrebuildConfigurationFunc(ctx, nwo, params)
+}
+
+// CreatePrebuildConfigurationCalls gets all the calls that were made to CreatePrebuildConfiguration.
+// Check the length with:
+//     len(mockedapiClient.CreatePrebuildConfigurationCalls())
+func (mock *apiClientMock) CreatePrebuildConfigurationCalls() []struct {
+	Ctx    context.Context
+	Nwo    string
+	Params *api.PrebuildConfiguration
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Nwo    string
+		Params *api.PrebuildConfiguration
+	}
+	mock.lockCreatePrebuildConfiguration.RLock()
+	calls = mock.calls.CreatePrebuildConfiguration
+	mock.lockCreatePrebuildConfiguration.RUnlock()
+	return calls
+}
+
+// DeletePrebuildConfiguration calls DeletePrebuildConfigurationFunc.
+func (mock *apiClientMock) DeletePrebuildConfiguration(ctx context.Context, nwo string, id int64) error {
+	if mock.DeletePrebuildConfigurationFunc == nil {
+		panic("apiClientMock.DeletePrebuildConfigurationFunc: method is nil but apiClient.DeletePrebuildConfiguration was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Nwo string
+		ID  int64
+	}{
+		Ctx: ctx,
+		Nwo: nwo,
+		ID:  id,
+	}
+	mock.lockDeletePrebuildConfiguration.Lock()
+	mock.calls.DeletePrebuildConfiguration = append(mock.calls.DeletePrebuildConfiguration, callInfo)
+	mock.lockDeletePrebuildConfiguration.Unlock()
+	return mock.DeletePrebuildConfigurationFunc(ctx, nwo, id)
+}
+
+// DeletePrebuildConfigurationCalls gets all the calls that were made to DeletePrebuildConfiguration.
+// Check the length with:
+//     len(mockedapiClient.DeletePrebuildConfigurationCalls())
+func (mock *apiClientMock) DeletePrebuildConfigurationCalls() []struct {
+	Ctx context.Context
+	Nwo string
+	ID  int64
+} {
+	var calls []struct {
+		Ctx context.Context
+		Nwo string
+		ID  int64
+	}
+	mock.lockDeletePrebuildConfiguration.RLock()
+	calls = mock.calls.DeletePrebuildConfiguration
+	mock.lockDeletePrebuildConfiguration.RUnlock()
+	return calls
+}
+
+// ListPrebuildConfigurations calls ListPrebuildConfigurationsFunc.
+func (mock *apiClientMock) ListPrebuildConfigurations(ctx context.Context, nwo string) ([]*api.PrebuildConfiguration, error) {
+	if mock.ListPrebuildConfigurationsFunc == nil {
+		panic("apiClientMock.ListPrebuildConfigurationsFunc: method is nil but apiClient.ListPrebuildConfigurations was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Nwo string
+	}{
+		Ctx: ctx,
+		Nwo: nwo,
+	}
+	mock.lockListPrebuildConfigurations.Lock()
+	mock.calls.ListPrebuildConfigurations = append(mock.calls.ListPrebuildConfigurations, callInfo)
+	mock.lockListPrebuildConfigurations.Unlock()
+	return mock.ListPrebuildConfigurationsFunc(ctx, nwo)
+}
+
+// ListPrebuildConfigurationsCalls gets all the calls that were made to ListPrebuildConfigurations.
+// Check the length with:
+//     len(mockedapiClient.ListPrebuildConfigurationsCalls())
+func (mock *apiClientMock) ListPrebuildConfigurationsCalls() []struct {
+	Ctx context.Context
+	Nwo string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Nwo string
+	}
+	mock.lockListPrebuildConfigurations.RLock()
+	calls = mock.calls.ListPrebuildConfigurations
+	mock.lockListPrebuildConfigurations.RUnlock()
+	return calls
+}
+
+// ListPrebuildRuns calls ListPrebuildRunsFunc.
+func (mock *apiClientMock) ListPrebuildRuns(ctx context.Context, nwo string, id int64) ([]*api.PrebuildRun, error) {
+	if mock.ListPrebuildRunsFunc == nil {
+		panic("apiClientMock.ListPrebuildRunsFunc: method is nil but apiClient.ListPrebuildRuns was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Nwo string
+		ID  int64
+	}{
+		Ctx: ctx,
+		Nwo: nwo,
+		ID:  id,
+	}
+	mock.lockListPrebuildRuns.Lock()
+	mock.calls.ListPrebuildRuns = append(mock.calls.ListPrebuildRuns, callInfo)
+	mock.lockListPrebuildRuns.Unlock()
+	return mock.ListPrebuildRunsFunc(ctx, nwo, id)
+}
+
+// ListPrebuildRunsCalls gets all the calls that were made to ListPrebuildRuns.
+// Check the length with:
+//     len(mockedapiClient.ListPrebuildRunsCalls())
+func (mock *apiClientMock) ListPrebuildRunsCalls() []struct {
+	Ctx context.Context
+	Nwo string
+	ID  int64
+} {
+	var calls []struct {
+		Ctx context.Context
+		Nwo string
+		ID  int64
+	}
+	mock.lockListPrebuildRuns.RLock()
+	calls = mock.calls.ListPrebuildRuns
+	mock.lockListPrebuildRuns.RUnlock()
+	return calls
+}
+
+// TriggerPrebuildRun calls TriggerPrebuildRunFunc.
+func (mock *apiClientMock) TriggerPrebuildRun(ctx context.Context, nwo string, id int64) (*api.PrebuildRun, error) {
+	if mock.TriggerPrebuildRunFunc == nil {
+		panic("apiClientMock.TriggerPrebuildRunFunc: method is nil but apiClient.TriggerPrebuildRun was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Nwo string
+		ID  int64
+	}{
+		Ctx: ctx,
+		Nwo: nwo,
+		ID:  id,
+	}
+	mock.lockTriggerPrebuildRun.Lock()
+	mock.calls.TriggerPrebuildRun = append(mock.calls.TriggerPrebuildRun, callInfo)
+	mock.lockTriggerPrebuildRun.Unlock()
+	return mock.TriggerPrebuildRunFunc(ctx, nwo, id)
+}
+
+// TriggerPrebuildRunCalls gets all the calls that were made to TriggerPrebuildRun.
+// Check the length with:
+//     len(mockedapiClient.TriggerPrebuildRunCalls())
+func (mock *apiClientMock) TriggerPrebuildRunCalls() []struct {
+	Ctx context.Context
+	Nwo string
+	ID  int64
+} {
+	var calls []struct {
+		Ctx context.Context
+		Nwo string
+		ID  int64
+	}
+	mock.lockTriggerPrebuildRun.RLock()
+	calls = mock.calls.TriggerPrebuildRun
+	mock.lockTriggerPrebuildRun.RUnlock()
+	return calls
+}
+
+// RebuildCodespace calls RebuildCodespaceFunc.
+func (mock *apiClientMock) RebuildCodespace(ctx context.Context, codespaceName string, full bool) error {
+	if mock.RebuildCodespaceFunc == nil {
+		panic("apiClientMock.RebuildCodespaceFunc: method is nil but apiClient.RebuildCodespace was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		CodespaceName string
+		Full          bool
+	}{
+		Ctx:           ctx,
+		CodespaceName: codespaceName,
+		Full:          full,
+	}
+	mock.lockRebuildCodespace.Lock()
+	mock.calls.RebuildCodespace = append(mock.calls.RebuildCodespace, callInfo)
+	mock.lockRebuildCodespace.Unlock()
+	return mock.RebuildCodespaceFunc(ctx, codespaceName, full)
+}
+
+// RebuildCodespaceCalls gets all the calls that were made to RebuildCodespace.
+// Check the length with:
+//     len(mockedapiClient.RebuildCodespaceCalls())
+func (mock *apiClientMock) RebuildCodespaceCalls() []struct {
+	Ctx           context.Context
+	CodespaceName string
+	Full          bool
+} {
+	var calls []struct {
+		Ctx           context.Context
+		CodespaceName string
+		Full          bool
+	}
+	mock.lockRebuildCodespace.RLock()
+	calls = mock.calls.RebuildCodespace
+	mock.lockRebuildCodespace.RUnlock()
+	return calls
+}
@@ -209,7 +209,9 @@ func getDevContainer(ctx context.Context, apiClient apiClient, codespace *api.Co
 }
 
 func newPortsVisibilityCmd(app *App) *cobra.Command {
-	return &cobra.Command{
+	var allMatchingRepo string
+
+	cmd := &cobra.Command{
 		Use:     "visibility <port>:{public|private|org}...",
 		Short:   "Change the visibility of the forwarded port",
 		Example: "gh codespace ports visibility 80:org 3000:private 8000:public",
@@ -222,9 +224,50 @@ func newPortsVisibilityCmd(app *App) *cobra.Command {
 				// since it's a persistent flag that we control it should never happen
 				return fmt.Errorf("get codespace flag: %w", err)
 			}
+			if allMatchingRepo != "" {
+				if codespace != "" {
+					return cmdutil.FlagErrorf("cannot use both --codespace and --all-matching")
+				}
+				return app.UpdatePortVisibilityForRepo(cmd.Context(), allMatchingRepo, args)
+			}
 			return app.UpdatePortVisibility(cmd.Context(), codespace, args)
 		},
 	}
+
+	cmd.Flags().StringVar(&allMatchingRepo, "all-matching", "", "Apply to every running codespace for this repository, e.g. \"owner/repo\"")
+
+	return cmd
+}
+
+// UpdatePortVisibilityForRepo applies UpdatePortVisibility to every running
+// codespace belonging to the given repository, so that demo environments
+// spread across several codespaces can be updated in one command.
+func (a *App) UpdatePortVisibilityForRepo(ctx context.Context, nwo string, args []string) error {
+	a.StartProgressIndicatorWithLabel("Fetching codespaces")
+	codespaces, err := a.apiClient.ListCodespaces(ctx, -1)
+	a.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("error getting codespaces: %w", err)
+	}
+
+	var matched []string
+	for _, c := range codespaces {
+		cs := codespace{c}
+		if cs.running() && strings.EqualFold(c.Repository.FullName, nwo) {
+			matched = append(matched, c.Name)
+		}
+	}
+	if len(matched) == 0 {
+		return fmt.Errorf("no running codespaces found for repository %q", nwo)
+	}
+
+	for _, name := range matched {
+		a.errLogger.Printf("Updating ports in codespace %s", name)
+		if err := a.UpdatePortVisibility(ctx, name, args); err != nil {
+			return fmt.Errorf("codespace %s: %w", name, err)
+		}
+	}
+	return nil
 }
 
 type ErrUpdatingPortVisibility struct {
@@ -338,10 +381,12 @@ func (a *App) parsePortVisibilities(args []string) ([]portVisibility, error) {
 // NewPortsForwardCmd returns a Cobra "ports forward" subcommand, which forwards a set of
 // port pairs from the codespace to localhost.
 func newPortsForwardCmd(app *App) *cobra.Command {
-	return &cobra.Command{
+	var profile string
+
+	cmd := &cobra.Command{
 		Use:   "forward <remote-port>:<local-port>...",
 		Short: "Forward ports",
-		Args:  cobra.MinimumNArgs(1),
+		Args:  cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			codespace, err := cmd.Flags().GetString("codespace")
 			if err != nil {
@@ -351,9 +396,94 @@ func newPortsForwardCmd(app *App) *cobra.Command {
 				return fmt.Errorf("get codespace flag: %w", err)
 			}
 
-			return app.ForwardPorts(cmd.Context(), codespace, args)
+			ports := args
+			if profile != "" {
+				profiles, err := loadPortProfiles()
+				if err != nil {
+					return err
+				}
+				profilePorts, ok := profiles[profile]
+				if !ok {
+					return fmt.Errorf("no such port-forward profile: %q", profile)
+				}
+				ports = append(append([]string{}, profilePorts...), args...)
+			}
+			if len(ports) == 0 {
+				return cmdutil.FlagErrorf("must specify either port pairs or --profile")
+			}
+
+			return app.ForwardPorts(cmd.Context(), codespace, ports)
 		},
 	}
+
+	cmd.Flags().StringVar(&profile, "profile", "", "Establish all port forwards saved under this profile name")
+	cmd.AddCommand(newPortsForwardProfileCmd(app))
+
+	return cmd
+}
+
+// newPortsForwardProfileCmd returns a Cobra "ports forward profile" subcommand for
+// managing named groups of port forwards, so that `ports forward --profile <name>`
+// doesn't require repeating a long list of port pairs every time.
+func newPortsForwardProfileCmd(app *App) *cobra.Command {
+	root := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage port forwarding profiles",
+	}
+
+	root.AddCommand(&cobra.Command{
+		Use:   "save <name> <remote-port>:<local-port>...",
+		Short: "Save a named port forwarding profile",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, ports := args[0], args[1:]
+			if _, err := getPortPairs(ports); err != nil {
+				return fmt.Errorf("get port pairs: %w", err)
+			}
+
+			profiles, err := loadPortProfiles()
+			if err != nil {
+				return err
+			}
+			profiles[name] = ports
+			return savePortProfiles(profiles)
+		},
+	})
+
+	root.AddCommand(&cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a port forwarding profile",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profiles, err := loadPortProfiles()
+			if err != nil {
+				return err
+			}
+			if _, ok := profiles[args[0]]; !ok {
+				return fmt.Errorf("no such port-forward profile: %q", args[0])
+			}
+			delete(profiles, args[0])
+			return savePortProfiles(profiles)
+		},
+	})
+
+	root.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List port forwarding profiles",
+		Args:  noArgsConstraint,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profiles, err := loadPortProfiles()
+			if err != nil {
+				return err
+			}
+			for name, ports := range profiles {
+				fmt.Fprintf(app.io.Out, "%s\t%s\n", name, strings.Join(ports, " "))
+			}
+			return nil
+		},
+	})
+
+	return root
 }
 
 func (a *App) ForwardPorts(ctx context.Context, codespaceName string, ports []string) (err error) {
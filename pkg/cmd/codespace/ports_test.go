@@ -268,3 +268,26 @@ func testingPortsApp() *App {
 
 	return NewApp(io, nil, apiMock, nil)
 }
+
+func TestUpdatePortVisibilityForRepoNoMatches(t *testing.T) {
+	apiMock := &apiClientMock{
+		ListCodespacesFunc: func(_ context.Context, _ int) ([]*api.Codespace, error) {
+			return []*api.Codespace{
+				{Name: "stopped", State: api.CodespaceStateShutdown, Repository: api.Repository{FullName: "monalisa/spoon-knife"}},
+				{Name: "other-repo", State: api.CodespaceStateAvailable, Repository: api.Repository{FullName: "monalisa/other"}},
+			}, nil
+		},
+	}
+
+	io, _, _, _ := iostreams.Test()
+	app := NewApp(io, nil, apiMock, nil)
+
+	err := app.UpdatePortVisibilityForRepo(context.Background(), "monalisa/spoon-knife", []string{"80:org"})
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	wantErr := `no running codespaces found for repository "monalisa/spoon-knife"`
+	if err.Error() != wantErr {
+		t.Errorf("error = %q, want %q", err.Error(), wantErr)
+	}
+}
@@ -0,0 +1,175 @@
+package codespace
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+func newPrebuildCmd(app *App) *cobra.Command {
+	root := &cobra.Command{
+		Use:   "prebuild",
+		Short: "Manage codespace prebuild configurations",
+	}
+
+	root.AddCommand(newPrebuildListCmd(app))
+	root.AddCommand(newPrebuildCreateCmd(app))
+	root.AddCommand(newPrebuildDeleteCmd(app))
+	root.AddCommand(newPrebuildRunCmd(app))
+
+	return root
+}
+
+func newPrebuildListCmd(app *App) *cobra.Command {
+	var repo string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List prebuild configurations for a repository",
+		Args:  noArgsConstraint,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.ListPrebuilds(cmd.Context(), repo)
+		},
+	}
+	cmd.Flags().StringVarP(&repo, "repo", "R", "", "Repository in the `[HOST/]OWNER/REPO` format")
+	_ = cmd.MarkFlagRequired("repo")
+
+	return cmd
+}
+
+func (a *App) ListPrebuilds(ctx context.Context, nwo string) error {
+	configs, err := a.apiClient.ListPrebuildConfigurations(ctx, nwo)
+	if err != nil {
+		return fmt.Errorf("error listing prebuild configurations: %w", err)
+	}
+
+	cs := a.io.ColorScheme()
+	tp := utils.NewTablePrinter(a.io)
+	if tp.IsTTY() {
+		tp.AddField("ID", nil, nil)
+		tp.AddField("BRANCH", nil, nil)
+		tp.AddField("DEVCONTAINER", nil, nil)
+		tp.AddField("REGION", nil, nil)
+		tp.EndRow()
+	}
+	for _, c := range configs {
+		tp.AddField(strconv.FormatInt(c.ID, 10), nil, cs.Yellow)
+		tp.AddField(c.Branch, nil, nil)
+		tp.AddField(c.DevcontainerPath, nil, nil)
+		tp.AddField(c.Region, nil, nil)
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+func newPrebuildCreateCmd(app *App) *cobra.Command {
+	var repo, branch, devcontainerPath, region string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a prebuild configuration for a repository",
+		Args:  noArgsConstraint,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.CreatePrebuild(cmd.Context(), repo, branch, devcontainerPath, region)
+		},
+	}
+	cmd.Flags().StringVarP(&repo, "repo", "R", "", "Repository in the `[HOST/]OWNER/REPO` format")
+	cmd.Flags().StringVar(&branch, "branch", "main", "Branch the prebuild tracks")
+	cmd.Flags().StringVar(&devcontainerPath, "devcontainer-path", ".devcontainer/devcontainer.json", "Path to the devcontainer configuration")
+	cmd.Flags().StringVar(&region, "region", "", "Region to build prebuilds in")
+	_ = cmd.MarkFlagRequired("repo")
+
+	return cmd
+}
+
+func (a *App) CreatePrebuild(ctx context.Context, nwo, branch, devcontainerPath, region string) error {
+	config, err := a.apiClient.CreatePrebuildConfiguration(ctx, nwo, &api.PrebuildConfiguration{
+		Branch:           branch,
+		DevcontainerPath: devcontainerPath,
+		Region:           region,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating prebuild configuration: %w", err)
+	}
+
+	cs := a.io.ColorScheme()
+	fmt.Fprintf(a.io.Out, "%s Created prebuild configuration %s for branch %s\n", cs.SuccessIcon(), cs.Yellow(strconv.FormatInt(config.ID, 10)), config.Branch)
+	return nil
+}
+
+func newPrebuildDeleteCmd(app *App) *cobra.Command {
+	var repo string
+
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a prebuild configuration",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid prebuild configuration id: %q", args[0])
+			}
+			return app.apiClient.DeletePrebuildConfiguration(cmd.Context(), repo, id)
+		},
+	}
+	cmd.Flags().StringVarP(&repo, "repo", "R", "", "Repository in the `[HOST/]OWNER/REPO` format")
+	_ = cmd.MarkFlagRequired("repo")
+
+	return cmd
+}
+
+func newPrebuildRunCmd(app *App) *cobra.Command {
+	var repo string
+
+	cmd := &cobra.Command{
+		Use:   "run <id>",
+		Short: "Trigger a prebuild run and show its status history",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid prebuild configuration id: %q", args[0])
+			}
+			return app.RunPrebuild(cmd.Context(), repo, id)
+		},
+	}
+	cmd.Flags().StringVarP(&repo, "repo", "R", "", "Repository in the `[HOST/]OWNER/REPO` format")
+	_ = cmd.MarkFlagRequired("repo")
+
+	return cmd
+}
+
+func (a *App) RunPrebuild(ctx context.Context, nwo string, id int64) error {
+	run, err := a.apiClient.TriggerPrebuildRun(ctx, nwo, id)
+	if err != nil {
+		return fmt.Errorf("error triggering prebuild run: %w", err)
+	}
+
+	runs, err := a.apiClient.ListPrebuildRuns(ctx, nwo, id)
+	if err != nil {
+		return fmt.Errorf("error listing prebuild run history: %w", err)
+	}
+
+	cs := a.io.ColorScheme()
+	fmt.Fprintf(a.io.Out, "%s Triggered prebuild run %s (%s)\n", cs.SuccessIcon(), cs.Yellow(strconv.FormatInt(run.ID, 10)), run.State)
+
+	tp := utils.NewTablePrinter(a.io)
+	if tp.IsTTY() {
+		tp.AddField("ID", nil, nil)
+		tp.AddField("STATE", nil, nil)
+		tp.AddField("CREATED", nil, nil)
+		tp.EndRow()
+	}
+	for _, r := range runs {
+		tp.AddField(strconv.FormatInt(r.ID, 10), nil, cs.Yellow)
+		tp.AddField(r.State, nil, nil)
+		tp.AddField(r.CreatedAt, nil, nil)
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,52 @@
+package codespace
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cli/cli/v2/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// portProfiles is the on-disk representation of named port-forwarding profiles,
+// keyed by profile name, each holding the <remote>:<local> pairs that `ports
+// forward --profile` should establish.
+type portProfiles map[string][]string
+
+func portProfilesPath() string {
+	return filepath.Join(config.ConfigDir(), "codespaces-port-profiles.yml")
+}
+
+func loadPortProfiles() (portProfiles, error) {
+	path := portProfilesPath()
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return portProfiles{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading port profiles: %w", err)
+	}
+
+	var profiles portProfiles
+	if err := yaml.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("error parsing port profiles: %w", err)
+	}
+	if profiles == nil {
+		profiles = portProfiles{}
+	}
+	return profiles, nil
+}
+
+func savePortProfiles(profiles portProfiles) error {
+	data, err := yaml.Marshal(profiles)
+	if err != nil {
+		return fmt.Errorf("error serializing port profiles: %w", err)
+	}
+
+	path := portProfilesPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creating config directory: %w", err)
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
@@ -0,0 +1,23 @@
+package codespace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortProfilesSaveAndLoad(t *testing.T) {
+	t.Setenv("GH_CONFIG_DIR", t.TempDir())
+
+	profiles, err := loadPortProfiles()
+	require.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	profiles["web-dev"] = []string{"3000:3000", "8080:8000"}
+	require.NoError(t, savePortProfiles(profiles))
+
+	reloaded, err := loadPortProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3000:3000", "8080:8000"}, reloaded["web-dev"])
+}
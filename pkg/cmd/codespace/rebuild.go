@@ -0,0 +1,54 @@
+package codespace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+)
+
+type rebuildOptions struct {
+	codespaceName string
+	full          bool
+}
+
+func newRebuildCmd(app *App) *cobra.Command {
+	opts := rebuildOptions{}
+
+	rebuildCmd := &cobra.Command{
+		Use:   "rebuild",
+		Short: "Rebuild a codespace",
+		Long: heredoc.Docf(`
+			Rebuild a codespace's container, re-running the devcontainer setup.
+
+			By default this performs an incremental rebuild, reusing cached
+			container layers. Pass %[1]s--full%[1]s to rebuild from scratch.
+		`, "`"),
+		Args: noArgsConstraint,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.Rebuild(cmd.Context(), opts)
+		},
+	}
+
+	rebuildCmd.Flags().StringVarP(&opts.codespaceName, "codespace", "c", "", "Name of the codespace")
+	rebuildCmd.Flags().BoolVar(&opts.full, "full", false, "perform a full rebuild, discarding the container cache")
+
+	return rebuildCmd
+}
+
+func (a *App) Rebuild(ctx context.Context, opts rebuildOptions) error {
+	codespace, err := getOrChooseCodespace(ctx, a.apiClient, opts.codespaceName)
+	if err != nil {
+		return err
+	}
+
+	a.StartProgressIndicatorWithLabel("Rebuilding codespace")
+	err = a.apiClient.RebuildCodespace(ctx, codespace.Name, opts.full)
+	a.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("error rebuilding codespace: %w", err)
+	}
+
+	return nil
+}
@@ -21,6 +21,10 @@ func NewRootCmd(app *App) *cobra.Command {
 	root.AddCommand(newCpCmd(app))
 	root.AddCommand(newStopCmd(app))
 	root.AddCommand(newSelectCmd(app))
+	root.AddCommand(newPrebuildCmd(app))
+	root.AddCommand(newRebuildCmd(app))
+	root.AddCommand(newDotfilesCmd(app))
+	root.AddCommand(newTemplateCmd(app))
 
 	return root
 }
@@ -3,6 +3,7 @@ package codespace
 // This file defines the 'gh cs ssh' and 'gh cs cp' subcommands.
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -25,14 +26,17 @@ import (
 )
 
 type sshOptions struct {
-	codespace  string
-	profile    string
-	serverPort int
-	debug      bool
-	debugFile  string
-	stdio      bool
-	config     bool
-	scpArgs    []string // scp arguments, for 'cs cp' (nil for 'cs ssh')
+	codespace   string
+	profile     string
+	serverPort  int
+	debug       bool
+	debugFile   string
+	stdio       bool
+	config      bool
+	configWrite string
+	refresh     bool
+	scpArgs     []string // scp arguments, for 'cs cp' (nil for 'cs ssh')
+	rsyncArgs   []string // rsync arguments, for 'cs cp --delta' (nil otherwise)
 }
 
 func newSSHCmd(app *App) *cobra.Command {
@@ -84,11 +88,27 @@ func newSSHCmd(app *App) *cobra.Command {
 					return errors.New("cannot use `--config` with `--server-port`")
 				}
 			}
+			if opts.configWrite != "" {
+				if opts.config {
+					return errors.New("cannot use `--config-write` with `--config`")
+				}
+				if opts.profile != "" {
+					return errors.New("cannot use `--config-write` with `--profile`")
+				}
+				if opts.serverPort != 0 {
+					return errors.New("cannot use `--config-write` with `--server-port`")
+				}
+			}
+			if opts.refresh && opts.configWrite == "" {
+				return errors.New("`--refresh` requires `--config-write`")
+			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if opts.config {
 				return app.printOpenSSHConfig(cmd.Context(), opts)
+			} else if opts.configWrite != "" {
+				return app.writeOpenSSHConfigFile(cmd.Context(), opts)
 			} else {
 				return app.SSH(cmd.Context(), args, opts)
 			}
@@ -102,6 +122,8 @@ func newSSHCmd(app *App) *cobra.Command {
 	sshCmd.Flags().BoolVarP(&opts.debug, "debug", "d", false, "Log debug data to a file")
 	sshCmd.Flags().StringVarP(&opts.debugFile, "debug-file", "", "", "Path of the file log to")
 	sshCmd.Flags().BoolVarP(&opts.config, "config", "", false, "Write OpenSSH configuration to stdout")
+	sshCmd.Flags().StringVarP(&opts.configWrite, "config-write", "", "", "Write OpenSSH configuration to the given file, replacing any block previously managed by this command")
+	sshCmd.Flags().BoolVarP(&opts.refresh, "refresh", "", false, "Used with `--config-write` to refresh a previously written configuration block")
 	sshCmd.Flags().BoolVar(&opts.stdio, "stdio", false, "Proxy sshd connection to stdio")
 	if err := sshCmd.Flags().MarkHidden("stdio"); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
@@ -190,9 +212,12 @@ func (a *App) SSH(ctx context.Context, sshArgs []string, opts sshOptions) (err e
 	shellClosed := make(chan error, 1)
 	go func() {
 		var err error
-		if opts.scpArgs != nil {
+		switch {
+		case opts.rsyncArgs != nil:
+			err = codespaces.Sync(ctx, opts.rsyncArgs, localSSHServerPort, connectDestination)
+		case opts.scpArgs != nil:
 			err = codespaces.Copy(ctx, opts.scpArgs, localSSHServerPort, connectDestination)
-		} else {
+		default:
 			err = codespaces.Shell(ctx, a.errLogger, sshArgs, localSSHServerPort, connectDestination, usingCustomPort)
 		}
 		shellClosed <- err
@@ -210,6 +235,69 @@ func (a *App) SSH(ctx context.Context, sshArgs []string, opts sshOptions) (err e
 }
 
 func (a *App) printOpenSSHConfig(ctx context.Context, opts sshOptions) error {
+	return a.writeOpenSSHConfig(ctx, opts, a.io.Out)
+}
+
+const (
+	sshConfigBlockBegin = "# >>> gh codespace ssh --config-write >>>\n"
+	sshConfigBlockEnd   = "# <<< gh codespace ssh --config-write <<<\n"
+)
+
+// writeOpenSSHConfigFile renders the OpenSSH configuration for the user's
+// codespaces and writes it into the managed block of the given file,
+// creating the file and its parent directory if necessary, and leaving any
+// surrounding content untouched. Re-running with --refresh simply replaces
+// the existing managed block with freshly rendered host entries.
+func (a *App) writeOpenSSHConfigFile(ctx context.Context, opts sshOptions) error {
+	var rendered bytes.Buffer
+	if err := a.writeOpenSSHConfig(ctx, opts, &rendered); err != nil {
+		return err
+	}
+
+	existing, err := ioutil.ReadFile(opts.configWrite)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error reading %s: %w", opts.configWrite, err)
+	}
+
+	out, err := mergeSSHConfigBlock(string(existing), rendered.String(), opts.refresh)
+	if err != nil {
+		return fmt.Errorf("%s: %w", opts.configWrite, err)
+	}
+
+	if dir := filepath.Dir(opts.configWrite); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("error creating directory %s: %w", dir, err)
+		}
+	}
+	if err := ioutil.WriteFile(opts.configWrite, []byte(out), 0600); err != nil {
+		return fmt.Errorf("error writing %s: %w", opts.configWrite, err)
+	}
+
+	fmt.Fprintf(a.io.Out, "Wrote SSH configuration to %s\n", opts.configWrite)
+	return nil
+}
+
+// mergeSSHConfigBlock returns existing with its managed gh codespace config block
+// (if any) replaced by rendered. If existing has no managed block, rendered is
+// appended unless existing is non-empty and refresh is false, in which case an
+// error asks the caller to pass --refresh before writing into unmanaged content.
+func mergeSSHConfigBlock(existing, rendered string, refresh bool) (string, error) {
+	block := sshConfigBlockBegin + rendered + sshConfigBlockEnd
+
+	if begin := strings.Index(existing, sshConfigBlockBegin); begin >= 0 {
+		end := strings.Index(existing, sshConfigBlockEnd)
+		if end < begin {
+			return "", errors.New("contains an unterminated gh codespace ssh config block; use --refresh to overwrite it")
+		}
+		return existing[:begin] + block + existing[end+len(sshConfigBlockEnd):], nil
+	}
+	if len(existing) > 0 && !refresh {
+		return "", errors.New("already exists and has no managed config block; pass --refresh to append one")
+	}
+	return existing + block, nil
+}
+
+func (a *App) writeOpenSSHConfig(ctx context.Context, opts sshOptions, w io.Writer) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -325,7 +413,7 @@ func (a *App) printOpenSSHConfig(ctx context.Context, opts sshOptions) error {
 			SSHUser:    result.user,
 			GHExec:     ghExec,
 		}
-		if err := t.Execute(a.io.Out, conf); err != nil {
+		if err := t.Execute(w, conf); err != nil {
 			return err
 		}
 	}
@@ -335,8 +423,10 @@ func (a *App) printOpenSSHConfig(ctx context.Context, opts sshOptions) error {
 
 type cpOptions struct {
 	sshOptions
-	recursive bool // -r
-	expand    bool // -e
+	recursive bool     // -r
+	expand    bool     // -e
+	delta     bool     // --delta
+	exclude   []string // --exclude
 }
 
 func newCpCmd(app *App) *cobra.Command {
@@ -363,6 +453,12 @@ func newCpCmd(app *App) *cobra.Command {
 			be evaluated on the remote machine, subject to expansion of tildes, braces, globs,
 			environment variables, and backticks. For security, do not use this flag with arguments
 			provided by untrusted users; see <https://lwn.net/Articles/835962/> for discussion.
+
+			With the %[1]s--delta%[1]s flag, cp uses rsync instead of scp: directories are synced
+			recursively, only changed files are transferred, and progress is reported as the
+			transfer runs. Use %[1]s--exclude%[1]s (repeatable) to skip matching paths, e.g. for
+			build artifacts or dependency directories. This requires rsync to be installed both
+			locally and inside the codespace.
 		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh codespace cp -e README.md 'remote:/workspaces/$RepositoryName/'
@@ -379,26 +475,38 @@ func newCpCmd(app *App) *cobra.Command {
 	// We don't expose all sshOptions.
 	cpCmd.Flags().BoolVarP(&opts.recursive, "recursive", "r", false, "Recursively copy directories")
 	cpCmd.Flags().BoolVarP(&opts.expand, "expand", "e", false, "Expand remote file names on remote shell")
+	cpCmd.Flags().BoolVar(&opts.delta, "delta", false, "Sync only changed files, recursively, with progress reporting (uses rsync)")
+	cpCmd.Flags().StringArrayVar(&opts.exclude, "exclude", nil, "Exclude paths matching this pattern; can be specified multiple times (requires --delta)")
 	cpCmd.Flags().StringVarP(&opts.codespace, "codespace", "c", "", "Name of the codespace")
 	cpCmd.Flags().StringVarP(&opts.profile, "profile", "p", "", "Name of the SSH profile to use")
 	return cpCmd
 }
 
 // Copy copies files between the local and remote file systems.
-// The mechanics are similar to 'ssh' but using 'scp'.
+// The mechanics are similar to 'ssh' but using 'scp', or 'rsync' when opts.delta is set.
 func (a *App) Copy(ctx context.Context, args []string, opts cpOptions) error {
 	if len(args) < 2 {
 		return fmt.Errorf("cp requires source and destination arguments")
 	}
-	if opts.recursive {
-		opts.scpArgs = append(opts.scpArgs, "-r")
+	if len(opts.exclude) > 0 && !opts.delta {
+		return cmdutil.FlagErrorf("--exclude requires --delta")
+	}
+
+	var transferArgs []string
+	if opts.delta {
+		transferArgs = append(transferArgs, "-a", "--info=progress2")
+		for _, pattern := range opts.exclude {
+			transferArgs = append(transferArgs, "--exclude="+pattern)
+		}
+	} else if opts.recursive {
+		transferArgs = append(transferArgs, "-r")
 	}
 
 	hasRemote := false
 	for _, arg := range args {
 		if rest := strings.TrimPrefix(arg, "remote:"); rest != arg {
 			hasRemote = true
-			// scp treats each filename argument as a shell expression,
+			// scp/rsync treat each filename argument as a shell expression,
 			// subjecting it to expansion of environment variables, braces,
 			// tilde, backticks, globs and so on. Because these present a
 			// security risk (see https://lwn.net/Articles/835962/), we
@@ -409,7 +517,7 @@ func (a *App) Copy(ctx context.Context, args []string, opts cpOptions) error {
 			}
 
 		} else if !filepath.IsAbs(arg) {
-			// scp treats a colon in the first path segment as a host identifier.
+			// scp/rsync treat a colon in the first path segment as a host identifier.
 			// Escape it by prepending "./".
 			// TODO(adonovan): test on Windows, including with a c:\\foo path.
 			const sep = string(os.PathSeparator)
@@ -418,11 +526,17 @@ func (a *App) Copy(ctx context.Context, args []string, opts cpOptions) error {
 				arg = "." + sep + arg
 			}
 		}
-		opts.scpArgs = append(opts.scpArgs, arg)
+		transferArgs = append(transferArgs, arg)
 	}
 	if !hasRemote {
 		return cmdutil.FlagErrorf("at least one argument must have a 'remote:' prefix")
 	}
+
+	if opts.delta {
+		opts.rsyncArgs = transferArgs
+	} else {
+		opts.scpArgs = transferArgs
+	}
 	return a.SSH(ctx, nil, opts.sshOptions)
 }
 
@@ -45,3 +45,53 @@ func testingSSHApp() *App {
 	io, _, _, _ := iostreams.Test()
 	return NewApp(io, nil, apiMock, nil)
 }
+
+func TestMergeSSHConfigBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		refresh  bool
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "empty file",
+			existing: "",
+			want:     sshConfigBlockBegin + "Host foo\n" + sshConfigBlockEnd,
+		},
+		{
+			name:     "non-empty file requires refresh",
+			existing: "Host bar\n",
+			wantErr:  true,
+		},
+		{
+			name:     "non-empty file with refresh appends block",
+			existing: "Host bar\n",
+			refresh:  true,
+			want:     "Host bar\n" + sshConfigBlockBegin + "Host foo\n" + sshConfigBlockEnd,
+		},
+		{
+			name:     "existing block is replaced in place",
+			existing: "Host bar\n" + sshConfigBlockBegin + "Host stale\n" + sshConfigBlockEnd + "Host baz\n",
+			want:     "Host bar\n" + sshConfigBlockBegin + "Host foo\n" + sshConfigBlockEnd + "Host baz\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergeSSHConfigBlock(tt.existing, "Host foo\n", tt.refresh)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,23 +4,41 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/cli/cli/v2/internal/codespaces/api"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
+type stopOptions struct {
+	codespaceName  string
+	stopAll        bool
+	idleLongerThan time.Duration
+
+	now func() time.Time
+}
+
 func newStopCmd(app *App) *cobra.Command {
-	var codespace string
+	opts := stopOptions{now: time.Now}
 
 	stopCmd := &cobra.Command{
 		Use:   "stop",
 		Short: "Stop a running codespace",
 		Args:  noArgsConstraint,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return app.StopCodespace(cmd.Context(), codespace)
+			if opts.stopAll {
+				return app.StopAll(cmd.Context(), opts)
+			}
+			if opts.idleLongerThan > 0 {
+				return errors.New("--idle-longer-than can only be used with --all")
+			}
+			return app.StopCodespace(cmd.Context(), opts.codespaceName)
 		},
 	}
-	stopCmd.Flags().StringVarP(&codespace, "codespace", "c", "", "Name of the codespace")
+	stopCmd.Flags().StringVarP(&opts.codespaceName, "codespace", "c", "", "Name of the codespace")
+	stopCmd.Flags().BoolVar(&opts.stopAll, "all", false, "Stop all running codespaces")
+	stopCmd.Flags().DurationVar(&opts.idleLongerThan, "idle-longer-than", 0, "Only stop codespaces idle for longer than this, e.g. \"2h\" (requires --all)")
 
 	return stopCmd
 }
@@ -71,3 +89,58 @@ func (a *App) StopCodespace(ctx context.Context, codespaceName string) error {
 
 	return nil
 }
+
+// StopAll stops every running codespace, optionally restricted to those that
+// have been idle longer than opts.idleLongerThan, for use in scripted
+// cost-control sweeps.
+func (a *App) StopAll(ctx context.Context, opts stopOptions) error {
+	a.StartProgressIndicatorWithLabel("Fetching codespaces")
+	codespaces, err := a.apiClient.ListCodespaces(ctx, -1)
+	a.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to list codespaces: %w", err)
+	}
+
+	idleCutoff := opts.now().Add(-opts.idleLongerThan)
+	var codespacesToStop []*api.Codespace
+	for _, c := range codespaces {
+		cs := codespace{c}
+		if !cs.running() {
+			continue
+		}
+		if opts.idleLongerThan > 0 {
+			t, err := time.Parse(time.RFC3339, c.LastUsedAt)
+			if err != nil {
+				return fmt.Errorf("error parsing last_used_at timestamp %q: %w", c.LastUsedAt, err)
+			}
+			if t.After(idleCutoff) {
+				continue
+			}
+		}
+		codespacesToStop = append(codespacesToStop, c)
+	}
+
+	if len(codespacesToStop) == 0 {
+		return errors.New("no running codespaces to stop")
+	}
+
+	a.StartProgressIndicatorWithLabel("Stopping codespaces")
+	defer a.StopProgressIndicator()
+
+	var g errgroup.Group
+	for _, c := range codespacesToStop {
+		codespaceName := c.Name
+		g.Go(func() error {
+			if err := a.apiClient.StopCodespace(ctx, codespaceName); err != nil {
+				a.errLogger.Printf("error stopping codespace %q: %v\n", codespaceName, err)
+				return err
+			}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return errors.New("some codespaces failed to stop")
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package codespace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/muhammadmuzzammil1998/jsonc"
+	"github.com/spf13/cobra"
+)
+
+func newTemplateCmd(app *App) *cobra.Command {
+	templateCmd := &cobra.Command{
+		Use:   "template",
+		Short: "Manage codespace templates",
+	}
+
+	templateCmd.AddCommand(newTemplateCreateCmd(app))
+
+	return templateCmd
+}
+
+type templateCreateOptions struct {
+	repo             string
+	devcontainerPath string
+	branch           string
+}
+
+func newTemplateCreateCmd(app *App) *cobra.Command {
+	opts := templateCreateOptions{devcontainerPath: ".devcontainer"}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Validate a local devcontainer and publish it as a codespace template",
+		Long: heredoc.Docf(`
+			The %[1]stemplate create%[1]s command validates the local devcontainer configuration
+			and pushes it to a repository, so teammates can create codespaces from it with
+			%[1]sgh codespace create --template <owner>/<repo>%[1]s.
+		`, "`"),
+		Args: noArgsConstraint,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.CreateTemplate(cmd.Context(), opts)
+		},
+	}
+
+	createCmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Repository to publish the template to, e.g. \"owner/templates\" (required)")
+	createCmd.Flags().StringVar(&opts.devcontainerPath, "devcontainer-path", opts.devcontainerPath, "Path to the local devcontainer directory")
+	createCmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "Branch to push the template to (defaults to the repository's default branch)")
+	_ = createCmd.MarkFlagRequired("repo")
+
+	return createCmd
+}
+
+// CreateTemplate validates the devcontainer at opts.devcontainerPath and pushes it to
+// opts.repo, registering it as a codespace template that can be used with
+// "gh codespace create --template".
+func (a *App) CreateTemplate(ctx context.Context, opts templateCreateOptions) error {
+	if err := validateDevcontainer(opts.devcontainerPath); err != nil {
+		return err
+	}
+
+	repository, err := a.apiClient.GetRepository(ctx, opts.repo)
+	if err != nil {
+		return fmt.Errorf("error getting repository: %w", err)
+	}
+	branch := opts.branch
+	if branch == "" {
+		branch = repository.DefaultBranch
+	}
+
+	tmpDir, err := ioutil.TempDir("", "gh-codespace-template")
+	if err != nil {
+		return fmt.Errorf("error creating temp dir: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	a.StartProgressIndicatorWithLabel("Publishing template")
+	defer a.StopProgressIndicator()
+
+	remoteURL := fmt.Sprintf("https://github.com/%s.git", repository.FullName)
+	if err := runGit("", "clone", "--depth=1", "--branch", branch, remoteURL, tmpDir); err != nil {
+		return fmt.Errorf("error cloning template repository: %w", err)
+	}
+
+	if err := copyDir(opts.devcontainerPath, filepath.Join(tmpDir, ".devcontainer")); err != nil {
+		return fmt.Errorf("error copying devcontainer: %w", err)
+	}
+
+	if err := runGit(tmpDir, "add", ".devcontainer"); err != nil {
+		return fmt.Errorf("error staging devcontainer: %w", err)
+	}
+	if err := runGit(tmpDir, "commit", "-m", "Add codespace template devcontainer"); err != nil {
+		return fmt.Errorf("error committing devcontainer: %w", err)
+	}
+	if err := runGit(tmpDir, "push", "origin", "HEAD:"+branch); err != nil {
+		return fmt.Errorf("error pushing devcontainer: %w", err)
+	}
+
+	a.StopProgressIndicator()
+	fmt.Fprintf(a.io.Out, "Published template to %s. Teammates can now run: gh codespace create --template %s\n", repository.FullName, repository.FullName)
+
+	return nil
+}
+
+// runGit runs a git subcommand, optionally with a "-C dir" prefix when dir is non-empty.
+func runGit(dir string, args ...string) error {
+	var fullArgs []string
+	if dir != "" {
+		fullArgs = append(fullArgs, "-C", dir)
+	}
+	fullArgs = append(fullArgs, args...)
+	cmd, err := git.GitCommand(fullArgs...)
+	if err != nil {
+		return err
+	}
+	return run.PrepareCmd(cmd).Run()
+}
+
+// validateDevcontainer reports an error if dir does not contain a devcontainer.json
+// that specifies one of the container sources required by the devcontainer spec.
+func validateDevcontainer(dir string) error {
+	path := filepath.Join(dir, "devcontainer.json")
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	converted := normalizeJSON(jsonc.ToJSON(contents))
+	if !jsonc.Valid(converted) {
+		return fmt.Errorf("%s is not valid JSON", path)
+	}
+
+	var config struct {
+		Image             string                 `json:"image"`
+		DockerFile        string                 `json:"dockerFile"`
+		Build             map[string]interface{} `json:"build"`
+		DockerComposeFile interface{}            `json:"dockerComposeFile"`
+	}
+	if err := json.Unmarshal(converted, &config); err != nil {
+		return fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	if config.Image == "" && config.DockerFile == "" && config.Build == nil && config.DockerComposeFile == nil {
+		return fmt.Errorf("%s must specify one of \"image\", \"dockerFile\", \"build\", or \"dockerComposeFile\"", path)
+	}
+
+	return nil
+}
+
+func copyDir(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", src)
+	}
+
+	if err := os.RemoveAll(dst); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return err
+	}
+
+	entries, err := ioutil.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		srcPath := filepath.Join(src, entry.Name())
+		dstPath := filepath.Join(dst, entry.Name())
+		if entry.IsDir() {
+			if err := copyDir(srcPath, dstPath); err != nil {
+				return err
+			}
+			continue
+		}
+		contents, err := ioutil.ReadFile(srcPath)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(dstPath, contents, entry.Mode()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package commitstatus
+
+import (
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/commit-status/create"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCommitStatus(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-status <command>",
+		Short: "Create commit statuses",
+		Long:  "Report commit statuses on GitHub, independently of check runs.",
+	}
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+
+	return cmd
+}
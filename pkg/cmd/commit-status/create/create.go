@@ -0,0 +1,107 @@
+package create
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	SHA         string
+	State       string
+	TargetURL   string
+	Description string
+	Context     string
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <sha>",
+		Short: "Create a commit status",
+		Long: heredoc.Doc(`
+			Create a commit status on GitHub.
+
+			This lets external CI systems and scripts report a pass/fail/pending
+			state on a commit through gh's own authentication, instead of scripting
+			curl requests with a hand-managed token.
+		`),
+		Example: heredoc.Doc(`
+			$ gh commit-status create abc123 --state success --context ci/lint --target-url https://ci.example.com/build/1
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot create a commit status: sha argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.SHA = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "", "", []string{"error", "failure", "pending", "success"}, "State of the status")
+	_ = cmd.MarkFlagRequired("state")
+	cmd.Flags().StringVar(&opts.TargetURL, "target-url", "", "URL to associate with the status, shown on GitHub as details")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Short description of the status")
+	cmd.Flags().StringVarP(&opts.Context, "context", "c", "default", "Label to differentiate this status from others for the same commit")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	err = createStatus(httpClient, baseRepo, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created %q status for %s\n", cs.SuccessIcon(), opts.Context, opts.SHA)
+	}
+
+	return nil
+}
+
+func createStatus(client *http.Client, repo ghrepo.Interface, opts *CreateOptions) error {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/%s/statuses/%s", repo.RepoOwner(), repo.RepoName(), opts.SHA)
+	requestByte, err := json.Marshal(map[string]string{
+		"state":       opts.State,
+		"target_url":  opts.TargetURL,
+		"description": opts.Description,
+		"context":     opts.Context,
+	})
+	if err != nil {
+		return err
+	}
+	requestBody := bytes.NewReader(requestByte)
+	return apiClient.REST(repo.RepoHost(), "POST", path, requestBody, nil)
+}
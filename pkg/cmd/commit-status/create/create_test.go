@@ -0,0 +1,138 @@
+package create
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  CreateOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no argument",
+			input:   "",
+			wantErr: true,
+			errMsg:  "cannot create a commit status: sha argument required",
+		},
+		{
+			name:    "no state",
+			input:   "abc123",
+			wantErr: true,
+			errMsg:  `required flag(s) "state" not set`,
+		},
+		{
+			name:  "minimal",
+			input: "abc123 --state success",
+			output: CreateOptions{
+				SHA:     "abc123",
+				State:   "success",
+				Context: "default",
+			},
+		},
+		{
+			name:  "all flags",
+			input: "abc123 --state failure --target-url https://ci.example.com/1 --description broke --context ci/lint",
+			output: CreateOptions{
+				SHA:         "abc123",
+				State:       "failure",
+				TargetURL:   "https://ci.example.com/1",
+				Description: "broke",
+				Context:     "ci/lint",
+			},
+		},
+		{
+			name:    "invalid state",
+			input:   "abc123 --state bogus",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *CreateOptions
+			cmd := NewCmdCreate(f, func(opts *CreateOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errMsg != "" {
+					assert.EqualError(t, err, tt.errMsg)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.SHA, gotOpts.SHA)
+			assert.Equal(t, tt.output.State, gotOpts.State)
+			assert.Equal(t, tt.output.TargetURL, gotOpts.TargetURL)
+			assert.Equal(t, tt.output.Description, gotOpts.Description)
+			assert.Equal(t, tt.output.Context, gotOpts.Context)
+		})
+	}
+}
+
+func TestCreateRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/statuses/abc123"),
+		httpmock.StatusStringResponse(201, "{}"),
+	)
+	defer reg.Verify(t)
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &CreateOptions{
+		SHA:     "abc123",
+		State:   "success",
+		Context: "ci/lint",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := createRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Created \"ci/lint\" status for abc123\n", stdout.String())
+
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[0].Body)
+	reqBody := map[string]string{}
+	err = json.Unmarshal(bodyBytes, &reqBody)
+	assert.NoError(t, err)
+	assert.Equal(t, "success", reqBody["state"])
+	assert.Equal(t, "ci/lint", reqBody["context"])
+}
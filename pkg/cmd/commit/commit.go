@@ -0,0 +1,27 @@
+package commit
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/commit/list"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/commit/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCommit(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit <command>",
+		Short: "Inspect commits",
+		Long:  "Look up commit details, statuses, and associated pull requests without cloning the repository or hitting the API by hand.",
+		Example: heredoc.Doc(`
+			$ gh commit view HEAD
+			$ gh commit list --author=mislav --since=2022-01-01
+		`),
+	}
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,125 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/commit/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/text"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	Author string
+	Since  string
+	Until  string
+	Path   string
+	Limit  int
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List commits in a repository",
+		Long:    "List commits in a repository, without cloning it or hitting the API by hand.",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ gh commit list --author=mislav --since=2022-01-01
+			$ gh commit list --path=pkg/cmd/commit
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Author, "author", "", "Filter by commit author (`username` or email)")
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Only show commits after this date (ISO 8601 `timestamp`)")
+	cmd.Flags().StringVar(&opts.Until, "until", "", "Only show commits before this date (ISO 8601 `timestamp`)")
+	cmd.Flags().StringVar(&opts.Path, "path", "", "Only show commits touching this file `path`")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of commits to fetch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.ListFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	commits, err := shared.ListCommits(httpClient, baseRepo, shared.ListCommitsOptions{
+		Author: opts.Author,
+		Since:  opts.Since,
+		Until:  opts.Until,
+		Path:   opts.Path,
+		Limit:  opts.Limit,
+	})
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, commits)
+	}
+
+	if len(commits) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No commits found")
+		return cmdutil.SilentError
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, commit := range commits {
+		sha := commit.SHA
+		if len(sha) > 7 {
+			sha = sha[:7]
+		}
+		tp.AddField(sha, nil, cs.Yellow)
+		message := text.ReplaceExcessiveWhitespace(strings.SplitN(commit.Commit.Message, "\n", 2)[0])
+		tp.AddField(message, nil, nil)
+		tp.AddField(commit.Commit.Author.Name, nil, cs.Bold)
+		if tp.IsTTY() {
+			tp.AddField(utils.FuzzyAgoAbbr(time.Now(), commit.Commit.Author.Date), nil, cs.Gray)
+		} else {
+			tp.AddField(commit.Commit.Author.Date.Format(time.RFC3339), nil, nil)
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,243 @@
+// Package shared contains helpers for working with commits that are reused across the
+// `gh commit` leaf commands. Everything here goes through the REST API, since the vendored
+// GraphQL schema has no typed support for combined statuses or check runs.
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// Commit represents a single commit as returned by the REST API.
+type Commit struct {
+	SHA     string `json:"sha"`
+	HTMLURL string `json:"html_url"`
+	Commit  struct {
+		Message string `json:"message"`
+		Author  struct {
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
+		} `json:"author"`
+		Verification struct {
+			Verified bool   `json:"verified"`
+			Reason   string `json:"reason"`
+		} `json:"verification"`
+	} `json:"commit"`
+	Author *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	Parents []struct {
+		SHA string `json:"sha"`
+	} `json:"parents"`
+	Stats struct {
+		Additions int `json:"additions"`
+		Deletions int `json:"deletions"`
+		Total     int `json:"total"`
+	} `json:"stats"`
+
+	// PullRequests, Statuses, and CheckRuns are populated by CommitByRef from separate
+	// REST requests; they have no equivalent field in the single-commit response.
+	PullRequests []PullRequest           `json:"pull_requests"`
+	Statuses     []CombinedStatusContext `json:"statuses"`
+	CheckRuns    []CheckRun              `json:"check_runs"`
+}
+
+// CombinedStatusContext is a single status context within a commit's combined status.
+type CombinedStatusContext struct {
+	Context     string `json:"context"`
+	State       string `json:"state"`
+	Description string `json:"description"`
+	TargetURL   string `json:"target_url"`
+}
+
+// CheckRun is the subset of a check run's fields relevant to `gh commit view`.
+type CheckRun struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+	DetailsURL string `json:"details_url"`
+}
+
+// PullRequest is the subset of a pull request's fields relevant to `gh commit view`.
+type PullRequest struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	URL    string `json:"html_url"`
+}
+
+// CombinedStatus is a commit's combined status, made up of every status context reported for it.
+type CombinedStatus struct {
+	State    string                  `json:"state"`
+	Statuses []CombinedStatusContext `json:"statuses"`
+}
+
+// CheckRunsResponse holds the check runs reported for a commit.
+type CheckRunsResponse struct {
+	CheckRuns []CheckRun `json:"check_runs"`
+}
+
+// CommitFields are the field names accepted by the `--json` flag for `gh commit view`.
+var CommitFields = []string{
+	"sha", "message", "author", "authorEmail", "authoredDate", "url", "verified",
+	"verificationReason", "parents", "additions", "deletions", "pullRequests", "statuses", "checkRuns",
+}
+
+// ListFields are the field names accepted by the `--json` flag for `gh commit list`.
+var ListFields = []string{
+	"sha", "message", "author", "authorEmail", "authoredDate", "url",
+}
+
+// ExportData implements cmdutil.Exporter for a single commit, as used by `gh commit view`.
+func (c *Commit) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "sha":
+			data[f] = c.SHA
+		case "message":
+			data[f] = c.Commit.Message
+		case "author":
+			data[f] = c.Commit.Author.Name
+		case "authorEmail":
+			data[f] = c.Commit.Author.Email
+		case "authoredDate":
+			data[f] = c.Commit.Author.Date
+		case "url":
+			data[f] = c.HTMLURL
+		case "verified":
+			data[f] = c.Commit.Verification.Verified
+		case "verificationReason":
+			data[f] = c.Commit.Verification.Reason
+		case "parents":
+			parents := make([]string, len(c.Parents))
+			for i, p := range c.Parents {
+				parents[i] = p.SHA
+			}
+			data[f] = parents
+		case "additions":
+			data[f] = c.Stats.Additions
+		case "deletions":
+			data[f] = c.Stats.Deletions
+		case "pullRequests":
+			data[f] = c.PullRequests
+		case "statuses":
+			data[f] = c.Statuses
+		case "checkRuns":
+			data[f] = c.CheckRuns
+		}
+	}
+	return data
+}
+
+// ExportData implements cmdutil.Exporter for a commit summary, as used by `gh commit list`.
+func (c *ListCommit) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "sha":
+			data[f] = c.SHA
+		case "message":
+			data[f] = c.Commit.Message
+		case "author":
+			data[f] = c.Commit.Author.Name
+		case "authorEmail":
+			data[f] = c.Commit.Author.Email
+		case "authoredDate":
+			data[f] = c.Commit.Author.Date
+		case "url":
+			data[f] = c.HTMLURL
+		}
+	}
+	return data
+}
+
+// ListCommit is a commit as returned by the commit-listing endpoint, which omits the
+// fields (verification, stats) only available when fetching a single commit.
+type ListCommit struct {
+	SHA     string `json:"sha"`
+	HTMLURL string `json:"html_url"`
+	Commit  struct {
+		Message string `json:"message"`
+		Author  struct {
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
+		} `json:"author"`
+	} `json:"commit"`
+}
+
+// CommitByRef fetches a single commit, along with its associated pull requests,
+// combined status, and check runs.
+func CommitByRef(httpClient *http.Client, repo ghrepo.Interface, ref string) (*Commit, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var commit Commit
+	path := fmt.Sprintf("repos/%s/%s/commits/%s", repo.RepoOwner(), repo.RepoName(), ref)
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &commit); err != nil {
+		return nil, err
+	}
+
+	var pulls []PullRequest
+	pullsPath := fmt.Sprintf("repos/%s/%s/commits/%s/pulls", repo.RepoOwner(), repo.RepoName(), ref)
+	if err := apiClient.REST(repo.RepoHost(), "GET", pullsPath, nil, &pulls); err != nil {
+		return nil, err
+	}
+	commit.PullRequests = pulls
+
+	var status CombinedStatus
+	statusPath := fmt.Sprintf("repos/%s/%s/commits/%s/status", repo.RepoOwner(), repo.RepoName(), ref)
+	if err := apiClient.REST(repo.RepoHost(), "GET", statusPath, nil, &status); err != nil {
+		return nil, err
+	}
+	commit.Statuses = status.Statuses
+
+	var checkRuns CheckRunsResponse
+	checksPath := fmt.Sprintf("repos/%s/%s/commits/%s/check-runs", repo.RepoOwner(), repo.RepoName(), ref)
+	if err := apiClient.REST(repo.RepoHost(), "GET", checksPath, nil, &checkRuns); err != nil {
+		return nil, err
+	}
+	commit.CheckRuns = checkRuns.CheckRuns
+
+	return &commit, nil
+}
+
+// ListCommitsOptions configures a call to ListCommits.
+type ListCommitsOptions struct {
+	Author string
+	Since  string
+	Until  string
+	Path   string
+	Limit  int
+}
+
+// ListCommits lists commits in repo, most recent first, matching the given filters.
+func ListCommits(httpClient *http.Client, repo ghrepo.Interface, opts ListCommitsOptions) ([]ListCommit, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	query := url.Values{}
+	query.Set("per_page", fmt.Sprintf("%d", opts.Limit))
+	if opts.Author != "" {
+		query.Set("author", opts.Author)
+	}
+	if opts.Since != "" {
+		query.Set("since", opts.Since)
+	}
+	if opts.Until != "" {
+		query.Set("until", opts.Until)
+	}
+	if opts.Path != "" {
+		query.Set("path", opts.Path)
+	}
+	path := fmt.Sprintf("repos/%s/%s/commits?%s", repo.RepoOwner(), repo.RepoName(), query.Encode())
+
+	var commits []ListCommit
+	err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &commits)
+	return commits, err
+}
@@ -0,0 +1,54 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommitByRef(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/abc123"),
+		httpmock.StringResponse(`{"sha":"abc123","html_url":"https://github.com/OWNER/REPO/commit/abc123",
+			"commit":{"message":"Fix bug","author":{"name":"Mona","email":"mona@example.com"},"verification":{"verified":true,"reason":"valid"}}}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/abc123/pulls"),
+		httpmock.StringResponse(`[{"number":7,"title":"Fix bug","state":"closed","html_url":"https://github.com/OWNER/REPO/pull/7"}]`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/abc123/status"),
+		httpmock.StringResponse(`{"state":"success","statuses":[{"context":"ci/lint","state":"success"}]}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/abc123/check-runs"),
+		httpmock.StringResponse(`{"check_runs":[{"name":"build","status":"completed","conclusion":"success"}]}`))
+
+	commit, err := CommitByRef(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), "abc123")
+	require.NoError(t, err)
+	assert.Equal(t, "Fix bug", commit.Commit.Message)
+	assert.True(t, commit.Commit.Verification.Verified)
+	assert.Equal(t, 1, len(commit.PullRequests))
+	assert.Equal(t, "ci/lint", commit.Statuses[0].Context)
+	assert.Equal(t, "build", commit.CheckRuns[0].Name)
+}
+
+func Test_ListCommits(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits"),
+		httpmock.StringResponse(`[{"sha":"abc123","html_url":"https://github.com/OWNER/REPO/commit/abc123",
+			"commit":{"message":"Fix bug","author":{"name":"Mona","email":"mona@example.com"}}}]`))
+
+	commits, err := ListCommits(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), ListCommitsOptions{
+		Author: "mona",
+		Limit:  30,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(commits))
+	assert.Equal(t, "Fix bug", commits[0].Commit.Message)
+}
@@ -0,0 +1,193 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/commit/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/markdown"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type browser interface {
+	Browse(string) error
+}
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Browser    browser
+	Exporter   cmdutil.Exporter
+
+	SHA     string
+	WebMode bool
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Browser:    f.Browser,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <sha>",
+		Short: "View a commit",
+		Long: heredoc.Doc(`
+			Display the message, author, commit signature verification status, associated
+			pull requests, and commit statuses/check runs for a commit.
+
+			With '--web', open the commit in a web browser instead.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.SHA = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open a commit in the browser")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.CommitFields)
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if opts.WebMode {
+		url := ghrepo.GenerateRepoURL(baseRepo, "commit/%s", opts.SHA)
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(url))
+		}
+		return opts.Browser.Browse(url)
+	}
+
+	opts.IO.StartProgressIndicator()
+	commit, err := shared.CommitByRef(httpClient, baseRepo, opts.SHA)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, commit)
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	if opts.IO.IsStdoutTTY() {
+		return printHumanCommitPreview(opts, commit)
+	}
+	return printRawCommitPreview(opts, commit)
+}
+
+func printRawCommitPreview(opts *ViewOptions, commit *shared.Commit) error {
+	out := opts.IO.Out
+	fmt.Fprintf(out, "sha:\t%s\n", commit.SHA)
+	fmt.Fprintf(out, "author:\t%s <%s>\n", commit.Commit.Author.Name, commit.Commit.Author.Email)
+	fmt.Fprintf(out, "verified:\t%v\n", commit.Commit.Verification.Verified)
+	for _, pr := range commit.PullRequests {
+		fmt.Fprintf(out, "pull request:\t#%d %s\n", pr.Number, pr.Title)
+	}
+	for _, s := range commit.Statuses {
+		fmt.Fprintf(out, "status:\t%s\t%s\n", s.Context, s.State)
+	}
+	for _, c := range commit.CheckRuns {
+		fmt.Fprintf(out, "check:\t%s\t%s\t%s\n", c.Name, c.Status, c.Conclusion)
+	}
+	fmt.Fprintln(out, "--")
+	fmt.Fprintln(out, commit.Commit.Message)
+
+	return nil
+}
+
+func printHumanCommitPreview(opts *ViewOptions, commit *shared.Commit) error {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	message := strings.SplitN(commit.Commit.Message, "\n", 2)
+	fmt.Fprintf(out, "%s %s\n", cs.Bold(message[0]), cs.Gray(commit.SHA))
+	fmt.Fprintf(out, "%s <%s>\n", commit.Commit.Author.Name, commit.Commit.Author.Email)
+	fmt.Fprintf(out, "%s %s\n", verificationIcon(cs, commit), verificationLabel(commit))
+	fmt.Fprintln(out)
+
+	if len(message) > 1 && strings.TrimSpace(message[1]) != "" {
+		body, err := markdown.Render(message[1], markdown.WithIO(opts.IO))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, body)
+	}
+
+	if len(commit.PullRequests) > 0 {
+		fmt.Fprintln(out, cs.Bold("Pull requests"))
+		for _, pr := range commit.PullRequests {
+			fmt.Fprintf(out, "  #%d  %s  %s\n", pr.Number, pr.Title, cs.Gray(pr.State))
+		}
+		fmt.Fprintln(out)
+	}
+
+	if len(commit.Statuses) > 0 || len(commit.CheckRuns) > 0 {
+		fmt.Fprintln(out, cs.Bold("Statuses and checks"))
+		for _, s := range commit.Statuses {
+			fmt.Fprintf(out, "  %s  %s  %s\n", statusIcon(cs, s.State), s.Context, cs.Gray(s.Description))
+		}
+		for _, c := range commit.CheckRuns {
+			fmt.Fprintf(out, "  %s  %s  %s\n", statusIcon(cs, c.Conclusion), c.Name, cs.Gray(c.Status))
+		}
+		fmt.Fprintln(out)
+	}
+
+	fmt.Fprintf(out, cs.Gray("View this commit on GitHub: %s\n"), commit.HTMLURL)
+
+	return nil
+}
+
+func verificationIcon(cs *iostreams.ColorScheme, commit *shared.Commit) string {
+	if commit.Commit.Verification.Verified {
+		return cs.SuccessIcon()
+	}
+	return cs.Gray("-")
+}
+
+func verificationLabel(commit *shared.Commit) string {
+	if commit.Commit.Verification.Verified {
+		return "Verified"
+	}
+	return "Unverified"
+}
+
+func statusIcon(cs *iostreams.ColorScheme, state string) string {
+	switch strings.ToLower(state) {
+	case "success":
+		return cs.SuccessIcon()
+	case "failure", "error":
+		return cs.FailureIcon()
+	default:
+		return cs.Gray("-")
+	}
+}
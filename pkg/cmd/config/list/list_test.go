@@ -78,12 +78,13 @@ func Test_listRun(t *testing.T) {
 		{
 			name: "list",
 			config: config.ConfigStub{
-				"HOST:git_protocol":     "ssh",
-				"HOST:editor":           "/usr/bin/vim",
-				"HOST:prompt":           "disabled",
-				"HOST:pager":            "less",
-				"HOST:http_unix_socket": "",
-				"HOST:browser":          "brave",
+				"HOST:git_protocol":       "ssh",
+				"HOST:editor":             "/usr/bin/vim",
+				"HOST:prompt":             "disabled",
+				"HOST:pager":              "less",
+				"HOST:http_unix_socket":   "",
+				"HOST:browser":            "brave",
+				"HOST:telemetry_endpoint": "",
 			},
 			input: &ListOptions{Hostname: "HOST"}, // ConfigStub gives empty DefaultHost
 			stdout: `git_protocol=ssh
@@ -92,6 +93,7 @@ prompt=disabled
 pager=less
 http_unix_socket=
 browser=brave
+telemetry_endpoint=
 `,
 		},
 	}
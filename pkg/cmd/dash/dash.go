@@ -0,0 +1,334 @@
+package dash
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+type DashOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RepoNames []string
+	Interval  int
+	Watch     bool
+}
+
+func NewCmdDash(f *cmdutil.Factory, runF func(*DashOptions) error) *cobra.Command {
+	opts := &DashOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "dash",
+		Short: "Show a dashboard of review requests, your pull requests, assigned issues, and failing runs",
+		Long: heredoc.Doc(`
+			Print a dashboard summarizing your work across one or more repositories:
+			pull requests awaiting your review, pull requests you've opened, issues
+			assigned to you, and runs that are currently failing.
+
+			Repositories default to the one in the current directory; pass --repo one
+			or more times to cover others instead.
+
+			This is a snapshot, not yet the full interactive dashboard extension
+			experience: use --watch to have it refresh in place instead of opening a
+			browser or checkout/merge keybindings.
+		`),
+		Example: heredoc.Doc(`
+			$ gh dash
+			$ gh dash -R cli/cli -R cli/go-gh --watch
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(opts.RepoNames) == 0 {
+				repo, err := opts.BaseRepo()
+				if err != nil {
+					return fmt.Errorf("could not determine a repository to show; pass --repo: %w", err)
+				}
+				opts.RepoNames = []string{ghrepo.FullName(repo)}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return dashRun(opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&opts.RepoNames, "repo", "R", nil, "Repository to include in the dashboard (can be specified multiple times)")
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Refresh the dashboard instead of printing it once")
+	cmd.Flags().IntVarP(&opts.Interval, "interval", "i", 30, "Refresh interval in seconds, used with --watch")
+
+	return cmd
+}
+
+type dashItem struct {
+	Repository string
+	Identifier string
+	preview    string
+}
+
+func (i dashItem) Preview() string {
+	return i.preview
+}
+
+type searchResult struct {
+	Type       string `json:"__typename"`
+	Title      string
+	Number     int
+	Repository struct {
+		NameWithOwner string
+	}
+}
+
+type dashData struct {
+	ReviewRequests []dashItem
+	MyPullRequests []dashItem
+	AssignedIssues []dashItem
+	FailingRuns    []dashItem
+}
+
+func loadDashData(client *http.Client, repos []ghrepo.Interface) (*dashData, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	data := &dashData{}
+
+	g := new(errgroup.Group)
+
+	g.Go(func() error {
+		reviewRequests, myPullRequests, assignedIssues, err := searchAcrossRepos(apiClient, repos)
+		if err != nil {
+			return fmt.Errorf("could not search: %w", err)
+		}
+		data.ReviewRequests = toDashItems(reviewRequests)
+		data.MyPullRequests = toDashItems(myPullRequests)
+		data.AssignedIssues = toDashItems(assignedIssues)
+		return nil
+	})
+
+	g.Go(func() error {
+		items, err := failingRunsAcrossRepos(apiClient, repos)
+		if err != nil {
+			return fmt.Errorf("could not load failing runs: %w", err)
+		}
+		data.FailingRuns = items
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// searchAcrossRepos fetches review requests, the viewer's own open pull requests, and their
+// assigned issues in a single GraphQL request, aliasing each search the same way `gh status`
+// batches "assignments" and "reviewRequested" into one query instead of issuing one request per
+// repository or per section.
+func searchAcrossRepos(client *api.Client, repos []ghrepo.Interface) (reviewRequests, myPullRequests, assignedIssues []searchResult, err error) {
+	repoFilter := ""
+	for _, repo := range repos {
+		repoFilter += " repo:" + ghrepo.FullName(repo)
+	}
+
+	fragment := `
+		edges {
+			node {
+				...on Issue {
+					__typename
+					title
+					number
+					repository { nameWithOwner }
+				}
+				...on PullRequest {
+					__typename
+					title
+					number
+					repository { nameWithOwner }
+				}
+			}
+		}`
+
+	q := fmt.Sprintf(`
+	query DashSearch {
+		reviewRequests: search(first: 25, type: ISSUE, query: %[1]q) { %[4]s }
+		myPullRequests: search(first: 25, type: ISSUE, query: %[2]q) { %[4]s }
+		assignedIssues: search(first: 25, type: ISSUE, query: %[3]q) { %[4]s }
+	}`,
+		"review-requested:@me state:open"+repoFilter,
+		"author:@me state:open"+repoFilter,
+		"assignee:@me state:open type:issue"+repoFilter,
+		fragment)
+
+	var resp struct {
+		ReviewRequests struct {
+			Edges []struct{ Node searchResult }
+		}
+		MyPullRequests struct {
+			Edges []struct{ Node searchResult }
+		}
+		AssignedIssues struct {
+			Edges []struct{ Node searchResult }
+		}
+	}
+	if err := client.GraphQL(ghinstance.Default(), q, nil, &resp); err != nil {
+		return nil, nil, nil, err
+	}
+
+	extract := func(edges []struct{ Node searchResult }) []searchResult {
+		results := make([]searchResult, 0, len(edges))
+		for _, e := range edges {
+			results = append(results, e.Node)
+		}
+		sort.Slice(results, func(i, j int) bool {
+			if results[i].Repository.NameWithOwner != results[j].Repository.NameWithOwner {
+				return results[i].Repository.NameWithOwner < results[j].Repository.NameWithOwner
+			}
+			return results[i].Number < results[j].Number
+		})
+		return results
+	}
+
+	return extract(resp.ReviewRequests.Edges), extract(resp.MyPullRequests.Edges), extract(resp.AssignedIssues.Edges), nil
+}
+
+func toDashItems(results []searchResult) []dashItem {
+	items := make([]dashItem, 0, len(results))
+	for _, r := range results {
+		items = append(items, dashItem{
+			Repository: r.Repository.NameWithOwner,
+			Identifier: fmt.Sprintf("%s#%d", r.Repository.NameWithOwner, r.Number),
+			preview:    r.Title,
+		})
+	}
+	return items
+}
+
+func failingRunsAcrossRepos(client *api.Client, repos []ghrepo.Interface) ([]dashItem, error) {
+	var items []dashItem
+	for _, repo := range repos {
+		runs, err := shared.GetRunsWithFilter(client, repo, nil, 5, func(run shared.Run) bool {
+			return run.Status == shared.Completed && shared.IsFailureState(run.Conclusion)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", ghrepo.FullName(repo), err)
+		}
+		for _, run := range runs {
+			items = append(items, dashItem{
+				Repository: ghrepo.FullName(repo),
+				Identifier: fmt.Sprintf("%s#%d", ghrepo.FullName(repo), run.ID),
+				preview:    run.Name,
+			})
+		}
+	}
+	return items, nil
+}
+
+func dashRun(opts *DashOptions) error {
+	client, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create client: %w", err)
+	}
+
+	repos := make([]ghrepo.Interface, 0, len(opts.RepoNames))
+	for _, name := range opts.RepoNames {
+		repo, err := ghrepo.FromFullName(name)
+		if err != nil {
+			return fmt.Errorf("argument error: %w", err)
+		}
+		repos = append(repos, repo)
+	}
+
+	out := opts.IO.Out
+
+	render := func() error {
+		opts.IO.StartProgressIndicator()
+		data, err := loadDashData(client, repos)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+
+		renderDash(opts.IO, data)
+		return nil
+	}
+
+	if !opts.Watch {
+		return render()
+	}
+
+	if err := opts.IO.EnableVirtualTerminalProcessing(); err == nil {
+		fmt.Fprint(out, "\x1b[2J")
+	}
+
+	duration := time.Duration(opts.Interval) * time.Second
+	for {
+		fmt.Fprint(out, "\x1b[H")
+		if err := render(); err != nil {
+			return err
+		}
+		time.Sleep(duration)
+	}
+}
+
+func renderDash(io *iostreams.IOStreams, data *dashData) {
+	cs := io.ColorScheme()
+	out := io.Out
+	fullWidth := io.TerminalWidth()
+	halfWidth := (fullWidth / 2) - 2
+
+	idStyle := cs.Cyan
+	leftHalfStyle := lipgloss.NewStyle().Width(halfWidth).Padding(0).MarginRight(1).BorderRight(true).BorderStyle(lipgloss.NormalBorder())
+	rightHalfStyle := lipgloss.NewStyle().Width(halfWidth).Padding(0)
+
+	section := func(header string, items []dashItem, width, rowLimit int) string {
+		tableOut := &bytes.Buffer{}
+		fmt.Fprintln(tableOut, cs.Bold(header))
+		tp := utils.NewTablePrinterWithOptions(io, utils.TablePrinterOptions{
+			IsTTY:    io.IsStdoutTTY(),
+			MaxWidth: width,
+			Out:      tableOut,
+		})
+		if len(items) == 0 {
+			tp.AddField("Nothing here ^_^", nil, nil)
+			tp.EndRow()
+		} else {
+			for i, item := range items {
+				if i == rowLimit {
+					break
+				}
+				tp.AddField(item.Identifier, nil, idStyle)
+				tp.AddField(item.Preview(), nil, nil)
+				tp.EndRow()
+			}
+		}
+		_ = tp.Render()
+		return tableOut.String()
+	}
+
+	rrSection := leftHalfStyle.Render(section("Review Requests", data.ReviewRequests, halfWidth, 5))
+	prSection := rightHalfStyle.Render(section("Your Pull Requests", data.MyPullRequests, halfWidth, 5))
+	issueSection := leftHalfStyle.Render(section("Assigned Issues", data.AssignedIssues, halfWidth, 5))
+	runsSection := rightHalfStyle.Render(section("Failing Runs", data.FailingRuns, halfWidth, 5))
+
+	fmt.Fprintln(out, lipgloss.JoinHorizontal(lipgloss.Top, rrSection, prSection))
+	fmt.Fprintln(out, lipgloss.JoinHorizontal(lipgloss.Top, issueSection, runsSection))
+}
@@ -0,0 +1,114 @@
+package dash
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdDash(t *testing.T) {
+	tests := []struct {
+		name  string
+		cli   string
+		wants DashOptions
+	}{
+		{
+			name: "defaults",
+			cli:  "",
+			wants: DashOptions{
+				RepoNames: []string{"OWNER/REPO"},
+				Interval:  30,
+			},
+		},
+		{
+			name: "repos and watch",
+			cli:  "-R cli/cli -R cli/go-gh --watch -i 5",
+			wants: DashOptions{
+				RepoNames: []string{"cli/cli", "cli/go-gh"},
+				Watch:     true,
+				Interval:  5,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		io, _, _, _ := iostreams.Test()
+		f := &cmdutil.Factory{
+			IOStreams: io,
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			},
+		}
+
+		t.Run(tt.name, func(t *testing.T) {
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *DashOptions
+			cmd := NewCmdDash(f, func(opts *DashOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.RepoNames, gotOpts.RepoNames)
+			assert.Equal(t, tt.wants.Watch, gotOpts.Watch)
+			assert.Equal(t, tt.wants.Interval, gotOpts.Interval)
+		})
+	}
+}
+
+func TestDashRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`DashSearch`),
+		httpmock.StringResponse(`{"data": {
+			"reviewRequests": {"edges": [
+				{"node": {"__typename": "PullRequest", "title": "Fix the thing", "number": 42, "repository": {"nameWithOwner": "cli/cli"}}}
+			]},
+			"myPullRequests": {"edges": []},
+			"assignedIssues": {"edges": []}
+		}}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/cli/cli/actions/runs"),
+		httpmock.StringResponse(`{"total_count": 1, "workflow_runs": [
+			{"id": 1, "name": "CI", "status": "completed", "conclusion": "failure"}
+		]}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	repo, err := ghrepo.FromFullName("cli/cli")
+	assert.NoError(t, err)
+
+	opts := &DashOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo:  func() (ghrepo.Interface, error) { return repo, nil },
+		RepoNames: []string{"cli/cli"},
+	}
+
+	err = dashRun(opts)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Review Requests")
+	assert.Contains(t, stdout.String(), "cli/cli#42")
+	assert.Contains(t, stdout.String(), "Failing Runs")
+	assert.Contains(t, stdout.String(), "cli/cli#1")
+}
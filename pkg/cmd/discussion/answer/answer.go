@@ -0,0 +1,101 @@
+package answer
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/discussion/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AnswerOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	SelectorArg   string
+	CommentNumber int
+}
+
+func NewCmdAnswer(f *cmdutil.Factory, runF func(*AnswerOptions) error) *cobra.Command {
+	opts := &AnswerOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "answer {<number> | <url>}",
+		Short: "Mark a comment as the answer to a discussion",
+		Long: heredoc.Doc(`
+			Mark a comment as the accepted answer to a discussion.
+
+			This only applies to discussions in a Q&A-format category. Use '--comment'
+			to select which of the discussion's top-level comments to mark, counting
+			from 1 in the order they were posted.
+		`),
+		Example: heredoc.Doc(`
+			$ gh discussion answer 12 --comment 2
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.SelectorArg = args[0]
+
+			if opts.CommentNumber < 1 {
+				return cmdutil.FlagErrorf("`--comment` is required and must be greater than 0")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return answerRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.CommentNumber, "comment", 0, "The `position` of the comment to mark as the answer, starting at 1")
+
+	return cmd
+}
+
+func answerRun(opts *AnswerOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	discussion, baseRepo, err := shared.DiscussionFromArg(httpClient, opts.BaseRepo, opts.SelectorArg)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	comments, err := shared.ListComments(httpClient, baseRepo, discussion.Number)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+	if opts.CommentNumber > len(comments) {
+		opts.IO.StopProgressIndicator()
+		return fmt.Errorf("discussion #%d only has %d comment(s)", discussion.Number, len(comments))
+	}
+	comment := comments[opts.CommentNumber-1]
+
+	err = shared.MarkCommentAsAnswer(httpClient, baseRepo.RepoHost(), comment.ID)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Marked comment by %s as the answer to discussion #%d\n",
+			cs.SuccessIcon(), comment.Author.Login, discussion.Number)
+	}
+
+	return nil
+}
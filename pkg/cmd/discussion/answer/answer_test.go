@@ -0,0 +1,74 @@
+package answer
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_answerRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query DiscussionByNumber\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"discussion":{"id":"DISC_1","number":3,"title":"Welcome"}}}}`))
+	reg.Register(
+		httpmock.GraphQL(`query DiscussionCommentList\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"discussion":{"comments":{"nodes":[
+			{"id":"COMMENT_1","author":{"login":"monalisa"}},
+			{"id":"COMMENT_2","author":{"login":"hubot"}}
+		]}}}}}`))
+	reg.Register(
+		httpmock.GraphQL(`mutation DiscussionMarkCommentAsAnswer\b`),
+		httpmock.StringResponse(`{"data":{"markDiscussionCommentAsAnswer":{"discussion":{"id":"DISC_1"}}}}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	err := answerRun(&AnswerOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		SelectorArg:   "3",
+		CommentNumber: 2,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Marked comment by hubot as the answer to discussion #3")
+}
+
+func Test_answerRun_commentOutOfRange(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query DiscussionByNumber\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"discussion":{"id":"DISC_1","number":3,"title":"Welcome"}}}}`))
+	reg.Register(
+		httpmock.GraphQL(`query DiscussionCommentList\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"discussion":{"comments":{"nodes":[
+			{"id":"COMMENT_1","author":{"login":"monalisa"}}
+		]}}}}}`))
+
+	io, _, _, _ := iostreams.Test()
+
+	err := answerRun(&AnswerOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		SelectorArg:   "3",
+		CommentNumber: 2,
+	})
+	assert.EqualError(t, err, "discussion #3 only has 1 comment(s)")
+}
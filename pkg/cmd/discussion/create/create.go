@@ -0,0 +1,104 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/discussion/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Title    string
+	Body     string
+	Category string
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	var bodyFile string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new discussion",
+		Long: heredoc.Doc(`
+			Create a new discussion in a repository.
+
+			A discussion category is required; it can be given by name or slug.
+		`),
+		Example: heredoc.Doc(`
+			$ gh discussion create --title "How do I configure X?" --body "..." --category "Q&A"
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Title == "" {
+				return cmdutil.FlagErrorf("`--title` is required")
+			}
+			if opts.Category == "" {
+				return cmdutil.FlagErrorf("`--category` is required")
+			}
+
+			if bodyFile != "" {
+				b, err := cmdutil.ReadFile(bodyFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				opts.Body = string(b)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title for the discussion")
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Body for the discussion")
+	cmd.Flags().StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().StringVarP(&opts.Category, "category", "c", "", "Category for the discussion, by name or slug")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	categoryID, err := shared.CategoryByName(httpClient, baseRepo, opts.Category)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+	discussion, err := shared.CreateDiscussion(httpClient, baseRepo, categoryID, opts.Title, opts.Body)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(opts.IO.Out, discussion.URL)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package discussion
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdAnswer "github.com/cli/cli/v2/pkg/cmd/discussion/answer"
+	cmdComment "github.com/cli/cli/v2/pkg/cmd/discussion/comment"
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/discussion/create"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/discussion/list"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/discussion/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdDiscussion(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discussion <command>",
+		Short: "Manage discussions",
+		Long:  "Work with GitHub Discussions.",
+		Example: heredoc.Doc(`
+			$ gh discussion list
+			$ gh discussion create --title "How do I configure X?" --category "Q&A"
+			$ gh discussion comment 12 --body "Thanks!"
+		`),
+	}
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+	cmd.AddCommand(cmdComment.NewCmdComment(f, nil))
+	cmd.AddCommand(cmdAnswer.NewCmdAnswer(f, nil))
+
+	return cmd
+}
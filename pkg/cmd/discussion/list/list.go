@@ -0,0 +1,118 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/discussion/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	Category string
+	Limit    int
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List discussions in a repository",
+		Long:    "Display discussions in a GitHub repository.",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ gh discussion list
+			$ gh discussion list --category "Q&A"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Category, "category", "c", "", "Filter by discussion category name or slug")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of discussions to fetch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.Fields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	var categoryID string
+	if opts.Category != "" {
+		categoryID, err = shared.CategoryByName(httpClient, baseRepo, opts.Category)
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return err
+		}
+	}
+	discussions, err := shared.ListDiscussions(httpClient, baseRepo, categoryID, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, discussions)
+	}
+
+	if len(discussions) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "No discussions found in %s\n", ghrepo.FullName(baseRepo))
+		return nil
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		title := fmt.Sprintf("Showing %s in %s", utils.Pluralize(len(discussions), "discussion"), ghrepo.FullName(baseRepo))
+		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
+	}
+
+	cs := opts.IO.ColorScheme()
+	table := utils.NewTablePrinter(opts.IO)
+	for _, d := range discussions {
+		table.AddField(fmt.Sprintf("#%d", d.Number), nil, cs.Bold)
+		table.AddField(d.Title, nil, nil)
+		table.AddField(d.Category.Name, nil, cs.Gray)
+		answered := "no"
+		if d.Answer != nil {
+			answered = "yes"
+		}
+		table.AddField(answered, nil, nil)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
@@ -0,0 +1,62 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query DiscussionList\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"discussions":{"nodes":[
+			{"number":3,"title":"Welcome","category":{"name":"General"},"comments":{"totalCount":2}}
+		]}}}}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	err := listRun(&ListOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Limit: 30,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Welcome")
+	assert.Contains(t, stdout.String(), "General")
+}
+
+func Test_listRun_noDiscussions(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query DiscussionList\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"discussions":{"nodes":[]}}}}`))
+
+	io, _, _, stderr := iostreams.Test()
+
+	err := listRun(&ListOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Limit: 30,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "No discussions found in OWNER/REPO\n", stderr.String())
+}
@@ -0,0 +1,387 @@
+// Package shared contains helpers for working with GitHub Discussions that are reused
+// across the `gh discussion` leaf commands.
+//
+// GitHub Discussions has no REST API, so every call here goes through raw GraphQL
+// query and mutation strings (rather than the typed githubv4 input structs used
+// elsewhere in this codebase) because the vendored githubv4 schema predates the
+// Discussions feature and has no typed support for it.
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// Discussion represents a GitHub Discussion.
+type Discussion struct {
+	ID        string
+	Number    int
+	Title     string
+	Body      string
+	URL       string
+	CreatedAt time.Time
+	Author    struct {
+		Login string
+	}
+	Category struct {
+		ID   string
+		Name string
+		Slug string
+	}
+	Comments struct {
+		TotalCount int
+	}
+	Answer *struct {
+		ID string
+	}
+}
+
+// Comment represents a comment on a GitHub Discussion.
+type Comment struct {
+	ID     string
+	Body   string
+	URL    string
+	Author struct {
+		Login string
+	}
+	IsAnswer bool
+}
+
+// Fields are the field names accepted by the `--json` flag for `gh discussion list` and `gh discussion view`.
+var Fields = []string{
+	"number", "title", "body", "url", "author", "category", "comments", "isAnswered", "createdAt",
+}
+
+// ExportData implements cmdutil.Exporter.
+func (d *Discussion) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "number":
+			data[f] = d.Number
+		case "title":
+			data[f] = d.Title
+		case "body":
+			data[f] = d.Body
+		case "url":
+			data[f] = d.URL
+		case "author":
+			data[f] = map[string]interface{}{"login": d.Author.Login}
+		case "category":
+			data[f] = map[string]interface{}{"name": d.Category.Name, "slug": d.Category.Slug}
+		case "comments":
+			data[f] = d.Comments.TotalCount
+		case "isAnswered":
+			data[f] = d.Answer != nil
+		case "createdAt":
+			data[f] = d.CreatedAt
+		}
+	}
+	return data
+}
+
+const discussionFragment = `
+	id
+	number
+	title
+	body
+	url
+	createdAt
+	author { login }
+	category { id name slug }
+	comments { totalCount }
+	answer { id }
+`
+
+var discussionURLRE = regexp.MustCompile(`^/([^/]+)/([^/]+)/discussions/(\d+)`)
+
+// DiscussionFromArg resolves a discussion number or URL to a Discussion.
+func DiscussionFromArg(httpClient *http.Client, baseRepoFn func() (ghrepo.Interface, error), arg string) (*Discussion, ghrepo.Interface, error) {
+	number, baseRepo := discussionMetadataFromURL(arg)
+
+	if number == 0 {
+		var err error
+		number, err = strconv.Atoi(arg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid discussion format: %q", arg)
+		}
+	}
+
+	if baseRepo == nil {
+		var err error
+		baseRepo, err = baseRepoFn()
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not determine base repo: %w", err)
+		}
+	}
+
+	discussion, err := FindDiscussion(httpClient, baseRepo, number)
+	return discussion, baseRepo, err
+}
+
+func discussionMetadataFromURL(s string) (int, ghrepo.Interface) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return 0, nil
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return 0, nil
+	}
+
+	m := discussionURLRE.FindStringSubmatch(u.Path)
+	if m == nil {
+		return 0, nil
+	}
+
+	repo := ghrepo.NewWithHost(m[1], m[2], u.Hostname())
+	number, _ := strconv.Atoi(m[3])
+	return number, repo
+}
+
+// FindDiscussion looks up a single discussion by its number.
+func FindDiscussion(httpClient *http.Client, repo ghrepo.Interface, number int) (*Discussion, error) {
+	query := fmt.Sprintf(`
+	query DiscussionByNumber($owner: String!, $repo: String!, $number: Int!) {
+		repository(owner: $owner, name: $repo) {
+			discussion(number: $number) {%s}
+		}
+	}`, discussionFragment)
+
+	variables := map[string]interface{}{
+		"owner":  repo.RepoOwner(),
+		"repo":   repo.RepoName(),
+		"number": number,
+	}
+
+	var result struct {
+		Repository struct {
+			Discussion *Discussion
+		}
+	}
+
+	client := api.NewClientFromHTTP(httpClient)
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Repository.Discussion == nil {
+		return nil, fmt.Errorf("no discussion found for number %d", number)
+	}
+
+	return result.Repository.Discussion, nil
+}
+
+// ListDiscussions returns up to `limit` discussions in the repository, optionally filtered by category ID.
+func ListDiscussions(httpClient *http.Client, repo ghrepo.Interface, categoryID string, limit int) ([]Discussion, error) {
+	query := fmt.Sprintf(`
+	query DiscussionList($owner: String!, $repo: String!, $limit: Int!, $categoryId: ID) {
+		repository(owner: $owner, name: $repo) {
+			discussions(first: $limit, categoryId: $categoryId, orderBy: {field: CREATED_AT, direction: DESC}) {
+				nodes {%s}
+			}
+		}
+	}`, discussionFragment)
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+		"limit": limit,
+	}
+	if categoryID != "" {
+		variables["categoryId"] = categoryID
+	}
+
+	var result struct {
+		Repository struct {
+			Discussions struct {
+				Nodes []Discussion
+			}
+		}
+	}
+
+	client := api.NewClientFromHTTP(httpClient)
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Repository.Discussions.Nodes, nil
+}
+
+// CategoryByName resolves a discussion category's name or slug to its GraphQL ID.
+func CategoryByName(httpClient *http.Client, repo ghrepo.Interface, name string) (string, error) {
+	query := `
+	query DiscussionCategoryList($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			discussionCategories(first: 100) {
+				nodes { id name slug }
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}
+
+	var result struct {
+		Repository struct {
+			DiscussionCategories struct {
+				Nodes []struct {
+					ID   string
+					Name string
+					Slug string
+				}
+			}
+		}
+	}
+
+	client := api.NewClientFromHTTP(httpClient)
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &result); err != nil {
+		return "", err
+	}
+
+	for _, c := range result.Repository.DiscussionCategories.Nodes {
+		if c.Name == name || c.Slug == name {
+			return c.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("no discussion category found with name %q", name)
+}
+
+// CreateDiscussion creates a new discussion in the given category.
+func CreateDiscussion(httpClient *http.Client, repo ghrepo.Interface, categoryID, title, body string) (*Discussion, error) {
+	r, err := api.GitHubRepo(api.NewClientFromHTTP(httpClient), repo)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+	mutation DiscussionCreate($input: CreateDiscussionInput!) {
+		createDiscussion(input: $input) {
+			discussion {%s}
+		}
+	}`, discussionFragment)
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"repositoryId": r.ID,
+			"categoryId":   categoryID,
+			"title":        title,
+			"body":         body,
+		},
+	}
+
+	var result struct {
+		CreateDiscussion struct {
+			Discussion Discussion
+		}
+	}
+
+	client := api.NewClientFromHTTP(httpClient)
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	return &result.CreateDiscussion.Discussion, nil
+}
+
+// AddComment adds a comment to a discussion and returns the new comment.
+func AddComment(httpClient *http.Client, hostname, discussionID, body string) (*Comment, error) {
+	query := `
+	mutation DiscussionAddComment($input: AddDiscussionCommentInput!) {
+		addDiscussionComment(input: $input) {
+			comment { id body url author { login } }
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"discussionId": discussionID,
+			"body":         body,
+		},
+	}
+
+	var result struct {
+		AddDiscussionComment struct {
+			Comment Comment
+		}
+	}
+
+	client := api.NewClientFromHTTP(httpClient)
+	if err := client.GraphQL(hostname, query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	return &result.AddDiscussionComment.Comment, nil
+}
+
+// ListComments returns the top-level comments on a discussion, in the order they were posted.
+func ListComments(httpClient *http.Client, repo ghrepo.Interface, number int) ([]Comment, error) {
+	query := `
+	query DiscussionCommentList($owner: String!, $repo: String!, $number: Int!) {
+		repository(owner: $owner, name: $repo) {
+			discussion(number: $number) {
+				comments(first: 100) {
+					nodes { id body url author { login } isAnswer }
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":  repo.RepoOwner(),
+		"repo":   repo.RepoName(),
+		"number": number,
+	}
+
+	var result struct {
+		Repository struct {
+			Discussion *struct {
+				Comments struct {
+					Nodes []Comment
+				}
+			}
+		}
+	}
+
+	client := api.NewClientFromHTTP(httpClient)
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Repository.Discussion == nil {
+		return nil, errors.New("discussion was not found but GraphQL reported no error")
+	}
+
+	return result.Repository.Discussion.Comments.Nodes, nil
+}
+
+// MarkCommentAsAnswer marks the given comment as the accepted answer for its discussion.
+func MarkCommentAsAnswer(httpClient *http.Client, hostname, commentID string) error {
+	query := `
+	mutation DiscussionMarkCommentAsAnswer($input: MarkDiscussionCommentAsAnswerInput!) {
+		markDiscussionCommentAsAnswer(input: $input) {
+			discussion { id }
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id": commentID,
+		},
+	}
+
+	var result struct{}
+	client := api.NewClientFromHTTP(httpClient)
+	return client.GraphQL(hostname, query, variables, &result)
+}
@@ -0,0 +1,58 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindDiscussion(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query DiscussionByNumber\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"discussion":{"number":3,"title":"Welcome","category":{"name":"General"}}}}}`))
+
+	d, err := FindDiscussion(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), 3)
+	require.NoError(t, err)
+	assert.Equal(t, "Welcome", d.Title)
+	assert.Equal(t, "General", d.Category.Name)
+}
+
+func Test_FindDiscussion_notFound(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query DiscussionByNumber\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"discussion":null}}}`))
+
+	_, err := FindDiscussion(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), 9)
+	assert.EqualError(t, err, "no discussion found for number 9")
+}
+
+func Test_CategoryByName(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query DiscussionCategoryList\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"discussionCategories":{"nodes":[{"id":"CAT1","name":"Q&A","slug":"q-a"}]}}}}`))
+
+	id, err := CategoryByName(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), "q-a")
+	require.NoError(t, err)
+	assert.Equal(t, "CAT1", id)
+}
+
+func Test_CategoryByName_notFound(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query DiscussionCategoryList\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"discussionCategories":{"nodes":[]}}}}`))
+
+	_, err := CategoryByName(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), "nonexistent")
+	assert.EqualError(t, err, `no discussion category found with name "nonexistent"`)
+}
@@ -0,0 +1,175 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/discussion/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/markdown"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type browser interface {
+	Browse(string) error
+}
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Browser    browser
+	Exporter   cmdutil.Exporter
+
+	SelectorArg string
+	WebMode     bool
+	Comments    bool
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Browser:    f.Browser,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view {<number> | <url>}",
+		Short: "View a discussion",
+		Long: heredoc.Doc(`
+			Display the title, body, and other information about a discussion.
+
+			With '--web', open the discussion in a web browser instead.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open a discussion in the browser")
+	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View discussion comments")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.Fields)
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	discussion, baseRepo, err := shared.DiscussionFromArg(httpClient, opts.BaseRepo, opts.SelectorArg)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.WebMode {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(discussion.URL))
+		}
+		return opts.Browser.Browse(discussion.URL)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, discussion)
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	var comments []shared.Comment
+	if opts.Comments {
+		comments, err = shared.ListComments(httpClient, baseRepo, discussion.Number)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		return printHumanDiscussionPreview(opts, baseRepo, discussion, comments)
+	}
+
+	return printRawDiscussionPreview(opts, discussion, comments)
+}
+
+func printRawDiscussionPreview(opts *ViewOptions, discussion *shared.Discussion, comments []shared.Comment) error {
+	out := opts.IO.Out
+	fmt.Fprintf(out, "title:\t%s\n", discussion.Title)
+	fmt.Fprintf(out, "state:\t%s\n", answeredState(discussion))
+	fmt.Fprintf(out, "author:\t%s\n", discussion.Author.Login)
+	fmt.Fprintf(out, "category:\t%s\n", discussion.Category.Name)
+	fmt.Fprintf(out, "comments:\t%d\n", discussion.Comments.TotalCount)
+	fmt.Fprintln(out, "--")
+	fmt.Fprintln(out, discussion.Body)
+
+	for _, c := range comments {
+		fmt.Fprintln(out, "--")
+		fmt.Fprintf(out, "author:\t%s\n", c.Author.Login)
+		if c.IsAnswer {
+			fmt.Fprintln(out, "answer:\ttrue")
+		}
+		fmt.Fprintln(out, "--")
+		fmt.Fprintln(out, c.Body)
+	}
+
+	return nil
+}
+
+func printHumanDiscussionPreview(opts *ViewOptions, baseRepo ghrepo.Interface, discussion *shared.Discussion, comments []shared.Comment) error {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	fmt.Fprintf(out, "%s #%d\n", cs.Bold(discussion.Title), discussion.Number)
+	fmt.Fprintf(out, "%s opened this discussion in %s • %s\n",
+		discussion.Author.Login, ghrepo.FullName(baseRepo), answeredState(discussion))
+	fmt.Fprintf(out, "%s • %s\n",
+		cs.Gray(discussion.Category.Name),
+		utils.Pluralize(discussion.Comments.TotalCount, "comment"))
+	fmt.Fprintln(out)
+
+	body, err := markdown.Render(discussion.Body, markdown.WithIO(opts.IO))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, body)
+
+	for _, c := range comments {
+		label := fmt.Sprintf("%s commented", c.Author.Login)
+		if c.IsAnswer {
+			label = fmt.Sprintf("%s (marked as answer)", label)
+		}
+		fmt.Fprintln(out, cs.Gray(label))
+		commentBody, err := markdown.Render(c.Body, markdown.WithIO(opts.IO))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, commentBody)
+	}
+
+	fmt.Fprintf(out, cs.Gray("View this discussion on GitHub: %s\n"), discussion.URL)
+
+	return nil
+}
+
+func answeredState(d *shared.Discussion) string {
+	if d.Answer != nil {
+		return "answered"
+	}
+	return "unanswered"
+}
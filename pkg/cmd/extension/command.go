@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -13,6 +14,8 @@ import (
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/extensions"
 	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/cli/cli/v2/pkg/text"
 	"github.com/cli/cli/v2/utils"
 	"github.com/spf13/cobra"
 )
@@ -39,72 +42,260 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	extCmd.AddCommand(
-		&cobra.Command{
-			Use:     "list",
-			Short:   "List installed extension commands",
-			Aliases: []string{"ls"},
-			Args:    cobra.NoArgs,
-			RunE: func(cmd *cobra.Command, args []string) error {
-				cmds := m.List(true)
-				if len(cmds) == 0 {
-					return errors.New("no extensions installed")
-				}
-				cs := io.ColorScheme()
-				t := utils.NewTablePrinter(io)
-				for _, c := range cmds {
-					var repo string
-					if u, err := git.ParseURL(c.URL()); err == nil {
-						if r, err := ghrepo.FromURL(u); err == nil {
-							repo = ghrepo.FullName(r)
+		func() *cobra.Command {
+			var exporter cmdutil.Exporter
+			cmd := &cobra.Command{
+				Use:     "list",
+				Short:   "List installed extension commands",
+				Aliases: []string{"ls"},
+				Args:    cobra.NoArgs,
+				RunE: func(cmd *cobra.Command, args []string) error {
+					cmds := m.List(true)
+					if len(cmds) == 0 {
+						return errors.New("no extensions installed")
+					}
+
+					if exporter != nil {
+						items := make([]extensionListItem, len(cmds))
+						for i, c := range cmds {
+							items[i] = extensionListItemFor(c)
 						}
+						return exporter.Write(io, items)
 					}
 
-					t.AddField(fmt.Sprintf("gh %s", c.Name()), nil, nil)
-					t.AddField(repo, nil, nil)
-					version := c.CurrentVersion()
-					if !c.IsBinary() && len(version) > 8 {
-						version = version[:8]
+					cs := io.ColorScheme()
+					t := utils.NewTablePrinter(io)
+					for _, c := range cmds {
+						item := extensionListItemFor(c)
+
+						t.AddField(fmt.Sprintf("gh %s", item.Name), nil, nil)
+						t.AddField(item.Repo, nil, nil)
+						version := item.Version
+						if item.Pinned {
+							t.AddField(version, nil, cs.Cyan)
+						} else {
+							t.AddField(version, nil, nil)
+						}
+
+						var updateAvailable string
+						if item.UpdateAvailable {
+							updateAvailable = "Upgrade available"
+						}
+						t.AddField(updateAvailable, nil, cs.Green)
+
+						if item.Verification == "verified" {
+							t.AddField("Verified", nil, cs.Green)
+						} else {
+							t.AddField("", nil, nil)
+						}
+						t.EndRow()
 					}
+					return t.Render()
+				},
+			}
+			cmdutil.AddJSONFlags(cmd, &exporter, extensionListFields)
+			return cmd
+		}(),
+		func() *cobra.Command {
+			var order string
+			var sortBy string
+			var licenses []string
+			var limit int
+			var webMode bool
+			var exporter cmdutil.Exporter
 
-					if c.IsPinned() {
-						t.AddField(version, nil, cs.Cyan)
-					} else {
-						t.AddField(version, nil, nil)
+			cmd := &cobra.Command{
+				Use:   "search [<query>]",
+				Short: "Search extensions to the gh CLI",
+				Long: heredoc.Doc(`
+					Search for gh extensions.
+
+					With no arguments, this command prints out the first 30 extensions
+					available to install, sorted by best match. Extensions are searched
+					among repositories tagged with the "gh-extension" topic on GitHub.
+
+					When run interactively, the results can be installed directly by
+					choosing one from the list.
+				`),
+				Example: heredoc.Doc(`
+					# List extensions sorted by star count
+					$ gh extension search --sort stars
+
+					# Search extensions matching "pull request"
+					$ gh extension search pull request
+
+					# Filter extensions by license
+					$ gh extension search --license mit --license apache-2.0
+				`),
+				Args: cobra.ArbitraryArgs,
+				RunE: func(cmd *cobra.Command, args []string) error {
+					if limit < 1 || limit > 1000 {
+						return cmdutil.FlagErrorf("`--limit` must be between 1 and 1000")
+					}
+					if err := cmdutil.MutuallyExclusive(
+						"specify only one of `--json` or `--web`",
+						cmd.Flags().Changed("json"), webMode,
+					); err != nil {
+						return err
 					}
 
-					var updateAvailable string
-					if c.UpdateAvailable() {
-						updateAvailable = "Upgrade available"
+					query := search.Query{
+						Keywords: args,
+						Kind:     search.KindRepositories,
+						Limit:    limit,
+						Order:    order,
+						Sort:     sortBy,
+						Qualifiers: search.Qualifiers{
+							Topic:   []string{"gh-extension"},
+							License: licenses,
+						},
 					}
-					t.AddField(updateAvailable, nil, cs.Green)
-					t.EndRow()
-				}
-				return t.Render()
-			},
-		},
+
+					cfg, err := f.Config()
+					if err != nil {
+						return err
+					}
+					host, err := cfg.DefaultHost()
+					if err != nil {
+						return err
+					}
+					httpClient, err := f.HttpClient()
+					if err != nil {
+						return err
+					}
+					searcher := search.NewSearcher(httpClient, host)
+
+					if webMode {
+						url := searcher.URL(query)
+						if io.IsStdoutTTY() {
+							fmt.Fprintf(io.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(url))
+						}
+						return f.Browser.Browse(url)
+					}
+
+					io.StartProgressIndicator()
+					result, err := searcher.Repositories(query)
+					io.StopProgressIndicator()
+					if err != nil {
+						return err
+					}
+
+					if exporter != nil {
+						return exporter.Write(io, result.Items)
+					}
+
+					if len(result.Items) == 0 {
+						fmt.Fprintln(io.ErrOut, "no extensions found")
+						return cmdutil.SilentError
+					}
+
+					cs := io.ColorScheme()
+					t := utils.NewTablePrinter(io)
+					for _, repo := range result.Items {
+						t.AddField(repo.FullName, nil, cs.Bold)
+						t.AddField(text.ReplaceExcessiveWhitespace(repo.Description), nil, nil)
+						t.AddField(fmt.Sprintf("%d", repo.StargazersCount), nil, cs.Yellow)
+						t.EndRow()
+					}
+					if err := t.Render(); err != nil {
+						return err
+					}
+
+					if !io.CanPrompt() {
+						return nil
+					}
+
+					options := make([]string, len(result.Items))
+					for i, repo := range result.Items {
+						options[i] = repo.FullName
+					}
+					options = append(options, "Exit without installing")
+
+					var choice string
+					if err := prompt.SurveyAskOne(&survey.Select{
+						Message: "Install an extension?",
+						Options: options,
+					}, &choice); err != nil {
+						return fmt.Errorf("could not prompt: %w", err)
+					}
+					if choice == "Exit without installing" {
+						return nil
+					}
+
+					repo, err := ghrepo.FromFullName(choice)
+					if err != nil {
+						return err
+					}
+					if err := checkValidExtension(cmd.Root(), m, repo.RepoName()); err != nil {
+						return err
+					}
+					if err := m.Install(repo, "", false); err != nil {
+						return err
+					}
+					fmt.Fprintf(io.Out, "%s Installed extension %s\n", cs.SuccessIcon(), choice)
+					return nil
+				},
+			}
+			cmdutil.StringEnumFlag(cmd, &sortBy, "sort", "", "best-match", []string{"stars", "updated"}, "Sort fetched extensions")
+			cmdutil.StringEnumFlag(cmd, &order, "order", "", "desc", []string{"asc", "desc"}, "Order of extensions returned, ignored unless `--sort` flag is specified")
+			cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of extensions to fetch")
+			cmd.Flags().StringSliceVar(&licenses, "license", nil, "Filter based on license type")
+			cmd.Flags().BoolVarP(&webMode, "web", "w", false, "Open the search query in the web browser")
+			cmdutil.AddJSONFlags(cmd, &exporter, search.RepositoryFields)
+			return cmd
+		}(),
 		func() *cobra.Command {
 			var pinFlag string
+			var fromLockFileFlag bool
+			var skipVerificationFlag bool
 			cmd := &cobra.Command{
 				Use:   "install <repository>",
 				Short: "Install a gh extension from a repository",
 				Long: heredoc.Doc(`
-				Install a GitHub repository locally as a GitHub CLI extension.
-				
-				The repository argument can be specified in "owner/repo" format as well as a full URL.
-				The URL format is useful when the repository is not hosted on github.com.
-				
-				To install an extension in development from the current directory, use "." as the
-				value of the repository argument.
-
-				See the list of available extensions at <https://github.com/topics/gh-extension>.
-			`),
+			Install a GitHub repository locally as a GitHub CLI extension.
+			
+			The repository argument can be specified in "owner/repo" format as well as a full URL.
+			The URL format is useful when the repository is not hosted on github.com.
+			
+			To install an extension in development from the current directory, use "." as the
+			value of the repository argument.
+
+			To install an extension from a local tarball created by "gh extension package",
+			e.g. for distribution inside networks that can't reach api.github.com, pass the
+			path to the tarball as the repository argument.
+
+			Use "--from-lockfile" to reinstall every extension recorded in this machine's
+			extension lockfile, pinned to the exact tag or commit it was recorded with. Every
+			"gh extension install" and "gh extension upgrade" updates the lockfile, so copying
+			it to another machine and running "gh extension install --from-lockfile" there
+			reproduces the same set of extensions.
+
+			Binary extensions are verified against the checksums.txt published with their
+			release, when one is present. Use "--skip-verification" to bypass this check.
+
+			See the list of available extensions at <https://github.com/topics/gh-extension>.
+		`),
 				Example: heredoc.Doc(`
-				$ gh extension install owner/gh-extension
-				$ gh extension install https://git.example.com/owner/gh-extension
-				$ gh extension install .
-			`),
-				Args: cmdutil.MinimumArgs(1, "must specify a repository to install from"),
+			$ gh extension install owner/gh-extension
+			$ gh extension install https://git.example.com/owner/gh-extension
+			$ gh extension install .
+			$ gh extension install ./gh-extension.tar.gz
+			$ gh extension install --from-lockfile
+		`),
+				Args: func(cmd *cobra.Command, args []string) error {
+					if fromLockFileFlag {
+						if len(args) > 0 {
+							return cmdutil.FlagErrorf("cannot use `--from-lockfile` with a repository argument")
+						}
+						return nil
+					}
+					return cmdutil.MinimumArgs(1, "must specify a repository to install from")(cmd, args)
+				},
 				RunE: func(cmd *cobra.Command, args []string) error {
+					if fromLockFileFlag {
+						return m.InstallFromLockFile()
+					}
+
 					if args[0] == "." {
 						if pinFlag != "" {
 							return fmt.Errorf("local extensions cannot be pinned")
@@ -116,6 +307,13 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 						return m.InstallLocal(wd)
 					}
 
+					if fi, err := os.Stat(args[0]); err == nil && !fi.IsDir() {
+						if pinFlag != "" {
+							return fmt.Errorf("local extensions cannot be pinned")
+						}
+						return m.InstallFromTarball(args[0])
+					}
+
 					repo, err := ghrepo.FromFullName(args[0])
 					if err != nil {
 						return err
@@ -126,7 +324,7 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 					}
 
 					cs := io.ColorScheme()
-					if err := m.Install(repo, pinFlag); err != nil {
+					if err := m.Install(repo, pinFlag, skipVerificationFlag); err != nil {
 						if errors.Is(err, releaseNotFoundErr) {
 							return fmt.Errorf("%s Could not find a release of %s for %s",
 								cs.FailureIcon(), args[0], cs.Cyan(pinFlag))
@@ -147,6 +345,53 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 				},
 			}
 			cmd.Flags().StringVar(&pinFlag, "pin", "", "pin extension to a release tag or commit ref")
+			cmd.Flags().BoolVar(&fromLockFileFlag, "from-lockfile", false, "install every extension recorded in the extension lockfile")
+			cmd.Flags().BoolVar(&skipVerificationFlag, "skip-verification", false, "skip checksum and signature verification of the downloaded binary")
+			return cmd
+		}(),
+		func() *cobra.Command {
+			var outputFlag string
+			cmd := &cobra.Command{
+				Use:   "package [<directory>]",
+				Short: "Package a gh extension for offline distribution",
+				Long: heredoc.Doc(`
+					Package a local gh extension directory into a gzip-compressed tarball
+					that can be installed without network access, via
+					"gh extension install <tarball>".
+
+					With no argument, packages the extension in the current directory.
+				`),
+				Example: heredoc.Doc(`
+					$ gh extension package
+					$ gh extension package ./gh-screensaver -o gh-screensaver.tar.gz
+				`),
+				Args: cobra.MaximumNArgs(1),
+				RunE: func(cmd *cobra.Command, args []string) error {
+					dir := "."
+					if len(args) > 0 {
+						dir = args[0]
+					}
+					dir, err := filepath.Abs(dir)
+					if err != nil {
+						return err
+					}
+
+					if outputFlag == "" {
+						outputFlag = filepath.Base(dir) + ".tar.gz"
+					}
+
+					if err := m.Package(dir, outputFlag); err != nil {
+						return err
+					}
+
+					if io.IsStdoutTTY() {
+						cs := io.ColorScheme()
+						fmt.Fprintf(io.Out, "%s Packaged extension into %s\n", cs.SuccessIcon(), outputFlag)
+					}
+					return nil
+				},
+			}
+			cmd.Flags().StringVarP(&outputFlag, "output", "o", "", "Path of the resulting tarball (default: `<extension>.tar.gz`)")
 			return cmd
 		}(),
 		func() *cobra.Command {
@@ -370,3 +615,61 @@ func normalizeExtensionSelector(n string) string {
 	}
 	return strings.TrimPrefix(n, "gh-")
 }
+
+// extensionListFields are the field names accepted by the `--json` flag for `gh extension list`.
+var extensionListFields = []string{"name", "repo", "version", "pinned", "updateAvailable", "verification"}
+
+// extensionListItem is the exportable view of an extensions.Extension used for `--json` output.
+type extensionListItem struct {
+	Name            string `json:"name"`
+	Repo            string `json:"repo"`
+	Version         string `json:"version"`
+	Pinned          bool   `json:"pinned"`
+	UpdateAvailable bool   `json:"updateAvailable"`
+	Verification    string `json:"verification"`
+}
+
+func extensionListItemFor(c extensions.Extension) extensionListItem {
+	var repo string
+	if u, err := git.ParseURL(c.URL()); err == nil {
+		if r, err := ghrepo.FromURL(u); err == nil {
+			repo = ghrepo.FullName(r)
+		}
+	}
+
+	version := c.CurrentVersion()
+	if !c.IsBinary() && len(version) > 8 {
+		version = version[:8]
+	}
+
+	return extensionListItem{
+		Name:            c.Name(),
+		Repo:            repo,
+		Version:         version,
+		Pinned:          c.IsPinned(),
+		UpdateAvailable: c.UpdateAvailable(),
+		Verification:    c.VerificationStatus(),
+	}
+}
+
+// ExportData implements cmdutil.Exporter.
+func (e extensionListItem) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "name":
+			data[f] = e.Name
+		case "repo":
+			data[f] = e.Repo
+		case "version":
+			data[f] = e.Version
+		case "pinned":
+			data[f] = e.Pinned
+		case "updateAvailable":
+			data[f] = e.UpdateAvailable
+		case "verification":
+			data[f] = e.Verification
+		}
+	}
+	return data
+}
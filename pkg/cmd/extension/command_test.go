@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -26,10 +27,13 @@ func TestNewCmdExtension(t *testing.T) {
 	assert.NoError(t, os.Chdir(tempDir))
 	t.Cleanup(func() { _ = os.Chdir(oldWd) })
 
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "ext.tar.gz"), []byte("fake"), 0600))
+
 	tests := []struct {
 		name         string
 		args         []string
 		managerStubs func(em *extensions.ExtensionManagerMock) func(*testing.T)
+		httpStubs    func(*httpmock.Registry)
 		askStubs     func(as *prompt.AskStubber)
 		isTTY        bool
 		wantErr      bool
@@ -37,6 +41,33 @@ func TestNewCmdExtension(t *testing.T) {
 		wantStdout   string
 		wantStderr   string
 	}{
+		{
+			name: "search extensions",
+			args: []string{"search", "screensaver"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "search/repositories"),
+					httpmock.StringResponse(`{
+						"total_count": 1,
+						"items": [
+							{"full_name": "monalisa/gh-screensaver", "description": "A screensaver extension", "stargazers_count": 42}
+						]
+					}`))
+			},
+			wantStdout: "monalisa/gh-screensaver\tA screensaver extension\t42\n",
+		},
+		{
+			name: "search extensions no results",
+			args: []string{"search", "screensaver"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "search/repositories"),
+					httpmock.StringResponse(`{"total_count": 0, "items": []}`))
+			},
+			wantErr:    true,
+			errMsg:     "SilentError",
+			wantStderr: "no extensions found\n",
+		},
 		{
 			name: "install an extension",
 			args: []string{"install", "owner/gh-some-ext"},
@@ -44,13 +75,13 @@ func TestNewCmdExtension(t *testing.T) {
 				em.ListFunc = func(bool) []extensions.Extension {
 					return []extensions.Extension{}
 				}
-				em.InstallFunc = func(_ ghrepo.Interface, _ string) error {
+				em.InstallFunc = func(_ ghrepo.Interface, _ string, _ bool) error {
 					return nil
 				}
 				return func(t *testing.T) {
 					installCalls := em.InstallCalls()
 					assert.Equal(t, 1, len(installCalls))
-					assert.Equal(t, "gh-some-ext", installCalls[0].InterfaceMoqParam.RepoName())
+					assert.Equal(t, "gh-some-ext", installCalls[0].Repo.RepoName())
 					listCalls := em.ListCalls()
 					assert.Equal(t, 1, len(listCalls))
 				}
@@ -93,6 +124,74 @@ func TestNewCmdExtension(t *testing.T) {
 			errMsg:  "local extensions cannot be pinned",
 			isTTY:   true,
 		},
+		{
+			name: "install from tarball",
+			args: []string{"install", "ext.tar.gz"},
+			managerStubs: func(em *extensions.ExtensionManagerMock) func(*testing.T) {
+				em.InstallFromTarballFunc = func(tarballPath string) error {
+					return nil
+				}
+				return func(t *testing.T) {
+					calls := em.InstallFromTarballCalls()
+					assert.Equal(t, 1, len(calls))
+					assert.Equal(t, "ext.tar.gz", calls[0].TarballPath)
+				}
+			},
+		},
+		{
+			name:    "install from tarball with pin",
+			args:    []string{"install", "ext.tar.gz", "--pin", "v1.0.0"},
+			wantErr: true,
+			errMsg:  "local extensions cannot be pinned",
+		},
+		{
+			name: "package extension",
+			args: []string{"package"},
+			managerStubs: func(em *extensions.ExtensionManagerMock) func(*testing.T) {
+				em.PackageFunc = func(dir, destPath string) error {
+					return nil
+				}
+				return func(t *testing.T) {
+					calls := em.PackageCalls()
+					assert.Equal(t, 1, len(calls))
+					assert.Equal(t, tempDir, normalizeDir(calls[0].Dir))
+					assert.Equal(t, filepath.Base(tempDir)+".tar.gz", calls[0].DestPath)
+				}
+			},
+		},
+		{
+			name: "package extension with output flag",
+			args: []string{"package", ".", "-o", "out.tar.gz"},
+			managerStubs: func(em *extensions.ExtensionManagerMock) func(*testing.T) {
+				em.PackageFunc = func(dir, destPath string) error {
+					return nil
+				}
+				return func(t *testing.T) {
+					calls := em.PackageCalls()
+					assert.Equal(t, 1, len(calls))
+					assert.Equal(t, "out.tar.gz", calls[0].DestPath)
+				}
+			},
+		},
+		{
+			name: "install from lockfile",
+			args: []string{"install", "--from-lockfile"},
+			managerStubs: func(em *extensions.ExtensionManagerMock) func(*testing.T) {
+				em.InstallFromLockFileFunc = func() error {
+					return nil
+				}
+				return func(t *testing.T) {
+					calls := em.InstallFromLockFileCalls()
+					assert.Equal(t, 1, len(calls))
+				}
+			},
+		},
+		{
+			name:    "install from lockfile with repository argument",
+			args:    []string{"install", "owner/gh-some-ext", "--from-lockfile"},
+			wantErr: true,
+			errMsg:  "cannot use `--from-lockfile` with a repository argument",
+		},
 		{
 			name:    "upgrade argument error",
 			args:    []string{"upgrade"},
@@ -322,7 +421,23 @@ func TestNewCmdExtension(t *testing.T) {
 					assert.Equal(t, 1, len(em.ListCalls()))
 				}
 			},
-			wantStdout: "gh test\tcli/gh-test\t1\t\ngh test2\tcli/gh-test2\t1\tUpgrade available\n",
+			wantStdout: "gh test\tcli/gh-test\t1\t\t\ngh test2\tcli/gh-test2\t1\tUpgrade available\t\n",
+		},
+		{
+			name: "list extensions as JSON",
+			args: []string{"list", "--json", "name,repo,updateAvailable"},
+			managerStubs: func(em *extensions.ExtensionManagerMock) func(*testing.T) {
+				em.ListFunc = func(bool) []extensions.Extension {
+					ex1 := &Extension{path: "cli/gh-test", url: "https://github.com/cli/gh-test", currentVersion: "1", latestVersion: "1"}
+					ex2 := &Extension{path: "cli/gh-test2", url: "https://github.com/cli/gh-test2", currentVersion: "1", latestVersion: "2"}
+					return []extensions.Extension{ex1, ex2}
+				}
+				return func(t *testing.T) {
+					assert.Equal(t, 1, len(em.ListCalls()))
+				}
+			},
+			wantStdout: `[{"name":"test","repo":"cli/gh-test","updateAvailable":false},{"name":"test2","repo":"cli/gh-test2","updateAvailable":true}]
+`,
 		},
 		{
 			name: "create extension interactive",
@@ -488,6 +603,9 @@ func TestNewCmdExtension(t *testing.T) {
 
 			reg := httpmock.Registry{}
 			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(&reg)
+			}
 			client := http.Client{Transport: &reg}
 
 			f := cmdutil.Factory{
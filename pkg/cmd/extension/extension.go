@@ -15,13 +15,14 @@ const (
 )
 
 type Extension struct {
-	path           string
-	url            string
-	isLocal        bool
-	isPinned       bool
-	currentVersion string
-	latestVersion  string
-	kind           ExtensionKind
+	path               string
+	url                string
+	isLocal            bool
+	isPinned           bool
+	currentVersion     string
+	latestVersion      string
+	kind               ExtensionKind
+	verificationStatus string
 }
 
 func (e *Extension) Name() string {
@@ -62,3 +63,10 @@ func (e *Extension) UpdateAvailable() bool {
 func (e *Extension) IsBinary() bool {
 	return e.kind == BinaryKind
 }
+
+// VerificationStatus reports how this extension's binary was checked against the
+// checksums.txt published with its release: "verified", "skipped", or "" if the
+// release published no checksums.txt (including for all non-binary extensions).
+func (e *Extension) VerificationStatus() string {
+	return e.verificationStatus
+}
@@ -8,6 +8,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strconv"
+	"sync"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghinstance"
@@ -52,14 +54,85 @@ type release struct {
 	Assets []releaseAsset
 }
 
-// downloadAsset downloads a single asset to the given file path.
+// assetDownload pairs a release asset with the local path it should be saved to.
+type assetDownload struct {
+	asset    releaseAsset
+	destPath string
+}
+
+// fetchAsset downloads a release asset into memory. It's meant for small assets like
+// checksums.txt and signature files, not for the extension binaries themselves.
+func fetchAsset(httpClient *http.Client, asset releaseAsset) ([]byte, error) {
+	req, err := http.NewRequest("GET", asset.APIURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/octet-stream")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+// downloadAssets downloads multiple assets concurrently. If any download fails, the
+// first error encountered is returned, though the other downloads are still allowed
+// to finish since each one resumes independently on a later retry.
+func downloadAssets(httpClient *http.Client, downloads []assetDownload) error {
+	type result struct {
+		name string
+		err  error
+	}
+
+	ch := make(chan result, len(downloads))
+	var wg sync.WaitGroup
+	wg.Add(len(downloads))
+	for _, d := range downloads {
+		go func(dl assetDownload) {
+			defer wg.Done()
+			ch <- result{name: dl.asset.Name, err: downloadAsset(httpClient, dl.asset, dl.destPath)}
+		}(d)
+	}
+	wg.Wait()
+	close(ch)
+
+	for r := range ch {
+		if r.err != nil {
+			return fmt.Errorf("failed to download asset %s: %w", r.name, r.err)
+		}
+	}
+	return nil
+}
+
+// downloadAsset downloads a single asset to the given file path, resuming a previous
+// partial download via an HTTP Range request when possible. It writes to a temporary
+// file alongside destPath and renames it into place only once the download completes,
+// so a failure partway through never leaves a corrupt file at destPath.
 func downloadAsset(httpClient *http.Client, asset releaseAsset, destPath string) error {
+	tempPath := destPath + ".download"
+
+	var offset int64
+	if fi, err := os.Stat(tempPath); err == nil {
+		offset = fi.Size()
+	}
+
 	req, err := http.NewRequest("GET", asset.APIURL, nil)
 	if err != nil {
 		return err
 	}
 
 	req.Header.Set("Accept", "application/octet-stream")
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -67,18 +140,41 @@ func downloadAsset(httpClient *http.Client, asset releaseAsset, destPath string)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		// Our partial download is stale or already complete; discard it and
+		// restart the download from scratch.
+		_ = os.Remove(tempPath)
+		return downloadAsset(httpClient, asset, destPath)
+	}
+
 	if resp.StatusCode > 299 {
 		return api.HandleHTTPError(resp)
 	}
 
-	f, err := os.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	// The server may not support resuming; if it sent back the full content
+	// instead of a range, start the file over rather than appending to it.
+	flags := os.O_CREATE | os.O_WRONLY
+	if offset > 0 && resp.StatusCode == http.StatusPartialContent {
+		flags |= os.O_APPEND
+	} else {
+		flags |= os.O_TRUNC
+	}
+
+	f, err := os.OpenFile(tempPath, flags, 0755)
 	if err != nil {
 		return err
 	}
-	defer f.Close()
 
 	_, err = io.Copy(f, resp.Body)
-	return err
+	closeErr := f.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return os.Rename(tempPath, destPath)
 }
 
 var releaseNotFoundErr = errors.New("release not found")
@@ -0,0 +1,86 @@
+package extension
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadAsset(t *testing.T) {
+	content := "FAKE EXTENSION BINARY"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(content))
+	}))
+	defer ts.Close()
+
+	destPath := filepath.Join(t.TempDir(), "gh-some-ext")
+	asset := releaseAsset{Name: "gh-some-ext", APIURL: ts.URL}
+
+	err := downloadAsset(http.DefaultClient, asset, destPath)
+	require.NoError(t, err)
+
+	bs, err := os.ReadFile(destPath)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(bs))
+
+	_, err = os.Stat(destPath + ".download")
+	assert.True(t, os.IsNotExist(err), "expected temp file to be removed after rename")
+}
+
+func TestDownloadAsset_resumesFromPartialDownload(t *testing.T) {
+	content := "FAKE EXTENSION BINARY"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rangeHeader := r.Header.Get("Range")
+		if rangeHeader == "" {
+			w.Write([]byte(content))
+			return
+		}
+
+		var start int
+		_, err := fmt.Sscanf(rangeHeader, "bytes=%d-", &start)
+		require.NoError(t, err)
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(content)-1, len(content)))
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write([]byte(content[start:]))
+	}))
+	defer ts.Close()
+
+	destPath := filepath.Join(t.TempDir(), "gh-some-ext")
+	require.NoError(t, os.WriteFile(destPath+".download", []byte(content[:10]), 0600))
+	asset := releaseAsset{Name: "gh-some-ext", APIURL: ts.URL}
+
+	err := downloadAsset(http.DefaultClient, asset, destPath)
+	require.NoError(t, err)
+
+	bs, err := os.ReadFile(destPath)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(bs))
+}
+
+func TestDownloadAssets_concurrent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("asset contents for " + r.URL.Path))
+	}))
+	defer ts.Close()
+
+	dir := t.TempDir()
+	downloads := []assetDownload{
+		{asset: releaseAsset{Name: "one", APIURL: ts.URL + "/one"}, destPath: filepath.Join(dir, "one")},
+		{asset: releaseAsset{Name: "two", APIURL: ts.URL + "/two"}, destPath: filepath.Join(dir, "two")},
+	}
+
+	err := downloadAssets(http.DefaultClient, downloads)
+	require.NoError(t, err)
+
+	for _, d := range downloads {
+		bs, err := os.ReadFile(d.destPath)
+		require.NoError(t, err)
+		assert.Equal(t, "asset contents for /"+d.asset.Name, string(bs))
+	}
+}
@@ -1,7 +1,10 @@
 package extension
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
+	"context"
 	_ "embed"
 	"errors"
 	"fmt"
@@ -15,7 +18,6 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
-	"sync"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/git"
@@ -28,6 +30,21 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+const lockFileName = "lock.yml"
+
+// lockFile records the exact tag or commit SHA of every non-local installed extension,
+// so that InstallFromLockFile can reproduce the same set of extensions on another machine.
+type lockFile struct {
+	Extensions []lockEntry `yaml:"extensions"`
+}
+
+type lockEntry struct {
+	Owner string `yaml:"owner"`
+	Name  string `yaml:"name"`
+	Host  string `yaml:"host"`
+	Tag   string `yaml:"tag"`
+}
+
 type Manager struct {
 	dataDir    func() string
 	lookPath   func(string) (string, error)
@@ -109,6 +126,42 @@ func (m *Manager) Dispatch(args []string, stdin io.Reader, stdout, stderr io.Wri
 	return true, externalCmd.Run()
 }
 
+// Complete asks an extension to complete the given arguments, following the same
+// `__complete` convention that cobra-based binaries implement. Extensions that are
+// not themselves cobra commands simply fail the dispatch and yield no completions.
+func (m *Manager) Complete(extName string, args []string, toComplete string) ([]string, error) {
+	exts, _ := m.list(false)
+	var exe string
+	for _, e := range exts {
+		if e.Name() == extName {
+			exe = e.Path()
+			break
+		}
+	}
+	if exe == "" {
+		return nil, nil
+	}
+
+	completionArgs := append([]string{"__complete"}, args...)
+	completionArgs = append(completionArgs, toComplete)
+
+	var out bytes.Buffer
+	cmd := m.newCommand(exe, completionArgs...)
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return nil, nil
+	}
+
+	var results []string
+	for _, line := range strings.Split(out.String(), "\n") {
+		if line == "" || strings.HasPrefix(line, ":") {
+			continue
+		}
+		results = append(results, strings.SplitN(line, "\t", 2)[0])
+	}
+	return results, nil
+}
+
 func (m *Manager) List(includeMetadata bool) []extensions.Extension {
 	exts, _ := m.list(includeMetadata)
 	r := make([]extensions.Extension, len(exts))
@@ -199,6 +252,7 @@ func (m *Manager) parseBinaryExtensionDir(fi fs.FileInfo) (Extension, error) {
 	ext.url = remoteURL
 	ext.currentVersion = bm.Tag
 	ext.isPinned = bm.IsPinned
+	ext.verificationStatus = bm.Verification
 	return ext, nil
 }
 
@@ -258,27 +312,16 @@ func (m *Manager) getRemoteUrl(extension string) string {
 }
 
 func (m *Manager) populateLatestVersions(exts []Extension) {
-	size := len(exts)
-	type result struct {
-		index   int
-		version string
-	}
-	ch := make(chan result, size)
-	var wg sync.WaitGroup
-	wg.Add(size)
+	pool, _ := api.NewPool(context.Background(), api.DefaultPoolConcurrency)
 	for idx, ext := range exts {
-		go func(i int, e Extension) {
-			defer wg.Done()
+		i, e := idx, ext
+		pool.Go(func() error {
 			version, _ := m.getLatestVersion(e)
-			ch <- result{index: i, version: version}
-		}(idx, ext)
-	}
-	wg.Wait()
-	close(ch)
-	for r := range ch {
-		ext := &exts[r.index]
-		ext.latestVersion = r.version
+			exts[i].latestVersion = version
+			return nil
+		})
 	}
+	_ = pool.Wait()
 }
 
 func (m *Manager) getLatestVersion(ext Extension) (string, error) {
@@ -329,16 +372,22 @@ type binManifest struct {
 	IsPinned bool
 	// TODO I may end up not using this; just thinking ahead to local installs
 	Path string
+	// Verification records whether the downloaded binary was checked against a
+	// checksums.txt published with the release; empty if the release published none.
+	Verification string
 }
 
 // Install installs an extension from repo, and pins to commitish if provided
-func (m *Manager) Install(repo ghrepo.Interface, target string) error {
+func (m *Manager) Install(repo ghrepo.Interface, target string, skipVerification bool) error {
 	isBin, err := isBinExtension(m.client, repo)
 	if err != nil {
 		return fmt.Errorf("could not check for binary extension: %w", err)
 	}
 	if isBin {
-		return m.installBin(repo, target)
+		if err := m.installBin(repo, target, skipVerification); err != nil {
+			return err
+		}
+		return m.writeLockFile()
 	}
 
 	hs, err := hasScript(m.client, repo)
@@ -349,10 +398,260 @@ func (m *Manager) Install(repo ghrepo.Interface, target string) error {
 		return errors.New("extension is not installable: missing executable")
 	}
 
-	return m.installGit(repo, target, m.io.Out, m.io.ErrOut)
+	if err := m.installGit(repo, target, m.io.Out, m.io.ErrOut); err != nil {
+		return err
+	}
+	return m.writeLockFile()
+}
+
+// InstallFromLockFile installs, or re-pins, every extension recorded in the lockfile at the
+// exact tag or commit it was recorded with.
+func (m *Manager) InstallFromLockFile() error {
+	lf, err := m.readLockFile()
+	if err != nil {
+		return err
+	}
+	if len(lf.Extensions) == 0 {
+		return errors.New("no extensions recorded in lockfile")
+	}
+
+	var failed bool
+	for _, entry := range lf.Extensions {
+		repo := ghrepo.NewWithHost(entry.Owner, entry.Name, entry.Host)
+		fmt.Fprintf(m.io.Out, "[%s]: ", repo.RepoName())
+		if err := m.Install(repo, entry.Tag, false); err != nil {
+			failed = true
+			fmt.Fprintf(m.io.Out, "%s\n", err)
+			continue
+		}
+		fmt.Fprintf(m.io.Out, "installed %s\n", entry.Tag)
+	}
+	if failed {
+		return errors.New("some extensions failed to install from lockfile")
+	}
+	return nil
+}
+
+// InstallFromTarball installs an extension packaged by "gh extension package" (or
+// matching its layout) from a local gzip-compressed tarball, for distributing
+// extensions inside networks that can't reach api.github.com.
+func (m *Manager) InstallFromTarball(tarballPath string) error {
+	f, err := os.Open(tarballPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("could not read %s as a gzip-compressed tarball: %w", tarballPath, err)
+	}
+	defer gz.Close()
+
+	if err := os.MkdirAll(m.installDir(), 0755); err != nil {
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp(m.installDir(), ".extension-package-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("could not read %s: %w", tarballPath, err)
+		}
+
+		target := filepath.Join(tmpDir, hdr.Name)
+		if !strings.HasPrefix(target, filepath.Clean(tmpDir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid extension package: entry %q escapes the archive", hdr.Name)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(hdr.Mode))
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(out, tr)
+			out.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	manifestPath := filepath.Join(tmpDir, manifestName)
+	bs, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return fmt.Errorf("invalid extension package: missing %s", manifestName)
+	}
+	var bm binManifest
+	if err := yaml.Unmarshal(bs, &bm); err != nil {
+		return fmt.Errorf("invalid extension package: could not parse %s: %w", manifestName, err)
+	}
+	if !strings.HasPrefix(bm.Name, "gh-") {
+		return fmt.Errorf("invalid extension package: %s name must start with `gh-`, got %q", manifestName, bm.Name)
+	}
+
+	targetDir := filepath.Join(m.installDir(), bm.Name)
+	if _, err := os.Stat(targetDir); err == nil {
+		return fmt.Errorf("there is already an installed extension at %s", targetDir)
+	}
+
+	return os.Rename(tmpDir, targetDir)
 }
 
-func (m *Manager) installBin(repo ghrepo.Interface, target string) error {
+// Package bundles dir, a local extension's directory, into a gzip-compressed
+// tarball at destPath along with a manifest.yml, so the result can be installed
+// offline with "gh extension install <tarball>".
+func (m *Manager) Package(dir, destPath string) error {
+	name := filepath.Base(filepath.Clean(dir))
+	if !strings.HasPrefix(name, "gh-") {
+		return fmt.Errorf("extension directory name must start with `gh-`, got %q", name)
+	}
+	if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+		return fmt.Errorf("could not find executable %s in %s: %w", name, dir, err)
+	}
+
+	out, err := os.Create(destPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+
+	err = filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() && fi.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		if fi.IsDir() {
+			return tw.WriteHeader(&tar.Header{Name: rel + "/", Mode: 0755, Typeflag: tar.TypeDir})
+		}
+		hdr, err := tar.FileInfoHeader(fi, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = rel
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		_, err = io.Copy(tw, in)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	manifest := binManifest{Name: name}
+	bs, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: manifestName, Mode: 0600, Size: int64(len(bs))}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(bs); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// lockFilePath returns the path to the lockfile that records installed extensions'
+// exact tags/SHAs, e.g. ~/.local/share/gh/extensions/lock.yml.
+func (m *Manager) lockFilePath() string {
+	return filepath.Join(m.installDir(), lockFileName)
+}
+
+func (m *Manager) readLockFile() (*lockFile, error) {
+	var lf lockFile
+	bs, err := os.ReadFile(m.lockFilePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &lf, nil
+		}
+		return nil, fmt.Errorf("could not read lockfile: %w", err)
+	}
+	if err := yaml.Unmarshal(bs, &lf); err != nil {
+		return nil, fmt.Errorf("could not parse lockfile: %w", err)
+	}
+	return &lf, nil
+}
+
+// writeLockFile regenerates the lockfile from the extensions currently installed.
+func (m *Manager) writeLockFile() error {
+	exts, _ := m.list(false)
+
+	var lf lockFile
+	for _, ext := range exts {
+		if ext.isLocal {
+			continue
+		}
+		repo, err := repoForExtensionURL(ext.url)
+		if err != nil {
+			continue
+		}
+		lf.Extensions = append(lf.Extensions, lockEntry{
+			Owner: repo.RepoOwner(),
+			Name:  repo.RepoName(),
+			Host:  repo.RepoHost(),
+			Tag:   ext.currentVersion,
+		})
+	}
+
+	bs, err := yaml.Marshal(lf)
+	if err != nil {
+		return fmt.Errorf("failed to serialize lockfile: %w", err)
+	}
+	if err := os.MkdirAll(m.installDir(), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(m.lockFilePath(), bs, 0600)
+}
+
+func repoForExtensionURL(rawURL string) (ghrepo.Interface, error) {
+	u, err := git.ParseURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return ghrepo.FromURL(u)
+}
+
+func (m *Manager) installBin(repo ghrepo.Interface, target string, skipVerification bool) error {
 	var r *release
 	var err error
 	isPinned := target != ""
@@ -392,18 +691,30 @@ func (m *Manager) installBin(repo ghrepo.Interface, target string) error {
 	binPath := filepath.Join(targetDir, name)
 	binPath += ext
 
-	err = downloadAsset(m.client, *asset, binPath)
+	err = downloadAssets(m.client, []assetDownload{{asset: *asset, destPath: binPath}})
 	if err != nil {
-		return fmt.Errorf("failed to download asset %s: %w", asset.Name, err)
+		return err
+	}
+
+	var verification verificationStatus
+	if skipVerification {
+		verification = verificationSkipped
+	} else {
+		verification, err = verifyBinary(m, r.Assets, asset.Name, binPath)
+		if err != nil {
+			_ = os.Remove(binPath)
+			return fmt.Errorf("refusing to install %s: %w", asset.Name, err)
+		}
 	}
 
 	manifest := binManifest{
-		Name:     name,
-		Owner:    repo.RepoOwner(),
-		Host:     repo.RepoHost(),
-		Path:     binPath,
-		Tag:      r.Tag,
-		IsPinned: isPinned,
+		Name:         name,
+		Owner:        repo.RepoOwner(),
+		Host:         repo.RepoHost(),
+		Path:         binPath,
+		Tag:          r.Tag,
+		IsPinned:     isPinned,
+		Verification: string(verification),
 	}
 
 	bs, err := yaml.Marshal(manifest)
@@ -552,11 +863,17 @@ func (m *Manager) upgradeExtension(ext Extension, force bool) error {
 			if err != nil {
 				return fmt.Errorf("failed to migrate to new precompiled extension format: %w", err)
 			}
-			return m.installBin(repo, "")
+			if err := m.installBin(repo, "", false); err != nil {
+				return err
+			}
+			return m.writeLockFile()
 		}
 		err = m.upgradeGitExtension(ext, force)
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	return m.writeLockFile()
 }
 
 func (m *Manager) upgradeGitExtension(ext Extension, force bool) error {
@@ -579,7 +896,7 @@ func (m *Manager) upgradeBinExtension(ext Extension) error {
 	if err != nil {
 		return fmt.Errorf("failed to parse URL %s: %w", ext.url, err)
 	}
-	return m.installBin(repo, "")
+	return m.installBin(repo, "", false)
 }
 
 func (m *Manager) Remove(name string) error {
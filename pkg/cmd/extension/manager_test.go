@@ -1,7 +1,11 @@
 package extension
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -177,6 +181,27 @@ func TestManager_Dispatch_binary(t *testing.T) {
 	assert.Equal(t, "", stderr.String())
 }
 
+func TestManager_Complete(t *testing.T) {
+	tempDir := t.TempDir()
+	extPath := filepath.Join(tempDir, "extensions", "gh-hello", "gh-hello")
+	assert.NoError(t, stubExtension(extPath))
+
+	m := newTestManager(tempDir, nil, nil)
+
+	results, err := m.Complete("hello", []string{"one"}, "tw")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("[%s __complete one tw]", extPath)}, results)
+}
+
+func TestManager_Complete_unknownExtension(t *testing.T) {
+	tempDir := t.TempDir()
+	m := newTestManager(tempDir, nil, nil)
+
+	results, err := m.Complete("nope", nil, "")
+	assert.NoError(t, err)
+	assert.Nil(t, results)
+}
+
 func TestManager_Remove(t *testing.T) {
 	tempDir := t.TempDir()
 	assert.NoError(t, stubExtension(filepath.Join(tempDir, "extensions", "gh-hello", "gh-hello")))
@@ -192,6 +217,80 @@ func TestManager_Remove(t *testing.T) {
 	assert.Equal(t, "gh-two", items[0].Name())
 }
 
+func TestManager_writeLockFile(t *testing.T) {
+	tempDir := t.TempDir()
+	assert.NoError(t, stubBinaryExtension(
+		filepath.Join(tempDir, "extensions", "gh-bin-ext"),
+		binManifest{
+			Owner: "owner",
+			Name:  "gh-bin-ext",
+			Host:  "example.com",
+			Tag:   "v1.0.1",
+		}))
+
+	m := newTestManager(tempDir, nil, nil)
+	assert.NoError(t, m.writeLockFile())
+
+	bs, err := os.ReadFile(filepath.Join(tempDir, "extensions", lockFileName))
+	assert.NoError(t, err)
+
+	var lf lockFile
+	assert.NoError(t, yaml.Unmarshal(bs, &lf))
+	assert.Equal(t, []lockEntry{
+		{Owner: "owner", Name: "gh-bin-ext", Host: "example.com", Tag: "v1.0.1"},
+	}, lf.Extensions)
+}
+
+func TestManager_InstallFromLockFile(t *testing.T) {
+	tempDir := t.TempDir()
+
+	reg := httpmock.Registry{}
+	defer reg.Verify(t)
+	client := http.Client{Transport: &reg}
+
+	io, _, _, stderr := iostreams.Test()
+	m := newTestManager(tempDir, &client, io)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "extensions"), 0755))
+	lf := lockFile{Extensions: []lockEntry{
+		{Owner: "owner", Name: "gh-some-ext", Host: "github.com", Tag: "v1.0.0"},
+	}}
+	bs, err := yaml.Marshal(lf)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "extensions", lockFileName), bs, 0600))
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "extensions", "gh-some-ext"), 0700))
+
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/gh-some-ext/releases/latest"),
+		httpmock.JSONResponse(
+			release{
+				Assets: []releaseAsset{
+					{
+						Name:   "not-a-binary",
+						APIURL: "https://example.com/release/cool",
+					},
+				},
+			}))
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/gh-some-ext/commits/v1.0.0"),
+		httpmock.StringResponse("abcd1234"))
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/gh-some-ext/contents/gh-some-ext"),
+		httpmock.StringResponse("script"))
+
+	err = m.InstallFromLockFile()
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+}
+
+func TestManager_InstallFromLockFile_empty(t *testing.T) {
+	tempDir := t.TempDir()
+	m := newTestManager(tempDir, nil, nil)
+
+	err := m.InstallFromLockFile()
+	assert.Error(t, err)
+}
+
 func TestManager_Upgrade_NoExtensions(t *testing.T) {
 	tempDir := t.TempDir()
 	io, _, stdout, stderr := iostreams.Test()
@@ -516,7 +615,7 @@ func TestManager_Install_git(t *testing.T) {
 
 	repo := ghrepo.New("owner", "gh-some-ext")
 
-	err := m.Install(repo, "")
+	err := m.Install(repo, "", false)
 	assert.NoError(t, err)
 	assert.Equal(t, fmt.Sprintf("[git clone https://github.com/owner/gh-some-ext.git %s]\n", filepath.Join(tempDir, "extensions", "gh-some-ext")), stdout.String())
 	assert.Equal(t, "", stderr.String())
@@ -552,7 +651,7 @@ func TestManager_Install_git_pinned(t *testing.T) {
 
 	_ = os.MkdirAll(filepath.Join(m.installDir(), "gh-cool-ext"), 0700)
 	repo := ghrepo.New("owner", "gh-cool-ext")
-	err := m.Install(repo, "some-ref")
+	err := m.Install(repo, "some-ref", false)
 	assert.NoError(t, err)
 	assert.Equal(t, "", stderr.String())
 }
@@ -595,7 +694,7 @@ func TestManager_Install_binary_pinned(t *testing.T) {
 
 	m := newTestManager(tempDir, &http.Client{Transport: &reg}, io)
 
-	err := m.Install(repo, "v1.6.3-pre")
+	err := m.Install(repo, "v1.6.3-pre", false)
 	assert.NoError(t, err)
 
 	manifest, err := os.ReadFile(filepath.Join(tempDir, "extensions/gh-bin-ext", manifestName))
@@ -659,7 +758,7 @@ func TestManager_Install_binary_unsupported(t *testing.T) {
 
 	m := newTestManager(tempDir, &client, io)
 
-	err := m.Install(repo, "")
+	err := m.Install(repo, "", false)
 	assert.EqualError(t, err, "gh-bin-ext unsupported for windows-amd64. Open an issue: `gh issue create -R owner/gh-bin-ext -t'Support windows-amd64'`")
 
 	assert.Equal(t, "", stdout.String())
@@ -704,7 +803,7 @@ func TestManager_Install_binary(t *testing.T) {
 
 	m := newTestManager(tempDir, &http.Client{Transport: &reg}, io)
 
-	err := m.Install(repo, "")
+	err := m.Install(repo, "", false)
 	assert.NoError(t, err)
 
 	manifest, err := os.ReadFile(filepath.Join(tempDir, "extensions/gh-bin-ext", manifestName))
@@ -730,6 +829,193 @@ func TestManager_Install_binary(t *testing.T) {
 	assert.Equal(t, "", stderr.String())
 }
 
+func TestManager_Install_binary_verified(t *testing.T) {
+	repo := ghrepo.NewWithHost("owner", "gh-bin-ext", "example.com")
+
+	reg := httpmock.Registry{}
+	defer reg.Verify(t)
+
+	binContent := "FAKE BINARY"
+	sum := sha256.Sum256([]byte(binContent))
+	checksums := hex.EncodeToString(sum[:]) + "  gh-bin-ext-windows-amd64.exe\n"
+
+	r := release{
+		Tag: "v1.0.1",
+		Assets: []releaseAsset{
+			{Name: "gh-bin-ext-windows-amd64.exe", APIURL: "https://example.com/release/cool"},
+			{Name: "checksums.txt", APIURL: "https://example.com/release/checksums"},
+		},
+	}
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+		httpmock.JSONResponse(r))
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+		httpmock.JSONResponse(r))
+	reg.Register(
+		httpmock.REST("GET", "release/cool"),
+		httpmock.StringResponse(binContent))
+	reg.Register(
+		httpmock.REST("GET", "release/checksums"),
+		httpmock.StringResponse(checksums))
+
+	io, _, stdout, stderr := iostreams.Test()
+	tempDir := t.TempDir()
+
+	m := newTestManager(tempDir, &http.Client{Transport: &reg}, io)
+
+	err := m.Install(repo, "", false)
+	assert.NoError(t, err)
+
+	manifest, err := os.ReadFile(filepath.Join(tempDir, "extensions/gh-bin-ext", manifestName))
+	require.NoError(t, err)
+
+	var bm binManifest
+	require.NoError(t, yaml.Unmarshal(manifest, &bm))
+	assert.Equal(t, "verified", bm.Verification)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func TestManager_Install_binary_checksumMismatch(t *testing.T) {
+	repo := ghrepo.NewWithHost("owner", "gh-bin-ext", "example.com")
+
+	reg := httpmock.Registry{}
+	defer reg.Verify(t)
+
+	checksums := strings.Repeat("0", 64) + "  gh-bin-ext-windows-amd64.exe\n"
+
+	r := release{
+		Tag: "v1.0.1",
+		Assets: []releaseAsset{
+			{Name: "gh-bin-ext-windows-amd64.exe", APIURL: "https://example.com/release/cool"},
+			{Name: "checksums.txt", APIURL: "https://example.com/release/checksums"},
+		},
+	}
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+		httpmock.JSONResponse(r))
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+		httpmock.JSONResponse(r))
+	reg.Register(
+		httpmock.REST("GET", "release/cool"),
+		httpmock.StringResponse("FAKE BINARY"))
+	reg.Register(
+		httpmock.REST("GET", "release/checksums"),
+		httpmock.StringResponse(checksums))
+
+	io, _, _, _ := iostreams.Test()
+	tempDir := t.TempDir()
+
+	m := newTestManager(tempDir, &http.Client{Transport: &reg}, io)
+
+	err := m.Install(repo, "", false)
+	assert.ErrorContains(t, err, "refusing to install")
+	assert.NoFileExists(t, filepath.Join(tempDir, "extensions/gh-bin-ext/gh-bin-ext.exe"))
+}
+
+func TestManager_Install_binary_skipVerification(t *testing.T) {
+	repo := ghrepo.NewWithHost("owner", "gh-bin-ext", "example.com")
+
+	reg := httpmock.Registry{}
+	defer reg.Verify(t)
+
+	r := release{
+		Tag: "v1.0.1",
+		Assets: []releaseAsset{
+			{Name: "gh-bin-ext-windows-amd64.exe", APIURL: "https://example.com/release/cool"},
+			{Name: "checksums.txt", APIURL: "https://example.com/release/checksums"},
+		},
+	}
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+		httpmock.JSONResponse(r))
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+		httpmock.JSONResponse(r))
+	reg.Register(
+		httpmock.REST("GET", "release/cool"),
+		httpmock.StringResponse("FAKE BINARY"))
+
+	io, _, _, _ := iostreams.Test()
+	tempDir := t.TempDir()
+
+	m := newTestManager(tempDir, &http.Client{Transport: &reg}, io)
+
+	err := m.Install(repo, "", true)
+	assert.NoError(t, err)
+
+	manifest, err := os.ReadFile(filepath.Join(tempDir, "extensions/gh-bin-ext", manifestName))
+	require.NoError(t, err)
+
+	var bm binManifest
+	require.NoError(t, yaml.Unmarshal(manifest, &bm))
+	assert.Equal(t, "skipped", bm.Verification)
+}
+
+func TestManager_Package_and_InstallFromTarball(t *testing.T) {
+	sourceDir := t.TempDir()
+	extDir := filepath.Join(sourceDir, "gh-screensaver")
+	assert.NoError(t, stubExtension(filepath.Join(extDir, "gh-screensaver")))
+	assert.NoError(t, os.WriteFile(filepath.Join(extDir, "README.md"), []byte("# gh-screensaver"), 0600))
+
+	tempDir := t.TempDir()
+	m := newTestManager(tempDir, nil, nil)
+
+	tarballPath := filepath.Join(t.TempDir(), "gh-screensaver.tar.gz")
+	require.NoError(t, m.Package(extDir, tarballPath))
+
+	require.NoError(t, m.InstallFromTarball(tarballPath))
+
+	installPath := filepath.Join(tempDir, "extensions", "gh-screensaver")
+	assert.FileExists(t, filepath.Join(installPath, "gh-screensaver"))
+	assert.FileExists(t, filepath.Join(installPath, "README.md"))
+
+	manifest, err := os.ReadFile(filepath.Join(installPath, manifestName))
+	require.NoError(t, err)
+	var bm binManifest
+	require.NoError(t, yaml.Unmarshal(manifest, &bm))
+	assert.Equal(t, "gh-screensaver", bm.Name)
+
+	exts := m.List(false)
+	require.Equal(t, 1, len(exts))
+	assert.Equal(t, "screensaver", exts[0].Name())
+}
+
+func TestManager_InstallFromTarball_alreadyInstalled(t *testing.T) {
+	sourceDir := t.TempDir()
+	extDir := filepath.Join(sourceDir, "gh-screensaver")
+	assert.NoError(t, stubExtension(filepath.Join(extDir, "gh-screensaver")))
+
+	tempDir := t.TempDir()
+	m := newTestManager(tempDir, nil, nil)
+	assert.NoError(t, stubExtension(filepath.Join(tempDir, "extensions", "gh-screensaver", "gh-screensaver")))
+
+	tarballPath := filepath.Join(t.TempDir(), "gh-screensaver.tar.gz")
+	require.NoError(t, m.Package(extDir, tarballPath))
+
+	err := m.InstallFromTarball(tarballPath)
+	assert.EqualError(t, err, fmt.Sprintf("there is already an installed extension at %s", filepath.Join(tempDir, "extensions", "gh-screensaver")))
+}
+
+func TestManager_InstallFromTarball_missingManifest(t *testing.T) {
+	tempDir := t.TempDir()
+	m := newTestManager(tempDir, nil, nil)
+
+	tarballPath := filepath.Join(t.TempDir(), "empty.tar.gz")
+	f, err := os.Create(tarballPath)
+	require.NoError(t, err)
+	gz := gzip.NewWriter(f)
+	require.NoError(t, tar.NewWriter(gz).Close())
+	require.NoError(t, gz.Close())
+	require.NoError(t, f.Close())
+
+	err = m.InstallFromTarball(tarballPath)
+	assert.EqualError(t, err, "invalid extension package: missing manifest.yml")
+}
+
 func TestManager_Create(t *testing.T) {
 	chdirTemp(t)
 	io, _, stdout, stderr := iostreams.Test()
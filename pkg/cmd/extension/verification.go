@@ -0,0 +1,144 @@
+package extension
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	checksumsAssetName = "checksums.txt"
+	ascSignatureSuffix = ".asc"
+)
+
+// verificationStatus records how a binary extension's download was verified against
+// the release's checksums.txt, for persisting in its manifest and surfacing in
+// `gh extension list`. The zero value means the release published no checksums.txt.
+type verificationStatus string
+
+const (
+	verificationUnavailable verificationStatus = ""
+	verificationSkipped     verificationStatus = "skipped"
+	verificationVerified    verificationStatus = "verified"
+)
+
+// findAsset returns the release asset with the given name, if present.
+func findAsset(assets []releaseAsset, name string) *releaseAsset {
+	for _, a := range assets {
+		if a.Name == name {
+			return &a
+		}
+	}
+	return nil
+}
+
+// verifyBinary checks binPath's contents against the checksum recorded for assetName
+// in the release's checksums.txt, and the checksums.txt itself against a GPG
+// signature when the release published one. It returns verificationUnavailable, with
+// no error, when the release didn't publish a checksums.txt at all.
+func verifyBinary(m *Manager, assets []releaseAsset, assetName, binPath string) (verificationStatus, error) {
+	checksumsAsset := findAsset(assets, checksumsAssetName)
+	if checksumsAsset == nil {
+		return verificationUnavailable, nil
+	}
+
+	checksums, err := fetchAsset(m.client, *checksumsAsset)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", checksumsAssetName, err)
+	}
+
+	if sigAsset := findAsset(assets, checksumsAssetName+ascSignatureSuffix); sigAsset != nil {
+		if err := m.verifySignature(checksums, sigAsset); err != nil {
+			return "", err
+		}
+	}
+
+	content, err := os.ReadFile(binPath)
+	if err != nil {
+		return "", err
+	}
+
+	if err := verifyChecksum(content, assetName, checksums); err != nil {
+		return "", err
+	}
+
+	return verificationVerified, nil
+}
+
+// verifyChecksum checks that the sha256 digest of content matches the entry for
+// assetName in a checksums.txt file, formatted as "<hex digest>  <filename>" per
+// line -- the output format of `sha256sum`/`shasum -a 256`.
+func verifyChecksum(content []byte, assetName string, checksums []byte) error {
+	sum := sha256.Sum256(content)
+	want := hex.EncodeToString(sum[:])
+
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		digest := fields[0]
+		name := strings.TrimPrefix(fields[1], "*") // shasum's binary-mode marker
+		if name != assetName {
+			continue
+		}
+		if !strings.EqualFold(digest, want) {
+			return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", assetName, digest, want)
+		}
+		return nil
+	}
+	return fmt.Errorf("no checksum entry found for %s in %s", assetName, checksumsAssetName)
+}
+
+// verifySignature verifies a detached GPG signature for checksums over the
+// extension maintainer's public keyring. If gpg isn't installed, the signature is
+// left unverified rather than blocking installation on a missing local tool.
+func (m *Manager) verifySignature(checksums []byte, sigAsset *releaseAsset) error {
+	gpgExe, err := m.lookPath("gpg")
+	if err != nil {
+		return nil
+	}
+
+	sig, err := fetchAsset(m.client, *sigAsset)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", sigAsset.Name, err)
+	}
+
+	sigFile, err := os.CreateTemp("", "gh-extension-checksums-*.asc")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(sigFile.Name())
+	if _, err := sigFile.Write(sig); err != nil {
+		sigFile.Close()
+		return err
+	}
+	if err := sigFile.Close(); err != nil {
+		return err
+	}
+
+	checksumsFile, err := os.CreateTemp("", "gh-extension-checksums-*.txt")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(checksumsFile.Name())
+	if _, err := checksumsFile.Write(checksums); err != nil {
+		checksumsFile.Close()
+		return err
+	}
+	if err := checksumsFile.Close(); err != nil {
+		return err
+	}
+
+	cmd := m.newCommand(gpgExe, "--verify", sigFile.Name(), checksumsFile.Name())
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("signature verification failed: %s", strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyChecksum(t *testing.T) {
+	content := []byte("binary contents")
+	checksums := []byte(testChecksums())
+
+	t.Run("matching checksum", func(t *testing.T) {
+		err := verifyChecksum(content, "gh-some-ext-linux-amd64", checksums)
+		require.NoError(t, err)
+	})
+
+	t.Run("shasum binary-mode marker is stripped", func(t *testing.T) {
+		err := verifyChecksum(content, "gh-some-ext-windows-amd64.exe", checksums)
+		require.NoError(t, err)
+	})
+
+	t.Run("mismatched checksum", func(t *testing.T) {
+		err := verifyChecksum([]byte("tampered contents"), "gh-some-ext-linux-amd64", checksums)
+		assert.ErrorContains(t, err, "checksum mismatch")
+	})
+
+	t.Run("no entry for asset", func(t *testing.T) {
+		err := verifyChecksum(content, "gh-some-ext-darwin-amd64", checksums)
+		assert.ErrorContains(t, err, "no checksum entry found")
+	})
+}
+
+func TestFindAsset(t *testing.T) {
+	assets := []releaseAsset{
+		{Name: "gh-some-ext-linux-amd64"},
+		{Name: checksumsAssetName},
+	}
+
+	assert.NotNil(t, findAsset(assets, checksumsAssetName))
+	assert.Nil(t, findAsset(assets, checksumsAssetName+ascSignatureSuffix))
+}
+
+func testChecksums() string {
+	// sha256sum of "binary contents"
+	return "58dd882b7907e7d10da755323a848544f42119b2e599801d794a32d2c23e4051  gh-some-ext-linux-amd64\n" +
+		"58dd882b7907e7d10da755323a848544f42119b2e599801d794a32d2c23e4051 *gh-some-ext-windows-amd64.exe\n"
+}
@@ -3,8 +3,11 @@ package factory
 import (
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cli/cli/v2/api"
@@ -86,7 +89,14 @@ func NewHTTPClient(io *iostreams.IOStreams, cfg configGetter, appVersion string,
 
 	if isVerbose, debugValue := utils.IsDebugEnabled(); isVerbose {
 		logTraffic := strings.Contains(debugValue, "api")
-		opts = append(opts, api.VerboseLog(io.ErrOut, logTraffic, io.IsStderrTTY()))
+		jsonFormat := strings.Contains(debugValue, "json")
+		logOut, err := utils.DebugLogFile(io.ErrOut)
+		if err != nil {
+			fmt.Fprintf(io.ErrOut, "failed to open log file: %s\n", err)
+			logOut = io.ErrOut
+		}
+		colorize := logOut == io.ErrOut && io.IsStderrTTY()
+		opts = append(opts, api.VerboseLog(logOut, logTraffic, colorize, jsonFormat, utils.DebugLogMaxBodyBytes()))
 	}
 
 	opts = append(opts,
@@ -125,9 +135,43 @@ func NewHTTPClient(io *iostreams.IOStreams, cfg configGetter, appVersion string,
 		)
 	}
 
+	// A zero TTL means cached responses are always considered stale and are revalidated with the
+	// origin (via ETags) rather than reused outright, but they remain available as a fallback when
+	// GH_OFFLINE is set.
+	opts = append(opts,
+		api.CacheResponse(0, filepath.Join(os.TempDir(), "gh-cli-cache")),
+		warnStaleCache(io),
+	)
+
 	return api.NewHTTPClient(opts...), nil
 }
 
+// warnStaleCache prints a one-time warning to stderr the first time a response is served from
+// the on-disk cache past its TTL, which only happens when GH_OFFLINE is set.
+func warnStaleCache(io *iostreams.IOStreams) api.ClientOption {
+	var once sync.Once
+	return func(tr http.RoundTripper) http.RoundTripper {
+		return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+			res, err := tr.RoundTrip(req)
+			if err == nil && api.IsStaleCachedResponse(res) {
+				once.Do(func() {
+					cs := io.ColorScheme()
+					fmt.Fprintf(io.ErrOut, "%s GH_OFFLINE is set; showing cached data that may be out of date\n", cs.WarningIcon())
+				})
+			}
+			return res, err
+		}}
+	}
+}
+
+type funcTripper struct {
+	roundTrip func(*http.Request) (*http.Response, error)
+}
+
+func (tr funcTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return tr.roundTrip(req)
+}
+
 var ssoHeader string
 var ssoURLRE = regexp.MustCompile(`\burl=([^;]+)`)
 
@@ -217,6 +217,37 @@ func TestNewHTTPClient(t *testing.T) {
 	}
 }
 
+func TestNewHTTPClient_offline(t *testing.T) {
+	reqCount := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqCount++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	io, _, _, stderr := iostreams.Test()
+	client, err := NewHTTPClient(io, tinyConfig{}, "v1.2.3", false)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("GET", ts.URL, nil)
+	require.NoError(t, err)
+	_, err = client.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reqCount)
+
+	t.Setenv("GH_OFFLINE", "1")
+	offlineClient, err := NewHTTPClient(io, tinyConfig{}, "v1.2.3", false)
+	require.NoError(t, err)
+
+	req2, err := http.NewRequest("GET", ts.URL, nil)
+	require.NoError(t, err)
+	res, err := offlineClient.Do(req2)
+	require.NoError(t, err)
+	assert.Equal(t, 204, res.StatusCode)
+	assert.Equal(t, 1, reqCount, "expected the cached response to be served without a network request")
+	assert.Contains(t, stderr.String(), "GH_OFFLINE is set")
+}
+
 type tinyConfig map[string]string
 
 func (c tinyConfig) Get(host, key string) (string, error) {
@@ -0,0 +1,181 @@
+package blame
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type BlameOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	Path      string
+	Ref       string
+	LineRange string
+
+	StartLine int
+	EndLine   int
+}
+
+var blameFields = []string{
+	"startingLine",
+	"endingLine",
+	"age",
+	"commit",
+}
+
+func NewCmdBlame(f *cmdutil.Factory, runF func(*BlameOptions) error) *cobra.Command {
+	opts := &BlameOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "blame <path>",
+		Short: "View line-by-line commit attribution for a file",
+		Long: heredoc.Doc(`
+			Show what commit last modified each line of a file, without needing a
+			local clone of the repository.
+		`),
+		Example: heredoc.Doc(`
+			$ gh file blame path/to/file.go
+			$ gh file blame path/to/file.go --ref v1.2.3
+			$ gh file blame path/to/file.go --line-range 10-25
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Path = args[0]
+
+			if opts.LineRange != "" {
+				start, end, err := parseLineRange(opts.LineRange)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid --line-range: %w", err)
+				}
+				opts.StartLine, opts.EndLine = start, end
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return blameRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Ref, "ref", "", "Git reference (branch, tag, or commit SHA) to blame (default: the default branch)")
+	cmd.Flags().StringVar(&opts.LineRange, "line-range", "", "Limit output to the given `start-end` line range")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, blameFields)
+
+	return cmd
+}
+
+func parseLineRange(s string) (int, int, error) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected format `start-end`, got %q", s)
+	}
+	start, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid starting line: %q", parts[0])
+	}
+	end, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid ending line: %q", parts[1])
+	}
+	if start < 1 || end < start {
+		return 0, 0, fmt.Errorf("range must satisfy 1 <= start <= end")
+	}
+	return start, end, nil
+}
+
+func blameRun(opts *BlameOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	ref := opts.Ref
+	if ref == "" {
+		ref, err = api.RepoDefaultBranch(api.NewClientFromHTTP(httpClient), baseRepo)
+		if err != nil {
+			return err
+		}
+	}
+
+	result, err := blameForFile(httpClient, baseRepo, ref, opts.Path)
+	if err != nil {
+		return err
+	}
+
+	ranges := result.Ranges
+	if opts.StartLine > 0 {
+		ranges = filterRanges(ranges, opts.StartLine, opts.EndLine)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, ranges)
+	}
+
+	if len(ranges) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No blame information found for this file")
+		return cmdutil.SilentError
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, r := range ranges {
+		for line := r.StartingLine; line <= r.EndingLine; line++ {
+			tp.AddField(r.Commit.AbbreviatedOid, nil, cs.Yellow)
+			tp.AddField(r.Commit.Author.Name, nil, nil)
+			tp.AddField(r.Commit.CommittedDate.Format(time.RFC3339), nil, cs.Gray)
+			tp.AddField(strconv.Itoa(line), nil, cs.Gray)
+			if idx := line - 1; idx >= 0 && idx < len(result.Lines) {
+				tp.AddField(result.Lines[idx], nil, nil)
+			} else {
+				tp.AddField("", nil, nil)
+			}
+			tp.EndRow()
+		}
+	}
+
+	return tp.Render()
+}
+
+func filterRanges(ranges []BlameRange, start, end int) []BlameRange {
+	var filtered []BlameRange
+	for _, r := range ranges {
+		if r.EndingLine < start || r.StartingLine > end {
+			continue
+		}
+		if r.StartingLine < start {
+			r.StartingLine = start
+		}
+		if r.EndingLine > end {
+			r.EndingLine = end
+		}
+		filtered = append(filtered, r)
+	}
+	return filtered
+}
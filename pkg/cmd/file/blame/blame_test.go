@@ -0,0 +1,106 @@
+package blame
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_blameRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query FileBlame\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": {
+			"object": { "blame": { "ranges": [
+				{ "startingLine": 1, "endingLine": 2, "age": 3, "commit": {
+					"oid": "abc123def456", "abbreviatedOid": "abc123d",
+					"messageHeadline": "initial commit", "committedDate": "2021-01-01T00:00:00Z",
+					"author": { "name": "Mona Lisa", "user": { "login": "monalisa" } }
+				} }
+			] } },
+			"blobObject": { "text": "line one\nline two\n" }
+		} } }`))
+
+	err := blameRun(&BlameOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Path: "path/to/file.go",
+		Ref:  "main",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Contains(t, stdout.String(), "abc123d")
+	assert.Contains(t, stdout.String(), "line one")
+	assert.Contains(t, stdout.String(), "line two")
+}
+
+func Test_blameRun_noRanges(t *testing.T) {
+	io, _, _, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query FileBlame\b`),
+		httpmock.StringResponse(`{ "data": { "repository": {
+			"object": { "blame": { "ranges": [] } },
+			"blobObject": { "text": "" }
+		} } }`))
+
+	err := blameRun(&BlameOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Path: "path/to/file.go",
+		Ref:  "main",
+	})
+	assert.Equal(t, cmdutil.SilentError, err)
+	assert.Equal(t, "No blame information found for this file\n", stderr.String())
+}
+
+func Test_parseLineRange(t *testing.T) {
+	tests := []struct {
+		input     string
+		wantStart int
+		wantEnd   int
+		wantErr   bool
+	}{
+		{input: "10-20", wantStart: 10, wantEnd: 20},
+		{input: "5-5", wantStart: 5, wantEnd: 5},
+		{input: "20-10", wantErr: true},
+		{input: "0-5", wantErr: true},
+		{input: "abc-5", wantErr: true},
+		{input: "5", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			start, end, err := parseLineRange(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+		})
+	}
+}
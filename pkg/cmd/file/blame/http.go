@@ -0,0 +1,108 @@
+package blame
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+type BlameCommit struct {
+	Oid             string
+	AbbreviatedOid  string
+	MessageHeadline string
+	CommittedDate   time.Time
+	Author          struct {
+		Name string
+		User struct {
+			Login string
+		}
+	}
+}
+
+type BlameRange struct {
+	StartingLine int
+	EndingLine   int
+	Age          int
+	Commit       BlameCommit
+}
+
+func (r BlameRange) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "startingLine":
+			data[f] = r.StartingLine
+		case "endingLine":
+			data[f] = r.EndingLine
+		case "age":
+			data[f] = r.Age
+		case "commit":
+			data[f] = map[string]interface{}{
+				"oid":             r.Commit.Oid,
+				"abbreviatedOid":  r.Commit.AbbreviatedOid,
+				"messageHeadline": r.Commit.MessageHeadline,
+				"committedDate":   r.Commit.CommittedDate,
+				"author":          r.Commit.Author.Name,
+			}
+		}
+	}
+	return data
+}
+
+type CommitBlame struct {
+	Blame struct {
+		Ranges []BlameRange
+	} `graphql:"blame(path: $path)"`
+}
+
+type Blob struct {
+	Text string
+}
+
+type FileBlame struct {
+	Ranges []BlameRange
+	Lines  []string
+}
+
+func blameForFile(httpClient *http.Client, repo ghrepo.Interface, ref, path string) (*FileBlame, error) {
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), httpClient)
+
+	var query struct {
+		Repository struct {
+			Object struct {
+				CommitBlame `graphql:"... on Commit"`
+			} `graphql:"object(expression: $ref)"`
+			BlobObject struct {
+				Blob `graphql:"... on Blob"`
+			} `graphql:"blobObject: object(expression: $blobExpr)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]interface{}{
+		"owner":    graphql.String(repo.RepoOwner()),
+		"name":     graphql.String(repo.RepoName()),
+		"ref":      graphql.String(ref),
+		"path":     graphql.String(path),
+		"blobExpr": graphql.String(fmt.Sprintf("%s:%s", ref, path)),
+	}
+
+	if err := gql.QueryNamed(context.Background(), "FileBlame", &query, variables); err != nil {
+		return nil, err
+	}
+
+	text := strings.TrimSuffix(query.Repository.BlobObject.Text, "\n")
+	var lines []string
+	if text != "" {
+		lines = strings.Split(text, "\n")
+	}
+
+	return &FileBlame{
+		Ranges: query.Repository.Object.Blame.Ranges,
+		Lines:  lines,
+	}, nil
+}
@@ -0,0 +1,22 @@
+package file
+
+import (
+	cmdBlame "github.com/cli/cli/v2/pkg/cmd/file/blame"
+	cmdHistory "github.com/cli/cli/v2/pkg/cmd/file/history"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdFile(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "file <command>",
+		Short: "View blame and commit history for a file",
+	}
+
+	cmd.AddCommand(cmdBlame.NewCmdBlame(f, nil))
+	cmd.AddCommand(cmdHistory.NewCmdHistory(f, nil))
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	return cmd
+}
@@ -0,0 +1,120 @@
+package history
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type HistoryOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	Path  string
+	Ref   string
+	Limit int
+}
+
+var historyFields = []string{
+	"oid",
+	"abbreviatedOid",
+	"messageHeadline",
+	"committedDate",
+	"author",
+}
+
+func NewCmdHistory(f *cmdutil.Factory, runF func(*HistoryOptions) error) *cobra.Command {
+	opts := &HistoryOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "history <path>",
+		Short: "View the commit history for a file",
+		Long: heredoc.Doc(`
+			Show the commits that touched a file, without needing a local clone of
+			the repository.
+		`),
+		Example: heredoc.Doc(`
+			$ gh file history path/to/file.go
+			$ gh file history path/to/file.go --ref v1.2.3 --limit 5
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Path = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return historyRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Ref, "ref", "", "Git reference (branch, tag, or commit SHA) to start from (default: the default branch)")
+	cmd.Flags().IntVar(&opts.Limit, "limit", 30, "Maximum number of commits to fetch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, historyFields)
+
+	return cmd
+}
+
+func historyRun(opts *HistoryOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	ref := opts.Ref
+	if ref == "" {
+		ref, err = api.RepoDefaultBranch(api.NewClientFromHTTP(httpClient), baseRepo)
+		if err != nil {
+			return err
+		}
+	}
+
+	commits, err := historyForFile(httpClient, baseRepo, ref, opts.Path, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, commits)
+	}
+
+	if len(commits) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No commits found for this file")
+		return cmdutil.SilentError
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, c := range commits {
+		tp.AddField(c.AbbreviatedOid, nil, cs.Yellow)
+		tp.AddField(c.MessageHeadline, nil, nil)
+		tp.AddField(c.Author.Name, nil, nil)
+		tp.AddField(utils.FuzzyAgoAbbr(time.Now(), c.CommittedDate), nil, cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,71 @@
+package history
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_historyRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query FileHistory\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "object": { "history": { "nodes": [
+			{ "oid": "abc123def456", "abbreviatedOid": "abc123d",
+				"messageHeadline": "fix bug", "committedDate": "2021-01-01T00:00:00Z",
+				"author": { "name": "Mona Lisa", "user": { "login": "monalisa" } } }
+		] } } } } }`))
+
+	err := historyRun(&HistoryOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Path:  "path/to/file.go",
+		Ref:   "main",
+		Limit: 30,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Contains(t, stdout.String(), "abc123d")
+	assert.Contains(t, stdout.String(), "fix bug")
+}
+
+func Test_historyRun_noCommits(t *testing.T) {
+	io, _, _, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query FileHistory\b`),
+		httpmock.StringResponse(`{ "data": { "repository": { "object": { "history": { "nodes": [] } } } } }`))
+
+	err := historyRun(&HistoryOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Path:  "path/to/file.go",
+		Ref:   "main",
+		Limit: 30,
+	})
+	assert.Equal(t, cmdutil.SilentError, err)
+	assert.Equal(t, "No commits found for this file\n", stderr.String())
+}
@@ -0,0 +1,72 @@
+package history
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+type HistoryCommit struct {
+	Oid             string
+	AbbreviatedOid  string
+	MessageHeadline string
+	CommittedDate   time.Time
+	Author          struct {
+		Name string
+		User struct {
+			Login string
+		}
+	}
+}
+
+func (c HistoryCommit) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "oid":
+			data[f] = c.Oid
+		case "abbreviatedOid":
+			data[f] = c.AbbreviatedOid
+		case "messageHeadline":
+			data[f] = c.MessageHeadline
+		case "committedDate":
+			data[f] = c.CommittedDate
+		case "author":
+			data[f] = c.Author.Name
+		}
+	}
+	return data
+}
+
+func historyForFile(httpClient *http.Client, repo ghrepo.Interface, ref, path string, limit int) ([]HistoryCommit, error) {
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), httpClient)
+
+	var query struct {
+		Repository struct {
+			Object struct {
+				Commit struct {
+					History struct {
+						Nodes []HistoryCommit
+					} `graphql:"history(first: $limit, path: $path)"`
+				} `graphql:"... on Commit"`
+			} `graphql:"object(expression: $ref)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]interface{}{
+		"owner": graphql.String(repo.RepoOwner()),
+		"name":  graphql.String(repo.RepoName()),
+		"ref":   graphql.String(ref),
+		"path":  graphql.String(path),
+		"limit": graphql.Int(limit),
+	}
+
+	if err := gql.QueryNamed(context.Background(), "FileHistory", &query, variables); err != nil {
+		return nil, err
+	}
+
+	return query.Repository.Object.Commit.History.Nodes, nil
+}
@@ -0,0 +1,137 @@
+package comment
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/gist/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CommentOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Selector string
+	Body     string
+	BodyFile string
+	DeleteID string
+}
+
+func NewCmdComment(f *cmdutil.Factory, runF func(*CommentOptions) error) *cobra.Command {
+	opts := CommentOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "comment {<id> | <url>}",
+		Short: "Add a comment to a gist",
+		Long: heredoc.Doc(`
+			Add a comment to a gist, or remove one with --delete.
+		`),
+		Example: heredoc.Doc(`
+			$ gh gist comment 1234 --body "Nice snippet!"
+			$ gh gist comment 1234 --delete 5678
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot comment: gist argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Selector = args[0]
+
+			if opts.BodyFile != "" {
+				b, err := cmdutil.ReadFile(opts.BodyFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				opts.Body = string(b)
+			}
+
+			if opts.DeleteID != "" && (opts.Body != "" || opts.BodyFile != "") {
+				return cmdutil.FlagErrorf("specify only one of --delete or --body/--body-file")
+			}
+			if opts.DeleteID == "" && opts.Body == "" {
+				return cmdutil.FlagErrorf("--body or --body-file required")
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return commentRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "The comment body `text`")
+	cmd.Flags().StringVarP(&opts.BodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().StringVar(&opts.DeleteID, "delete", "", "Delete the comment with the given ID")
+
+	return cmd
+}
+
+func commentRun(opts *CommentOptions) error {
+	gistID := opts.Selector
+
+	if strings.Contains(gistID, "/") {
+		id, err := shared.GistIDFromURL(gistID)
+		if err != nil {
+			return err
+		}
+		gistID = id
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(client)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	if opts.DeleteID != "" {
+		path := fmt.Sprintf("gists/%s/comments/%s", gistID, opts.DeleteID)
+		if err := apiClient.REST(host, "DELETE", path, nil, nil); err != nil {
+			return err
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Deleted comment %s\n", cs.SuccessIconWithColor(cs.Green), opts.DeleteID)
+		}
+		return nil
+	}
+
+	path := fmt.Sprintf("gists/%s/comments", gistID)
+	requestByte, err := json.Marshal(shared.Comment{Body: opts.Body})
+	if err != nil {
+		return err
+	}
+
+	comment := shared.Comment{}
+	if err := apiClient.REST(host, "POST", path, bytes.NewReader(requestByte), &comment); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Commented on gist %s\n", cs.SuccessIconWithColor(cs.Green), gistID)
+	} else {
+		fmt.Fprintln(opts.IO.Out, comment.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package comment
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wants   CommentOptions
+		wantErr string
+	}{
+		{
+			name: "body",
+			cli:  `1234 --body "hello"`,
+			wants: CommentOptions{
+				Selector: "1234",
+				Body:     "hello",
+			},
+		},
+		{
+			name: "delete",
+			cli:  "1234 --delete 5678",
+			wants: CommentOptions{
+				Selector: "1234",
+				DeleteID: "5678",
+			},
+		},
+		{
+			name:    "no body or delete",
+			cli:     "1234",
+			wantErr: "--body or --body-file required",
+		},
+		{
+			name:    "both body and delete",
+			cli:     `1234 --body "hi" --delete 5678`,
+			wantErr: "specify only one of --delete or --body/--body-file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+			var gotOpts *CommentOptions
+			cmd := NewCmdComment(f, func(opts *CommentOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
+			assert.Equal(t, tt.wants.Body, gotOpts.Body)
+			assert.Equal(t, tt.wants.DeleteID, gotOpts.DeleteID)
+		})
+	}
+}
+
+func Test_commentRun_post(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(httpmock.REST("POST", "gists/1234/comments"),
+		httpmock.StatusStringResponse(201, `{"id": "999"}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &CommentOptions{
+		IO:       io,
+		Selector: "1234",
+		Body:     "nice!",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	err := commentRun(opts)
+	reg.Verify(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Commented on gist 1234\n", stdout.String())
+}
+
+func Test_commentRun_delete(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(httpmock.REST("DELETE", "gists/1234/comments/999"),
+		httpmock.StatusStringResponse(204, ""))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &CommentOptions{
+		IO:       io,
+		Selector: "1234",
+		DeleteID: "999",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	err := commentRun(opts)
+	reg.Verify(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Deleted comment 999\n", stdout.String())
+}
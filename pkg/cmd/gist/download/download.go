@@ -0,0 +1,137 @@
+package download
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/gist/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DownloadOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Selector string
+	Dir      string
+	Pattern  string
+}
+
+func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobra.Command {
+	opts := DownloadOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "download {<id> | <url>}",
+		Short: "Download the files in a gist",
+		Long: heredoc.Doc(`
+			Download every file in a gist to a local directory, without cloning it as a
+			git repository.
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot download: gist argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Selector = args[0]
+			if runF != nil {
+				return runF(&opts)
+			}
+			return downloadRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Dir, "dir", "", "Directory to download files into (default: current directory)")
+	cmd.Flags().StringVar(&opts.Pattern, "pattern", "", "Only download files whose name matches the glob pattern")
+
+	return cmd
+}
+
+func downloadRun(opts *DownloadOptions) error {
+	gistID := opts.Selector
+
+	if strings.Contains(gistID, "/") {
+		id, err := shared.GistIDFromURL(gistID)
+		if err != nil {
+			return err
+		}
+		gistID = id
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	gist, err := shared.GetGist(client, host, gistID)
+	if err != nil {
+		if errors.Is(err, shared.NotFoundErr) {
+			return fmt.Errorf("gist not found: %s", gistID)
+		}
+		return err
+	}
+
+	filenames := make([]string, 0, len(gist.Files))
+	for filename := range gist.Files {
+		if opts.Pattern != "" {
+			matched, err := filepath.Match(opts.Pattern, filename)
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid --pattern: %w", err)
+			}
+			if !matched {
+				continue
+			}
+		}
+		filenames = append(filenames, filename)
+	}
+
+	if len(filenames) == 0 {
+		return fmt.Errorf("no files to download")
+	}
+
+	if opts.Dir != "" {
+		if err := os.MkdirAll(opts.Dir, 0755); err != nil {
+			return err
+		}
+	}
+
+	cs := opts.IO.ColorScheme()
+	for _, filename := range filenames {
+		path := filepath.Join(opts.Dir, filename)
+		if err := os.WriteFile(path, []byte(gist.Files[filename].Content), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Downloaded %d file(s) to %s\n", cs.SuccessIconWithColor(cs.Green), len(filenames), displayDir(opts.Dir))
+	}
+
+	return nil
+}
+
+func displayDir(dir string) string {
+	if dir == "" {
+		return "."
+	}
+	return dir
+}
@@ -0,0 +1,109 @@
+package download
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdDownload(t *testing.T) {
+	tests := []struct {
+		name  string
+		cli   string
+		wants DownloadOptions
+	}{
+		{
+			name: "valid selector",
+			cli:  "123",
+			wants: DownloadOptions{
+				Selector: "123",
+			},
+		},
+		{
+			name: "with dir and pattern",
+			cli:  "123 --dir out --pattern *.go",
+			wants: DownloadOptions{
+				Selector: "123",
+				Dir:      "out",
+				Pattern:  "*.go",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+			var gotOpts *DownloadOptions
+			cmd := NewCmdDownload(f, func(opts *DownloadOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
+			assert.Equal(t, tt.wants.Dir, gotOpts.Dir)
+			assert.Equal(t, tt.wants.Pattern, gotOpts.Pattern)
+		})
+	}
+}
+
+func Test_downloadRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(httpmock.REST("GET", "gists/1234"),
+		httpmock.StringResponse(`{
+			"id": "1234",
+			"files": {
+				"main.go": {"filename": "main.go", "content": "package main"},
+				"README.md": {"filename": "README.md", "content": "# hi"}
+			}
+		}`))
+
+	dir := t.TempDir()
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &DownloadOptions{
+		IO:       io,
+		Selector: "1234",
+		Dir:      dir,
+		Pattern:  "*.go",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	err := downloadRun(opts)
+	reg.Verify(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Downloaded 1 file(s) to "+dir+"\n", stdout.String())
+
+	content, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package main", string(content))
+
+	_, err = os.Stat(filepath.Join(dir, "README.md"))
+	assert.True(t, os.IsNotExist(err))
+}
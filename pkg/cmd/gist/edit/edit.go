@@ -30,11 +30,13 @@ type EditOptions struct {
 
 	Edit func(string, string, string, *iostreams.IOStreams) (string, error)
 
-	Selector     string
-	EditFilename string
-	AddFilename  string
-	SourceFile   string
-	Description  string
+	Selector        string
+	EditFilename    string
+	AddFilenames    []string
+	RemoveFilenames []string
+	RenameFilenames []string
+	SourceFile      string
+	Description     string
 }
 
 func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
@@ -77,7 +79,9 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 		},
 	}
 
-	cmd.Flags().StringVarP(&opts.AddFilename, "add", "a", "", "Add a new file to the gist")
+	cmd.Flags().StringArrayVarP(&opts.AddFilenames, "add", "a", nil, "Add a new file or directory contents to the gist")
+	cmd.Flags().StringArrayVar(&opts.RemoveFilenames, "remove", nil, "Remove a file from the gist")
+	cmd.Flags().StringArrayVar(&opts.RenameFilenames, "rename", nil, "Rename a file in the gist, e.g. \"old.txt:new.txt\"")
 	cmd.Flags().StringVarP(&opts.Description, "desc", "d", "", "New description for the gist")
 	cmd.Flags().StringVarP(&opts.EditFilename, "filename", "f", "", "Select a file to edit")
 
@@ -135,42 +139,47 @@ func editRun(opts *EditOptions) error {
 		gist.Description = opts.Description
 	}
 
-	if opts.AddFilename != "" {
-		var input io.Reader
-		switch src := opts.SourceFile; {
-		case src == "-":
-			input = opts.IO.In
-		case src != "":
-			f, err := os.Open(src)
-			if err != nil {
-				return err
-			}
-			defer func() {
-				_ = f.Close()
-			}()
-			input = f
-		default:
-			f, err := os.Open(opts.AddFilename)
-			if err != nil {
-				return err
+	if len(opts.AddFilenames) > 0 || len(opts.RemoveFilenames) > 0 || len(opts.RenameFilenames) > 0 {
+		if opts.SourceFile != "" && len(opts.AddFilenames) != 1 {
+			return cmdutil.FlagErrorf("the source file argument can only be used with a single --add")
+		}
+
+		// Only the files that are actually changing are sent in the request; the
+		// gist API leaves files that are omitted from the "files" map untouched.
+		changedFiles := map[string]*shared.GistFile{}
+
+		for _, filename := range opts.RemoveFilenames {
+			if _, found := gist.Files[filename]; !found {
+				return fmt.Errorf("gist has no file %q", filename)
 			}
-			defer func() {
-				_ = f.Close()
-			}()
-			input = f
+			changedFiles[filename] = nil
 		}
 
-		content, err := io.ReadAll(input)
-		if err != nil {
-			return fmt.Errorf("read content: %w", err)
+		for _, rename := range opts.RenameFilenames {
+			parts := strings.SplitN(rename, ":", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return cmdutil.FlagErrorf("invalid --rename value %q: expected OLD:NEW", rename)
+			}
+			gistFile, found := gist.Files[parts[0]]
+			if !found {
+				return fmt.Errorf("gist has no file %q", parts[0])
+			}
+			renamed := *gistFile
+			renamed.Filename = parts[1]
+			changedFiles[parts[0]] = &renamed
 		}
 
-		files, err := getFilesToAdd(opts.AddFilename, content)
-		if err != nil {
-			return err
+		for _, add := range opts.AddFilenames {
+			files, err := filesToAdd(opts.IO.In, add, opts.SourceFile)
+			if err != nil {
+				return err
+			}
+			for filename, file := range files {
+				changedFiles[filename] = file
+			}
 		}
 
-		gist.Files = files
+		gist.Files = changedFiles
 		return updateGist(apiClient, host, gist)
 	}
 
@@ -318,6 +327,84 @@ func updateGist(apiClient *api.Client, hostname string, gist *shared.Gist) error
 	return nil
 }
 
+// filesToAdd resolves add (a file or directory path) into a map of gist files keyed by
+// filename. sourceFile, when set, overrides where the contents are read from ("-" means
+// stdin) and is only valid when a single file is being added.
+func filesToAdd(stdin io.Reader, add, sourceFile string) (map[string]*shared.GistFile, error) {
+	if sourceFile == "" {
+		if info, err := os.Stat(add); err != nil {
+			return nil, err
+		} else if info.IsDir() {
+			return filesToAddFromDir(add)
+		}
+	}
+
+	var input io.Reader
+	switch {
+	case sourceFile == "-":
+		input = stdin
+	case sourceFile != "":
+		f, err := os.Open(sourceFile)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		input = f
+	default:
+		f, err := os.Open(add)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		input = f
+	}
+
+	content, err := io.ReadAll(input)
+	if err != nil {
+		return nil, fmt.Errorf("read content: %w", err)
+	}
+
+	return getFilesToAdd(add, content)
+}
+
+// filesToAddFromDir adds every regular file directly inside dir to the gist, keyed by
+// its base name, since gists do not support a nested directory structure.
+func filesToAddFromDir(dir string) (map[string]*shared.GistFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]*shared.GistFile{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		add, err := getFilesToAdd(path, content)
+		if err != nil {
+			return nil, err
+		}
+		for filename, file := range add {
+			files[filename] = file
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files found in %s", dir)
+	}
+
+	return files, nil
+}
+
 func getFilesToAdd(file string, content []byte) (map[string]*shared.GistFile, error) {
 	if shared.IsBinaryContents(content) {
 		return nil, fmt.Errorf("failed to upload %s: binary file not supported", file)
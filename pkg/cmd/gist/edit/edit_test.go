@@ -58,17 +58,41 @@ func TestNewCmdEdit(t *testing.T) {
 			name: "add",
 			cli:  "123 --add cool.md",
 			wants: EditOptions{
-				Selector:    "123",
-				AddFilename: "cool.md",
+				Selector:     "123",
+				AddFilenames: []string{"cool.md"},
 			},
 		},
 		{
 			name: "add with source",
 			cli:  "123 --add cool.md -",
 			wants: EditOptions{
-				Selector:    "123",
-				AddFilename: "cool.md",
-				SourceFile:  "-",
+				Selector:     "123",
+				AddFilenames: []string{"cool.md"},
+				SourceFile:   "-",
+			},
+		},
+		{
+			name: "add multiple",
+			cli:  "123 --add cool.md --add neat.md",
+			wants: EditOptions{
+				Selector:     "123",
+				AddFilenames: []string{"cool.md", "neat.md"},
+			},
+		},
+		{
+			name: "remove",
+			cli:  "123 --remove cool.md",
+			wants: EditOptions{
+				Selector:        "123",
+				RemoveFilenames: []string{"cool.md"},
+			},
+		},
+		{
+			name: "rename",
+			cli:  "123 --rename old.md:new.md",
+			wants: EditOptions{
+				Selector:        "123",
+				RenameFilenames: []string{"old.md:new.md"},
 			},
 		},
 		{
@@ -102,7 +126,9 @@ func TestNewCmdEdit(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, tt.wants.EditFilename, gotOpts.EditFilename)
-			assert.Equal(t, tt.wants.AddFilename, gotOpts.AddFilename)
+			assert.Equal(t, tt.wants.AddFilenames, gotOpts.AddFilenames)
+			assert.Equal(t, tt.wants.RemoveFilenames, gotOpts.RemoveFilenames)
+			assert.Equal(t, tt.wants.RenameFilenames, gotOpts.RenameFilenames)
 			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
 		})
 	}
@@ -274,7 +300,7 @@ func Test_editRun(t *testing.T) {
 					httpmock.StatusStringResponse(201, "{}"))
 			},
 			opts: &EditOptions{
-				AddFilename: fileToAdd,
+				AddFilenames: []string{fileToAdd},
 			},
 		},
 		{
@@ -328,8 +354,8 @@ func Test_editRun(t *testing.T) {
 					httpmock.StatusStringResponse(201, "{}"))
 			},
 			opts: &EditOptions{
-				AddFilename: "from_source.txt",
-				SourceFile:  fileToAdd,
+				AddFilenames: []string{"from_source.txt"},
+				SourceFile:   fileToAdd,
 			},
 			wantParams: map[string]interface{}{
 				"description": "",
@@ -361,8 +387,8 @@ func Test_editRun(t *testing.T) {
 					httpmock.StatusStringResponse(201, "{}"))
 			},
 			opts: &EditOptions{
-				AddFilename: "from_source.txt",
-				SourceFile:  "-",
+				AddFilenames: []string{"from_source.txt"},
+				SourceFile:   "-",
 			},
 			stdin: "data from stdin",
 			wantParams: map[string]interface{}{
@@ -410,6 +436,91 @@ func Test_editRun(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "remove file from gist",
+			gist: &shared.Gist{
+				ID: "1234",
+				Files: map[string]*shared.GistFile{
+					"cicada.txt": {
+						Filename: "cicada.txt",
+						Content:  "bwhiizzzbwhuiiizzzz",
+						Type:     "text/plain",
+					},
+					"sample.txt": {
+						Filename: "sample.txt",
+						Content:  "bwhiizzzbwhuiiizzzz",
+						Type:     "text/plain",
+					},
+				},
+				Owner: &shared.GistOwner{Login: "octocat"},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("POST", "gists/1234"),
+					httpmock.StatusStringResponse(201, "{}"))
+			},
+			opts: &EditOptions{
+				RemoveFilenames: []string{"sample.txt"},
+			},
+			wantParams: map[string]interface{}{
+				"description": "",
+				"updated_at":  "0001-01-01T00:00:00Z",
+				"public":      false,
+				"files": map[string]interface{}{
+					"sample.txt": nil,
+				},
+			},
+		},
+		{
+			name: "remove nonexistent file",
+			gist: &shared.Gist{
+				ID: "1234",
+				Files: map[string]*shared.GistFile{
+					"cicada.txt": {
+						Filename: "cicada.txt",
+						Content:  "bwhiizzzbwhuiiizzzz",
+						Type:     "text/plain",
+					},
+				},
+				Owner: &shared.GistOwner{Login: "octocat"},
+			},
+			opts: &EditOptions{
+				RemoveFilenames: []string{"sample.txt"},
+			},
+			wantErr: `gist has no file "sample.txt"`,
+		},
+		{
+			name: "rename file in gist",
+			gist: &shared.Gist{
+				ID: "1234",
+				Files: map[string]*shared.GistFile{
+					"cicada.txt": {
+						Filename: "cicada.txt",
+						Content:  "bwhiizzzbwhuiiizzzz",
+						Type:     "text/plain",
+					},
+				},
+				Owner: &shared.GistOwner{Login: "octocat"},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("POST", "gists/1234"),
+					httpmock.StatusStringResponse(201, "{}"))
+			},
+			opts: &EditOptions{
+				RenameFilenames: []string{"cicada.txt:bug.txt"},
+			},
+			wantParams: map[string]interface{}{
+				"description": "",
+				"updated_at":  "0001-01-01T00:00:00Z",
+				"public":      false,
+				"files": map[string]interface{}{
+					"cicada.txt": map[string]interface{}{
+						"content":  "bwhiizzzbwhuiiizzzz",
+						"filename": "bug.txt",
+						"type":     "text/plain",
+					},
+				},
+			},
+		},
 		{
 			name: "edit gist using stdin",
 			gist: &shared.Gist{
@@ -0,0 +1,113 @@
+package fork
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/gist/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ForkOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Selector string
+	Clone    bool
+}
+
+func NewCmdFork(f *cmdutil.Factory, runF func(*ForkOptions) error) *cobra.Command {
+	opts := ForkOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "fork {<id> | <url>}",
+		Short: "Fork a gist",
+		Long: heredoc.Doc(`
+			Fork a GitHub gist into your own account.
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot fork: gist argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Selector = args[0]
+			if runF != nil {
+				return runF(&opts)
+			}
+			return forkRun(&opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Clone, "clone", false, "Clone the fork locally after creating it")
+
+	return cmd
+}
+
+func forkRun(opts *ForkOptions) error {
+	gistID := opts.Selector
+
+	if strings.Contains(gistID, "/") {
+		id, err := shared.GistIDFromURL(gistID)
+		if err != nil {
+			return err
+		}
+		gistID = id
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(client)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	fork := shared.Gist{}
+	if err := apiClient.REST(host, "POST", fmt.Sprintf("gists/%s/forks", gistID), nil, &fork); err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Forked gist %s\n", cs.SuccessIconWithColor(cs.Green), fork.ID)
+	} else {
+		fmt.Fprintln(opts.IO.Out, fork.ID)
+	}
+
+	if !opts.Clone {
+		return nil
+	}
+
+	protocol, err := cfg.GetOrDefault(host, "git_protocol")
+	if err != nil {
+		return err
+	}
+	cloneURL := formatRemoteURL(host, fork.ID, protocol)
+
+	_, err = git.RunClone(cloneURL, []string{})
+	return err
+}
+
+func formatRemoteURL(hostname string, gistID string, protocol string) string {
+	if protocol == "ssh" {
+		return fmt.Sprintf("git@gist.%s:%s.git", hostname, gistID)
+	}
+
+	return fmt.Sprintf("https://gist.%s/%s.git", hostname, gistID)
+}
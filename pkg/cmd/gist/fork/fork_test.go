@@ -0,0 +1,88 @@
+package fork
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdFork(t *testing.T) {
+	tests := []struct {
+		name  string
+		cli   string
+		wants ForkOptions
+	}{
+		{
+			name: "valid selector",
+			cli:  "123",
+			wants: ForkOptions{
+				Selector: "123",
+			},
+		},
+		{
+			name: "with clone",
+			cli:  "123 --clone",
+			wants: ForkOptions{
+				Selector: "123",
+				Clone:    true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+			var gotOpts *ForkOptions
+			cmd := NewCmdFork(f, func(opts *ForkOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
+			assert.Equal(t, tt.wants.Clone, gotOpts.Clone)
+		})
+	}
+}
+
+func Test_forkRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(httpmock.REST("POST", "gists/1234/forks"),
+		httpmock.StatusStringResponse(201, `{"id": "9876"}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &ForkOptions{
+		IO:       io,
+		Selector: "1234",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	err := forkRun(opts)
+	reg.Verify(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Forked gist 9876\n", stdout.String())
+}
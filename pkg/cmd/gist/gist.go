@@ -3,10 +3,17 @@ package gist
 import (
 	"github.com/MakeNowJust/heredoc"
 	gistCloneCmd "github.com/cli/cli/v2/pkg/cmd/gist/clone"
+	gistCommentCmd "github.com/cli/cli/v2/pkg/cmd/gist/comment"
 	gistCreateCmd "github.com/cli/cli/v2/pkg/cmd/gist/create"
 	gistDeleteCmd "github.com/cli/cli/v2/pkg/cmd/gist/delete"
+	gistDownloadCmd "github.com/cli/cli/v2/pkg/cmd/gist/download"
 	gistEditCmd "github.com/cli/cli/v2/pkg/cmd/gist/edit"
+	gistForkCmd "github.com/cli/cli/v2/pkg/cmd/gist/fork"
 	gistListCmd "github.com/cli/cli/v2/pkg/cmd/gist/list"
+	gistSearchCmd "github.com/cli/cli/v2/pkg/cmd/gist/search"
+	gistStarCmd "github.com/cli/cli/v2/pkg/cmd/gist/star"
+	gistSyncCmd "github.com/cli/cli/v2/pkg/cmd/gist/sync"
+	gistUnstarCmd "github.com/cli/cli/v2/pkg/cmd/gist/unstar"
 	gistViewCmd "github.com/cli/cli/v2/pkg/cmd/gist/view"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -28,11 +35,18 @@ func NewCmdGist(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.AddCommand(gistCloneCmd.NewCmdClone(f, nil))
+	cmd.AddCommand(gistCommentCmd.NewCmdComment(f, nil))
 	cmd.AddCommand(gistCreateCmd.NewCmdCreate(f, nil))
+	cmd.AddCommand(gistDownloadCmd.NewCmdDownload(f, nil))
 	cmd.AddCommand(gistListCmd.NewCmdList(f, nil))
+	cmd.AddCommand(gistSearchCmd.NewCmdSearch(f, nil))
 	cmd.AddCommand(gistViewCmd.NewCmdView(f, nil))
 	cmd.AddCommand(gistEditCmd.NewCmdEdit(f, nil))
+	cmd.AddCommand(gistForkCmd.NewCmdFork(f, nil))
 	cmd.AddCommand(gistDeleteCmd.NewCmdDelete(f, nil))
+	cmd.AddCommand(gistStarCmd.NewCmdStar(f, nil))
+	cmd.AddCommand(gistUnstarCmd.NewCmdUnstar(f, nil))
+	cmd.AddCommand(gistSyncCmd.NewCmdSync(f, nil))
 
 	return cmd
 }
@@ -22,6 +22,7 @@ type ListOptions struct {
 
 	Limit      int
 	Visibility string // all, secret, public
+	Starred    bool
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -44,6 +45,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
 			}
 
+			if opts.Starred && (flagPublic || flagSecret) {
+				return cmdutil.FlagErrorf("--starred is not supported with --public or --secret")
+			}
+
 			opts.Visibility = "all"
 			if flagSecret {
 				opts.Visibility = "secret"
@@ -61,6 +66,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 10, "Maximum number of gists to fetch")
 	cmd.Flags().BoolVar(&flagPublic, "public", false, "Show only public gists")
 	cmd.Flags().BoolVar(&flagSecret, "secret", false, "Show only secret gists")
+	cmd.Flags().BoolVar(&opts.Starred, "starred", false, "Show only starred gists")
 
 	return cmd
 }
@@ -81,7 +87,12 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
-	gists, err := shared.ListGists(client, host, opts.Limit, opts.Visibility)
+	var gists []shared.Gist
+	if opts.Starred {
+		gists, err = shared.ListStarredGists(client, host, opts.Limit)
+	} else {
+		gists, err = shared.ListGists(client, host, opts.Limit, opts.Visibility)
+	}
 	if err != nil {
 		return err
 	}
@@ -69,6 +69,15 @@ func TestNewCmdList(t *testing.T) {
 				Visibility: "all",
 			},
 		},
+		{
+			name: "starred",
+			cli:  "--starred",
+			wants: ListOptions{
+				Limit:      10,
+				Visibility: "all",
+				Starred:    true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -93,6 +102,7 @@ func TestNewCmdList(t *testing.T) {
 
 			assert.Equal(t, tt.wants.Visibility, gotOpts.Visibility)
 			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.wants.Starred, gotOpts.Starred)
 		})
 	}
 }
@@ -350,6 +360,29 @@ func Test_listRun(t *testing.T) {
 			`),
 			nontty: true,
 		},
+		{
+			name: "starred gists",
+			opts: &ListOptions{Starred: true},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "gists/starred"),
+					httpmock.StringResponse(fmt.Sprintf(
+						`[
+							{
+								"id": "1234567890",
+								"description": "",
+								"files": { "cool.txt": { "filename": "cool.txt" } },
+								"updated_at": "%v",
+								"public": true,
+								"owner": { "login": "monalisa" }
+							}
+						]`,
+						sixHoursAgo.Format(time.RFC3339),
+					)),
+				)
+			},
+			wantOut: "1234567890  cool.txt  1 file  public  about 6 hours ago\n",
+		},
 	}
 
 	for _, tt := range tests {
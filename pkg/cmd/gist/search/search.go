@@ -0,0 +1,274 @@
+package search
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/gist/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/cli/cli/v2/pkg/text"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type browser interface {
+	Browse(string) error
+}
+
+type SearchOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+	Browser    browser
+	Exporter   cmdutil.Exporter
+
+	Query     string
+	Language  string
+	OwnedOnly bool
+	Limit     int
+}
+
+func NewCmdSearch(f *cmdutil.Factory, runF func(*SearchOptions) error) *cobra.Command {
+	opts := &SearchOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+		Browser:    f.Browser,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search your gists",
+		Long: heredoc.Doc(`
+			Search your own and starred gists by description and filename.
+
+			GitHub does not offer an API for full-text search of gist content, so
+			only gist descriptions and filenames are matched against <query>.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Query = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return searchRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.GistFields)
+	cmd.Flags().StringVar(&opts.Language, "language", "", "Filter results by the coding language of a gist file")
+	cmd.Flags().BoolVar(&opts.OwnedOnly, "user", false, "Search only gists you own, excluding starred gists")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 100, "Maximum number of gists to fetch per source (owned, starred) before filtering")
+
+	return cmd
+}
+
+func searchRun(opts *SearchOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	gists, err := shared.ListOwnedGists(httpClient, host, opts.Limit)
+	if err == nil && !opts.OwnedOnly {
+		var starred []shared.Gist
+		starred, err = shared.ListStarredGists(httpClient, host, opts.Limit)
+		gists = append(gists, starred...)
+	}
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to search gists: %w", err)
+	}
+
+	matches := filterGists(gists, opts.Query, opts.Language)
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, matches)
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if err := printResults(opts.IO, matches); err != nil {
+		return err
+	}
+
+	if len(matches) == 0 || !opts.IO.CanPrompt() {
+		return nil
+	}
+
+	return selectGist(opts, matches)
+}
+
+func filterGists(gists []shared.Gist, query, language string) []shared.Gist {
+	seen := map[string]bool{}
+	var matches []shared.Gist
+
+	for _, gist := range gists {
+		if seen[gist.ID] {
+			continue
+		}
+		seen[gist.ID] = true
+
+		if language != "" && !hasLanguage(gist, language) {
+			continue
+		}
+		if query != "" && !matchesQuery(gist, query) {
+			continue
+		}
+
+		matches = append(matches, gist)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].UpdatedAt.After(matches[j].UpdatedAt)
+	})
+
+	return matches
+}
+
+func hasLanguage(gist shared.Gist, language string) bool {
+	for _, file := range gist.Files {
+		if file != nil && strings.EqualFold(file.Language, language) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesQuery(gist shared.Gist, query string) bool {
+	query = strings.ToLower(query)
+	if strings.Contains(strings.ToLower(gist.Description), query) {
+		return true
+	}
+	for filename := range gist.Files {
+		if strings.Contains(strings.ToLower(filename), query) {
+			return true
+		}
+	}
+	return false
+}
+
+func printResults(io *iostreams.IOStreams, gists []shared.Gist) error {
+	cs := io.ColorScheme()
+	tp := utils.NewTablePrinter(io)
+
+	for _, gist := range gists {
+		fileCount := len(gist.Files)
+
+		visibility := "public"
+		visColor := cs.Green
+		if !gist.Public {
+			visibility = "secret"
+			visColor = cs.Red
+		}
+
+		description := gist.Description
+		if description == "" {
+			for filename := range gist.Files {
+				if !strings.HasPrefix(filename, "gistfile") {
+					description = filename
+					break
+				}
+			}
+		}
+
+		owner := ""
+		if gist.Owner != nil {
+			owner = gist.Owner.Login
+		}
+
+		gistTime := gist.UpdatedAt.Format(time.RFC3339)
+		if tp.IsTTY() {
+			gistTime = utils.FuzzyAgo(time.Since(gist.UpdatedAt))
+		}
+
+		tp.AddField(gist.ID, nil, nil)
+		tp.AddField(text.ReplaceExcessiveWhitespace(description), nil, cs.Bold)
+		tp.AddField(owner, nil, cs.Gray)
+		tp.AddField(utils.Pluralize(fileCount, "file"), nil, nil)
+		tp.AddField(visibility, nil, visColor)
+		tp.AddField(gistTime, nil, cs.Gray)
+		tp.EndRow()
+	}
+
+	if io.IsStdoutTTY() {
+		header := "No gists matched your search\n"
+		if len(gists) > 0 {
+			header = fmt.Sprintf("Showing %d gists\n\n", len(gists))
+		}
+		fmt.Fprintf(io.Out, "\n%s", header)
+	}
+
+	return tp.Render()
+}
+
+func selectGist(opts *SearchOptions, gists []shared.Gist) error {
+	cs := opts.IO.ColorScheme()
+	gistIDs := make([]string, len(gists))
+	candidates := make([]string, len(gists))
+
+	for i, gist := range gists {
+		gistIDs[i] = gist.ID
+		description := gist.Description
+		if description == "" {
+			description = "<no description>"
+		}
+		gistTime := utils.FuzzyAgo(time.Since(gist.UpdatedAt))
+		candidates[i] = fmt.Sprintf("%s %s %s", cs.Bold(gist.ID), text.Truncate(100, text.ReplaceExcessiveWhitespace(description)), cs.Gray(gistTime))
+	}
+
+	selected := 0
+	err := prompt.SurveyAskOne(&survey.Select{
+		Message: "Select a gist",
+		Options: candidates,
+	}, &selected)
+	if err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+
+	action := ""
+	err = prompt.SurveyAskOne(&survey.Select{
+		Message: "What do you want to do?",
+		Options: []string{"View in browser", "Clone", "Nothing"},
+	}, &action)
+	if err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+
+	gist := gists[selected]
+
+	switch action {
+	case "View in browser":
+		return opts.Browser.Browse(gist.HTMLURL)
+	case "Clone":
+		_, err := git.RunClone(gist.HTMLURL, nil)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmd/gist/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdSearch(t *testing.T) {
+	tests := []struct {
+		name  string
+		cli   string
+		wants SearchOptions
+	}{
+		{
+			name: "query only",
+			cli:  "octocat",
+			wants: SearchOptions{
+				Query: "octocat",
+			},
+		},
+		{
+			name: "language",
+			cli:  "octocat --language go",
+			wants: SearchOptions{
+				Query:    "octocat",
+				Language: "go",
+			},
+		},
+		{
+			name: "user",
+			cli:  "octocat --user",
+			wants: SearchOptions{
+				Query:     "octocat",
+				OwnedOnly: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *SearchOptions
+			cmd := NewCmdSearch(f, func(opts *SearchOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Query, gotOpts.Query)
+			assert.Equal(t, tt.wants.Language, gotOpts.Language)
+			assert.Equal(t, tt.wants.OwnedOnly, gotOpts.OwnedOnly)
+		})
+	}
+}
+
+func TestFilterGists(t *testing.T) {
+	now := time.Now()
+	gists := []shared.Gist{
+		{
+			ID:          "1",
+			Description: "a shell script",
+			UpdatedAt:   now,
+			Files: map[string]*shared.GistFile{
+				"hello.sh": {Filename: "hello.sh", Language: "Shell"},
+			},
+		},
+		{
+			ID:          "2",
+			Description: "a go program",
+			UpdatedAt:   now.Add(-time.Hour),
+			Files: map[string]*shared.GistFile{
+				"main.go": {Filename: "main.go", Language: "Go"},
+			},
+		},
+	}
+
+	matches := filterGists(gists, "go", "")
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "2", matches[0].ID)
+
+	matches = filterGists(gists, "", "shell")
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "1", matches[0].ID)
+
+	matches = filterGists(gists, "", "")
+	assert.Len(t, matches, 2)
+	assert.Equal(t, "1", matches[0].ID)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 	"time"
 
@@ -37,8 +38,66 @@ type Gist struct {
 	Owner       *GistOwner           `json:"owner,omitempty"`
 }
 
+type Comment struct {
+	ID        string     `json:"id,omitempty"`
+	Body      string     `json:"body"`
+	User      *GistOwner `json:"user,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
 var NotFoundErr = errors.New("not found")
 
+// GistFields lists the field names accepted by commands that support `--json` output for a gist.
+var GistFields = []string{
+	"description",
+	"files",
+	"id",
+	"owner",
+	"public",
+	"updatedAt",
+	"url",
+}
+
+func (g *Gist) ExportData(fields []string) map[string]interface{} {
+	v := reflect.ValueOf(g).Elem()
+	data := map[string]interface{}{}
+
+	for _, f := range fields {
+		switch f {
+		case "files":
+			files := map[string]interface{}{}
+			for name, file := range g.Files {
+				if file == nil {
+					continue
+				}
+				files[name] = map[string]interface{}{
+					"filename": file.Filename,
+					"type":     file.Type,
+					"language": file.Language,
+				}
+			}
+			data[f] = files
+		case "owner":
+			login := ""
+			if g.Owner != nil {
+				login = g.Owner.Login
+			}
+			data[f] = login
+		case "url":
+			data[f] = g.HTMLURL
+		default:
+			sf := v.FieldByNameFunc(func(s string) bool {
+				return strings.EqualFold(f, s)
+			})
+			if sf.IsValid() {
+				data[f] = sf.Interface()
+			}
+		}
+	}
+
+	return data
+}
+
 func GetGist(client *http.Client, hostname, gistID string) (*Gist, error) {
 	gist := Gist{}
 	path := fmt.Sprintf("gists/%s", gistID)
@@ -56,6 +115,20 @@ func GetGist(client *http.Client, hostname, gistID string) (*Gist, error) {
 	return &gist, nil
 }
 
+// ListComments returns the comments posted on a gist, oldest first.
+func ListComments(client *http.Client, hostname, gistID string) ([]Comment, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("gists/%s/comments", gistID)
+
+	var comments []Comment
+	err := apiClient.REST(hostname, "GET", path, nil, &comments)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
 func GistIDFromURL(gistURL string) (string, error) {
 	u, err := url.Parse(gistURL)
 	if err == nil && strings.HasPrefix(u.Path, "/") {
@@ -148,6 +221,48 @@ pagination:
 	return gists, nil
 }
 
+// ListOwnedGists returns up to limit of the viewer's own gists, fetched via the REST API so
+// that, unlike ListGists, each file's language and content type are populated.
+func ListOwnedGists(client *http.Client, hostname string, limit int) ([]Gist, error) {
+	return listGistsREST(client, hostname, "gists", limit)
+}
+
+// ListStarredGists returns up to limit gists the viewer has starred, most recently starred first.
+func ListStarredGists(client *http.Client, hostname string, limit int) ([]Gist, error) {
+	return listGistsREST(client, hostname, "gists/starred", limit)
+}
+
+func listGistsREST(client *http.Client, hostname, path string, limit int) ([]Gist, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	perPage := limit
+	if perPage > 100 {
+		perPage = 100
+	}
+	p := fmt.Sprintf("%s?per_page=%d", path, perPage)
+
+	var gists []Gist
+	for {
+		var page []Gist
+		next, err := apiClient.RESTWithNext(hostname, "GET", p, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		gists = append(gists, page...)
+
+		if len(gists) >= limit || next == "" {
+			break
+		}
+		p = next
+	}
+
+	if len(gists) > limit {
+		gists = gists[:limit]
+	}
+
+	return gists, nil
+}
+
 func IsBinaryFile(file string) (bool, error) {
 	detectedMime, err := mimetype.DetectFile(file)
 	if err != nil {
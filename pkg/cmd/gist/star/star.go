@@ -0,0 +1,82 @@
+package star
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/gist/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type StarOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Selector string
+}
+
+func NewCmdStar(f *cmdutil.Factory, runF func(*StarOptions) error) *cobra.Command {
+	opts := StarOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "star {<id> | <url>}",
+		Short: "Star a gist",
+		Args:  cmdutil.ExactArgs(1, "cannot star: gist argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Selector = args[0]
+			if runF != nil {
+				return runF(&opts)
+			}
+			return starRun(&opts)
+		},
+	}
+	return cmd
+}
+
+func starRun(opts *StarOptions) error {
+	gistID := opts.Selector
+
+	if strings.Contains(gistID, "/") {
+		id, err := shared.GistIDFromURL(gistID)
+		if err != nil {
+			return err
+		}
+		gistID = id
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(client)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	if err := apiClient.REST(host, "PUT", fmt.Sprintf("gists/%s/star", gistID), nil, nil); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Starred gist %s\n", cs.SuccessIconWithColor(cs.Green), gistID)
+	}
+
+	return nil
+}
@@ -0,0 +1,370 @@
+package sync
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/gist/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// syncStateFile is the metadata file a synced directory keeps to remember which gist it
+// is bound to and the content hash of each file as of the last successful sync.
+const syncStateFile = ".gistsync.json"
+
+type syncState struct {
+	GistID string            `json:"gist_id"`
+	Files  map[string]string `json:"files"`
+}
+
+type SyncOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Directory   string
+	GistID      string
+	Description string
+	Public      bool
+}
+
+func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Command {
+	opts := SyncOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync <directory>",
+		Short: "Sync a local directory with a gist",
+		Long: heredoc.Doc(`
+			Keep a local directory and a gist in sync.
+
+			The first time a directory is synced, a new gist is created from its files
+			unless --gist is given to bind the directory to an existing gist. The
+			directory is then associated with that gist for subsequent syncs.
+
+			On each run, files that changed locally are pushed and files that changed
+			on the gist are pulled. If a file was changed on both sides since the last
+			sync, it is reported as a conflict and left untouched.
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot sync: directory argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Directory = args[0]
+			if runF != nil {
+				return runF(&opts)
+			}
+			return syncRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.GistID, "gist", "", "Bind the directory to an existing gist by ID or URL")
+	cmd.Flags().StringVarP(&opts.Description, "desc", "d", "", "A description, used when creating a new gist")
+	cmd.Flags().BoolVarP(&opts.Public, "public", "p", false, "List the gist publicly when creating a new gist")
+
+	return cmd
+}
+
+func syncRun(opts *SyncOptions) error {
+	info, err := os.Stat(opts.Directory)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", opts.Directory)
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(client)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	state, err := readSyncState(opts.Directory)
+	if err != nil {
+		return err
+	}
+
+	gistID := opts.GistID
+	if strings.Contains(gistID, "/") {
+		id, err := shared.GistIDFromURL(gistID)
+		if err != nil {
+			return err
+		}
+		gistID = id
+	}
+	if gistID == "" {
+		gistID = state.GistID
+	}
+
+	localFiles, err := readLocalFiles(opts.Directory)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.ErrOut
+
+	if gistID == "" {
+		if len(localFiles) == 0 {
+			return fmt.Errorf("no files found in %s", opts.Directory)
+		}
+
+		files := map[string]*shared.GistFile{}
+		for name, content := range localFiles {
+			files[name] = &shared.GistFile{Content: content}
+		}
+
+		gist, err := createGist(apiClient, host, opts.Description, opts.Public, files)
+		if err != nil {
+			return fmt.Errorf("%s Failed to create gist: %w", cs.Red("X"), err)
+		}
+
+		state.GistID = gist.ID
+		for name, content := range localFiles {
+			state.Files[name] = hashContent(content)
+		}
+		if err := writeSyncState(opts.Directory, state); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(out, "%s Created gist %s and synced %d file(s)\n", cs.SuccessIconWithColor(cs.Green), gist.ID, len(localFiles))
+		return nil
+	}
+
+	gist, err := shared.GetGist(client, host, gistID)
+	if err != nil {
+		if errors.Is(err, shared.NotFoundErr) {
+			return fmt.Errorf("gist not found: %s", gistID)
+		}
+		return err
+	}
+
+	remoteFiles := map[string]string{}
+	for name, file := range gist.Files {
+		if file != nil {
+			remoteFiles[name] = file.Content
+		}
+	}
+
+	names := map[string]struct{}{}
+	for name := range localFiles {
+		names[name] = struct{}{}
+	}
+	for name := range remoteFiles {
+		names[name] = struct{}{}
+	}
+	for name := range state.Files {
+		names[name] = struct{}{}
+	}
+
+	toPush := map[string]*shared.GistFile{}
+	var conflicts []string
+	pulled, pushed := 0, 0
+
+	for name := range names {
+		localContent, hasLocal := localFiles[name]
+		remoteContent, hasRemote := remoteFiles[name]
+		lastHash, hadLast := state.Files[name]
+
+		localHash := ""
+		if hasLocal {
+			localHash = hashContent(localContent)
+		}
+		remoteHash := ""
+		if hasRemote {
+			remoteHash = hashContent(remoteContent)
+		}
+
+		localChanged := !hadLast || localHash != lastHash
+		if !hasLocal {
+			localChanged = hadLast
+		}
+		remoteChanged := !hadLast || remoteHash != lastHash
+		if !hasRemote {
+			remoteChanged = hadLast
+		}
+
+		switch {
+		case hasLocal && hasRemote && localHash == remoteHash:
+			state.Files[name] = localHash
+
+		case !hasLocal && !hasRemote:
+			delete(state.Files, name)
+
+		case localChanged && remoteChanged:
+			conflicts = append(conflicts, name)
+
+		case localChanged:
+			if hasLocal {
+				toPush[name] = &shared.GistFile{Content: localContent}
+				state.Files[name] = localHash
+			} else {
+				toPush[name] = nil
+				delete(state.Files, name)
+			}
+			pushed++
+
+		case remoteChanged:
+			if hasRemote {
+				if err := writeLocalFile(opts.Directory, name, remoteContent); err != nil {
+					return err
+				}
+				state.Files[name] = remoteHash
+			} else {
+				if err := os.Remove(filepath.Join(opts.Directory, name)); err != nil && !os.IsNotExist(err) {
+					return err
+				}
+				delete(state.Files, name)
+			}
+			pulled++
+		}
+	}
+
+	if len(toPush) > 0 {
+		gist.Files = toPush
+		if err := updateGist(apiClient, host, gist); err != nil {
+			return fmt.Errorf("%s Failed to update gist: %w", cs.Red("X"), err)
+		}
+	}
+
+	state.GistID = gistID
+	if err := writeSyncState(opts.Directory, state); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "%s Synced %s: %d pushed, %d pulled\n", cs.SuccessIconWithColor(cs.Green), gistID, pushed, pulled)
+
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		fmt.Fprintf(out, "%s %d file(s) changed on both sides and were left untouched:\n", cs.FailureIcon(), len(conflicts))
+		for _, name := range conflicts {
+			fmt.Fprintf(out, "  %s\n", name)
+		}
+		return cmdutil.SilentError
+	}
+
+	return nil
+}
+
+func readLocalFiles(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if shared.IsBinaryContents(content) {
+			continue
+		}
+		files[entry.Name()] = string(content)
+	}
+
+	return files, nil
+}
+
+func writeLocalFile(dir, name, content string) error {
+	return os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+}
+
+func hashContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func readSyncState(dir string) (*syncState, error) {
+	state := &syncState{Files: map[string]string{}}
+
+	data, err := os.ReadFile(filepath.Join(dir, syncStateFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", syncStateFile, err)
+	}
+	if state.Files == nil {
+		state.Files = map[string]string{}
+	}
+
+	return state, nil
+}
+
+func writeSyncState(dir string, state *syncState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, syncStateFile), data, 0644)
+}
+
+func createGist(apiClient *api.Client, hostname, description string, public bool, files map[string]*shared.GistFile) (*shared.Gist, error) {
+	body := &shared.Gist{
+		Description: description,
+		Public:      public,
+		Files:       files,
+	}
+
+	requestByte, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &shared.Gist{}
+	err = apiClient.REST(hostname, "POST", "gists", bytes.NewReader(requestByte), result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func updateGist(apiClient *api.Client, hostname string, gist *shared.Gist) error {
+	body := shared.Gist{
+		Description: gist.Description,
+		Files:       gist.Files,
+	}
+
+	requestByte, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	result := shared.Gist{}
+	return apiClient.REST(hostname, "POST", "gists/"+gist.ID, bytes.NewReader(requestByte), &result)
+}
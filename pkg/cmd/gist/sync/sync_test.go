@@ -0,0 +1,179 @@
+package sync
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdSync(t *testing.T) {
+	tests := []struct {
+		name  string
+		cli   string
+		wants SyncOptions
+	}{
+		{
+			name: "directory only",
+			cli:  "./dotfiles",
+			wants: SyncOptions{
+				Directory: "./dotfiles",
+			},
+		},
+		{
+			name: "with gist and flags",
+			cli:  "./dotfiles --gist 1234 --public -d \"my dotfiles\"",
+			wants: SyncOptions{
+				Directory:   "./dotfiles",
+				GistID:      "1234",
+				Public:      true,
+				Description: "my dotfiles",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+			var gotOpts *SyncOptions
+			cmd := NewCmdSync(f, func(opts *SyncOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Directory, gotOpts.Directory)
+			assert.Equal(t, tt.wants.GistID, gotOpts.GistID)
+			assert.Equal(t, tt.wants.Public, gotOpts.Public)
+			assert.Equal(t, tt.wants.Description, gotOpts.Description)
+		})
+	}
+}
+
+func newTestOpts(t *testing.T, reg *httpmock.Registry, dir string) *SyncOptions {
+	t.Helper()
+	io, _, _, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	return &SyncOptions{
+		IO:        io,
+		Directory: dir,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+}
+
+func Test_syncRun_createsNewGist(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "hello.txt"), []byte("hello"), 0600))
+
+	reg := &httpmock.Registry{}
+	reg.Register(httpmock.REST("POST", "gists"),
+		httpmock.StatusStringResponse(201, `{"id": "9876"}`))
+
+	opts := newTestOpts(t, reg, dir)
+	err := syncRun(opts)
+	reg.Verify(t)
+	assert.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, syncStateFile))
+	require.NoError(t, err)
+	var state syncState
+	require.NoError(t, json.Unmarshal(data, &state))
+	assert.Equal(t, "9876", state.GistID)
+	assert.Contains(t, state.Files, "hello.txt")
+}
+
+func Test_syncRun_pushAndPull(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "local.txt"), []byte("changed locally"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "remote.txt"), []byte("original remote"), 0600))
+
+	state := &syncState{
+		GistID: "1234",
+		Files: map[string]string{
+			"local.txt":  hashContent("original"),
+			"remote.txt": hashContent("original remote"),
+		},
+	}
+	require.NoError(t, writeSyncState(dir, state))
+
+	reg := &httpmock.Registry{}
+	reg.Register(httpmock.REST("GET", "gists/1234"),
+		httpmock.StringResponse(`{
+			"id": "1234",
+			"files": {
+				"local.txt": {"filename": "local.txt", "content": "original"},
+				"remote.txt": {"filename": "remote.txt", "content": "changed remotely"}
+			}
+		}`))
+	reg.Register(httpmock.REST("POST", "gists/1234"),
+		httpmock.StatusStringResponse(200, `{"id": "1234"}`))
+
+	opts := newTestOpts(t, reg, dir)
+	opts.GistID = "1234"
+	err := syncRun(opts)
+	reg.Verify(t)
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(dir, "remote.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "changed remotely", string(content))
+}
+
+func Test_syncRun_conflict(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "both.txt"), []byte("local edit"), 0600))
+
+	state := &syncState{
+		GistID: "1234",
+		Files: map[string]string{
+			"both.txt": hashContent("original"),
+		},
+	}
+	require.NoError(t, writeSyncState(dir, state))
+
+	reg := &httpmock.Registry{}
+	reg.Register(httpmock.REST("GET", "gists/1234"),
+		httpmock.StringResponse(`{
+			"id": "1234",
+			"files": {
+				"both.txt": {"filename": "both.txt", "content": "remote edit"}
+			}
+		}`))
+
+	opts := newTestOpts(t, reg, dir)
+	opts.GistID = "1234"
+	err := syncRun(opts)
+	reg.Verify(t)
+	assert.Error(t, err)
+
+	content, err := os.ReadFile(filepath.Join(dir, "both.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "local edit", string(content))
+}
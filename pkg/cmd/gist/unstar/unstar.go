@@ -0,0 +1,82 @@
+package unstar
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/gist/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UnstarOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Selector string
+}
+
+func NewCmdUnstar(f *cmdutil.Factory, runF func(*UnstarOptions) error) *cobra.Command {
+	opts := UnstarOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unstar {<id> | <url>}",
+		Short: "Unstar a gist",
+		Args:  cmdutil.ExactArgs(1, "cannot unstar: gist argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Selector = args[0]
+			if runF != nil {
+				return runF(&opts)
+			}
+			return unstarRun(&opts)
+		},
+	}
+	return cmd
+}
+
+func unstarRun(opts *UnstarOptions) error {
+	gistID := opts.Selector
+
+	if strings.Contains(gistID, "/") {
+		id, err := shared.GistIDFromURL(gistID)
+		if err != nil {
+			return err
+		}
+		gistID = id
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(client)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	if err := apiClient.REST(host, "DELETE", fmt.Sprintf("gists/%s/star", gistID), nil, nil); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Unstarred gist %s\n", cs.SuccessIconWithColor(cs.Green), gistID)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package unstar
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdUnstar(t *testing.T) {
+	tests := []struct {
+		name  string
+		cli   string
+		wants UnstarOptions
+	}{
+		{
+			name: "valid selector",
+			cli:  "123",
+			wants: UnstarOptions{
+				Selector: "123",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+			var gotOpts *UnstarOptions
+			cmd := NewCmdUnstar(f, func(opts *UnstarOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
+		})
+	}
+}
+
+func Test_unstarRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(httpmock.REST("DELETE", "gists/1234/star"),
+		httpmock.StatusStringResponse(204, ""))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &UnstarOptions{
+		IO:       io,
+		Selector: "1234",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	err := unstarRun(opts)
+	reg.Verify(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Unstarred gist 1234\n", stdout.String())
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/alecthomas/chroma/quick"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/pkg/cmd/gist/shared"
@@ -35,6 +36,7 @@ type ViewOptions struct {
 	Raw       bool
 	Web       bool
 	ListFiles bool
+	Comments  bool
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -70,6 +72,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open gist in the browser")
 	cmd.Flags().BoolVar(&opts.ListFiles, "files", false, "List file names from the gist")
 	cmd.Flags().StringVarP(&opts.Filename, "filename", "f", "", "Display a single file from the gist")
+	cmd.Flags().BoolVar(&opts.Comments, "comments", false, "Show gist comments")
 
 	return cmd
 }
@@ -152,10 +155,18 @@ func viewRun(opts *ViewOptions) error {
 			return err
 		}
 
-		if _, err := fmt.Fprint(opts.IO.Out, gf.Content); err != nil {
+		content := gf.Content
+		if !opts.Raw && opts.IO.ColorEnabled() {
+			highlighted, err := highlightedContent(opts.IO, gf, content)
+			if err == nil {
+				content = highlighted
+			}
+		}
+
+		if _, err := fmt.Fprint(opts.IO.Out, content); err != nil {
 			return err
 		}
-		if !strings.HasSuffix(gf.Content, "\n") {
+		if !strings.HasSuffix(content, "\n") {
 			_, err := fmt.Fprint(opts.IO.Out, "\n")
 			return err
 		}
@@ -164,11 +175,11 @@ func viewRun(opts *ViewOptions) error {
 	}
 
 	if opts.Filename != "" {
-		gistFile, ok := gist.Files[opts.Filename]
-		if !ok {
-			return fmt.Errorf("gist has no such file: %q", opts.Filename)
+		filename, err := findFile(gist.Files, opts.Filename)
+		if err != nil {
+			return err
 		}
-		return render(gistFile)
+		return render(gist.Files[filename])
 	}
 
 	if gist.Description != "" && !opts.ListFiles {
@@ -204,6 +215,47 @@ func viewRun(opts *ViewOptions) error {
 		}
 	}
 
+	if opts.Comments {
+		if err := renderComments(opts, client, hostname, gistID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func renderComments(opts *ViewOptions, client *http.Client, hostname, gistID string) error {
+	comments, err := shared.ListComments(client, hostname, gistID)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "\n%s\n\n", cs.Bold(fmt.Sprintf("Comments (%d)", len(comments))))
+
+	for i, comment := range comments {
+		login := ""
+		if comment.User != nil {
+			login = comment.User.Login
+		}
+		fmt.Fprintf(opts.IO.Out, "%s %s\n\n", cs.Bold(login), cs.Gray(utils.FuzzyAgo(time.Since(comment.CreatedAt))))
+
+		body := comment.Body
+		if !opts.Raw {
+			rendered, err := markdown.Render(body, markdown.WithIO(opts.IO))
+			if err == nil {
+				body = rendered
+			}
+		} else if !strings.HasSuffix(body, "\n") {
+			body += "\n"
+		}
+		fmt.Fprint(opts.IO.Out, body)
+
+		if i < len(comments)-1 {
+			fmt.Fprint(opts.IO.Out, "\n")
+		}
+	}
+
 	return nil
 }
 
@@ -257,3 +309,58 @@ func promptGists(client *http.Client, host string, cs *iostreams.ColorScheme) (g
 
 	return gistIDs[result], nil
 }
+
+// findFile resolves a user-provided filename against a gist's files, falling back to a
+// case-insensitive or substring match when there is no exact match so that a shortened or
+// differently-cased --file value still works as long as it is unambiguous.
+func findFile(files map[string]*shared.GistFile, name string) (string, error) {
+	if _, ok := files[name]; ok {
+		return name, nil
+	}
+
+	var matches []string
+	for fn := range files {
+		if strings.EqualFold(fn, name) {
+			return fn, nil
+		}
+		if strings.Contains(strings.ToLower(fn), strings.ToLower(name)) {
+			matches = append(matches, fn)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("gist has no such file: %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		sort.Strings(matches)
+		return "", fmt.Errorf("multiple files match %q: %s", name, strings.Join(matches, ", "))
+	}
+}
+
+// highlightedContent applies chroma syntax highlighting to a gist file's content, using the
+// file's reported language when available and otherwise detecting it from the filename.
+func highlightedContent(io *iostreams.IOStreams, gf *shared.GistFile, content string) (string, error) {
+	lexer := gf.Language
+	if lexer == "" {
+		lexer = gf.Filename
+	}
+
+	formatter := "terminal256"
+	if io.HasTrueColor() {
+		formatter = "terminal16m"
+	}
+
+	style := "monokai"
+	if io.TerminalTheme() == "light" {
+		style = "github"
+	}
+
+	var buf strings.Builder
+	if err := quick.Highlight(&buf, content, lexer, formatter, style); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -324,6 +324,23 @@ func Test_viewRun(t *testing.T) {
 			},
 			wantOut: "cicada.txt\nfoo.md\n",
 		},
+		{
+			name: "one file, with comments",
+			opts: &ViewOptions{
+				Selector: "1234",
+				Raw:      true,
+				Comments: true,
+			},
+			gist: &shared.Gist{
+				Files: map[string]*shared.GistFile{
+					"cicada.txt": {
+						Content: "bwhiizzzbwhuiiizzzz",
+						Type:    "text/plain",
+					},
+				},
+			},
+			wantOut: "bwhiizzzbwhuiiizzzz\n\nComments (1)\n\nmonalisa about 292 years ago\n\nnice one\n",
+		},
 	}
 
 	for _, tt := range tests {
@@ -336,6 +353,11 @@ func Test_viewRun(t *testing.T) {
 				httpmock.JSONResponse(tt.gist))
 		}
 
+		if tt.opts != nil && tt.opts.Comments {
+			reg.Register(httpmock.REST("GET", "gists/1234/comments"),
+				httpmock.StringResponse(`[{"id": "1", "body": "nice one", "user": {"login": "monalisa"}}]`))
+		}
+
 		if tt.mockGistList {
 			sixHours, _ := time.ParseDuration("6h")
 			sixHoursAgo := time.Now().Add(-sixHours)
@@ -389,6 +411,37 @@ func Test_viewRun(t *testing.T) {
 	}
 }
 
+func Test_findFile(t *testing.T) {
+	files := map[string]*shared.GistFile{
+		"main.go":   {},
+		"README.md": {},
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		want    string
+		wantErr bool
+	}{
+		{name: "exact match", file: "main.go", want: "main.go"},
+		{name: "case-insensitive match", file: "MAIN.GO", want: "main.go"},
+		{name: "substring match", file: "read", want: "README.md"},
+		{name: "no match", file: "nope.txt", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findFile(files, tt.file)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_promptGists(t *testing.T) {
 	tests := []struct {
 		name     string
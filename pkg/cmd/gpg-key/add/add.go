@@ -81,9 +81,9 @@ func runAdd(opts *AddOptions) error {
 		return err
 	}
 
-	err = gpgKeyUpload(httpClient, hostname, keyReader)
+	err = GPGKeyUpload(httpClient, hostname, keyReader)
 	if err != nil {
-		if errors.Is(err, scopesError) {
+		if errors.Is(err, ScopesError) {
 			cs := opts.IO.ColorScheme()
 			fmt.Fprint(opts.IO.ErrOut, "Error: insufficient OAuth scopes to list GPG keys\n")
 			fmt.Fprintf(opts.IO.ErrOut, "Run the following to grant scopes: %s\n", cs.Bold("gh auth refresh -s write:gpg_key"))
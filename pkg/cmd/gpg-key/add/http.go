@@ -12,9 +12,9 @@ import (
 	"github.com/cli/cli/v2/internal/ghinstance"
 )
 
-var scopesError = errors.New("insufficient OAuth scopes")
+var ScopesError = errors.New("insufficient OAuth scopes")
 
-func gpgKeyUpload(httpClient *http.Client, hostname string, keyFile io.Reader) error {
+func GPGKeyUpload(httpClient *http.Client, hostname string, keyFile io.Reader) error {
 	url := ghinstance.RESTPrefix(hostname) + "user/gpg_keys"
 
 	keyBytes, err := ioutil.ReadAll(keyFile)
@@ -43,7 +43,7 @@ func gpgKeyUpload(httpClient *http.Client, hostname string, keyFile io.Reader) e
 	defer resp.Body.Close()
 
 	if resp.StatusCode == 404 {
-		return scopesError
+		return ScopesError
 	} else if resp.StatusCode > 299 {
 		var httpError api.HTTPError
 		err := api.HandleHTTPError(resp)
@@ -0,0 +1,158 @@
+package create
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmd/gpg-key/add"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/safeexec"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HTTPClient func() (*http.Client, error)
+
+	Name  string
+	Email string
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Generate a GPG key, add it to your GitHub account, and set it up for commit signing",
+		Long: heredoc.Doc(`
+			Generate a new GPG key, upload it to your GitHub account, and configure
+			git to sign commits with it, turning the usual multi-step "verified
+			commits" setup into one command.
+
+			The key's user ID uses --email (falling back to git's user.email) and
+			--name (falling back to git's user.name); --email is required, since
+			GitHub only verifies commits signed with a key whose user ID matches a
+			verified email address on the account.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Email == "" {
+				email, _ := git.Config("user.email")
+				opts.Email = email
+			}
+			if opts.Email == "" {
+				return cmdutil.FlagErrorf("no email given and no git user.email configured; use --email")
+			}
+			if opts.Name == "" {
+				name, _ := git.Config("user.name")
+				opts.Name = name
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Name, "name", "", "Real name for the new key (default: git's user.name)")
+	cmd.Flags().StringVar(&opts.Email, "email", "", "Email for the new key (default: git's user.email)")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	gpgExe, err := safeexec.LookPath("gpg")
+	if err != nil {
+		return fmt.Errorf("could not find gpg on the system: %w", err)
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	hostname, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	uid := opts.Email
+	if opts.Name != "" {
+		uid = fmt.Sprintf("%s <%s>", opts.Name, opts.Email)
+	}
+
+	genKeyCmd := exec.Command(gpgExe, "--batch", "--passphrase", "", "--quick-generate-key", uid, "default", "default", "never")
+	if err := run.PrepareCmd(genKeyCmd).Run(); err != nil {
+		return fmt.Errorf("failed to generate a GPG key: %w", err)
+	}
+
+	fingerprint, err := keyFingerprint(gpgExe, opts.Email)
+	if err != nil {
+		return err
+	}
+
+	exportCmd := exec.Command(gpgExe, "--armor", "--export", fingerprint)
+	armoredKey, err := run.PrepareCmd(exportCmd).Output()
+	if err != nil {
+		return fmt.Errorf("failed to export the new GPG key: %w", err)
+	}
+
+	if err := add.GPGKeyUpload(httpClient, hostname, bytes.NewReader(armoredKey)); err != nil {
+		return err
+	}
+
+	if err := git.SetGlobalConfig("user.signingkey", fingerprint); err != nil {
+		return err
+	}
+	if err := git.SetGlobalConfig("commit.gpgsign", "true"); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Generated a new GPG key, added it to your GitHub account, and configured git to sign commits with it\n", cs.SuccessIcon())
+	}
+	return nil
+}
+
+// keyFingerprint finds the fingerprint of the most recently generated secret key whose user ID
+// includes email, by parsing gpg's machine-readable --with-colons output.
+func keyFingerprint(gpgExe, email string) (string, error) {
+	listCmd := exec.Command(gpgExe, "--batch", "--with-colons", "--list-secret-keys", email)
+	out, err := run.PrepareCmd(listCmd).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to look up the new GPG key: %w", err)
+	}
+
+	var fingerprint string
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Split(line, ":")
+		if len(fields) > 9 && fields[0] == "fpr" {
+			fingerprint = fields[9]
+		}
+	}
+	if fingerprint == "" {
+		return "", fmt.Errorf("could not determine the fingerprint of the new GPG key")
+	}
+
+	return fingerprint, nil
+}
@@ -0,0 +1,58 @@
+package create
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_createRun(t *testing.T) {
+	cs, restoreRun := run.Stub()
+	defer restoreRun(t)
+
+	cs.Register(`gpg --batch --passphrase`, 0, "")
+	cs.Register(`gpg --batch --with-colons --list-secret-keys`, 0, "sec:::::::::::::\nfpr:::::::::ABCDEF1234567890ABCDEF1234567890ABCDEF12:\n")
+	cs.Register(`gpg --armor --export`, 0, "-----BEGIN PGP PUBLIC KEY BLOCK-----\n...\n-----END PGP PUBLIC KEY BLOCK-----\n")
+	cs.Register(`git config --global user\.signingkey`, 0, "", func(args []string) {
+		if got := args[len(args)-1]; got != "ABCDEF1234567890ABCDEF1234567890ABCDEF12" {
+			t.Errorf("git config user.signingkey set to %q", got)
+		}
+	})
+	cs.Register(`git config --global commit\.gpgsign`, 0, "", func(args []string) {
+		if got := args[len(args)-1]; got != "true" {
+			t.Errorf("git config commit.gpgsign set to %q", got)
+		}
+	})
+
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdinTTY(false)
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	tr := httpmock.Registry{}
+	defer tr.Verify(t)
+	tr.Register(
+		httpmock.REST("POST", "user/gpg_keys"),
+		httpmock.StringResponse(`{}`))
+
+	err := createRun(&CreateOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &tr}, nil
+		},
+		Name:  "Mona Lisa",
+		Email: "mona@github.com",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Generated a new GPG key, added it to your GitHub account, and configured git to sign commits with it\n", stderr.String())
+}
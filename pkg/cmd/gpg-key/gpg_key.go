@@ -2,6 +2,7 @@ package key
 
 import (
 	cmdAdd "github.com/cli/cli/v2/pkg/cmd/gpg-key/add"
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/gpg-key/create"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/gpg-key/list"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -16,6 +17,7 @@ func NewCmdGPGKey(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,274 @@
+package init
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	authLoginCmd "github.com/cli/cli/v2/pkg/cmd/auth/login"
+	extensionCmd "github.com/cli/cli/v2/pkg/cmd/extension"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type InitOptions struct {
+	IO      *iostreams.IOStreams
+	Config  func() (config.Config, error)
+	Factory *cmdutil.Factory
+}
+
+func NewCmdInit(f *cmdutil.Factory, runF func(*InitOptions) error) *cobra.Command {
+	opts := &InitOptions{
+		IO:      f.IOStreams,
+		Config:  f.Config,
+		Factory: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Interactively set up gh for first use",
+		Long: heredoc.Doc(`
+			Walk through authenticating with GitHub and configuring gh's defaults.
+
+			This prompts for the git protocol to use along with a preferred editor
+			and pager, then offers to create a few common aliases and install
+			extensions. Run this once when setting up gh on a new machine, or to
+			help a team standardize on the same settings.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !opts.IO.CanPrompt() {
+				return cmdutil.FlagErrorf("gh init requires an interactive terminal")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return initRun(opts)
+		},
+	}
+
+	cmdutil.DisableAuthCheck(cmd)
+
+	return cmd
+}
+
+func initRun(opts *InitOptions) error {
+	io := opts.IO
+	cs := io.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(io.Out, cs.Bold("Welcome to GitHub CLI!"))
+	fmt.Fprintln(io.Out, "Let's get you set up.")
+	fmt.Fprintln(io.Out)
+
+	if err := initAuth(opts, cfg); err != nil {
+		return err
+	}
+
+	if err := initPreferences(opts, cfg); err != nil {
+		return err
+	}
+
+	if err := cfg.Write(); err != nil {
+		return err
+	}
+
+	if err := initAliases(opts, cfg); err != nil {
+		return err
+	}
+
+	if err := initExtensions(opts); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(io.Out, "\n%s gh is ready to go!\n", cs.SuccessIcon())
+	return nil
+}
+
+func initAuth(opts *InitOptions, cfg config.Config) error {
+	io := opts.IO
+
+	if hosts, _ := cfg.Hosts(); len(hosts) > 0 {
+		return nil
+	}
+
+	var wantsLogin bool
+	if err := prompt.SurveyAskOne(&survey.Confirm{
+		Message: "You are not logged into any GitHub hosts. Log in now?",
+		Default: true,
+	}, &wantsLogin); err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+	if !wantsLogin {
+		return nil
+	}
+
+	login := authLoginCmd.NewCmdLogin(opts.Factory, nil)
+	login.SilenceUsage = true
+	login.SilenceErrors = true
+	login.SetArgs([]string{})
+	login.SetIn(io.In)
+	login.SetOut(io.Out)
+	login.SetErr(io.ErrOut)
+	return login.Execute()
+}
+
+func initPreferences(opts *InitOptions, cfg config.Config) error {
+	io := opts.IO
+
+	protocol, err := cfg.GetOrDefault("", "git_protocol")
+	if err != nil {
+		protocol = "https"
+	}
+	if err := prompt.SurveyAskOne(&survey.Select{
+		Message: "What protocol should git use to talk to GitHub?",
+		Options: []string{"https", "ssh"},
+		Default: protocol,
+	}, &protocol); err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+	if err := cfg.Set("", "git_protocol", protocol); err != nil {
+		return err
+	}
+
+	editor, err := cfg.GetOrDefault("", "editor")
+	if err != nil {
+		editor = ""
+	}
+	if err := prompt.SurveyAskOne(&survey.Input{
+		Message: "What text editor should gh use? (leave blank to use $GH_EDITOR, $VISUAL, or $EDITOR)",
+		Default: editor,
+	}, &editor); err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+	if err := cfg.Set("", "editor", editor); err != nil {
+		return err
+	}
+
+	pager, err := cfg.GetOrDefault("", "pager")
+	if err != nil {
+		pager = ""
+	}
+	if err := prompt.SurveyAskOne(&survey.Input{
+		Message: "What pager should gh use for long output? (leave blank for the default)",
+		Default: pager,
+	}, &pager); err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+	if err := cfg.Set("", "pager", pager); err != nil {
+		return err
+	}
+
+	promptSetting, err := cfg.GetOrDefault("", "prompt")
+	if err != nil {
+		promptSetting = "enabled"
+	}
+	var interactivePrompting bool
+	if err := prompt.SurveyAskOne(&survey.Confirm{
+		Message: "Enable interactive prompting in the terminal?",
+		Default: promptSetting != "disabled",
+	}, &interactivePrompting); err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+	promptSetting = "enabled"
+	if !interactivePrompting {
+		promptSetting = "disabled"
+	}
+	if err := cfg.Set("", "prompt", promptSetting); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(io.Out, "%s Saved preferences\n", io.ColorScheme().SuccessIcon())
+	return nil
+}
+
+var commonAliases = []struct{ name, expansion string }{
+	{"co", "pr checkout"},
+	{"pv", "pr view"},
+	{"bugs", "issue list --label=bug"},
+}
+
+func initAliases(opts *InitOptions, cfg config.Config) error {
+	io := opts.IO
+
+	var wantsAliases bool
+	if err := prompt.SurveyAskOne(&survey.Confirm{
+		Message: "Add a few common aliases (co, pv, bugs)?",
+		Default: false,
+	}, &wantsAliases); err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+	if !wantsAliases {
+		return nil
+	}
+
+	aliasCfg, err := cfg.Aliases()
+	if err != nil {
+		return err
+	}
+
+	for _, a := range commonAliases {
+		if _, ok := aliasCfg.Get(a.name); ok {
+			continue
+		}
+		if err := aliasCfg.Add(a.name, a.expansion); err != nil {
+			return fmt.Errorf("could not create alias %q: %w", a.name, err)
+		}
+	}
+
+	if err := cfg.Write(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(io.Out, "%s Added aliases\n", io.ColorScheme().SuccessIcon())
+	return nil
+}
+
+func initExtensions(opts *InitOptions) error {
+	io := opts.IO
+
+	var names string
+	if err := prompt.SurveyAskOne(&survey.Input{
+		Message: "Install any extensions now? Enter a comma-separated list of repositories (leave blank to skip)",
+	}, &names); err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+	names = strings.TrimSpace(names)
+	if names == "" {
+		return nil
+	}
+
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, err := ghrepo.FromFullName(name); err != nil {
+			fmt.Fprintf(io.ErrOut, "%s %s is not a valid repository, skipping\n", io.ColorScheme().WarningIcon(), name)
+			continue
+		}
+
+		install := extensionCmd.NewCmdExtension(opts.Factory)
+		install.SilenceUsage = true
+		install.SilenceErrors = true
+		install.SetArgs([]string{"install", name})
+		install.SetIn(io.In)
+		install.SetOut(io.Out)
+		install.SetErr(io.ErrOut)
+		if err := install.Execute(); err != nil {
+			fmt.Fprintf(io.ErrOut, "%s could not install %s: %s\n", io.ColorScheme().FailureIcon(), name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package init
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdInit(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	io.SetStdinTTY(true)
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	f := &cmdutil.Factory{IOStreams: io}
+
+	var gotOpts *InitOptions
+	cmd := NewCmdInit(f, func(opts *InitOptions) error {
+		gotOpts = opts
+		return nil
+	})
+	cmd.SetArgs([]string{})
+
+	_, err := cmd.ExecuteC()
+	require.NoError(t, err)
+	assert.NotNil(t, gotOpts)
+}
+
+func TestNewCmdInit_nonTTY(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	io.SetStdinTTY(false)
+
+	cmd := NewCmdInit(&cmdutil.Factory{IOStreams: io}, func(opts *InitOptions) error {
+		return nil
+	})
+	cmd.SetArgs([]string{})
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "gh init requires an interactive terminal")
+}
+
+func TestInitRun_skipsLoginWhenAlreadyAuthenticated(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdinTTY(true)
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	cfg := config.NewFromString(heredocConfig)
+
+	as, teardown := prompt.InitAskStubber()
+	defer teardown()
+	//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
+	as.StubOne("https")
+	//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
+	as.StubOne("")
+	//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
+	as.StubOne("")
+	//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
+	as.StubOne(true)
+	//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
+	as.StubOne(false)
+	//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
+	as.StubOne("")
+
+	opts := &InitOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+		Factory: &cmdutil.Factory{
+			IOStreams: io,
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{}, nil
+			},
+		},
+	}
+
+	err := initRun(opts)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "gh is ready to go!")
+}
+
+var heredocConfig = `
+hosts:
+  github.com:
+    user: monalisa
+    oauth_token: abc123
+`
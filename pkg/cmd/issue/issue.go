@@ -11,6 +11,7 @@ import (
 	cmdReopen "github.com/cli/cli/v2/pkg/cmd/issue/reopen"
 	cmdStatus "github.com/cli/cli/v2/pkg/cmd/issue/status"
 	cmdTransfer "github.com/cli/cli/v2/pkg/cmd/issue/transfer"
+	cmdTriage "github.com/cli/cli/v2/pkg/cmd/issue/triage"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/issue/view"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -48,6 +49,7 @@ func NewCmdIssue(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
 	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
 	cmd.AddCommand(cmdTransfer.NewCmdTransfer(f, nil))
+	cmd.AddCommand(cmdTriage.NewCmdTriage(f, nil))
 
 	return cmd
 }
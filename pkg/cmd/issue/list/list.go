@@ -1,16 +1,13 @@
 package list
 
 import (
-	"context"
 	"fmt"
 	"net/http"
-	"strconv"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/config"
-	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	issueShared "github.com/cli/cli/v2/pkg/cmd/issue/shared"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
@@ -18,8 +15,6 @@ import (
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/utils"
-	graphql "github.com/cli/shurcooL-graphql"
-	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
 )
 
@@ -37,14 +32,15 @@ type ListOptions struct {
 	WebMode  bool
 	Exporter cmdutil.Exporter
 
-	Assignee     string
-	Labels       []string
-	State        string
-	LimitResults int
-	Author       string
-	Mention      string
-	Milestone    string
-	Search       string
+	Assignee       string
+	Labels         []string
+	State          string
+	LimitResults   int
+	Author         string
+	Mention        string
+	Milestone      string
+	Search         string
+	SearchComments bool
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -56,6 +52,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	}
 
 	var appAuthor string
+	var filterName string
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -72,6 +69,8 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 			$ gh issue list --assignee "@me"
 			$ gh issue list --milestone "The big 1.0"
 			$ gh issue list --search "error no:assignee sort:created-asc"
+			$ gh issue list --search "error" --search-comments
+			$ gh issue list --filter my-triage
 		`),
 		Aliases: []string{"ls"},
 		Args:    cmdutil.NoArgsQuoteReminder,
@@ -91,6 +90,14 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				opts.Author = fmt.Sprintf("app/%s", appAuthor)
 			}
 
+			if filterName != "" {
+				query, err := shared.SavedSearchQuery(opts.Config, filterName)
+				if err != nil {
+					return err
+				}
+				opts.Search = strings.TrimSpace(fmt.Sprintf("%s %s", opts.Search, query))
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -108,11 +115,22 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVar(&opts.Mention, "mention", "", "Filter by mention")
 	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone number or title")
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search issues with `query`")
+	cmd.Flags().BoolVar(&opts.SearchComments, "search-comments", false, "Include comment bodies in the search")
+	cmd.Flags().StringVar(&filterName, "filter", "", "Use a search saved with `gh search save`, by `name`")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
 
 	return cmd
 }
 
+func wantsComments(fields []string) bool {
+	for _, f := range fields {
+		if f == "comments" {
+			return true
+		}
+	}
+	return false
+}
+
 var defaultFields = []string{
 	"number",
 	"title",
@@ -139,15 +157,16 @@ func listRun(opts *ListOptions) error {
 	}
 
 	filterOptions := prShared.FilterOptions{
-		Entity:    "issue",
-		State:     issueState,
-		Assignee:  opts.Assignee,
-		Labels:    opts.Labels,
-		Author:    opts.Author,
-		Mention:   opts.Mention,
-		Milestone: opts.Milestone,
-		Search:    opts.Search,
-		Fields:    defaultFields,
+		Entity:         "issue",
+		State:          issueState,
+		Assignee:       opts.Assignee,
+		Labels:         opts.Labels,
+		Author:         opts.Author,
+		Mention:        opts.Mention,
+		Milestone:      opts.Milestone,
+		Search:         opts.Search,
+		SearchComments: opts.SearchComments,
+		Fields:         defaultFields,
 	}
 
 	isTerminal := opts.IO.IsStdoutTTY()
@@ -169,11 +188,19 @@ func listRun(opts *ListOptions) error {
 		filterOptions.Fields = opts.Exporter.Fields()
 	}
 
-	listResult, err := issueList(httpClient, baseRepo, filterOptions, opts.LimitResults)
+	listResult, err := issueShared.ListIssues(httpClient, baseRepo, filterOptions, opts.LimitResults)
 	if err != nil {
 		return err
 	}
 
+	if opts.Exporter != nil && wantsComments(opts.Exporter.Fields()) {
+		for i := range listResult.Issues {
+			if err := issueShared.PreloadComments(httpClient, baseRepo, &listResult.Issues[i]); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := opts.IO.StartPager(); err == nil {
 		defer opts.IO.StopPager()
 	} else {
@@ -196,60 +223,3 @@ func listRun(opts *ListOptions) error {
 
 	return nil
 }
-
-func issueList(client *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.IssuesAndTotalCount, error) {
-	apiClient := api.NewClientFromHTTP(client)
-
-	if filters.Search != "" || len(filters.Labels) > 0 || filters.Milestone != "" {
-		if milestoneNumber, err := strconv.ParseInt(filters.Milestone, 10, 32); err == nil {
-			milestone, err := milestoneByNumber(client, repo, int32(milestoneNumber))
-			if err != nil {
-				return nil, err
-			}
-			filters.Milestone = milestone.Title
-		}
-
-		return searchIssues(apiClient, repo, filters, limit)
-	}
-
-	var err error
-	meReplacer := shared.NewMeReplacer(apiClient, repo.RepoHost())
-	filters.Assignee, err = meReplacer.Replace(filters.Assignee)
-	if err != nil {
-		return nil, err
-	}
-	filters.Author, err = meReplacer.Replace(filters.Author)
-	if err != nil {
-		return nil, err
-	}
-	filters.Mention, err = meReplacer.Replace(filters.Mention)
-	if err != nil {
-		return nil, err
-	}
-
-	return listIssues(apiClient, repo, filters, limit)
-}
-
-func milestoneByNumber(client *http.Client, repo ghrepo.Interface, number int32) (*api.RepoMilestone, error) {
-	var query struct {
-		Repository struct {
-			Milestone *api.RepoMilestone `graphql:"milestone(number: $number)"`
-		} `graphql:"repository(owner: $owner, name: $name)"`
-	}
-
-	variables := map[string]interface{}{
-		"owner":  githubv4.String(repo.RepoOwner()),
-		"name":   githubv4.String(repo.RepoName()),
-		"number": githubv4.Int(number),
-	}
-
-	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), client)
-	if err := gql.QueryNamed(context.Background(), "RepositoryMilestoneByNumber", &query, variables); err != nil {
-		return nil, err
-	}
-	if query.Repository.Milestone == nil {
-		return nil, fmt.Errorf("no milestone found with number '%d'", number)
-	}
-
-	return query.Repository.Milestone, nil
-}
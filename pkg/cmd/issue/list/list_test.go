@@ -11,7 +11,6 @@ import (
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/run"
-	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -162,6 +161,91 @@ No issues match your search in OWNER/REPO
 `, output.String())
 }
 
+func TestIssueList_tty_withFilterFlag(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.GraphQLQuery(`
+		{ "data": {	"repository": { "hasIssuesEnabled": true },
+			"search": { "issueCount": 0, "nodes": [] }
+		} }`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, "is:open label:triage repo:OWNER/REPO state:open type:issue", params["query"].(string))
+		}))
+
+	mainBuf := bytes.Buffer{}
+	hostsBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, &hostsBuf)()
+
+	cfg := config.NewBlankConfig()
+	queriesCfg, err := cfg.Queries()
+	assert.NoError(t, err)
+	assert.NoError(t, queriesCfg.Add("my-triage", "issues", "is:open label:triage"))
+
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdList(factory, nil)
+	argv, err := shlex.Split("--filter my-triage")
+	assert.NoError(t, err)
+	cmd.SetArgs(argv)
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, `
+No issues match your search in OWNER/REPO
+
+`, stdout.String())
+}
+
+func TestIssueList_tty_withSearchCommentsFlag(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.GraphQLQuery(`
+		{ "data": {	"repository": { "hasIssuesEnabled": true },
+			"search": { "issueCount": 0, "nodes": [] }
+		} }`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, "error in:comments repo:OWNER/REPO state:open type:issue", params["query"].(string))
+		}))
+
+	output, err := runCommand(http, true, `--search "error" --search-comments`)
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+	assert.Equal(t, `
+No issues match your search in OWNER/REPO
+
+`, output.String())
+}
+
 func TestIssueList_withInvalidLimitFlag(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -229,235 +313,3 @@ func TestIssueList_web(t *testing.T) {
 	assert.Equal(t, "Opening github.com/OWNER/REPO/issues in your browser.\n", stderr.String())
 	browser.Verify(t, "https://github.com/OWNER/REPO/issues?q=assignee%3Apeter+author%3Ajohn+label%3Abug+label%3Adocs+mentions%3Afrank+milestone%3Av1.1+state%3Aall+type%3Aissue")
 }
-
-func Test_issueList(t *testing.T) {
-	type args struct {
-		repo    ghrepo.Interface
-		filters prShared.FilterOptions
-		limit   int
-	}
-	tests := []struct {
-		name      string
-		args      args
-		httpStubs func(*httpmock.Registry)
-		wantErr   bool
-	}{
-		{
-			name: "default",
-			args: args{
-				limit: 30,
-				repo:  ghrepo.New("OWNER", "REPO"),
-				filters: prShared.FilterOptions{
-					Entity: "issue",
-					State:  "open",
-				},
-			},
-			httpStubs: func(reg *httpmock.Registry) {
-				reg.Register(
-					httpmock.GraphQL(`query IssueList\b`),
-					httpmock.GraphQLQuery(`
-					{ "data": {	"repository": {
-						"hasIssuesEnabled": true,
-						"issues": { "nodes": [] }
-					} } }`, func(_ string, params map[string]interface{}) {
-						assert.Equal(t, map[string]interface{}{
-							"owner":  "OWNER",
-							"repo":   "REPO",
-							"limit":  float64(30),
-							"states": []interface{}{"OPEN"},
-						}, params)
-					}))
-			},
-		},
-		{
-			name: "milestone by number",
-			args: args{
-				limit: 30,
-				repo:  ghrepo.New("OWNER", "REPO"),
-				filters: prShared.FilterOptions{
-					Entity:    "issue",
-					State:     "open",
-					Milestone: "13",
-				},
-			},
-			httpStubs: func(reg *httpmock.Registry) {
-				reg.Register(
-					httpmock.GraphQL(`query RepositoryMilestoneByNumber\b`),
-					httpmock.StringResponse(`
-					{ "data": { "repository": { "milestone": {
-						"title": "1.x"
-					} } } }
-					`))
-				reg.Register(
-					httpmock.GraphQL(`query IssueSearch\b`),
-					httpmock.GraphQLQuery(`
-					{ "data": {
-						"repository": { "hasIssuesEnabled": true },
-						"search": {
-							"issueCount": 0,
-							"nodes": []
-						}
-					} }`, func(_ string, params map[string]interface{}) {
-						assert.Equal(t, map[string]interface{}{
-							"owner": "OWNER",
-							"repo":  "REPO",
-							"limit": float64(30),
-							"query": "milestone:1.x repo:OWNER/REPO state:open type:issue",
-							"type":  "ISSUE",
-						}, params)
-					}))
-			},
-		},
-		{
-			name: "milestone by title",
-			args: args{
-				limit: 30,
-				repo:  ghrepo.New("OWNER", "REPO"),
-				filters: prShared.FilterOptions{
-					Entity:    "issue",
-					State:     "open",
-					Milestone: "1.x",
-				},
-			},
-			httpStubs: func(reg *httpmock.Registry) {
-				reg.Register(
-					httpmock.GraphQL(`query IssueSearch\b`),
-					httpmock.GraphQLQuery(`
-					{ "data": {
-						"repository": { "hasIssuesEnabled": true },
-						"search": {
-							"issueCount": 0,
-							"nodes": []
-						}
-					} }`, func(_ string, params map[string]interface{}) {
-						assert.Equal(t, map[string]interface{}{
-							"owner": "OWNER",
-							"repo":  "REPO",
-							"limit": float64(30),
-							"query": "milestone:1.x repo:OWNER/REPO state:open type:issue",
-							"type":  "ISSUE",
-						}, params)
-					}))
-			},
-		},
-		{
-			name: "@me syntax",
-			args: args{
-				limit: 30,
-				repo:  ghrepo.New("OWNER", "REPO"),
-				filters: prShared.FilterOptions{
-					Entity:   "issue",
-					State:    "open",
-					Author:   "@me",
-					Assignee: "@me",
-					Mention:  "@me",
-				},
-			},
-			httpStubs: func(reg *httpmock.Registry) {
-				reg.Register(
-					httpmock.GraphQL(`query UserCurrent\b`),
-					httpmock.StringResponse(`{"data": {"viewer": {"login": "monalisa"} } }`))
-				reg.Register(
-					httpmock.GraphQL(`query IssueList\b`),
-					httpmock.GraphQLQuery(`
-					{ "data": {	"repository": {
-						"hasIssuesEnabled": true,
-						"issues": { "nodes": [] }
-					} } }`, func(_ string, params map[string]interface{}) {
-						assert.Equal(t, map[string]interface{}{
-							"owner":    "OWNER",
-							"repo":     "REPO",
-							"limit":    float64(30),
-							"states":   []interface{}{"OPEN"},
-							"assignee": "monalisa",
-							"author":   "monalisa",
-							"mention":  "monalisa",
-						}, params)
-					}))
-			},
-		},
-		{
-			name: "@me with search",
-			args: args{
-				limit: 30,
-				repo:  ghrepo.New("OWNER", "REPO"),
-				filters: prShared.FilterOptions{
-					Entity:   "issue",
-					State:    "open",
-					Author:   "@me",
-					Assignee: "@me",
-					Mention:  "@me",
-					Search:   "auth bug",
-				},
-			},
-			httpStubs: func(reg *httpmock.Registry) {
-				reg.Register(
-					httpmock.GraphQL(`query IssueSearch\b`),
-					httpmock.GraphQLQuery(`
-					{ "data": {
-						"repository": { "hasIssuesEnabled": true },
-						"search": {
-							"issueCount": 0,
-							"nodes": []
-						}
-					} }`, func(_ string, params map[string]interface{}) {
-						assert.Equal(t, map[string]interface{}{
-							"owner": "OWNER",
-							"repo":  "REPO",
-							"limit": float64(30),
-							"query": "auth bug assignee:@me author:@me mentions:@me repo:OWNER/REPO state:open type:issue",
-							"type":  "ISSUE",
-						}, params)
-					}))
-			},
-		},
-		{
-			name: "with labels",
-			args: args{
-				limit: 30,
-				repo:  ghrepo.New("OWNER", "REPO"),
-				filters: prShared.FilterOptions{
-					Entity: "issue",
-					State:  "open",
-					Labels: []string{"hello", "one world"},
-				},
-			},
-			httpStubs: func(reg *httpmock.Registry) {
-				reg.Register(
-					httpmock.GraphQL(`query IssueSearch\b`),
-					httpmock.GraphQLQuery(`
-					{ "data": {
-						"repository": { "hasIssuesEnabled": true },
-						"search": {
-							"issueCount": 0,
-							"nodes": []
-						}
-					} }`, func(_ string, params map[string]interface{}) {
-						assert.Equal(t, map[string]interface{}{
-							"owner": "OWNER",
-							"repo":  "REPO",
-							"limit": float64(30),
-							"query": `label:"one world" label:hello repo:OWNER/REPO state:open type:issue`,
-							"type":  "ISSUE",
-						}, params)
-					}))
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			httpreg := &httpmock.Registry{}
-			defer httpreg.Verify(t)
-			if tt.httpStubs != nil {
-				tt.httpStubs(httpreg)
-			}
-			client := &http.Client{Transport: httpreg}
-			_, err := issueList(client, tt.args.repo, tt.args.filters, tt.args.limit)
-			if tt.wantErr {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-			}
-		})
-	}
-}
@@ -1,13 +1,55 @@
-package list
+package shared
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strconv"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
 )
 
+// ListIssues fetches issues in repo matching filters, preferring the Repository.issues GraphQL
+// connection and falling back to the search API when filters require it (a free-text search, a
+// label filter, or a milestone filter).
+func ListIssues(client *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.IssuesAndTotalCount, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	if filters.Search != "" || len(filters.Labels) > 0 || filters.Milestone != "" || filters.SearchComments {
+		if milestoneNumber, err := strconv.ParseInt(filters.Milestone, 10, 32); err == nil {
+			milestone, err := milestoneByNumber(client, repo, int32(milestoneNumber))
+			if err != nil {
+				return nil, err
+			}
+			filters.Milestone = milestone.Title
+		}
+
+		return searchIssues(apiClient, repo, filters, limit)
+	}
+
+	meReplacer := prShared.NewMeReplacer(apiClient, repo.RepoHost())
+	var err error
+	filters.Assignee, err = meReplacer.Replace(filters.Assignee)
+	if err != nil {
+		return nil, err
+	}
+	filters.Author, err = meReplacer.Replace(filters.Author)
+	if err != nil {
+		return nil, err
+	}
+	filters.Mention, err = meReplacer.Replace(filters.Mention)
+	if err != nil {
+		return nil, err
+	}
+
+	return listIssues(apiClient, repo, filters, limit)
+}
+
 func listIssues(client *api.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.IssuesAndTotalCount, error) {
 	var states []string
 	switch filters.State {
@@ -190,6 +232,70 @@ loop:
 	return &ic, nil
 }
 
+// PreloadComments fetches any comment pages beyond the first 100 for issue, so that `--json
+// comments` reflects the full discussion instead of being silently capped.
+func PreloadComments(client *http.Client, repo ghrepo.Interface, issue *api.Issue) error {
+	if !issue.Comments.PageInfo.HasNextPage {
+		return nil
+	}
+
+	type response struct {
+		Node struct {
+			Issue struct {
+				Comments api.Comments `graphql:"comments(first: 100, after: $endCursor)"`
+			} `graphql:"...on Issue"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id":        githubv4.ID(issue.ID),
+		"endCursor": githubv4.String(issue.Comments.PageInfo.EndCursor),
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), client)
+	for {
+		var query response
+		err := gql.QueryNamed(context.Background(), "CommentsForIssue", &query, variables)
+		if err != nil {
+			return err
+		}
+
+		comments := query.Node.Issue.Comments
+		issue.Comments.Nodes = append(issue.Comments.Nodes, comments.Nodes...)
+		if !comments.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = githubv4.String(comments.PageInfo.EndCursor)
+	}
+
+	issue.Comments.PageInfo.HasNextPage = false
+	return nil
+}
+
+func milestoneByNumber(client *http.Client, repo ghrepo.Interface, number int32) (*api.RepoMilestone, error) {
+	var query struct {
+		Repository struct {
+			Milestone *api.RepoMilestone `graphql:"milestone(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(repo.RepoOwner()),
+		"name":   githubv4.String(repo.RepoName()),
+		"number": githubv4.Int(number),
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), client)
+	if err := gql.QueryNamed(context.Background(), "RepositoryMilestoneByNumber", &query, variables); err != nil {
+		return nil, err
+	}
+	if query.Repository.Milestone == nil {
+		return nil, fmt.Errorf("no milestone found with number '%d'", number)
+	}
+
+	return query.Repository.Milestone, nil
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
@@ -0,0 +1,478 @@
+package shared
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueList(t *testing.T) {
+	http := &httpmock.Registry{}
+	client := api.NewClient(api.ReplaceTripper(http))
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issues": {
+					"nodes": [],
+					"pageInfo": {
+						"hasNextPage": true,
+						"endCursor": "ENDCURSOR"
+					}
+				}
+			} } }
+		`),
+	)
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issues": {
+					"nodes": [],
+					"pageInfo": {
+						"hasNextPage": false,
+						"endCursor": "ENDCURSOR"
+					}
+				}
+			} } }
+			`),
+	)
+
+	repo, _ := ghrepo.FromFullName("OWNER/REPO")
+	filters := prShared.FilterOptions{
+		Entity: "issue",
+		State:  "open",
+	}
+	_, err := listIssues(client, repo, filters, 251)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(http.Requests) != 2 {
+		t.Fatalf("expected 2 HTTP requests, seen %d", len(http.Requests))
+	}
+	var reqBody struct {
+		Query     string
+		Variables map[string]interface{}
+	}
+
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[0].Body)
+	_ = json.Unmarshal(bodyBytes, &reqBody)
+	if reqLimit := reqBody.Variables["limit"].(float64); reqLimit != 100 {
+		t.Errorf("expected 100, got %v", reqLimit)
+	}
+	if _, cursorPresent := reqBody.Variables["endCursor"]; cursorPresent {
+		t.Error("did not expect first request to pass 'endCursor'")
+	}
+
+	bodyBytes, _ = ioutil.ReadAll(http.Requests[1].Body)
+	_ = json.Unmarshal(bodyBytes, &reqBody)
+	if endCursor := reqBody.Variables["endCursor"].(string); endCursor != "ENDCURSOR" {
+		t.Errorf("expected %q, got %q", "ENDCURSOR", endCursor)
+	}
+}
+
+func TestIssueList_pagination(t *testing.T) {
+	http := &httpmock.Registry{}
+	client := api.NewClient(api.ReplaceTripper(http))
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issues": {
+					"nodes": [
+						{
+							"title": "issue1",
+							"labels": { "nodes": [ { "name": "bug" } ], "totalCount": 1 },
+							"assignees": { "nodes": [ { "login": "user1" } ], "totalCount": 1 }
+						}
+					],
+					"pageInfo": {
+						"hasNextPage": true,
+						"endCursor": "ENDCURSOR"
+					},
+					"totalCount": 2
+				}
+			} } }
+			`),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issues": {
+					"nodes": [
+						{
+							"title": "issue2",
+							"labels": { "nodes": [ { "name": "enhancement" } ], "totalCount": 1 },
+							"assignees": { "nodes": [ { "login": "user2" } ], "totalCount": 1 }
+						}
+					],
+					"pageInfo": {
+						"hasNextPage": false,
+						"endCursor": "ENDCURSOR"
+					},
+					"totalCount": 2
+				}
+			} } }
+			`),
+	)
+
+	repo := ghrepo.New("OWNER", "REPO")
+	res, err := listIssues(client, repo, prShared.FilterOptions{}, 0)
+	if err != nil {
+		t.Fatalf("IssueList() error = %v", err)
+	}
+
+	assert.Equal(t, 2, res.TotalCount)
+	assert.Equal(t, 2, len(res.Issues))
+
+	getLabels := func(i api.Issue) []string {
+		var labels []string
+		for _, l := range i.Labels.Nodes {
+			labels = append(labels, l.Name)
+		}
+		return labels
+	}
+	getAssignees := func(i api.Issue) []string {
+		var logins []string
+		for _, u := range i.Assignees.Nodes {
+			logins = append(logins, u.Login)
+		}
+		return logins
+	}
+
+	assert.Equal(t, []string{"bug"}, getLabels(res.Issues[0]))
+	assert.Equal(t, []string{"user1"}, getAssignees(res.Issues[0]))
+	assert.Equal(t, []string{"enhancement"}, getLabels(res.Issues[1]))
+	assert.Equal(t, []string{"user2"}, getAssignees(res.Issues[1]))
+}
+
+func TestListIssues(t *testing.T) {
+	type args struct {
+		repo    ghrepo.Interface
+		filters prShared.FilterOptions
+		limit   int
+	}
+	tests := []struct {
+		name      string
+		args      args
+		httpStubs func(*httpmock.Registry)
+		wantErr   bool
+	}{
+		{
+			name: "default",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity: "issue",
+					State:  "open",
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query IssueList\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {	"repository": {
+						"hasIssuesEnabled": true,
+						"issues": { "nodes": [] }
+					} } }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner":  "OWNER",
+							"repo":   "REPO",
+							"limit":  float64(30),
+							"states": []interface{}{"OPEN"},
+						}, params)
+					}))
+			},
+		},
+		{
+			name: "milestone by number",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity:    "issue",
+					State:     "open",
+					Milestone: "13",
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryMilestoneByNumber\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": { "milestone": {
+						"title": "1.x"
+					} } } }
+					`))
+				reg.Register(
+					httpmock.GraphQL(`query IssueSearch\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {
+						"repository": { "hasIssuesEnabled": true },
+						"search": {
+							"issueCount": 0,
+							"nodes": []
+						}
+					} }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner": "OWNER",
+							"repo":  "REPO",
+							"limit": float64(30),
+							"query": "milestone:1.x repo:OWNER/REPO state:open type:issue",
+							"type":  "ISSUE",
+						}, params)
+					}))
+			},
+		},
+		{
+			name: "milestone by title",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity:    "issue",
+					State:     "open",
+					Milestone: "1.x",
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query IssueSearch\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {
+						"repository": { "hasIssuesEnabled": true },
+						"search": {
+							"issueCount": 0,
+							"nodes": []
+						}
+					} }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner": "OWNER",
+							"repo":  "REPO",
+							"limit": float64(30),
+							"query": "milestone:1.x repo:OWNER/REPO state:open type:issue",
+							"type":  "ISSUE",
+						}, params)
+					}))
+			},
+		},
+		{
+			name: "@me syntax",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity:   "issue",
+					State:    "open",
+					Author:   "@me",
+					Assignee: "@me",
+					Mention:  "@me",
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data": {"viewer": {"login": "monalisa"} } }`))
+				reg.Register(
+					httpmock.GraphQL(`query IssueList\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {	"repository": {
+						"hasIssuesEnabled": true,
+						"issues": { "nodes": [] }
+					} } }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner":    "OWNER",
+							"repo":     "REPO",
+							"limit":    float64(30),
+							"states":   []interface{}{"OPEN"},
+							"assignee": "monalisa",
+							"author":   "monalisa",
+							"mention":  "monalisa",
+						}, params)
+					}))
+			},
+		},
+		{
+			name: "@me with search",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity:   "issue",
+					State:    "open",
+					Author:   "@me",
+					Assignee: "@me",
+					Mention:  "@me",
+					Search:   "auth bug",
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query IssueSearch\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {
+						"repository": { "hasIssuesEnabled": true },
+						"search": {
+							"issueCount": 0,
+							"nodes": []
+						}
+					} }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner": "OWNER",
+							"repo":  "REPO",
+							"limit": float64(30),
+							"query": "auth bug assignee:@me author:@me mentions:@me repo:OWNER/REPO state:open type:issue",
+							"type":  "ISSUE",
+						}, params)
+					}))
+			},
+		},
+		{
+			name: "with search comments",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity:         "issue",
+					State:          "open",
+					Search:         "auth bug",
+					SearchComments: true,
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query IssueSearch\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {
+						"repository": { "hasIssuesEnabled": true },
+						"search": {
+							"issueCount": 0,
+							"nodes": []
+						}
+					} }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner": "OWNER",
+							"repo":  "REPO",
+							"limit": float64(30),
+							"query": "auth bug in:comments repo:OWNER/REPO state:open type:issue",
+							"type":  "ISSUE",
+						}, params)
+					}))
+			},
+		},
+		{
+			name: "with labels",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity: "issue",
+					State:  "open",
+					Labels: []string{"hello", "one world"},
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query IssueSearch\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {
+						"repository": { "hasIssuesEnabled": true },
+						"search": {
+							"issueCount": 0,
+							"nodes": []
+						}
+					} }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner": "OWNER",
+							"repo":  "REPO",
+							"limit": float64(30),
+							"query": `label:"one world" label:hello repo:OWNER/REPO state:open type:issue`,
+							"type":  "ISSUE",
+						}, params)
+					}))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpreg := &httpmock.Registry{}
+			defer httpreg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(httpreg)
+			}
+			client := &http.Client{Transport: httpreg}
+			_, err := ListIssues(client, tt.args.repo, tt.args.filters, tt.args.limit)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPreloadComments(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query CommentsForIssue\b`),
+		httpmock.StringResponse(`
+			{ "data": { "node": { "comments": {
+				"nodes": [ { "body": "comment 2" } ],
+				"pageInfo": { "hasNextPage": true, "endCursor": "CURSOR2" },
+				"totalCount": 3
+			} } } }
+		`))
+	reg.Register(
+		httpmock.GraphQL(`query CommentsForIssue\b`),
+		httpmock.StringResponse(`
+			{ "data": { "node": { "comments": {
+				"nodes": [ { "body": "comment 3" } ],
+				"pageInfo": { "hasNextPage": false, "endCursor": "CURSOR3" },
+				"totalCount": 3
+			} } } }
+		`))
+
+	repo := ghrepo.New("OWNER", "REPO")
+	issue := &api.Issue{
+		ID: "ISSUE-ID",
+		Comments: api.Comments{
+			Nodes:      []api.Comment{{Body: "comment 1"}},
+			TotalCount: 3,
+			PageInfo: struct {
+				HasNextPage bool
+				EndCursor   string
+			}{HasNextPage: true, EndCursor: "CURSOR1"},
+		},
+	}
+
+	client := &http.Client{Transport: reg}
+	err := PreloadComments(client, repo, issue)
+	assert.NoError(t, err)
+
+	assert.False(t, issue.Comments.PageInfo.HasNextPage)
+	assert.Equal(t, []string{"comment 1", "comment 2", "comment 3"}, func() []string {
+		var bodies []string
+		for _, c := range issue.Comments.Nodes {
+			bodies = append(bodies, c.Body)
+		}
+		return bodies
+	}())
+}
@@ -71,13 +71,8 @@ func statusRun(opts *StatusOptions) error {
 		return err
 	}
 
-	currentUser, err := api.CurrentLoginName(apiClient, baseRepo.RepoHost())
-	if err != nil {
-		return err
-	}
-
 	options := api.IssueStatusOptions{
-		Username: currentUser,
+		Username: "@me",
 		Fields:   defaultFields,
 	}
 	if opts.Exporter != nil {
@@ -58,9 +58,6 @@ func TestIssueStatus(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
 
-	http.Register(
-		httpmock.GraphQL(`query UserCurrent\b`),
-		httpmock.StringResponse(`{"data":{"viewer":{"login":"octocat"}}}`))
 	http.Register(
 		httpmock.GraphQL(`query IssueStatus\b`),
 		httpmock.FileResponse("./fixtures/issueStatus.json"))
@@ -89,18 +86,15 @@ func TestIssueStatus_blankSlate(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
 
-	http.Register(
-		httpmock.GraphQL(`query UserCurrent\b`),
-		httpmock.StringResponse(`{"data":{"viewer":{"login":"octocat"}}}`))
 	http.Register(
 		httpmock.GraphQL(`query IssueStatus\b`),
 		httpmock.StringResponse(`
-		{ "data": { "repository": {
-			"hasIssuesEnabled": true,
-			"assigned": { "nodes": [] },
-			"mentioned": { "nodes": [] },
-			"authored": { "nodes": [] }
-		} } }`))
+		{ "data": {
+			"repository": { "hasIssuesEnabled": true },
+			"assigned": { "edges": [] },
+			"mentioned": { "edges": [] },
+			"authored": { "edges": [] }
+		} }`))
 
 	output, err := runCommand(http, true, "")
 	if err != nil {
@@ -129,9 +123,6 @@ func TestIssueStatus_disabledIssues(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
 
-	http.Register(
-		httpmock.GraphQL(`query UserCurrent\b`),
-		httpmock.StringResponse(`{"data":{"viewer":{"login":"octocat"}}}`))
 	http.Register(
 		httpmock.GraphQL(`query IssueStatus\b`),
 		httpmock.StringResponse(`
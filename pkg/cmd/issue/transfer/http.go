@@ -0,0 +1,83 @@
+package transfer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+func destLabelNames(httpClient *http.Client, repo ghrepo.Interface) (map[string]struct{}, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	query := `
+	query TransferDestLabels($owner: String!, $repo: String!, $endCursor: String) {
+		repository(owner: $owner, name: $repo) {
+			labels(first: 100, after: $endCursor) {
+				nodes {
+					name
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}
+
+	type responseData struct {
+		Repository struct {
+			Labels struct {
+				Nodes    []struct{ Name string }
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			}
+		}
+	}
+
+	names := map[string]struct{}{}
+	for {
+		var response responseData
+		if err := apiClient.GraphQL(repo.RepoHost(), query, variables, &response); err != nil {
+			return nil, err
+		}
+		for _, label := range response.Repository.Labels.Nodes {
+			names[label.Name] = struct{}{}
+		}
+		if !response.Repository.Labels.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = response.Repository.Labels.PageInfo.EndCursor
+	}
+
+	return names, nil
+}
+
+func createLabel(httpClient *http.Client, repo ghrepo.Interface, name string) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/labels", repo.RepoOwner(), repo.RepoName())
+	requestByte, err := json.Marshal(map[string]string{"name": name})
+	if err != nil {
+		return err
+	}
+	return apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), nil)
+}
+
+func addLabels(httpClient *http.Client, repo ghrepo.Interface, issueNumber int, labels []string) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/issues/%d/labels", repo.RepoOwner(), repo.RepoName(), issueNumber)
+	requestByte, err := json.Marshal(map[string][]string{"labels": labels})
+	if err != nil {
+		return err
+	}
+	return apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), nil)
+}
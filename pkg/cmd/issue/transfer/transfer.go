@@ -1,15 +1,21 @@
 package transfer
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/issue/shared"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	graphql "github.com/cli/shurcooL-graphql"
@@ -23,8 +29,10 @@ type TransferOptions struct {
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 
-	IssueSelector    string
+	IssueSelectors   []string
 	DestRepoSelector string
+	Search           string
+	LabelMap         map[string]string
 }
 
 func NewCmdTransfer(f *cmdutil.Factory, runF func(*TransferOptions) error) *cobra.Command {
@@ -34,14 +42,52 @@ func NewCmdTransfer(f *cmdutil.Factory, runF func(*TransferOptions) error) *cobr
 		Config:     f.Config,
 	}
 
+	var mapLabels []string
+
 	cmd := &cobra.Command{
-		Use:   "transfer {<number> | <url>} <destination-repo>",
+		Use:   "transfer {<number> | <url> | -} [<number> | <url> ...] <destination-repo>",
 		Short: "Transfer issue to another repository",
-		Args:  cmdutil.ExactArgs(2, "issue and destination repository are required"),
+		Long: heredoc.Doc(`
+			Transfer one or more issues to another repository, recreating their labels there
+			if needed.
+
+			Issues can be given as one or more arguments, read one per line from standard
+			input with "-", or selected with "--search" against the current repository.
+		`),
+		Example: heredoc.Doc(`
+			$ gh issue transfer 1234 OWNER/REPO
+			$ gh issue transfer 1234 1235 1236 OWNER/REPO
+			$ gh issue transfer --search "label:triage" OWNER/REPO
+			$ gh issue transfer --map-labels bug=defect --map-labels p1=priority-1 1234 OWNER/REPO
+		`),
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.BaseRepo = f.BaseRepo
-			opts.IssueSelector = args[0]
-			opts.DestRepoSelector = args[1]
+
+			opts.DestRepoSelector = args[len(args)-1]
+			if len(args) > 1 {
+				opts.IssueSelectors = args[:len(args)-1]
+			}
+
+			if opts.Search == "" && len(opts.IssueSelectors) == 0 {
+				return cmdutil.FlagErrorf("issue and destination repository are required")
+			}
+			if opts.Search != "" && len(opts.IssueSelectors) > 0 {
+				return cmdutil.FlagErrorf("specify issues as arguments or `--search`, not both")
+			}
+			if len(opts.IssueSelectors) == 1 && opts.IssueSelectors[0] == "-" {
+				selectors, err := readSelectorsFromStdin(opts.IO)
+				if err != nil {
+					return err
+				}
+				opts.IssueSelectors = selectors
+			}
+
+			labelMap, err := parseLabelMap(mapLabels)
+			if err != nil {
+				return err
+			}
+			opts.LabelMap = labelMap
 
 			if runF != nil {
 				return runF(&opts)
@@ -51,6 +97,9 @@ func NewCmdTransfer(f *cmdutil.Factory, runF func(*TransferOptions) error) *cobr
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.Search, "search", "", "Transfer all issues matching a search query instead of listing them as arguments")
+	cmd.Flags().StringArrayVar(&mapLabels, "map-labels", nil, "Rename a label during transfer, as `old=new` (can be used multiple times)")
+
 	return cmd
 }
 
@@ -60,12 +109,14 @@ func transferRun(opts *TransferOptions) error {
 		return err
 	}
 
-	issue, baseRepo, err := shared.IssueFromArgWithFields(httpClient, opts.BaseRepo, opts.IssueSelector, []string{"id", "number"})
+	baseRepo, err := opts.BaseRepo()
 	if err != nil {
 		return err
 	}
-	if issue.IsPullRequest() {
-		return fmt.Errorf("issue #%d is a pull request and cannot be transferred", issue.Number)
+
+	issues, err := issuesToTransfer(httpClient, baseRepo, opts)
+	if err != nil {
+		return err
 	}
 
 	destRepo, err := ghrepo.FromFullNameWithHost(opts.DestRepoSelector, baseRepo.RepoHost())
@@ -73,13 +124,151 @@ func transferRun(opts *TransferOptions) error {
 		return err
 	}
 
-	url, err := issueTransfer(httpClient, issue.ID, destRepo)
+	apiClient := api.NewClientFromHTTP(httpClient)
+	destRepoInfo, err := api.GitHubRepo(apiClient, destRepo)
 	if err != nil {
 		return err
 	}
 
-	_, err = fmt.Fprintln(opts.IO.Out, url)
-	return err
+	if err := ensureDestLabels(httpClient, destRepo, issues, opts.LabelMap); err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		if issue.IsPullRequest() {
+			return fmt.Errorf("issue #%d is a pull request and cannot be transferred", issue.Number)
+		}
+
+		url, err := issueTransfer(httpClient, issue.ID, destRepoInfo)
+		if err != nil {
+			return fmt.Errorf("failed to transfer issue #%d: %w", issue.Number, err)
+		}
+
+		if _, err := fmt.Fprintln(opts.IO.Out, url); err != nil {
+			return err
+		}
+
+		if err := applyLabelMap(httpClient, destRepoInfo, url, issue, opts.LabelMap); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func issuesToTransfer(httpClient *http.Client, baseRepo ghrepo.Interface, opts *TransferOptions) ([]*api.Issue, error) {
+	fields := []string{"id", "number", "labels"}
+
+	if opts.Search != "" {
+		filters := prShared.FilterOptions{
+			Entity: "issue",
+			Search: opts.Search,
+			Fields: fields,
+		}
+		result, err := shared.ListIssues(httpClient, baseRepo, filters, 0)
+		if err != nil {
+			return nil, err
+		}
+		issues := make([]*api.Issue, len(result.Issues))
+		for i := range result.Issues {
+			issues[i] = &result.Issues[i]
+		}
+		return issues, nil
+	}
+
+	issues := make([]*api.Issue, 0, len(opts.IssueSelectors))
+	for _, selector := range opts.IssueSelectors {
+		issue, _, err := shared.IssueFromArgWithFields(httpClient, func() (ghrepo.Interface, error) { return baseRepo, nil }, selector, fields)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+func readSelectorsFromStdin(io *iostreams.IOStreams) ([]string, error) {
+	var selectors []string
+	scanner := bufio.NewScanner(io.In)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		selectors = append(selectors, line)
+	}
+	return selectors, scanner.Err()
+}
+
+func parseLabelMap(mapLabels []string) (map[string]string, error) {
+	if len(mapLabels) == 0 {
+		return nil, nil
+	}
+	labelMap := make(map[string]string, len(mapLabels))
+	for _, entry := range mapLabels {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid --map-labels value: %q (expected old=new)", entry)
+		}
+		labelMap[parts[0]] = parts[1]
+	}
+	return labelMap, nil
+}
+
+// ensureDestLabels creates any label in the destination repo that the issues being transferred
+// carry but that doesn't already exist there, applying --map-labels renames first.
+func ensureDestLabels(httpClient *http.Client, destRepo ghrepo.Interface, issues []*api.Issue, labelMap map[string]string) error {
+	wanted := map[string]struct{}{}
+	for _, issue := range issues {
+		for _, name := range issue.Labels.Names() {
+			if mapped, ok := labelMap[name]; ok {
+				name = mapped
+			}
+			wanted[name] = struct{}{}
+		}
+	}
+	if len(wanted) == 0 {
+		return nil
+	}
+
+	existing, err := destLabelNames(httpClient, destRepo)
+	if err != nil {
+		return err
+	}
+
+	for name := range wanted {
+		if _, ok := existing[name]; ok {
+			continue
+		}
+		if err := createLabel(httpClient, destRepo, name); err != nil {
+			return fmt.Errorf("could not recreate label %q in %s: %w", name, ghrepo.FullName(destRepo), err)
+		}
+	}
+	return nil
+}
+
+// applyLabelMap re-applies an issue's labels (after renaming per labelMap) to its transferred
+// copy, since transferIssue itself drops labels.
+func applyLabelMap(httpClient *http.Client, destRepo ghrepo.Interface, transferredURL string, issue *api.Issue, labelMap map[string]string) error {
+	names := issue.Labels.Names()
+	if len(names) == 0 {
+		return nil
+	}
+
+	number, err := issueNumberFromURL(transferredURL)
+	if err != nil {
+		return err
+	}
+
+	mapped := make([]string, len(names))
+	for i, name := range names {
+		if renamed, ok := labelMap[name]; ok {
+			name = renamed
+		}
+		mapped[i] = name
+	}
+
+	return addLabels(httpClient, destRepo, number, mapped)
 }
 
 func issueTransfer(httpClient *http.Client, issueID string, destRepo ghrepo.Interface) (string, error) {
@@ -114,3 +303,13 @@ func issueTransfer(httpClient *http.Client, issueID string, destRepo ghrepo.Inte
 	err := gql.MutateNamed(context.Background(), "IssueTransfer", &mutation, variables)
 	return mutation.TransferIssue.Issue.URL, err
 }
+
+var transferredIssueURLRE = regexp.MustCompile(`/issues/(\d+)$`)
+
+func issueNumberFromURL(url string) (int, error) {
+	m := transferredIssueURLRE.FindStringSubmatch(url)
+	if m == nil {
+		return 0, fmt.Errorf("could not determine issue number from %q", url)
+	}
+	return strconv.Atoi(m[1])
+}
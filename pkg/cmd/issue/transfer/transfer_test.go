@@ -65,10 +65,35 @@ func TestNewCmdTransfer(t *testing.T) {
 			name: "issue name",
 			cli:  "3252 OWNER/REPO",
 			wants: TransferOptions{
-				IssueSelector:    "3252",
+				IssueSelectors:   []string{"3252"},
 				DestRepoSelector: "OWNER/REPO",
 			},
 		},
+		{
+			name: "multiple issues",
+			cli:  "3252 3253 OWNER/REPO",
+			wants: TransferOptions{
+				IssueSelectors:   []string{"3252", "3253"},
+				DestRepoSelector: "OWNER/REPO",
+			},
+		},
+		{
+			name: "search",
+			cli:  "--search label:triage OWNER/REPO",
+			wants: TransferOptions{
+				Search:           "label:triage",
+				DestRepoSelector: "OWNER/REPO",
+			},
+		},
+		{
+			name: "map labels",
+			cli:  "--map-labels bug=defect 3252 OWNER/REPO",
+			wants: TransferOptions{
+				IssueSelectors:   []string{"3252"},
+				DestRepoSelector: "OWNER/REPO",
+				LabelMap:         map[string]string{"bug": "defect"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -90,8 +115,10 @@ func TestNewCmdTransfer(t *testing.T) {
 
 			_, cErr := cmd.ExecuteC()
 			assert.NoError(t, cErr)
-			assert.Equal(t, tt.wants.IssueSelector, gotOpts.IssueSelector)
+			assert.Equal(t, tt.wants.IssueSelectors, gotOpts.IssueSelectors)
 			assert.Equal(t, tt.wants.DestRepoSelector, gotOpts.DestRepoSelector)
+			assert.Equal(t, tt.wants.Search, gotOpts.Search)
+			assert.Equal(t, tt.wants.LabelMap, gotOpts.LabelMap)
 		})
 	}
 }
@@ -103,7 +130,7 @@ func Test_transferRun_noflags(t *testing.T) {
 	output, err := runCommand(http, "")
 
 	if err != nil {
-		assert.Equal(t, "issue and destination repository are required", err.Error())
+		assert.Equal(t, "requires at least 1 arg(s), only received 0", err.Error())
 	}
 
 	assert.Equal(t, "", output.String())
@@ -145,3 +172,64 @@ func Test_transferRunSuccessfulIssueTransfer(t *testing.T) {
 	}
 	assert.Equal(t, "https://github.com/OWNER1/REPO1/issues/1\n", output.String())
 }
+
+func Test_transferRunBulkTransferWithLabelRemapping(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "ISSUE-1", "number": 1234, "labels": { "nodes": [{ "name": "bug" }] } }
+			} } }`))
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "ISSUE-2", "number": 1235, "labels": { "nodes": [] } }
+			} } }`))
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+						"id": "dest-id",
+						"name": "REPO1",
+						"owner": { "login": "OWNER1" },
+						"viewerPermission": "WRITE",
+						"hasIssuesEnabled": true
+				}}}`))
+
+	http.Register(
+		httpmock.GraphQL(`query TransferDestLabels\b`),
+		httpmock.StringResponse(`{ "data": { "repository": { "labels": {
+			"nodes": [], "pageInfo": { "hasNextPage": false, "endCursor": "" }
+		} } } }`))
+	http.Register(
+		httpmock.REST("POST", "repos/OWNER1/REPO1/labels"),
+		httpmock.StringResponse(`{}`))
+
+	http.Register(
+		httpmock.GraphQL(`mutation IssueTransfer\b`),
+		httpmock.GraphQLMutation(`{"data":{"transferIssue":{"issue":{"url":"https://github.com/OWNER1/REPO1/issues/1"}}}}`, func(input map[string]interface{}) {
+			assert.Equal(t, input["issueId"], "ISSUE-1")
+		}))
+	http.Register(
+		httpmock.REST("POST", "repos/OWNER1/REPO1/issues/1/labels"),
+		httpmock.StringResponse(`{}`))
+
+	http.Register(
+		httpmock.GraphQL(`mutation IssueTransfer\b`),
+		httpmock.GraphQLMutation(`{"data":{"transferIssue":{"issue":{"url":"https://github.com/OWNER1/REPO1/issues/2"}}}}`, func(input map[string]interface{}) {
+			assert.Equal(t, input["issueId"], "ISSUE-2")
+		}))
+
+	output, err := runCommand(http, "--map-labels bug=defect 1234 1235 OWNER1/REPO1")
+	if err != nil {
+		t.Errorf("error running command `issue transfer`: %v", err)
+	}
+	assert.Equal(t, "https://github.com/OWNER1/REPO1/issues/1\nhttps://github.com/OWNER1/REPO1/issues/2\n", output.String())
+}
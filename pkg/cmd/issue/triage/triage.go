@@ -0,0 +1,359 @@
+package triage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	issueShared "github.com/cli/cli/v2/pkg/cmd/issue/shared"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+const (
+	actionLabel     = "Edit labels"
+	actionAssignee  = "Edit assignees"
+	actionMilestone = "Set milestone"
+	actionClose     = "Close"
+	actionComment   = "Comment"
+	actionSkip      = "Skip"
+	actionQuit      = "Quit"
+
+	noMilestone = "(none)"
+)
+
+type TriageOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Assignee     string
+	Labels       []string
+	State        string
+	Author       string
+	Milestone    string
+	Search       string
+	LimitResults int
+}
+
+// plannedAction is a mutation queued up while triaging an issue, to be sent to the API once the
+// interactive loop ends rather than one request per keystroke.
+type plannedAction struct {
+	issue    *api.Issue
+	editable prShared.Editable
+	close    bool
+	comment  string
+}
+
+func NewCmdTriage(f *cmdutil.Factory, runF func(*TriageOptions) error) *cobra.Command {
+	opts := &TriageOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "triage",
+		Short: "Interactively triage a list of issues",
+		Long: heredoc.Doc(`
+			Fetch a filtered list of issues and walk through them one at a time, choosing an
+			action for each: edit its labels, edit its assignees, set its milestone, close it,
+			or leave a comment. Chosen actions are applied once the list is exhausted or you
+			choose to quit.
+		`),
+		Example: heredoc.Doc(`
+			$ gh issue triage
+			$ gh issue triage --label "needs triage"
+			$ gh issue triage --assignee "@me"
+		`),
+		Args: cmdutil.NoArgsQuoteReminder,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.LimitResults < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.LimitResults)
+			}
+
+			if !opts.IO.CanPrompt() {
+				return cmdutil.FlagErrorf("triage requires an interactive terminal")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return triageRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
+	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Filter by label")
+	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "s", "open", []string{"open", "closed", "all"}, "Filter by state")
+	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 30, "Maximum number of issues to fetch")
+	cmd.Flags().StringVarP(&opts.Author, "author", "A", "", "Filter by author")
+	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone number or title")
+	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search issues with `query`")
+
+	return cmd
+}
+
+func triageRun(opts *TriageOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	filterOptions := prShared.FilterOptions{
+		Entity:    "issue",
+		State:     opts.State,
+		Assignee:  opts.Assignee,
+		Labels:    opts.Labels,
+		Author:    opts.Author,
+		Milestone: opts.Milestone,
+		Search:    opts.Search,
+		Fields:    []string{"id", "number", "title", "url", "state", "labels", "assignees", "milestone"},
+	}
+
+	listResult, err := issueShared.ListIssues(httpClient, baseRepo, filterOptions, opts.LimitResults)
+	if err != nil {
+		return err
+	}
+
+	if len(listResult.Issues) == 0 {
+		fmt.Fprintln(opts.IO.Out, "no issues match the given filters")
+		return nil
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	metadata, err := api.RepoMetadata(apiClient, baseRepo, api.RepoMetadataInput{
+		Assignees:  true,
+		Labels:     true,
+		Milestones: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	var assigneeOptions []string
+	for _, u := range metadata.AssignableUsers {
+		assigneeOptions = append(assigneeOptions, u.Login)
+	}
+	var labelOptions []string
+	for _, l := range metadata.Labels {
+		labelOptions = append(labelOptions, l.Name)
+	}
+	milestoneOptions := []string{noMilestone}
+	for _, m := range metadata.Milestones {
+		milestoneOptions = append(milestoneOptions, m.Title)
+	}
+
+	cs := opts.IO.ColorScheme()
+	var plans []plannedAction
+
+loop:
+	for i := range listResult.Issues {
+		issue := &listResult.Issues[i]
+		fmt.Fprintf(opts.IO.Out, "\n%s #%d %s\n%s\n", cs.Bold("Issue"), issue.Number, issue.Title, issue.URL)
+
+		action, err := selectSurvey("What would you like to do?", []string{
+			actionLabel, actionAssignee, actionMilestone, actionClose, actionComment, actionSkip, actionQuit,
+		})
+		if err != nil {
+			return err
+		}
+
+		switch action {
+		case actionQuit:
+			break loop
+		case actionSkip:
+			continue loop
+		case actionLabel:
+			editable := newEditable(*metadata)
+			editable.Labels.Edited = true
+			editable.Labels.Default = issue.Labels.Names()
+			editable.Labels.Options = labelOptions
+			selected, err := multiSelect("Labels", editable.Labels.Default, editable.Labels.Options)
+			if err != nil {
+				return err
+			}
+			editable.Labels.Add, editable.Labels.Remove = diffSelection(editable.Labels.Default, selected)
+			plans = append(plans, plannedAction{issue: issue, editable: editable})
+		case actionAssignee:
+			editable := newEditable(*metadata)
+			editable.Assignees.Edited = true
+			editable.Assignees.Default = issue.Assignees.Logins()
+			editable.Assignees.Options = assigneeOptions
+			selected, err := multiSelect("Assignees", editable.Assignees.Default, editable.Assignees.Options)
+			if err != nil {
+				return err
+			}
+			editable.Assignees.Value = selected
+			plans = append(plans, plannedAction{issue: issue, editable: editable})
+		case actionMilestone:
+			editable := newEditable(*metadata)
+			editable.Milestone.Edited = true
+			if issue.Milestone != nil {
+				editable.Milestone.Default = issue.Milestone.Title
+			} else {
+				editable.Milestone.Default = noMilestone
+			}
+			selected, err := selectSurvey("Milestone", milestoneOptions)
+			if err != nil {
+				return err
+			}
+			if selected == noMilestone {
+				selected = ""
+			}
+			editable.Milestone.Value = selected
+			plans = append(plans, plannedAction{issue: issue, editable: editable})
+		case actionClose:
+			plans = append(plans, plannedAction{issue: issue, close: true})
+		case actionComment:
+			body, err := commentSurvey()
+			if err != nil {
+				return err
+			}
+			if body != "" {
+				plans = append(plans, plannedAction{issue: issue, comment: body})
+			}
+		}
+	}
+
+	if len(plans) == 0 {
+		return nil
+	}
+
+	opts.IO.StartProgressIndicator()
+	err = applyPlans(httpClient, baseRepo, plans)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\n%s Applied %d action(s)\n", cs.SuccessIcon(), len(plans))
+
+	return nil
+}
+
+func newEditable(metadata api.RepoMetadataResult) prShared.Editable {
+	return prShared.Editable{Metadata: metadata}
+}
+
+// diffSelection compares the issue's current labels to the user's selection and returns the
+// labels to add and remove so that UpdateIssue only has to touch what actually changed.
+func diffSelection(current, selected []string) (add, remove []string) {
+	wasSelected := func(name string) bool {
+		for _, s := range selected {
+			if s == name {
+				return true
+			}
+		}
+		return false
+	}
+	isCurrent := func(name string) bool {
+		for _, c := range current {
+			if c == name {
+				return true
+			}
+		}
+		return false
+	}
+	for _, s := range selected {
+		if !isCurrent(s) {
+			add = append(add, s)
+		}
+	}
+	for _, c := range current {
+		if !wasSelected(c) {
+			remove = append(remove, c)
+		}
+	}
+	return add, remove
+}
+
+func applyPlans(httpClient *http.Client, repo ghrepo.Interface, plans []plannedAction) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	for _, plan := range plans {
+		if plan.editable.Dirty() {
+			if err := prShared.UpdateIssue(httpClient, repo, plan.issue.ID, plan.issue.IsPullRequest(), plan.editable); err != nil {
+				return err
+			}
+		}
+		if plan.close {
+			if err := closeIssue(httpClient, repo, plan.issue); err != nil {
+				return err
+			}
+		}
+		if plan.comment != "" {
+			params := api.CommentCreateInput{Body: plan.comment, SubjectId: plan.issue.ID}
+			if _, err := api.CommentCreate(apiClient, repo.RepoHost(), params); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func closeIssue(httpClient *http.Client, repo ghrepo.Interface, issue *api.Issue) error {
+	if issue.IsPullRequest() {
+		return api.PullRequestClose(httpClient, repo, issue.ID)
+	}
+
+	var mutation struct {
+		CloseIssue struct {
+			Issue struct {
+				ID githubv4.ID
+			}
+		} `graphql:"closeIssue(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.CloseIssueInput{
+			IssueID: issue.ID,
+		},
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), httpClient)
+	return gql.MutateNamed(context.Background(), "IssueClose", &mutation, variables)
+}
+
+func selectSurvey(message string, options []string) (string, error) {
+	var result string
+	err := prompt.SurveyAskOne(&survey.Select{
+		Message: message,
+		Options: options,
+	}, &result)
+	return result, err
+}
+
+func multiSelect(message string, defaults, options []string) ([]string, error) {
+	var result []string
+	err := prompt.SurveyAskOne(&survey.MultiSelect{
+		Message: message,
+		Options: options,
+		Default: defaults,
+	}, &result)
+	return result, err
+}
+
+func commentSurvey() (string, error) {
+	var result string
+	err := prompt.SurveyAskOne(&survey.Multiline{
+		Message: "Comment",
+	}, &result)
+	return result, err
+}
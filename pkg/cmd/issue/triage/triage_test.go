@@ -0,0 +1,133 @@
+package triage
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/cli/cli/v2/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdTriage(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestTriage_withInvalidLimitFlag(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "--limit=0")
+
+	if err == nil || err.Error() != "invalid limit: 0" {
+		t.Errorf("error running command `issue triage`: %v", err)
+	}
+}
+
+func TestTriage_nonInteractive(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, false, "")
+
+	if err == nil || err.Error() != "triage requires an interactive terminal" {
+		t.Errorf("error running command `issue triage`: %v", err)
+	}
+}
+
+func TestTriageRun_close(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issues": { "nodes": [
+					{ "id": "ISSUE-1", "number": 1, "title": "a bug", "url": "https://github.com/OWNER/REPO/issues/1" }
+				], "pageInfo": { "hasNextPage": false } }
+			} } }
+		`))
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryAssignableUsers\b`),
+		httpmock.StringResponse(`{ "data": { "repository": { "assignableUsers": { "nodes": [], "pageInfo": { "hasNextPage": false } } } } }`))
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryLabelList\b`),
+		httpmock.StringResponse(`{ "data": { "repository": { "labels": { "nodes": [], "pageInfo": { "hasNextPage": false } } } } }`))
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryMilestoneList\b`),
+		httpmock.StringResponse(`{ "data": { "repository": { "milestones": { "nodes": [], "pageInfo": { "hasNextPage": false } } } } }`))
+	reg.Register(
+		httpmock.GraphQL(`mutation IssueClose\b`),
+		httpmock.GraphQLMutation(`{ "data": { "closeIssue": { "issue": { "id": "ISSUE-1" } } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "ISSUE-1", inputs["issueId"])
+			}))
+
+	as := prompt.NewAskStubber(t)
+	as.StubPrompt("What would you like to do?").AnswerWith("Close")
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &TriageOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		State:        "open",
+		LimitResults: 30,
+	}
+
+	err := triageRun(opts)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Applied 1 action(s)")
+}
@@ -3,6 +3,7 @@ package label
 import (
 	labelCreateCmd "github.com/cli/cli/v2/pkg/cmd/label/create"
 	labelListCmd "github.com/cli/cli/v2/pkg/cmd/label/list"
+	labelSyncCmd "github.com/cli/cli/v2/pkg/cmd/label/sync"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -17,6 +18,7 @@ func NewCmdLabel(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(labelListCmd.NewCmdList(f, nil))
 	cmd.AddCommand(labelCreateCmd.NewCmdCreate(f, nil))
+	cmd.AddCommand(labelSyncCmd.NewCmdSync(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,66 @@
+package sync
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/label/shared"
+)
+
+func listRepoLabels(client *http.Client, repo ghrepo.Interface) ([]shared.Label, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/%s/labels?per_page=100", repo.RepoOwner(), repo.RepoName())
+
+	var labels []shared.Label
+	for path != "" {
+		var page []shared.Label
+		nextPath, err := apiClient.RESTWithNext(repo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, page...)
+		path = nextPath
+	}
+
+	return labels, nil
+}
+
+func createRepoLabel(client *http.Client, repo ghrepo.Interface, l shared.Label) error {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/%s/labels", repo.RepoOwner(), repo.RepoName())
+	requestByte, err := json.Marshal(map[string]string{
+		"name":        l.Name,
+		"color":       l.Color,
+		"description": l.Description,
+	})
+	if err != nil {
+		return err
+	}
+	return apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), nil)
+}
+
+func updateRepoLabel(client *http.Client, repo ghrepo.Interface, currentName string, l shared.Label) error {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/%s/labels/%s", repo.RepoOwner(), repo.RepoName(), url.PathEscape(currentName))
+	body := map[string]string{
+		"new_name":    l.Name,
+		"color":       l.Color,
+		"description": l.Description,
+	}
+	requestByte, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return apiClient.REST(repo.RepoHost(), "PATCH", path, bytes.NewReader(requestByte), nil)
+}
+
+func deleteRepoLabel(client *http.Client, repo ghrepo.Interface, name string) error {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/%s/labels/%s", repo.RepoOwner(), repo.RepoName(), url.PathEscape(name))
+	return apiClient.REST(repo.RepoHost(), "DELETE", path, nil, nil)
+}
@@ -0,0 +1,41 @@
+package sync
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/label/shared"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func escapedPathMatcher(method, wantEscapedPath string) httpmock.Matcher {
+	return func(req *http.Request) bool {
+		return req.Method == method && req.URL.EscapedPath() == wantEscapedPath
+	}
+}
+
+func TestUpdateRepoLabel_escapesSlashInName(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		escapedPathMatcher("PATCH", "/repos/OWNER/REPO/labels/priority%2Fhigh"),
+		httpmock.StringResponse(`{}`))
+
+	repo := ghrepo.New("OWNER", "REPO")
+	err := updateRepoLabel(&http.Client{Transport: reg}, repo, "priority/high", shared.Label{Name: "priority/high", Color: "ffffff"})
+	assert.NoError(t, err)
+}
+
+func TestDeleteRepoLabel_escapesSlashInName(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		escapedPathMatcher("DELETE", "/repos/OWNER/REPO/labels/type%2Fbug"),
+		httpmock.StringResponse(`{}`))
+
+	repo := ghrepo.New("OWNER", "REPO")
+	err := deleteRepoLabel(&http.Client{Transport: reg}, repo, "type/bug")
+	assert.NoError(t, err)
+}
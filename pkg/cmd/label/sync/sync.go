@@ -0,0 +1,245 @@
+package sync
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/label/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type labelConfig struct {
+	Name        string `yaml:"name"`
+	OldName     string `yaml:"oldName,omitempty"`
+	Color       string `yaml:"color"`
+	Description string `yaml:"description"`
+}
+
+type labelsFile struct {
+	Labels []labelConfig `yaml:"labels"`
+}
+
+type SyncOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Filename string
+	RepoList string
+	DryRun   bool
+}
+
+func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Command {
+	opts := &SyncOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync <file>",
+		Short: "Sync labels to match a declarative file across one or more repositories",
+		Long: heredoc.Doc(`
+			Create, update, rename, and delete labels on a repository so that they
+			match a YAML file.
+
+			Renames are detected by setting "oldName" on an entry, so that an
+			existing label is renamed in place rather than deleted and
+			recreated, preserving the label's history on issues and pull
+			requests. Any label present on the repository but not described in
+			the file is deleted.
+
+			By default the sync targets the current repository. Pass
+			--repo-list to instead apply the file to every "OWNER/REPO" named,
+			one per line, in a text file.
+		`),
+		Example: heredoc.Doc(`
+			# Sync the current repository's labels
+			$ gh label sync labels.yml
+
+			# Sync labels across many repositories
+			$ gh label sync labels.yml --repo-list repos.txt
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot sync labels: file argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Filename = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return syncRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.RepoList, "repo-list", "", "Path to a file of `OWNER/REPO` names, one per line, to sync instead of the current repository")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the changes that would be made without making them")
+
+	return cmd
+}
+
+func syncRun(opts *SyncOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	desired, err := loadLabelsFile(opts.Filename)
+	if err != nil {
+		return err
+	}
+
+	repos, err := syncTargetRepos(opts)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	for _, repo := range repos {
+		changed, err := syncRepoLabels(httpClient, repo, desired, opts.DryRun)
+		if err != nil {
+			return fmt.Errorf("failed to sync labels for %s: %w", ghrepo.FullName(repo), err)
+		}
+
+		if opts.IO.IsStdoutTTY() && !opts.IO.GetQuiet() {
+			if changed == 0 {
+				fmt.Fprintf(opts.IO.Out, "%s %s already up to date\n", cs.SuccessIcon(), ghrepo.FullName(repo))
+			} else {
+				fmt.Fprintf(opts.IO.Out, "%s %s: %d change(s)\n", cs.SuccessIcon(), ghrepo.FullName(repo), changed)
+			}
+		}
+	}
+
+	return nil
+}
+
+func loadLabelsFile(filename string) ([]labelConfig, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var f labelsFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+
+	for i, l := range f.Labels {
+		if l.Name == "" {
+			return nil, fmt.Errorf("label at index %d is missing a name", i)
+		}
+	}
+
+	return f.Labels, nil
+}
+
+func syncTargetRepos(opts *SyncOptions) ([]ghrepo.Interface, error) {
+	if opts.RepoList == "" {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return nil, err
+		}
+		return []ghrepo.Interface{repo}, nil
+	}
+
+	f, err := os.Open(opts.RepoList)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var repos []ghrepo.Interface
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		repo, err := ghrepo.FromFullName(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid repository %q in %s: %w", line, opts.RepoList, err)
+		}
+		repos = append(repos, repo)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return repos, nil
+}
+
+// syncRepoLabels reconciles a single repository's labels against desired,
+// returning the number of create/update/rename/delete operations performed
+// (or that would be performed, when dryRun is set).
+func syncRepoLabels(client *http.Client, repo ghrepo.Interface, desired []labelConfig, dryRun bool) (int, error) {
+	existing, err := listRepoLabels(client, repo)
+	if err != nil {
+		return 0, err
+	}
+
+	byName := map[string]shared.Label{}
+	for _, l := range existing {
+		byName[l.Name] = l
+	}
+
+	changed := 0
+	keep := map[string]bool{}
+
+	for _, want := range desired {
+		target := shared.Label{Name: want.Name, Color: want.Color, Description: want.Description}
+
+		if current, ok := byName[want.Name]; ok {
+			keep[want.Name] = true
+			if current.Color != want.Color || current.Description != want.Description {
+				changed++
+				if !dryRun {
+					if err := updateRepoLabel(client, repo, want.Name, target); err != nil {
+						return 0, err
+					}
+				}
+			}
+			continue
+		}
+
+		if want.OldName != "" {
+			if _, ok := byName[want.OldName]; ok {
+				keep[want.OldName] = true
+				changed++
+				if !dryRun {
+					if err := updateRepoLabel(client, repo, want.OldName, target); err != nil {
+						return 0, err
+					}
+				}
+				continue
+			}
+		}
+
+		changed++
+		if !dryRun {
+			if err := createRepoLabel(client, repo, target); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	for _, l := range existing {
+		if keep[l.Name] {
+			continue
+		}
+		changed++
+		if !dryRun {
+			if err := deleteRepoLabel(client, repo, l.Name); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,147 @@
+package sync
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeLabelsFile(t *testing.T, contents string) string {
+	t.Helper()
+	f, err := os.CreateTemp(t.TempDir(), "labels-*.yml")
+	require.NoError(t, err)
+	_, err = f.WriteString(contents)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	return f.Name()
+}
+
+func Test_syncRun_createsUpdatesRenamesAndDeletes(t *testing.T) {
+	filename := writeLabelsFile(t, `
+labels:
+  - name: bug
+    color: "d73a4a"
+    description: Something isn't working
+  - name: help wanted
+    oldName: help-wanted
+    color: "008672"
+    description: Extra attention is needed
+  - name: new-label
+    color: "ffffff"
+    description: Brand new
+`)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/labels"),
+		httpmock.StringResponse(`[
+			{"name": "bug", "color": "aaaaaa", "description": "old description"},
+			{"name": "help-wanted", "color": "008672", "description": "Extra attention is needed"},
+			{"name": "wontfix", "color": "ffffff", "description": "This will not be worked on"}
+		]`))
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO/labels/bug"),
+		httpmock.StringResponse(`{}`))
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO/labels/help-wanted"),
+		httpmock.StringResponse(`{}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/labels"),
+		httpmock.StringResponse(`{}`))
+	reg.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/labels/wontfix"),
+		httpmock.StringResponse(`{}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	err := syncRun(&SyncOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Filename: filename,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "✓ OWNER/REPO: 4 change(s)\n", stdout.String())
+}
+
+func Test_syncRun_quietSuppressesOutput(t *testing.T) {
+	filename := writeLabelsFile(t, `
+labels:
+  - name: bug
+    color: "d73a4a"
+    description: Something isn't working
+`)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/labels"),
+		httpmock.StringResponse(`[{"name": "wontfix", "color": "ffffff", "description": ""}]`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/labels"),
+		httpmock.StringResponse(`{}`))
+	reg.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/labels/wontfix"),
+		httpmock.StringResponse(`{}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetQuiet(true)
+
+	err := syncRun(&SyncOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Filename: filename,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+}
+
+func Test_syncRun_dryRunMakesNoChanges(t *testing.T) {
+	filename := writeLabelsFile(t, `
+labels:
+  - name: bug
+    color: "d73a4a"
+    description: Something isn't working
+`)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/labels"),
+		httpmock.StringResponse(`[{"name": "wontfix", "color": "ffffff", "description": ""}]`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	err := syncRun(&SyncOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Filename: filename,
+		DryRun:   true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "✓ OWNER/REPO: 2 change(s)\n", stdout.String())
+}
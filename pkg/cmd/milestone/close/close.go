@@ -0,0 +1,77 @@
+package close
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/milestone/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CloseOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Selector string
+}
+
+func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Command {
+	opts := &CloseOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "close {<number> | <title>}",
+		Short: "Close a milestone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Selector = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return closeRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func closeRun(opts *CloseOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	m, err := shared.MilestoneByArg(httpClient, baseRepo, opts.Selector)
+	if err != nil {
+		return err
+	}
+
+	if m.State == "closed" {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Milestone %q is already closed\n", cs.Yellow("!"), m.Title)
+		return nil
+	}
+
+	_, err = shared.UpdateMilestone(httpClient, baseRepo, m.Number, map[string]interface{}{"state": "closed"})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Closed milestone %q\n", cs.SuccessIconWithColor(cs.Red), m.Title)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package close
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_closeRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones/3"),
+		httpmock.StringResponse(`{"number": 3, "title": "The big 1.0", "state": "open"}`))
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO/milestones/3"),
+		httpmock.StringResponse(`{"number": 3, "title": "The big 1.0", "state": "closed"}`))
+
+	io, _, _, stderr := iostreams.Test()
+
+	err := closeRun(&CloseOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Selector: "3",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, stderr.String(), "Closed milestone")
+}
+
+func Test_closeRun_alreadyClosed(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones/3"),
+		httpmock.StringResponse(`{"number": 3, "title": "The big 1.0", "state": "closed"}`))
+
+	io, _, _, stderr := iostreams.Test()
+
+	err := closeRun(&CloseOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Selector: "3",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, stderr.String(), "already closed")
+}
@@ -0,0 +1,82 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/milestone/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Title       string
+	Description string
+	DueDate     string
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <title>",
+		Short: "Create a new milestone",
+		Long: heredoc.Doc(`
+			Create a new milestone in a repository.
+
+			The due date, if given, must be in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ).
+		`),
+		Example: heredoc.Doc(`
+			$ gh milestone create "The big 1.0" --due-date 2021-12-01T00:00:00Z
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot create milestone: title argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Title = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the milestone")
+	cmd.Flags().StringVar(&opts.DueDate, "due-date", "", "Due date for the milestone (ISO 8601 format)")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	m, err := shared.CreateMilestone(httpClient, baseRepo, opts.Title, opts.Description, opts.DueDate)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Milestone %q created in %s\n", cs.SuccessIcon(), m.Title, ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package delete
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_deleteRun_skipConfirm(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones/3"),
+		httpmock.StringResponse(`{"number": 3, "title": "The big 1.0"}`))
+	reg.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/milestones/3"),
+		httpmock.StatusStringResponse(204, ""))
+
+	io, _, _, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	err := deleteRun(&DeleteOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Selector:    "3",
+		SkipConfirm: true,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, stderr.String(), "Deleted milestone")
+}
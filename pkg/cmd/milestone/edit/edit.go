@@ -0,0 +1,102 @@
+package edit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/milestone/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type EditOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Selector string
+
+	Title       string
+	Description string
+	DueDate     string
+}
+
+func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit {<number> | <title>}",
+		Short: "Edit a milestone",
+		Long:  "Edit a milestone's title, description, or due date.",
+		Example: heredoc.Doc(`
+			$ gh milestone edit "The big 1.0" --title "1.0"
+			$ gh milestone edit 3 --due-date 2022-01-15T00:00:00Z
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Selector = args[0]
+
+			if !c.Flags().Changed("title") && !c.Flags().Changed("description") && !c.Flags().Changed("due-date") {
+				return cmdutil.FlagErrorf("specify at least one of `--title`, `--description`, or `--due-date`")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return editRun(c, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "New title for the milestone")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "New description for the milestone")
+	cmd.Flags().StringVar(&opts.DueDate, "due-date", "", "New due date for the milestone (ISO 8601 format)")
+
+	return cmd
+}
+
+func editRun(cmd *cobra.Command, opts *EditOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	m, err := shared.MilestoneByArg(httpClient, baseRepo, opts.Selector)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]interface{}{}
+	if cmd.Flags().Changed("title") {
+		fields["title"] = opts.Title
+	}
+	if cmd.Flags().Changed("description") {
+		fields["description"] = opts.Description
+	}
+	if cmd.Flags().Changed("due-date") {
+		fields["due_on"] = opts.DueDate
+	}
+
+	updated, err := shared.UpdateMilestone(httpClient, baseRepo, m.Number, fields)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Milestone %q updated\n", cs.SuccessIcon(), updated.Title)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/milestone/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	State string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List milestones in a repository",
+		Long:    "Display milestones in a GitHub repository, including their issue progress.",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ gh milestone list
+			$ gh milestone list --state closed
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			switch opts.State {
+			case "open", "closed", "all":
+			default:
+				return cmdutil.FlagErrorf("invalid state: %q", opts.State)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.State, "state", "open", "Filter by state: {open|closed|all}")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.Fields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	milestones, err := shared.ListMilestones(httpClient, baseRepo, opts.State)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, milestones)
+	}
+
+	if len(milestones) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "No milestones found in %s\n", ghrepo.FullName(baseRepo))
+		return nil
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		title := fmt.Sprintf("Showing %s in %s", utils.Pluralize(len(milestones), "milestone"), ghrepo.FullName(baseRepo))
+		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
+	}
+
+	cs := opts.IO.ColorScheme()
+	table := utils.NewTablePrinter(opts.IO)
+	for _, m := range milestones {
+		table.AddField(m.Title, nil, cs.Bold)
+		table.AddField(progress(m), nil, nil)
+		table.AddField(dueDate(m), nil, cs.Gray)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+func progress(m shared.Milestone) string {
+	total := m.OpenIssues + m.ClosedIssues
+	if total == 0 {
+		return "no issues"
+	}
+	percent := m.ClosedIssues * 100 / total
+	return fmt.Sprintf("%d%% (%d/%d issues closed)", percent, m.ClosedIssues, total)
+}
+
+func dueDate(m shared.Milestone) string {
+	if m.DueOn == nil {
+		return "no due date"
+	}
+	return fmt.Sprintf("due %s", m.DueOn.Format("Jan 2, 2006"))
+}
+
+func milestonesToInterface(ms []shared.Milestone) []interface{} {
+	result := make([]interface{}, len(ms))
+	for i := range ms {
+		result[i] = &ms[i]
+	}
+	return result
+}
@@ -0,0 +1,36 @@
+package milestone
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdClose "github.com/cli/cli/v2/pkg/cmd/milestone/close"
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/milestone/create"
+	cmdDelete "github.com/cli/cli/v2/pkg/cmd/milestone/delete"
+	cmdEdit "github.com/cli/cli/v2/pkg/cmd/milestone/edit"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/milestone/list"
+	cmdTransfer "github.com/cli/cli/v2/pkg/cmd/milestone/transfer"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdMilestone(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "milestone <command>",
+		Short: "Manage milestones",
+		Long:  "Work with GitHub milestones.",
+		Example: heredoc.Doc(`
+			$ gh milestone list
+			$ gh milestone create "The big 1.0"
+			$ gh milestone close "The big 1.0"
+		`),
+	}
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
+	cmd.AddCommand(cmdClose.NewCmdClose(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdTransfer.NewCmdTransfer(f, nil))
+
+	return cmd
+}
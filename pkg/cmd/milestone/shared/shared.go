@@ -0,0 +1,183 @@
+// Package shared holds helpers for working with a repository's
+// milestones over the REST API, used by the leaf commands under
+// `gh milestone`.
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type Milestone struct {
+	Number       int        `json:"number"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	State        string     `json:"state"`
+	DueOn        *time.Time `json:"due_on"`
+	OpenIssues   int        `json:"open_issues"`
+	ClosedIssues int        `json:"closed_issues"`
+	HTMLURL      string     `json:"html_url"`
+}
+
+var Fields = []string{"number", "title", "description", "state", "dueOn", "openIssues", "closedIssues", "url"}
+
+func (m *Milestone) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{
+		"number":       m.Number,
+		"title":        m.Title,
+		"description":  m.Description,
+		"state":        m.State,
+		"openIssues":   m.OpenIssues,
+		"closedIssues": m.ClosedIssues,
+		"url":          m.HTMLURL,
+	}
+	if m.DueOn != nil {
+		data["dueOn"] = m.DueOn.Format(time.RFC3339)
+	} else {
+		data["dueOn"] = nil
+	}
+
+	result := map[string]interface{}{}
+	for _, f := range fields {
+		result[f] = data[f]
+	}
+	return result
+}
+
+// ListMilestones returns a repository's milestones in the given state
+// ("open", "closed", or "all").
+func ListMilestones(httpClient *http.Client, repo ghrepo.Interface, state string) ([]Milestone, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/milestones?state=%s&per_page=100", repo.RepoOwner(), repo.RepoName(), state)
+
+	var milestones []Milestone
+	for path != "" {
+		var page []Milestone
+		nextPath, err := apiClient.RESTWithNext(repo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		milestones = append(milestones, page...)
+		path = nextPath
+	}
+
+	return milestones, nil
+}
+
+// MilestoneByArg resolves a milestone number or title to a Milestone.
+func MilestoneByArg(httpClient *http.Client, repo ghrepo.Interface, arg string) (*Milestone, error) {
+	if number, err := strconv.Atoi(arg); err == nil {
+		apiClient := api.NewClientFromHTTP(httpClient)
+		path := fmt.Sprintf("repos/%s/%s/milestones/%d", repo.RepoOwner(), repo.RepoName(), number)
+		var m Milestone
+		if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &m); err != nil {
+			return nil, err
+		}
+		return &m, nil
+	}
+
+	milestones, err := ListMilestones(httpClient, repo, "all")
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range milestones {
+		if m.Title == arg {
+			return &m, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no milestone found with title %q", arg)
+}
+
+func CreateMilestone(httpClient *http.Client, repo ghrepo.Interface, title, description, dueOn string) (*Milestone, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/milestones", repo.RepoOwner(), repo.RepoName())
+
+	body := map[string]string{"title": title}
+	if description != "" {
+		body["description"] = description
+	}
+	if dueOn != "" {
+		body["due_on"] = dueOn
+	}
+	requestByte, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Milestone
+	if err := apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func UpdateMilestone(httpClient *http.Client, repo ghrepo.Interface, number int, fields map[string]interface{}) (*Milestone, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/milestones/%d", repo.RepoOwner(), repo.RepoName(), number)
+
+	requestByte, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Milestone
+	if err := apiClient.REST(repo.RepoHost(), "PATCH", path, bytes.NewReader(requestByte), &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// ListMilestoneIssueNumbers returns the numbers of every open and closed
+// issue (pull requests excluded) assigned to the given milestone.
+func ListMilestoneIssueNumbers(httpClient *http.Client, repo ghrepo.Interface, milestoneNumber int) ([]int, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/issues?milestone=%d&state=all&per_page=100", repo.RepoOwner(), repo.RepoName(), milestoneNumber)
+
+	var numbers []int
+	for path != "" {
+		var page []struct {
+			Number      int             `json:"number"`
+			PullRequest json.RawMessage `json:"pull_request"`
+		}
+		nextPath, err := apiClient.RESTWithNext(repo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range page {
+			if issue.PullRequest != nil {
+				continue
+			}
+			numbers = append(numbers, issue.Number)
+		}
+		path = nextPath
+	}
+
+	return numbers, nil
+}
+
+// ReassignIssueMilestone moves a single issue onto a different milestone.
+func ReassignIssueMilestone(httpClient *http.Client, repo ghrepo.Interface, issueNumber, milestoneNumber int) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/issues/%d", repo.RepoOwner(), repo.RepoName(), issueNumber)
+
+	requestByte, err := json.Marshal(map[string]int{"milestone": milestoneNumber})
+	if err != nil {
+		return err
+	}
+
+	return apiClient.REST(repo.RepoHost(), "PATCH", path, bytes.NewReader(requestByte), nil)
+}
+
+func DeleteMilestone(httpClient *http.Client, repo ghrepo.Interface, number int) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/milestones/%d", repo.RepoOwner(), repo.RepoName(), number)
+	return apiClient.REST(repo.RepoHost(), "DELETE", path, nil, nil)
+}
@@ -0,0 +1,46 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MilestoneByArg_byNumber(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones/3"),
+		httpmock.StringResponse(`{"number": 3, "title": "The big 1.0"}`))
+
+	m, err := MilestoneByArg(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), "3")
+	require.NoError(t, err)
+	assert.Equal(t, "The big 1.0", m.Title)
+}
+
+func Test_MilestoneByArg_byTitle(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones"),
+		httpmock.StringResponse(`[{"number": 3, "title": "The big 1.0"}]`))
+
+	m, err := MilestoneByArg(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), "The big 1.0")
+	require.NoError(t, err)
+	assert.Equal(t, 3, m.Number)
+}
+
+func Test_MilestoneByArg_notFound(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones"),
+		httpmock.StringResponse(`[]`))
+
+	_, err := MilestoneByArg(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), "nonexistent")
+	assert.EqualError(t, err, `no milestone found with title "nonexistent"`)
+}
@@ -0,0 +1,105 @@
+package transfer
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/milestone/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type TransferOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Source string
+	Target string
+}
+
+func NewCmdTransfer(f *cmdutil.Factory, runF func(*TransferOptions) error) *cobra.Command {
+	opts := &TransferOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "transfer {<number> | <title>} {<number> | <title>}",
+		Short: "Reassign every issue on one milestone to another",
+		Long: heredoc.Doc(`
+			Move every issue assigned to a source milestone onto a target
+			milestone. The source milestone itself is left untouched; use
+			'gh milestone delete' or 'gh milestone close' afterward if it is
+			no longer needed.
+		`),
+		Example: heredoc.Doc(`
+			$ gh milestone transfer "Sprint 1" "Sprint 2"
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Source = args[0]
+			opts.Target = args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return transferRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func transferRun(opts *TransferOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	source, err := shared.MilestoneByArg(httpClient, baseRepo, opts.Source)
+	if err != nil {
+		return fmt.Errorf("source milestone: %w", err)
+	}
+
+	target, err := shared.MilestoneByArg(httpClient, baseRepo, opts.Target)
+	if err != nil {
+		return fmt.Errorf("target milestone: %w", err)
+	}
+
+	if source.Number == target.Number {
+		return cmdutil.FlagErrorf("source and target milestones must be different")
+	}
+
+	opts.IO.StartProgressIndicator()
+	issueNumbers, err := shared.ListMilestoneIssueNumbers(httpClient, baseRepo, source.Number)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	for _, number := range issueNumbers {
+		if err := shared.ReassignIssueMilestone(httpClient, baseRepo, number, target.Number); err != nil {
+			opts.IO.StopProgressIndicator()
+			return fmt.Errorf("failed to reassign issue #%d: %w", number, err)
+		}
+	}
+	opts.IO.StopProgressIndicator()
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Reassigned %s from %q to %q\n", cs.SuccessIcon(), utils.Pluralize(len(issueNumbers), "issue"), source.Title, target.Title)
+	}
+
+	return nil
+}
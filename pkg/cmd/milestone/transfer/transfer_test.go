@@ -0,0 +1,46 @@
+package transfer
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_transferRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones/1"),
+		httpmock.StringResponse(`{"number": 1, "title": "Sprint 1"}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones/2"),
+		httpmock.StringResponse(`{"number": 2, "title": "Sprint 2"}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/issues"),
+		httpmock.StringResponse(`[{"number": 10}, {"number": 11, "pull_request": {}}]`))
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO/issues/10"),
+		httpmock.StringResponse(`{}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	err := transferRun(&TransferOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Source: "1",
+		Target: "2",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Reassigned 1 issue from \"Sprint 1\" to \"Sprint 2\"")
+}
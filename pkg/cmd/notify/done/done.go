@@ -0,0 +1,72 @@
+package done
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/pkg/cmd/notify/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DoneOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	IDs []string
+}
+
+func NewCmdDone(f *cmdutil.Factory, runF func(*DoneOptions) error) *cobra.Command {
+	opts := &DoneOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "done <id>...",
+		Short: "Mark notifications as done",
+		Long: heredoc.Doc(`
+			Mark one or more notifications as done by their ID, as shown by 'gh notify list'.
+
+			A notification marked as done is removed from the default inbox view, unlike
+			marking it as read, which keeps it visible with '--all'.
+		`),
+		Example: heredoc.Doc(`
+			$ gh notify done 1234567890
+		`),
+		Args: cmdutil.MinimumArgs(1, "specify at least one notification ID"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.IDs = args
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return doneRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func doneRun(opts *DoneOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	for _, id := range opts.IDs {
+		if err := shared.MarkDone(httpClient, ghinstance.Default(), id); err != nil {
+			return fmt.Errorf("failed to mark notification %s as done: %w", id, err)
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Marked notification %s as done\n", cs.SuccessIcon(), id)
+		}
+	}
+
+	return nil
+}
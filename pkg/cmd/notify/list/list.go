@@ -0,0 +1,126 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/pkg/cmd/notify/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Exporter   cmdutil.Exporter
+
+	Repo          string
+	Reason        string
+	Type          string
+	All           bool
+	Participating bool
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List notifications in your inbox",
+		Long:    "List notifications in your inbox, optionally filtered by reason, repository, or subject type.",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ gh notify list
+			$ gh notify list --reason review_requested
+			$ gh notify list --repo cli/cli --type PullRequest
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "Filter by repository, in `[HOST/]OWNER/REPO` format")
+	cmd.Flags().StringVar(&opts.Reason, "reason", "", "Filter by notification reason (e.g. mention, review_requested, author)")
+	cmd.Flags().StringVar(&opts.Type, "type", "", "Filter by subject type (e.g. Issue, PullRequest, Discussion, Release)")
+	cmd.Flags().BoolVarP(&opts.Participating, "participating", "p", false, "Only show notifications in which you are directly participating or mentioned")
+	cmd.Flags().BoolVarP(&opts.All, "all", "a", false, "Include notifications that have already been read")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.Fields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	notifications, err := shared.List(httpClient, ghinstance.Default(), shared.ListOptions{
+		Repo:          opts.Repo,
+		All:           opts.All,
+		Participating: opts.Participating,
+	})
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	notifications = filter(notifications, opts.Reason, opts.Type)
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, notifications)
+	}
+
+	if len(notifications) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No notifications found")
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	table := utils.NewTablePrinter(opts.IO)
+	for _, n := range notifications {
+		id := n.ID
+		if n.Unread {
+			id = cs.Bold(id)
+		}
+		table.AddField(id, nil, nil)
+		table.AddField(n.Reason, nil, cs.Gray)
+		table.AddField(n.Repository.FullName, nil, nil)
+		table.AddField(n.Subject.Title, nil, nil)
+		table.AddField(utils.FuzzyAgo(time.Since(n.UpdatedAt)), nil, cs.Gray)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+func filter(notifications []shared.Notification, reason, subjectType string) []shared.Notification {
+	if reason == "" && subjectType == "" {
+		return notifications
+	}
+
+	filtered := make([]shared.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		if reason != "" && n.Reason != reason {
+			continue
+		}
+		if subjectType != "" && n.Subject.Type != subjectType {
+			continue
+		}
+		filtered = append(filtered, n)
+	}
+	return filtered
+}
@@ -0,0 +1,55 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "notifications"),
+		httpmock.StringResponse(`[
+			{"id": "1", "reason": "mention", "subject": {"title": "fix bug", "type": "Issue"}, "repository": {"full_name": "OWNER/REPO"}},
+			{"id": "2", "reason": "review_requested", "subject": {"title": "add feature", "type": "PullRequest"}, "repository": {"full_name": "OWNER/REPO"}}
+		]`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	err := listRun(&ListOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Type: "Issue",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "fix bug")
+	assert.NotContains(t, stdout.String(), "add feature")
+}
+
+func Test_listRun_noNotifications(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "notifications"),
+		httpmock.StringResponse(`[]`))
+
+	io, _, _, stderr := iostreams.Test()
+
+	err := listRun(&ListOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "No notifications found\n", stderr.String())
+}
@@ -0,0 +1,137 @@
+package notify
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	cmdDone "github.com/cli/cli/v2/pkg/cmd/notify/done"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/notify/list"
+	cmdRead "github.com/cli/cli/v2/pkg/cmd/notify/read"
+	"github.com/cli/cli/v2/pkg/cmd/notify/shared"
+	cmdUnsubscribe "github.com/cli/cli/v2/pkg/cmd/notify/unsubscribe"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type TriageOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Browser    browser
+}
+
+type browser interface {
+	Browse(string) error
+}
+
+func NewCmdNotify(f *cmdutil.Factory) *cobra.Command {
+	opts := &TriageOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Browser:    f.Browser,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "notify [<command>]",
+		Short: "Manage your GitHub notifications",
+		Long: heredoc.Doc(`
+			Work with your GitHub notifications inbox.
+
+			Running 'gh notify' with no subcommand starts an interactive triage session,
+			presenting each unread notification and letting you open, read, mark as
+			done, or unsubscribe from it.
+		`),
+		Example: heredoc.Doc(`
+			$ gh notify
+			$ gh notify list --reason review_requested
+			$ gh notify done 1234567890
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return triageRun(opts)
+		},
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdRead.NewCmdRead(f, nil))
+	cmd.AddCommand(cmdDone.NewCmdDone(f, nil))
+	cmd.AddCommand(cmdUnsubscribe.NewCmdUnsubscribe(f, nil))
+
+	return cmd
+}
+
+const (
+	actionOpen        = "Open in browser"
+	actionRead        = "Mark as read"
+	actionDone        = "Mark as done"
+	actionUnsubscribe = "Unsubscribe"
+	actionSkip        = "Skip"
+	actionQuit        = "Quit"
+)
+
+func triageRun(opts *TriageOptions) error {
+	if !opts.IO.CanPrompt() {
+		return cmdutil.FlagErrorf("notification triage requires an interactive terminal; use `gh notify list` instead")
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	notifications, err := shared.List(httpClient, ghinstance.Default(), shared.ListOptions{})
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(notifications) == 0 {
+		fmt.Fprintln(opts.IO.Out, "No notifications found")
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	for _, n := range notifications {
+		fmt.Fprintf(opts.IO.Out, "\n%s %s\n", cs.Bold(n.Repository.FullName), n.Subject.Title)
+		fmt.Fprintf(opts.IO.Out, "%s\n", cs.Gray(n.Reason))
+
+		var action string
+		err := prompt.SurveyAskOne(&survey.Select{
+			Message: "What would you like to do?",
+			Options: []string{actionOpen, actionRead, actionDone, actionUnsubscribe, actionSkip, actionQuit},
+		}, &action)
+		if err != nil {
+			return err
+		}
+
+		switch action {
+		case actionOpen:
+			if err := opts.Browser.Browse(shared.WebURL(&n)); err != nil {
+				return err
+			}
+		case actionRead:
+			if err := shared.MarkRead(httpClient, ghinstance.Default(), n.ID); err != nil {
+				return err
+			}
+		case actionDone:
+			if err := shared.MarkDone(httpClient, ghinstance.Default(), n.ID); err != nil {
+				return err
+			}
+		case actionUnsubscribe:
+			if err := shared.Unsubscribe(httpClient, ghinstance.Default(), n.ID); err != nil {
+				return err
+			}
+		case actionSkip:
+			continue
+		case actionQuit:
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package read
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/pkg/cmd/notify/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ReadOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	IDs []string
+	All bool
+}
+
+func NewCmdRead(f *cmdutil.Factory, runF func(*ReadOptions) error) *cobra.Command {
+	opts := &ReadOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "read [<id>...]",
+		Short: "Mark notifications as read",
+		Long: heredoc.Doc(`
+			Mark one or more notifications as read by their ID, as shown by 'gh notify list'.
+
+			Use '--all' to mark every notification in your inbox as read.
+		`),
+		Example: heredoc.Doc(`
+			$ gh notify read 1234567890
+			$ gh notify read --all
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.IDs = args
+
+			if !opts.All && len(opts.IDs) == 0 {
+				return cmdutil.FlagErrorf("specify at least one notification ID or use `--all`")
+			}
+			if opts.All && len(opts.IDs) > 0 {
+				return cmdutil.FlagErrorf("specify notification IDs or `--all`, not both")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return readRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Mark every notification in your inbox as read")
+
+	return cmd
+}
+
+func readRun(opts *ReadOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	if opts.All {
+		if err := shared.MarkAllRead(httpClient, ghinstance.Default()); err != nil {
+			return err
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Marked all notifications as read\n", cs.SuccessIcon())
+		}
+		return nil
+	}
+
+	for _, id := range opts.IDs {
+		if err := shared.MarkRead(httpClient, ghinstance.Default(), id); err != nil {
+			return fmt.Errorf("failed to mark notification %s as read: %w", id, err)
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Marked notification %s as read\n", cs.SuccessIcon(), id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package read
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_readRun_byID(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("PATCH", "notifications/threads/123"),
+		httpmock.StatusStringResponse(205, ""))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	err := readRun(&ReadOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IDs: []string{"123"},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Marked notification 123 as read")
+}
+
+func Test_readRun_all(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("PUT", "notifications"),
+		httpmock.StatusStringResponse(205, ""))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	err := readRun(&ReadOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		All: true,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Marked all notifications as read")
+}
@@ -0,0 +1,153 @@
+// Package shared contains helpers for working with the GitHub notifications
+// inbox that are reused across the `gh notify` leaf commands.
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+// restNoContent issues a REST request whose success response has no JSON body (as is the
+// case for the notification-threads endpoints), which api.Client.REST cannot handle since
+// it always attempts to decode the response body.
+func restNoContent(httpClient *http.Client, hostname, method, path string) error {
+	req, err := http.NewRequest(method, ghinstance.RESTPrefix(hostname)+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
+
+// Notification represents a single entry in a user's notifications inbox.
+type Notification struct {
+	ID        string
+	Unread    bool
+	Reason    string
+	UpdatedAt time.Time `json:"updated_at"`
+	Subject   struct {
+		Title string
+		Type  string
+		URL   string
+	}
+	Repository struct {
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login string
+		}
+	}
+}
+
+// Fields are the field names accepted by the `--json` flag for `gh notify list`.
+var Fields = []string{
+	"id", "reason", "unread", "updatedAt", "title", "type", "repository", "url",
+}
+
+// ExportData implements cmdutil.Exporter.
+func (n *Notification) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "id":
+			data[f] = n.ID
+		case "reason":
+			data[f] = n.Reason
+		case "unread":
+			data[f] = n.Unread
+		case "updatedAt":
+			data[f] = n.UpdatedAt
+		case "title":
+			data[f] = n.Subject.Title
+		case "type":
+			data[f] = n.Subject.Type
+		case "repository":
+			data[f] = n.Repository.FullName
+		case "url":
+			data[f] = WebURL(n)
+		}
+	}
+	return data
+}
+
+// ListOptions narrows the set of notifications returned by List.
+type ListOptions struct {
+	Repo          string
+	All           bool
+	Participating bool
+	PerPage       int
+}
+
+// List fetches notifications from the user's inbox, optionally scoped to a single repository.
+func List(httpClient *http.Client, hostname string, opts ListOptions) ([]Notification, error) {
+	query := url.Values{}
+	perPage := opts.PerPage
+	if perPage == 0 {
+		perPage = 50
+	}
+	query.Add("per_page", fmt.Sprintf("%d", perPage))
+	if opts.All {
+		query.Add("all", "true")
+	}
+	if opts.Participating {
+		query.Add("participating", "true")
+	}
+
+	path := fmt.Sprintf("notifications?%s", query.Encode())
+	if opts.Repo != "" {
+		path = fmt.Sprintf("repos/%s/notifications?%s", opts.Repo, query.Encode())
+	}
+
+	var notifications []Notification
+	client := api.NewClientFromHTTP(httpClient)
+	err := client.REST(hostname, "GET", path, nil, &notifications)
+	return notifications, err
+}
+
+// MarkRead marks a single notification thread as read.
+func MarkRead(httpClient *http.Client, hostname, threadID string) error {
+	return restNoContent(httpClient, hostname, "PATCH", fmt.Sprintf("notifications/threads/%s", threadID))
+}
+
+// MarkAllRead marks every notification in the inbox as read.
+func MarkAllRead(httpClient *http.Client, hostname string) error {
+	return restNoContent(httpClient, hostname, "PUT", "notifications")
+}
+
+// MarkDone marks a notification thread as done, removing it from the default inbox view.
+func MarkDone(httpClient *http.Client, hostname, threadID string) error {
+	return restNoContent(httpClient, hostname, "DELETE", fmt.Sprintf("notifications/threads/%s", threadID))
+}
+
+// Unsubscribe stops future notifications for a thread.
+func Unsubscribe(httpClient *http.Client, hostname, threadID string) error {
+	return restNoContent(httpClient, hostname, "DELETE", fmt.Sprintf("notifications/threads/%s/subscription", threadID))
+}
+
+// WebURL converts a notification's API subject URL into the URL of the page on github.com.
+func WebURL(n *Notification) string {
+	apiURL := n.Subject.URL
+	if apiURL == "" {
+		return fmt.Sprintf("https://%s/%s", ghinstance.Default(), n.Repository.FullName)
+	}
+
+	webURL := strings.Replace(apiURL, "api.github.com/repos", ghinstance.Default(), 1)
+	webURL = strings.Replace(webURL, "/pulls/", "/pull/", 1)
+	return webURL
+}
@@ -0,0 +1,35 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_List(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "notifications"),
+		httpmock.StringResponse(`[{"id": "1", "reason": "mention", "subject": {"title": "fix bug", "type": "Issue"}, "repository": {"full_name": "OWNER/REPO"}}]`))
+
+	ns, err := List(&http.Client{Transport: reg}, "github.com", ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, ns, 1)
+	assert.Equal(t, "mention", ns[0].Reason)
+}
+
+func Test_WebURL(t *testing.T) {
+	n := &Notification{}
+	n.Subject.URL = "https://api.github.com/repos/OWNER/REPO/issues/123"
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/123", WebURL(n))
+}
+
+func Test_WebURL_pullRequest(t *testing.T) {
+	n := &Notification{}
+	n.Subject.URL = "https://api.github.com/repos/OWNER/REPO/pulls/123"
+	assert.Equal(t, "https://github.com/OWNER/REPO/pull/123", WebURL(n))
+}
@@ -0,0 +1,70 @@
+package unsubscribe
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/pkg/cmd/notify/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UnsubscribeOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	IDs []string
+}
+
+func NewCmdUnsubscribe(f *cmdutil.Factory, runF func(*UnsubscribeOptions) error) *cobra.Command {
+	opts := &UnsubscribeOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unsubscribe <id>...",
+		Short: "Unsubscribe from notification threads",
+		Long: heredoc.Doc(`
+			Stop receiving future notifications for one or more threads, by ID as
+			shown by 'gh notify list'.
+		`),
+		Example: heredoc.Doc(`
+			$ gh notify unsubscribe 1234567890
+		`),
+		Args: cmdutil.MinimumArgs(1, "specify at least one notification ID"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.IDs = args
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return unsubscribeRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func unsubscribeRun(opts *UnsubscribeOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	for _, id := range opts.IDs {
+		if err := shared.Unsubscribe(httpClient, ghinstance.Default(), id); err != nil {
+			return fmt.Errorf("failed to unsubscribe from notification %s: %w", id, err)
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Unsubscribed from notification %s\n", cs.SuccessIcon(), id)
+		}
+	}
+
+	return nil
+}
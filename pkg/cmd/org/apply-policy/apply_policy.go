@@ -0,0 +1,379 @@
+package applypolicy
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type policyFile struct {
+	Settings         map[string]interface{}  `yaml:"settings"`
+	BranchProtection *policyBranchProtection `yaml:"branch_protection"`
+	Labels           []policyLabel           `yaml:"labels"`
+	Files            []policyFileEntry       `yaml:"files"`
+}
+
+type policyBranchProtection struct {
+	Branch                       string   `yaml:"branch"`
+	RequiredApprovingReviewCount int      `yaml:"required_approving_review_count"`
+	RequiredStatusChecks         []string `yaml:"required_status_checks"`
+	EnforceAdmins                bool     `yaml:"enforce_admins"`
+}
+
+type policyLabel struct {
+	Name        string `yaml:"name"`
+	Color       string `yaml:"color"`
+	Description string `yaml:"description"`
+}
+
+type policyFileEntry struct {
+	Path   string `yaml:"path"`
+	Source string `yaml:"source"`
+}
+
+type ApplyPolicyOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org      string
+	Filename string
+	Filter   string
+	DryRun   bool
+}
+
+func NewCmdApplyPolicy(f *cmdutil.Factory, runF func(*ApplyPolicyOptions) error) *cobra.Command {
+	opts := &ApplyPolicyOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "apply-policy <organization> <file>",
+		Short: "Apply repository settings, branch protection, labels, and files across an organization",
+		Long: heredoc.Doc(`
+			Apply a declared set of repository settings, branch protection,
+			labels, and required files across every matching repository in an
+			organization, and report what was out of compliance ("drift") in
+			each one.
+
+			Use '--filter topic:TOPIC' to only apply the policy to repositories
+			tagged with a given topic. Without '--filter', every repository in
+			the organization is checked.
+
+			Branch protection is applied using the classic branch protection
+			API; newer repository rulesets are not supported.
+
+			The file has the following format:
+
+			    settings:
+			      delete_branch_on_merge: true
+			      has_wiki: false
+			    branch_protection:
+			      branch: main
+			      required_approving_review_count: 1
+			      required_status_checks: [ci]
+			      enforce_admins: true
+			    labels:
+			      - name: bug
+			        color: d73a4a
+			        description: Something isn't working
+			    files:
+			      - path: .github/CODEOWNERS
+			        source: ./CODEOWNERS
+		`),
+		Example: heredoc.Doc(`
+			# Report and fix drift for every repo in an org
+			$ gh org apply-policy my-org policy.yml
+
+			# Only apply the policy to repos tagged "prod"
+			$ gh org apply-policy my-org policy.yml --filter topic:prod
+
+			# See what would change without making any changes
+			$ gh org apply-policy my-org policy.yml --dry-run
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+			opts.Filename = args[1]
+
+			if opts.Filter != "" && !strings.HasPrefix(opts.Filter, "topic:") {
+				return cmdutil.FlagErrorf("--filter only supports the %q key", "topic")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return applyPolicyRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Only apply the policy to repos matching `topic:TOPIC`")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Report drift without applying any changes")
+
+	return cmd
+}
+
+func applyPolicyRun(opts *ApplyPolicyOptions) error {
+	content, err := os.ReadFile(opts.Filename)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.Filename, err)
+	}
+
+	var policy policyFile
+	if err := yaml.Unmarshal(content, &policy); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", opts.Filename, err)
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	repos, err := listOrgRepos(httpClient, host, opts.Org)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories for %s: %w", opts.Org, err)
+	}
+
+	var topicFilter string
+	if opts.Filter != "" {
+		topicFilter = strings.TrimPrefix(opts.Filter, "topic:")
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	for _, repo := range repos {
+		if topicFilter != "" && !hasTopic(repo.Topics, topicFilter) {
+			continue
+		}
+
+		drift, err := applyPolicyToRepo(httpClient, host, repo.FullName, &policy, opts.DryRun)
+		if err != nil {
+			return fmt.Errorf("failed to apply policy to %s: %w", repo.FullName, err)
+		}
+
+		if len(drift) == 0 {
+			continue
+		}
+
+		if opts.DryRun {
+			fmt.Fprintf(opts.IO.Out, "%s\n", repo.FullName)
+		} else {
+			fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.SuccessIcon(), repo.FullName)
+		}
+		for _, d := range drift {
+			fmt.Fprintf(opts.IO.Out, "  %s\n", d)
+		}
+	}
+
+	return nil
+}
+
+func hasTopic(topics []string, topic string) bool {
+	for _, t := range topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// applyPolicyToRepo reconciles a single repo against the policy and returns
+// a human-readable description of every drift item it found (and fixed,
+// unless dryRun is set).
+func applyPolicyToRepo(httpClient *http.Client, host, fullName string, policy *policyFile, dryRun bool) ([]string, error) {
+	var drift []string
+
+	if len(policy.Settings) > 0 {
+		settingsDrift, err := reconcileSettings(httpClient, host, fullName, policy.Settings, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		drift = append(drift, settingsDrift...)
+	}
+
+	if policy.BranchProtection != nil {
+		bpDrift, err := reconcileBranchProtection(httpClient, host, fullName, policy.BranchProtection, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		drift = append(drift, bpDrift...)
+	}
+
+	if len(policy.Labels) > 0 {
+		labelDrift, err := reconcileLabels(httpClient, host, fullName, policy.Labels, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		drift = append(drift, labelDrift...)
+	}
+
+	if len(policy.Files) > 0 {
+		fileDrift, err := reconcileFiles(httpClient, host, fullName, policy.Files, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		drift = append(drift, fileDrift...)
+	}
+
+	return drift, nil
+}
+
+func reconcileSettings(httpClient *http.Client, host, fullName string, desired map[string]interface{}, dryRun bool) ([]string, error) {
+	current, err := getRepoSettings(httpClient, host, fullName)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := map[string]interface{}{}
+	var drift []string
+	for key, want := range desired {
+		if fmt.Sprintf("%v", current[key]) == fmt.Sprintf("%v", want) {
+			continue
+		}
+		patch[key] = want
+		drift = append(drift, fmt.Sprintf("settings: %s should be %v (was %v)", key, want, current[key]))
+	}
+
+	if len(patch) > 0 && !dryRun {
+		if err := patchRepoSettings(httpClient, host, fullName, patch); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Strings(drift)
+	return drift, nil
+}
+
+func reconcileBranchProtection(httpClient *http.Client, host, fullName string, desired *policyBranchProtection, dryRun bool) ([]string, error) {
+	branch := desired.Branch
+	if branch == "" {
+		branch = "main"
+	}
+
+	current, err := getBranchProtection(httpClient, host, fullName, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	want := branchProtection{
+		RequiredApprovingReviewCount: desired.RequiredApprovingReviewCount,
+		RequiredStatusChecks:         desired.RequiredStatusChecks,
+		EnforceAdmins:                desired.EnforceAdmins,
+	}
+
+	if current != nil && branchProtectionMatches(*current, want) {
+		return nil, nil
+	}
+
+	drift := []string{fmt.Sprintf("branch protection for %q does not match policy", branch)}
+
+	if !dryRun {
+		if err := putBranchProtection(httpClient, host, fullName, branch, want); err != nil {
+			return nil, err
+		}
+	}
+
+	return drift, nil
+}
+
+func branchProtectionMatches(a, b branchProtection) bool {
+	if a.RequiredApprovingReviewCount != b.RequiredApprovingReviewCount || a.EnforceAdmins != b.EnforceAdmins {
+		return false
+	}
+	return strings.Join(a.RequiredStatusChecks, ",") == strings.Join(b.RequiredStatusChecks, ",")
+}
+
+func reconcileLabels(httpClient *http.Client, host, fullName string, desired []policyLabel, dryRun bool) ([]string, error) {
+	existing, err := listRepoLabels(httpClient, host, fullName)
+	if err != nil {
+		return nil, err
+	}
+
+	var drift []string
+	for _, want := range desired {
+		current, ok := existing[want.Name]
+		if ok && current.Color == want.Color && current.Description == want.Description {
+			continue
+		}
+
+		l := repoLabel{Name: want.Name, Color: want.Color, Description: want.Description}
+		if ok {
+			drift = append(drift, fmt.Sprintf("label %q does not match policy", want.Name))
+			if !dryRun {
+				if err := updateRepoLabel(httpClient, host, fullName, l); err != nil {
+					return nil, err
+				}
+			}
+		} else {
+			drift = append(drift, fmt.Sprintf("label %q is missing", want.Name))
+			if !dryRun {
+				if err := createRepoLabel(httpClient, host, fullName, l); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return drift, nil
+}
+
+func reconcileFiles(httpClient *http.Client, host, fullName string, desired []policyFileEntry, dryRun bool) ([]string, error) {
+	var drift []string
+	for _, f := range desired {
+		wantContent, err := os.ReadFile(f.Source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", f.Source, err)
+		}
+
+		existing, err := getRepoFile(httpClient, host, fullName, f.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if existing != nil {
+			haveContent, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(existing.Content, "\n", ""))
+			if err == nil && string(haveContent) == string(wantContent) {
+				continue
+			}
+		}
+
+		var sha string
+		if existing != nil {
+			drift = append(drift, fmt.Sprintf("file %q does not match policy", f.Path))
+			sha = existing.SHA
+		} else {
+			drift = append(drift, fmt.Sprintf("file %q is missing", f.Path))
+		}
+
+		if !dryRun {
+			message := fmt.Sprintf("Apply org policy: update %s", f.Path)
+			if err := putRepoFile(httpClient, host, fullName, f.Path, message, wantContent, sha); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return drift, nil
+}
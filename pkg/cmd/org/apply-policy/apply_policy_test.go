@@ -0,0 +1,117 @@
+package applypolicy
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func writePolicyFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "policy.yml")
+	assert.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func Test_applyPolicyRun_settingsDrift(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	path := writePolicyFile(t, `
+settings:
+  has_wiki: false
+`)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/repos"),
+		httpmock.StringResponse(`[{"name": "web", "full_name": "my-org/web", "topics": ["prod"]}]`))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/web"),
+		httpmock.StringResponse(`{"has_wiki": true}`))
+	reg.Register(
+		httpmock.REST("PATCH", "repos/my-org/web"),
+		httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+			assert.Equal(t, false, payload["has_wiki"])
+		}))
+
+	err := applyPolicyRun(&ApplyPolicyOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:   func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:      "my-org",
+		Filename: path,
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "my-org/web")
+	assert.Contains(t, stdout.String(), "has_wiki should be false (was true)")
+}
+
+func Test_applyPolicyRun_filtersByTopic(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+
+	path := writePolicyFile(t, `
+settings:
+  has_wiki: false
+`)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/repos"),
+		httpmock.StringResponse(`[{"name": "web", "full_name": "my-org/web", "topics": []}]`))
+
+	err := applyPolicyRun(&ApplyPolicyOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:   func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:      "my-org",
+		Filename: path,
+		Filter:   "topic:prod",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+}
+
+func Test_applyPolicyRun_dryRunMakesNoChanges(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+
+	path := writePolicyFile(t, `
+labels:
+  - name: bug
+    color: d73a4a
+`)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/repos"),
+		httpmock.StringResponse(`[{"name": "web", "full_name": "my-org/web", "topics": []}]`))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/web/labels"),
+		httpmock.StringResponse(`[]`))
+
+	err := applyPolicyRun(&ApplyPolicyOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:   func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:      "my-org",
+		Filename: path,
+		DryRun:   true,
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), `label "bug" is missing`)
+}
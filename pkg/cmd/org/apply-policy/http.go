@@ -0,0 +1,273 @@
+package applypolicy
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type orgRepo struct {
+	Name     string   `json:"name"`
+	FullName string   `json:"full_name"`
+	Topics   []string `json:"topics"`
+}
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func listOrgRepos(httpClient *http.Client, host, org string) ([]orgRepo, error) {
+	var repos []orgRepo
+
+	url := fmt.Sprintf("%sorgs/%s/repos?per_page=100", ghinstance.RESTPrefix(host), org)
+	for url != "" {
+		var page []orgRepo
+		nextURL, err := restGET(httpClient, url, &page)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, page...)
+		url = nextURL
+	}
+
+	return repos, nil
+}
+
+func restGET(httpClient *http.Client, url string, data interface{}) (string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(data); err != nil {
+		return "", err
+	}
+
+	return findNextPage(resp.Header.Get("Link")), nil
+}
+
+func findNextPage(link string) string {
+	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+func getRepoSettings(httpClient *http.Client, host, fullName string) (map[string]interface{}, error) {
+	url := fmt.Sprintf("%srepos/%s", ghinstance.RESTPrefix(host), fullName)
+	var settings map[string]interface{}
+	if _, err := restGET(httpClient, url, &settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func patchRepoSettings(httpClient *http.Client, host, fullName string, patch map[string]interface{}) error {
+	url := fmt.Sprintf("%srepos/%s", ghinstance.RESTPrefix(host), fullName)
+	return restRequest(httpClient, "PATCH", url, patch, nil)
+}
+
+type repoLabel struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+func listRepoLabels(httpClient *http.Client, host, fullName string) (map[string]repoLabel, error) {
+	labels := map[string]repoLabel{}
+
+	url := fmt.Sprintf("%srepos/%s/labels?per_page=100", ghinstance.RESTPrefix(host), fullName)
+	for url != "" {
+		var page []repoLabel
+		nextURL, err := restGET(httpClient, url, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range page {
+			labels[l.Name] = l
+		}
+		url = nextURL
+	}
+
+	return labels, nil
+}
+
+func createRepoLabel(httpClient *http.Client, host, fullName string, l repoLabel) error {
+	url := fmt.Sprintf("%srepos/%s/labels", ghinstance.RESTPrefix(host), fullName)
+	return restRequest(httpClient, "POST", url, l, nil)
+}
+
+func updateRepoLabel(httpClient *http.Client, host, fullName string, l repoLabel) error {
+	url := fmt.Sprintf("%srepos/%s/labels/%s", ghinstance.RESTPrefix(host), fullName, l.Name)
+	return restRequest(httpClient, "PATCH", url, l, nil)
+}
+
+type branchProtection struct {
+	RequiredApprovingReviewCount int      `json:"required_approving_review_count"`
+	RequiredStatusChecks         []string `json:"required_status_checks"`
+	EnforceAdmins                bool     `json:"enforce_admins"`
+}
+
+// getBranchProtection returns nil if the branch has no protection configured.
+func getBranchProtection(httpClient *http.Client, host, fullName, branch string) (*branchProtection, error) {
+	url := fmt.Sprintf("%srepos/%s/branches/%s/protection", ghinstance.RESTPrefix(host), fullName, branch)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	var raw struct {
+		RequiredPullRequestReviews *struct {
+			RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+		} `json:"required_pull_request_reviews"`
+		RequiredStatusChecks *struct {
+			Contexts []string `json:"contexts"`
+		} `json:"required_status_checks"`
+		EnforceAdmins struct {
+			Enabled bool `json:"enabled"`
+		} `json:"enforce_admins"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	bp := &branchProtection{EnforceAdmins: raw.EnforceAdmins.Enabled}
+	if raw.RequiredPullRequestReviews != nil {
+		bp.RequiredApprovingReviewCount = raw.RequiredPullRequestReviews.RequiredApprovingReviewCount
+	}
+	if raw.RequiredStatusChecks != nil {
+		bp.RequiredStatusChecks = raw.RequiredStatusChecks.Contexts
+	}
+	return bp, nil
+}
+
+func putBranchProtection(httpClient *http.Client, host, fullName, branch string, bp branchProtection) error {
+	url := fmt.Sprintf("%srepos/%s/branches/%s/protection", ghinstance.RESTPrefix(host), fullName, branch)
+
+	statusChecks := map[string]interface{}{
+		"strict":   false,
+		"contexts": bp.RequiredStatusChecks,
+	}
+	if len(bp.RequiredStatusChecks) == 0 {
+		statusChecks = nil
+	}
+
+	payload := map[string]interface{}{
+		"required_status_checks": statusChecks,
+		"enforce_admins":         bp.EnforceAdmins,
+		"required_pull_request_reviews": map[string]interface{}{
+			"required_approving_review_count": bp.RequiredApprovingReviewCount,
+		},
+		"restrictions": nil,
+	}
+
+	return restRequest(httpClient, "PUT", url, payload, nil)
+}
+
+type repoContent struct {
+	SHA     string `json:"sha"`
+	Content string `json:"content"`
+}
+
+// getRepoFile returns nil if the file does not exist in the repo.
+func getRepoFile(httpClient *http.Client, host, fullName, path string) (*repoContent, error) {
+	url := fmt.Sprintf("%srepos/%s/contents/%s", ghinstance.RESTPrefix(host), fullName, path)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	var rc repoContent
+	if err := json.NewDecoder(resp.Body).Decode(&rc); err != nil {
+		return nil, err
+	}
+	return &rc, nil
+}
+
+func putRepoFile(httpClient *http.Client, host, fullName, path, message string, content []byte, sha string) error {
+	url := fmt.Sprintf("%srepos/%s/contents/%s", ghinstance.RESTPrefix(host), fullName, path)
+
+	payload := map[string]interface{}{
+		"message": message,
+		"content": base64.StdEncoding.EncodeToString(content),
+	}
+	if sha != "" {
+		payload["sha"] = sha
+	}
+
+	return restRequest(httpClient, "PUT", url, payload, nil)
+}
+
+func restRequest(httpClient *http.Client, method, url string, body interface{}, out interface{}) error {
+	buf := &bytes.Buffer{}
+	if body != nil {
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, url, buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	if out != nil {
+		return json.NewDecoder(resp.Body).Decode(out)
+	}
+	return nil
+}
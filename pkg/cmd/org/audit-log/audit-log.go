@@ -0,0 +1,18 @@
+package auditlog
+
+import (
+	exportCmd "github.com/cli/cli/v2/pkg/cmd/org/audit-log/export"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdAuditLog(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit-log <command>",
+		Short: "Work with an organization's audit log",
+	}
+
+	cmd.AddCommand(exportCmd.NewCmdExport(f, nil))
+
+	return cmd
+}
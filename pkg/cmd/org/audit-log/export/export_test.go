@@ -0,0 +1,95 @@
+package export
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportRun_jsonl(t *testing.T) {
+	paginationDelay = 0
+
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStderrTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/audit-log"),
+		httpmock.StringResponse(`[{"action": "team.add_member", "actor": "monalisa", "created_at": 1609459200000}]`))
+
+	err := exportRun(&ExportOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:    "my-org",
+		Format: "jsonl",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"action":"team.add_member","actor":"monalisa","created_at":1609459200000}`+"\n", stdout.String())
+	assert.Equal(t, "Exported 1 audit log events\n", stderr.String())
+}
+
+func TestExportRun_csv(t *testing.T) {
+	paginationDelay = 0
+
+	io, _, stdout, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/audit-log"),
+		httpmock.StringResponse(`[{"action": "team.add_member", "actor": "monalisa", "created_at": "2021-01-01T00:00:00Z", "org": "my-org", "user": "hubot"}]`))
+
+	err := exportRun(&ExportOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:    "my-org",
+		Format: "csv",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "action,actor,created_at,org,user,repo\nteam.add_member,monalisa,2021-01-01T00:00:00Z,my-org,hubot,\n", stdout.String())
+}
+
+func TestExportRun_resumesFromCursorFile(t *testing.T) {
+	paginationDelay = 0
+
+	io, _, stdout, _ := iostreams.Test()
+
+	cursorFile, err := os.CreateTemp(t.TempDir(), "cursor")
+	assert.NoError(t, err)
+	_, err = cursorFile.WriteString("https://api.github.com/orgs/my-org/audit-log?after=cursor123")
+	assert.NoError(t, err)
+	assert.NoError(t, cursorFile.Close())
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/audit-log"),
+		httpmock.StringResponse(`[{"action": "org.update_member"}]`))
+
+	err = exportRun(&ExportOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:     func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:        "my-org",
+		Format:     "jsonl",
+		CursorFile: cursorFile.Name(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"action":"org.update_member"}`+"\n", stdout.String())
+	_, err = os.Stat(cursorFile.Name())
+	assert.True(t, os.IsNotExist(err), "cursor file should be removed once the export finishes")
+}
@@ -0,0 +1,104 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+// paginationDelay is paced between successive pages of the audit log to stay
+// under GitHub's rate limits. It is a var so tests can zero it out.
+var paginationDelay = time.Second
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+// auditLogPage is one page of audit log events, plus the URL to fetch the
+// next page. NextURL is empty once the log has been fully paginated; it
+// doubles as the resumable cursor callers can persist between runs.
+type auditLogPage struct {
+	Events  []map[string]interface{}
+	NextURL string
+}
+
+func firstAuditLogURL(host, org, phrase string) string {
+	reqURL := fmt.Sprintf("%sorgs/%s/audit-log?per_page=100", ghinstance.RESTPrefix(host), org)
+	if phrase != "" {
+		reqURL += "&phrase=" + url.QueryEscape(phrase)
+	}
+	return reqURL
+}
+
+// getAuditLogPage fetches one page of the audit log. When the API signals
+// that the secondary rate limit is close to being hit (or returns a 403/429
+// with a Retry-After header), it sleeps for the requested duration and
+// retries once before giving up.
+func getAuditLogPage(httpClient *http.Client, reqURL string) (auditLogPage, error) {
+	for {
+		req, err := http.NewRequest("GET", reqURL, nil)
+		if err != nil {
+			return auditLogPage{}, err
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return auditLogPage{}, err
+		}
+
+		if wait := retryAfter(resp); wait > 0 {
+			resp.Body.Close()
+			time.Sleep(wait)
+			continue
+		}
+
+		defer resp.Body.Close()
+
+		if resp.StatusCode > 299 {
+			return auditLogPage{}, api.HandleHTTPError(resp)
+		}
+
+		var events []map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&events); err != nil {
+			return auditLogPage{}, err
+		}
+
+		return auditLogPage{Events: events, NextURL: findNextPage(resp.Header.Get("Link"))}, nil
+	}
+}
+
+// retryAfter returns how long to back off before retrying, or zero if the
+// response doesn't call for it.
+func retryAfter(resp *http.Response) time.Duration {
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+		if s := resp.Header.Get("Retry-After"); s != "" {
+			if secs, err := strconv.Atoi(s); err == nil {
+				return time.Duration(secs) * time.Second
+			}
+		}
+	}
+	if remaining := resp.Header.Get("X-RateLimit-Remaining"); remaining == "0" {
+		if resetAt := resp.Header.Get("X-RateLimit-Reset"); resetAt != "" {
+			if epoch, err := strconv.ParseInt(resetAt, 10, 64); err == nil {
+				if wait := time.Until(time.Unix(epoch, 0)); wait > 0 {
+					return wait
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func findNextPage(link string) string {
+	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,202 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/set"
+	"github.com/spf13/cobra"
+)
+
+var includableResources = []string{"issues", "labels", "milestones", "releases"}
+
+type manifest struct {
+	Org        string   `json:"org"`
+	ExportedAt string   `json:"exported_at"`
+	Include    []string `json:"include"`
+	Repos      []string `json:"repos"`
+}
+
+type ExportOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org     string
+	Dir     string
+	Repos   []string
+	Include []string
+
+	Now func() time.Time
+}
+
+func NewCmdExport(f *cmdutil.Factory, runF func(*ExportOptions) error) *cobra.Command {
+	opts := &ExportOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+		Now:        time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export <organization>",
+		Short: "Export an organization's issues, labels, milestones, and releases",
+		Long: heredoc.Doc(`
+			Export issues, labels, milestones, and releases for every repository
+			in an organization (or a chosen subset) into a directory, producing
+			a portable archive that 'gh org import' can replay onto another
+			host or organization.
+
+			This only exports metadata, not git history, commits, or release
+			assets. Use 'gh repo clone'/'git push --mirror' to move the actual
+			repository content.
+		`),
+		Example: heredoc.Doc(`
+			# Export everything for an organization
+			$ gh org export my-org --dir ./backup
+
+			# Export only issues and labels for specific repos
+			$ gh org export my-org --dir ./backup --repos api,web --include issues,labels
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if len(opts.Include) == 0 {
+				opts.Include = includableResources
+			} else {
+				for _, r := range opts.Include {
+					if !containsString(includableResources, r) {
+						return cmdutil.FlagErrorf("unsupported --include value %q", r)
+					}
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return exportRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Dir, "dir", "", "Directory to write the export to")
+	_ = cmd.MarkFlagRequired("dir")
+	cmd.Flags().StringSliceVar(&opts.Repos, "repos", nil, "Only export the given `repositories` (default: all repos in the org)")
+	cmd.Flags().StringSliceVar(&opts.Include, "include", nil, "Resource `types` to export: issues, labels, milestones, releases (default: all)")
+
+	return cmd
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func exportRun(opts *ExportOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	repos := opts.Repos
+	if len(repos) == 0 {
+		repos, err = listOrgRepos(httpClient, host, opts.Org)
+		if err != nil {
+			return fmt.Errorf("failed to list repositories for %s: %w", opts.Org, err)
+		}
+	}
+
+	if err := os.MkdirAll(opts.Dir, 0755); err != nil {
+		return err
+	}
+
+	include := set.NewStringSet()
+	include.AddValues(opts.Include)
+
+	cs := opts.IO.ColorScheme()
+
+	for _, repoName := range repos {
+		fullName := fmt.Sprintf("%s/%s", opts.Org, repoName)
+		repoDir := filepath.Join(opts.Dir, repoName)
+		if err := os.MkdirAll(repoDir, 0755); err != nil {
+			return err
+		}
+
+		if include.Contains("labels") {
+			if err := exportResource(httpClient, host, fullName, repoDir, "labels.json", fetchLabels); err != nil {
+				return err
+			}
+		}
+		if include.Contains("milestones") {
+			if err := exportResource(httpClient, host, fullName, repoDir, "milestones.json", fetchMilestones); err != nil {
+				return err
+			}
+		}
+		if include.Contains("issues") {
+			if err := exportResource(httpClient, host, fullName, repoDir, "issues.json", fetchIssues); err != nil {
+				return err
+			}
+		}
+		if include.Contains("releases") {
+			if err := exportResource(httpClient, host, fullName, repoDir, "releases.json", fetchReleases); err != nil {
+				return err
+			}
+		}
+
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Exported %s\n", cs.SuccessIcon(), fullName)
+		}
+	}
+
+	m := manifest{
+		Org:        opts.Org,
+		ExportedAt: opts.Now().UTC().Format(time.RFC3339),
+		Include:    opts.Include,
+		Repos:      repos,
+	}
+	manifestBytes, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(opts.Dir, "manifest.json"), manifestBytes, 0644); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func exportResource(httpClient *http.Client, host, fullName, repoDir, filename string, fetch func(*http.Client, string, string) ([]json.RawMessage, error)) error {
+	items, err := fetch(httpClient, host, fullName)
+	if err != nil {
+		return fmt.Errorf("failed to export %s for %s: %w", filename, fullName, err)
+	}
+
+	data, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(repoDir, filename), data, 0644)
+}
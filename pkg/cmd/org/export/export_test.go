@@ -0,0 +1,71 @@
+package export
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_exportRun_writesManifestAndResources(t *testing.T) {
+	dir := t.TempDir()
+	io, _, _, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/repos"),
+		httpmock.StringResponse(`[{"name": "api"}]`))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/api/labels"),
+		httpmock.StringResponse(`[{"name": "bug", "color": "ff0000"}]`))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/api/milestones"),
+		httpmock.StringResponse(`[]`))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/api/issues"),
+		httpmock.StringResponse(`[{"title": "a bug"}, {"title": "a pr", "pull_request": {}}]`))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/api/releases"),
+		httpmock.StringResponse(`[]`))
+
+	err := exportRun(&ExportOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Org:     "my-org",
+		Dir:     dir,
+		Include: includableResources,
+		Now:     func() time.Time { return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC) },
+	})
+	require.NoError(t, err)
+
+	manifestBytes, err := os.ReadFile(filepath.Join(dir, "manifest.json"))
+	require.NoError(t, err)
+	var m manifest
+	require.NoError(t, json.Unmarshal(manifestBytes, &m))
+	assert.Equal(t, []string{"api"}, m.Repos)
+
+	issuesBytes, err := os.ReadFile(filepath.Join(dir, "api", "issues.json"))
+	require.NoError(t, err)
+	var issues []json.RawMessage
+	require.NoError(t, json.Unmarshal(issuesBytes, &issues))
+	assert.Len(t, issues, 1)
+}
+
+func Test_containsString(t *testing.T) {
+	assert.True(t, containsString(includableResources, "labels"))
+	assert.False(t, containsString(includableResources, "bogus"))
+}
@@ -0,0 +1,124 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func findNextPage(link string) string {
+	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+func restGetPage(httpClient *http.Client, url string, data interface{}) (string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(data); err != nil {
+		return "", err
+	}
+
+	return findNextPage(resp.Header.Get("Link")), nil
+}
+
+func listOrgRepos(httpClient *http.Client, host, org string) ([]string, error) {
+	var names []string
+
+	url := fmt.Sprintf("%sorgs/%s/repos?per_page=100", ghinstance.RESTPrefix(host), org)
+	for url != "" {
+		var page []struct {
+			Name string `json:"name"`
+		}
+		nextURL, err := restGetPage(httpClient, url, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page {
+			names = append(names, r.Name)
+		}
+		url = nextURL
+	}
+
+	return names, nil
+}
+
+func fetchAllPages(httpClient *http.Client, firstURL string) ([]json.RawMessage, error) {
+	items := []json.RawMessage{}
+
+	url := firstURL
+	for url != "" {
+		var page []json.RawMessage
+		nextURL, err := restGetPage(httpClient, url, &page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		url = nextURL
+	}
+
+	return items, nil
+}
+
+func fetchLabels(httpClient *http.Client, host, fullName string) ([]json.RawMessage, error) {
+	url := fmt.Sprintf("%srepos/%s/labels?per_page=100", ghinstance.RESTPrefix(host), fullName)
+	return fetchAllPages(httpClient, url)
+}
+
+func fetchMilestones(httpClient *http.Client, host, fullName string) ([]json.RawMessage, error) {
+	url := fmt.Sprintf("%srepos/%s/milestones?state=all&per_page=100", ghinstance.RESTPrefix(host), fullName)
+	return fetchAllPages(httpClient, url)
+}
+
+func fetchReleases(httpClient *http.Client, host, fullName string) ([]json.RawMessage, error) {
+	url := fmt.Sprintf("%srepos/%s/releases?per_page=100", ghinstance.RESTPrefix(host), fullName)
+	return fetchAllPages(httpClient, url)
+}
+
+// fetchIssues returns issues only, filtering out the pull requests that the
+// GitHub REST API mixes into the same endpoint.
+func fetchIssues(httpClient *http.Client, host, fullName string) ([]json.RawMessage, error) {
+	url := fmt.Sprintf("%srepos/%s/issues?state=all&per_page=100", ghinstance.RESTPrefix(host), fullName)
+	all, err := fetchAllPages(httpClient, url)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := []json.RawMessage{}
+	for _, raw := range all {
+		var probe struct {
+			PullRequest json.RawMessage `json:"pull_request"`
+		}
+		if err := json.Unmarshal(raw, &probe); err != nil {
+			return nil, err
+		}
+		if probe.PullRequest != nil {
+			continue
+		}
+		issues = append(issues, raw)
+	}
+
+	return issues, nil
+}
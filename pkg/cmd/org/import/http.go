@@ -0,0 +1,178 @@
+package orgimport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func findNextPage(link string) string {
+	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+func restGetPage(httpClient *http.Client, url string, data interface{}) (string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(data); err != nil {
+		return "", err
+	}
+
+	return findNextPage(resp.Header.Get("Link")), nil
+}
+
+func restRequest(httpClient *http.Client, method, url string, body, out interface{}) error {
+	buf := &bytes.Buffer{}
+	if body != nil {
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, url, buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	if out != nil {
+		return json.NewDecoder(resp.Body).Decode(out)
+	}
+	return nil
+}
+
+type existingLabel struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+func listExistingLabels(httpClient *http.Client, host, fullName string) (map[string]existingLabel, error) {
+	labels := map[string]existingLabel{}
+
+	url := fmt.Sprintf("%srepos/%s/labels?per_page=100", ghinstance.RESTPrefix(host), fullName)
+	for url != "" {
+		var page []existingLabel
+		nextURL, err := restGetPage(httpClient, url, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range page {
+			labels[l.Name] = l
+		}
+		url = nextURL
+	}
+
+	return labels, nil
+}
+
+func createLabel(httpClient *http.Client, host, fullName string, l existingLabel) error {
+	url := fmt.Sprintf("%srepos/%s/labels", ghinstance.RESTPrefix(host), fullName)
+	return restRequest(httpClient, "POST", url, l, nil)
+}
+
+type existingMilestone struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+}
+
+func listExistingMilestones(httpClient *http.Client, host, fullName string) (map[string]int, error) {
+	milestones := map[string]int{}
+
+	url := fmt.Sprintf("%srepos/%s/milestones?state=all&per_page=100", ghinstance.RESTPrefix(host), fullName)
+	for url != "" {
+		var page []existingMilestone
+		nextURL, err := restGetPage(httpClient, url, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range page {
+			milestones[m.Title] = m.Number
+		}
+		url = nextURL
+	}
+
+	return milestones, nil
+}
+
+func createMilestone(httpClient *http.Client, host, fullName string, title, description, dueOn string) (int, error) {
+	url := fmt.Sprintf("%srepos/%s/milestones", ghinstance.RESTPrefix(host), fullName)
+	body := map[string]interface{}{"title": title}
+	if description != "" {
+		body["description"] = description
+	}
+	if dueOn != "" {
+		body["due_on"] = dueOn
+	}
+
+	var created existingMilestone
+	if err := restRequest(httpClient, "POST", url, body, &created); err != nil {
+		return 0, err
+	}
+	return created.Number, nil
+}
+
+func createIssue(httpClient *http.Client, host, fullName string, body map[string]interface{}) error {
+	url := fmt.Sprintf("%srepos/%s/issues", ghinstance.RESTPrefix(host), fullName)
+	return restRequest(httpClient, "POST", url, body, nil)
+}
+
+func listExistingReleaseTags(httpClient *http.Client, host, fullName string) (map[string]bool, error) {
+	tags := map[string]bool{}
+
+	url := fmt.Sprintf("%srepos/%s/releases?per_page=100", ghinstance.RESTPrefix(host), fullName)
+	for url != "" {
+		var page []struct {
+			TagName string `json:"tag_name"`
+		}
+		nextURL, err := restGetPage(httpClient, url, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page {
+			tags[r.TagName] = true
+		}
+		url = nextURL
+	}
+
+	return tags, nil
+}
+
+func createRelease(httpClient *http.Client, host, fullName string, body map[string]interface{}) error {
+	url := fmt.Sprintf("%srepos/%s/releases", ghinstance.RESTPrefix(host), fullName)
+	return restRequest(httpClient, "POST", url, body, nil)
+}
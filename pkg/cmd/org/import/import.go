@@ -0,0 +1,281 @@
+package orgimport
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type manifest struct {
+	Org   string   `json:"org"`
+	Repos []string `json:"repos"`
+}
+
+type ImportOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org   string
+	Dir   string
+	Repos []string
+}
+
+func NewCmdImport(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Command {
+	opts := &ImportOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "import <organization>",
+		Short: "Import issues, labels, milestones, and releases from a 'gh org export' archive",
+		Long: heredoc.Doc(`
+			Replay a directory produced by 'gh org export' onto an organization,
+			creating any missing labels, milestones, issues, and releases.
+
+			Labels are matched by name and left untouched if already present.
+			Milestones are matched by title, and issue milestone references are
+			resolved to the newly created (or existing) milestone. Releases are
+			matched by tag name so that running import more than once does not
+			create duplicates.
+
+			Issues are NOT deduplicated: the GitHub REST API cannot set an
+			issue's original number, author, or timestamps, so re-running
+			import against the same organization will create duplicate issues.
+			Release assets are not transferred.
+		`),
+		Example: heredoc.Doc(`
+			$ gh org import my-new-org --dir ./backup
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return importRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Dir, "dir", "", "Directory containing a 'gh org export' archive")
+	_ = cmd.MarkFlagRequired("dir")
+	cmd.Flags().StringSliceVar(&opts.Repos, "repos", nil, "Only import the given `repositories` (default: all repos in the archive)")
+
+	return cmd
+}
+
+func importRun(opts *ImportOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	manifestBytes, err := os.ReadFile(filepath.Join(opts.Dir, "manifest.json"))
+	if err != nil {
+		return fmt.Errorf("failed to read manifest: %w", err)
+	}
+	var m manifest
+	if err := json.Unmarshal(manifestBytes, &m); err != nil {
+		return fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	repos := opts.Repos
+	if len(repos) == 0 {
+		repos = m.Repos
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	for _, repoName := range repos {
+		fullName := fmt.Sprintf("%s/%s", opts.Org, repoName)
+		repoDir := filepath.Join(opts.Dir, repoName)
+
+		milestoneNumbers, err := importLabelsAndMilestones(httpClient, host, fullName, repoDir)
+		if err != nil {
+			return err
+		}
+
+		if err := importIssues(httpClient, host, fullName, repoDir, milestoneNumbers); err != nil {
+			return err
+		}
+
+		if err := importReleases(httpClient, host, fullName, repoDir); err != nil {
+			return err
+		}
+
+		if opts.IO.IsStdoutTTY() && !opts.IO.GetQuiet() {
+			fmt.Fprintf(opts.IO.Out, "%s Imported %s\n", cs.SuccessIcon(), fullName)
+		}
+	}
+
+	return nil
+}
+
+func importLabelsAndMilestones(httpClient *http.Client, host, fullName, repoDir string) (map[string]int, error) {
+	if labels, err := readJSONFile(filepath.Join(repoDir, "labels.json")); err == nil {
+		existing, err := listExistingLabels(httpClient, host, fullName)
+		if err != nil {
+			return nil, err
+		}
+		for _, raw := range labels {
+			var l existingLabel
+			if err := json.Unmarshal(raw, &l); err != nil {
+				return nil, err
+			}
+			if _, ok := existing[l.Name]; ok {
+				continue
+			}
+			if err := createLabel(httpClient, host, fullName, l); err != nil {
+				return nil, fmt.Errorf("failed to create label %q in %s: %w", l.Name, fullName, err)
+			}
+		}
+	}
+
+	milestoneNumbers, err := listExistingMilestones(httpClient, host, fullName)
+	if err != nil {
+		return nil, err
+	}
+
+	if milestones, err := readJSONFile(filepath.Join(repoDir, "milestones.json")); err == nil {
+		for _, raw := range milestones {
+			var ms struct {
+				Title       string `json:"title"`
+				Description string `json:"description"`
+				DueOn       string `json:"due_on"`
+			}
+			if err := json.Unmarshal(raw, &ms); err != nil {
+				return nil, err
+			}
+			if _, ok := milestoneNumbers[ms.Title]; ok {
+				continue
+			}
+			number, err := createMilestone(httpClient, host, fullName, ms.Title, ms.Description, ms.DueOn)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create milestone %q in %s: %w", ms.Title, fullName, err)
+			}
+			milestoneNumbers[ms.Title] = number
+		}
+	}
+
+	return milestoneNumbers, nil
+}
+
+func importIssues(httpClient *http.Client, host, fullName, repoDir string, milestoneNumbers map[string]int) error {
+	issues, err := readJSONFile(filepath.Join(repoDir, "issues.json"))
+	if err != nil {
+		return nil
+	}
+
+	for _, raw := range issues {
+		var issue struct {
+			Title     string `json:"title"`
+			Body      string `json:"body"`
+			Milestone *struct {
+				Title string `json:"title"`
+			} `json:"milestone"`
+			Labels []struct {
+				Name string `json:"name"`
+			} `json:"labels"`
+		}
+		if err := json.Unmarshal(raw, &issue); err != nil {
+			return err
+		}
+
+		body := map[string]interface{}{
+			"title": issue.Title,
+			"body":  issue.Body,
+		}
+		if issue.Milestone != nil {
+			if number, ok := milestoneNumbers[issue.Milestone.Title]; ok {
+				body["milestone"] = number
+			}
+		}
+		if len(issue.Labels) > 0 {
+			labelNames := make([]string, len(issue.Labels))
+			for i, l := range issue.Labels {
+				labelNames[i] = l.Name
+			}
+			body["labels"] = labelNames
+		}
+
+		if err := createIssue(httpClient, host, fullName, body); err != nil {
+			return fmt.Errorf("failed to create issue %q in %s: %w", issue.Title, fullName, err)
+		}
+	}
+
+	return nil
+}
+
+func importReleases(httpClient *http.Client, host, fullName, repoDir string) error {
+	releases, err := readJSONFile(filepath.Join(repoDir, "releases.json"))
+	if err != nil {
+		return nil
+	}
+
+	existingTags, err := listExistingReleaseTags(httpClient, host, fullName)
+	if err != nil {
+		return err
+	}
+
+	for _, raw := range releases {
+		var r struct {
+			TagName    string `json:"tag_name"`
+			Name       string `json:"name"`
+			Body       string `json:"body"`
+			Draft      bool   `json:"draft"`
+			Prerelease bool   `json:"prerelease"`
+		}
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return err
+		}
+		if existingTags[r.TagName] {
+			continue
+		}
+
+		body := map[string]interface{}{
+			"tag_name":   r.TagName,
+			"name":       r.Name,
+			"body":       r.Body,
+			"draft":      r.Draft,
+			"prerelease": r.Prerelease,
+		}
+		if err := createRelease(httpClient, host, fullName, body); err != nil {
+			return fmt.Errorf("failed to create release %q in %s: %w", r.TagName, fullName, err)
+		}
+	}
+
+	return nil
+}
+
+func readJSONFile(path string) ([]json.RawMessage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,93 @@
+package orgimport
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_importRun_createsMissingResources(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "api"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest.json"), []byte(`{"org": "my-org", "repos": ["api"]}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api", "labels.json"), []byte(`[{"name": "bug", "color": "ff0000"}]`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api", "milestones.json"), []byte(`[{"title": "v1"}]`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api", "issues.json"), []byte(`[{"title": "a bug", "milestone": {"title": "v1"}, "labels": [{"name": "bug"}]}]`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api", "releases.json"), []byte(`[{"tag_name": "v1.0.0"}]`), 0600))
+
+	io, _, _, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/api/labels"),
+		httpmock.StringResponse(`[]`))
+	reg.Register(
+		httpmock.REST("POST", "repos/my-org/api/labels"),
+		httpmock.StringResponse(`{}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/api/milestones"),
+		httpmock.StringResponse(`[]`))
+	reg.Register(
+		httpmock.REST("POST", "repos/my-org/api/milestones"),
+		httpmock.StringResponse(`{"number": 1, "title": "v1"}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/my-org/api/issues"),
+		httpmock.StringResponse(`{}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/api/releases"),
+		httpmock.StringResponse(`[]`))
+	reg.Register(
+		httpmock.REST("POST", "repos/my-org/api/releases"),
+		httpmock.StringResponse(`{}`))
+
+	err := importRun(&ImportOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Org: "my-org",
+		Dir: dir,
+	})
+	require.NoError(t, err)
+}
+
+func Test_importRun_skipsExistingReleases(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "api"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest.json"), []byte(`{"org": "my-org", "repos": ["api"]}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api", "releases.json"), []byte(`[{"tag_name": "v1.0.0"}]`), 0600))
+
+	io, _, _, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/api/milestones"),
+		httpmock.StringResponse(`[]`))
+	reg.Register(
+		httpmock.REST("GET", "repos/my-org/api/releases"),
+		httpmock.StringResponse(`[{"tag_name": "v1.0.0"}]`))
+
+	err := importRun(&ImportOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Org: "my-org",
+		Dir: dir,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,74 @@
+package cancel
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CancelOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org          string
+	InvitationID string
+}
+
+func NewCmdCancel(f *cmdutil.Factory, runF func(*CancelOptions) error) *cobra.Command {
+	opts := &CancelOptions{
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "cancel <organization> <invitation-id>",
+		Short: "Cancel a pending organization invitation",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+			opts.InvitationID = args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return cancelRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func cancelRun(opts *CancelOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	if err := cancelInvitation(httpClient, host, opts.Org, opts.InvitationID); err != nil {
+		return err
+	}
+
+	if !opts.IO.IsStdoutTTY() {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	_, err = fmt.Fprintf(opts.IO.Out, "%s Canceled invitation to %s\n", cs.SuccessIconWithColor(cs.Red), cs.Bold(opts.Org))
+	return err
+}
@@ -0,0 +1,30 @@
+package cancel
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+func cancelInvitation(httpClient *http.Client, host, org, invitationID string) error {
+	path := fmt.Sprintf("orgs/%s/invitations/%s", org, invitationID)
+	url := ghinstance.RESTPrefix(host) + path
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package invitation
+
+import (
+	cmdCancel "github.com/cli/cli/v2/pkg/cmd/org/invitation/cancel"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/org/invitation/list"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdInvitation(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invitation <command>",
+		Short: "Manage pending organization invitations",
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdCancel.NewCmdCancel(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type Invitation struct {
+	ID        int64     `json:"id"`
+	Login     string    `json:"login"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func orgInvitations(httpClient *http.Client, host, org string) ([]Invitation, error) {
+	path := fmt.Sprintf("orgs/%s/invitations?per_page=100", org)
+	url := ghinstance.RESTPrefix(host) + path
+
+	var invitations []Invitation
+	for url != "" {
+		page, next, err := getInvitationsPage(httpClient, url)
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, page...)
+		url = next
+	}
+	return invitations, nil
+}
+
+func getInvitationsPage(httpClient *http.Client, url string) ([]Invitation, string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, "", api.HandleHTTPError(resp)
+	}
+
+	var invitations []Invitation
+	if err := json.NewDecoder(resp.Body).Decode(&invitations); err != nil {
+		return nil, "", err
+	}
+
+	return invitations, findNextPage(resp.Header.Get("Link")), nil
+}
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func findNextPage(link string) string {
+	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list <organization>",
+		Short:   "List pending invitations for an organization",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	invitations, err := orgInvitations(httpClient, host, opts.Org)
+	if err != nil {
+		return fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	if len(invitations) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "No pending invitations for %s\n", opts.Org)
+		}
+		return cmdutil.SilentError
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	now := time.Now()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, invitation := range invitations {
+		login := invitation.Login
+		if login == "" {
+			login = invitation.Email
+		}
+		tp.AddField(login, nil, cs.Bold)
+		tp.AddField(invitation.Role, nil, nil)
+		createdAt := invitation.CreatedAt.Format(time.RFC3339)
+		if tp.IsTTY() {
+			createdAt = utils.FuzzyAgoAbbr(now, invitation.CreatedAt)
+		}
+		tp.AddField(createdAt, nil, cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,40 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(false)
+	io.SetStderrTTY(false)
+
+	createdAt, _ := time.Parse(time.RFC3339, "2020-08-31T15:44:24+02:00")
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/invitations"),
+		httpmock.StringResponse(`[
+			{"id": 1, "login": "monalisa", "role": "direct_member", "created_at": "2020-08-31T15:44:24+02:00"}
+		]`))
+
+	err := listRun(&ListOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:    "my-org",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "monalisa\tdirect_member\t"+createdAt.Format(time.RFC3339)+"\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
@@ -0,0 +1,91 @@
+package add
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AddOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org      string
+	Username string
+	Role     string
+}
+
+func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command {
+	opts := &AddOptions{
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <organization> <username>",
+		Short: "Add or invite a member to an organization",
+		Long: heredoc.Doc(`
+			Add a user to an organization, or invite them if they aren't already a
+			GitHub user who can be added directly.
+
+			If the organization requires two-factor authentication and the invited
+			user hasn't enabled it, GitHub sends them an invitation email instead of
+			adding them immediately.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+			opts.Username = args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return addRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Role, "role", "", "member", []string{"admin", "member"}, "The role to give the new member")
+
+	return cmd
+}
+
+func addRun(opts *AddOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	result, err := addOrgMember(httpClient, host, opts.Org, opts.Username, opts.Role)
+	if err != nil {
+		return err
+	}
+
+	if !opts.IO.IsStdoutTTY() {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	if result.State == "pending" {
+		_, err = fmt.Fprintf(opts.IO.Out, "%s Invited %s to %s as %s\n", cs.SuccessIcon(), cs.Bold(opts.Username), cs.Bold(opts.Org), result.Role)
+	} else {
+		_, err = fmt.Fprintf(opts.IO.Out, "%s Added %s to %s as %s\n", cs.SuccessIcon(), cs.Bold(opts.Username), cs.Bold(opts.Org), result.Role)
+	}
+	return err
+}
@@ -0,0 +1,49 @@
+package add
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type membership struct {
+	State string `json:"state"`
+	Role  string `json:"role"`
+}
+
+func addOrgMember(httpClient *http.Client, host, org, username, role string) (membership, error) {
+	var result membership
+
+	body := bytes.NewBufferString("")
+	if err := json.NewEncoder(body).Encode(map[string]string{"role": role}); err != nil {
+		return result, err
+	}
+
+	path := fmt.Sprintf("orgs/%s/memberships/%s", org, username)
+	url := ghinstance.RESTPrefix(host) + path
+	req, err := http.NewRequest("PUT", url, body)
+	if err != nil {
+		return result, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return result, api.HandleHTTPError(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,94 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type Member struct {
+	Login     string `json:"login"`
+	ID        int64  `json:"id"`
+	SiteAdmin bool   `json:"site_admin"`
+	URL       string `json:"html_url"`
+}
+
+func (m Member) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "login":
+			data[f] = m.Login
+		case "id":
+			data[f] = m.ID
+		case "siteAdmin":
+			data[f] = m.SiteAdmin
+		case "url":
+			data[f] = m.URL
+		}
+	}
+	return data
+}
+
+var MemberFields = []string{"id", "login", "siteAdmin", "url"}
+
+func orgMembers(httpClient *http.Client, host, org, role, filter string) ([]Member, error) {
+	path := fmt.Sprintf("orgs/%s/members?per_page=100", org)
+	if role != "" {
+		path += "&role=" + role
+	}
+	if filter != "" {
+		path += "&filter=" + filter
+	}
+	url := ghinstance.RESTPrefix(host) + path
+
+	var members []Member
+	for url != "" {
+		page, next, err := getMembersPage(httpClient, url)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, page...)
+		url = next
+	}
+	return members, nil
+}
+
+func getMembersPage(httpClient *http.Client, url string) ([]Member, string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, "", api.HandleHTTPError(resp)
+	}
+
+	var members []Member
+	if err := json.NewDecoder(resp.Body).Decode(&members); err != nil {
+		return nil, "", err
+	}
+
+	return members, findNextPage(resp.Header.Get("Link")), nil
+}
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func findNextPage(link string) string {
+	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
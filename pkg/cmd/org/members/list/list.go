@@ -0,0 +1,111 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	Exporter   cmdutil.Exporter
+
+	Org    string
+	Role   string
+	Filter string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list <organization>",
+		Short: "List members of an organization",
+		Long: heredoc.Doc(`
+			List members of an organization.
+
+			Note that GitHub's members API does not report each member's role or
+			two-factor authentication status directly; use '--role' and '--filter'
+			to have GitHub apply those filters server-side instead. Filtering by
+			last activity is not supported, as it requires an organization audit
+			log that is only available to GitHub Enterprise Cloud accounts.
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, MemberFields)
+	cmdutil.StringEnumFlag(cmd, &opts.Role, "role", "", "all", []string{"all", "admin", "member"}, "Filter members returned by their role in the organization")
+	cmdutil.StringEnumFlag(cmd, &opts.Filter, "filter", "", "all", []string{"all", "2fa_disabled"}, "Filter members by two-factor authentication status")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	members, err := orgMembers(httpClient, host, opts.Org, opts.Role, opts.Filter)
+	if err != nil {
+		return fmt.Errorf("failed to list members: %w", err)
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, members)
+	}
+
+	if len(members) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "No members found in %s\n", opts.Org)
+		}
+		return cmdutil.SilentError
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, member := range members {
+		tp.AddField(member.Login, nil, cs.Bold)
+		tp.AddField(member.URL, nil, cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
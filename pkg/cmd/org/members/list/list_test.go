@@ -0,0 +1,82 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ListOptions
+		isTTY      bool
+		httpStubs  func(t *testing.T, reg *httpmock.Registry)
+		wantStdout string
+		wantStderr string
+		wantErr    bool
+	}{
+		{
+			name:  "list tty",
+			isTTY: true,
+			opts:  ListOptions{Org: "my-org"},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/members"),
+					httpmock.StringResponse(`[
+						{"login": "monalisa", "id": 1, "html_url": "https://github.com/monalisa"},
+						{"login": "hubot", "id": 2, "html_url": "https://github.com/hubot"}
+					]`),
+				)
+			},
+			wantStdout: "monalisa  https://github.com/monalisa\nhubot     https://github.com/hubot\n",
+		},
+		{
+			name:  "no members",
+			isTTY: true,
+			opts:  ListOptions{Org: "my-org"},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/members"),
+					httpmock.StringResponse(`[]`),
+				)
+			},
+			wantStderr: "No members found in my-org\n",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, stderr := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+			defer reg.Verify(t)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.HTTPClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.Config = func() (config.Config, error) { return config.NewBlankConfig(), nil }
+
+			err := listRun(&opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package members
+
+import (
+	cmdAdd "github.com/cli/cli/v2/pkg/cmd/org/members/add"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/org/members/list"
+	cmdRemove "github.com/cli/cli/v2/pkg/cmd/org/members/remove"
+	cmdSetRole "github.com/cli/cli/v2/pkg/cmd/org/members/set-role"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdMembers(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members <command>",
+		Short: "Manage organization members",
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
+	cmd.AddCommand(cmdRemove.NewCmdRemove(f, nil))
+	cmd.AddCommand(cmdSetRole.NewCmdSetRole(f, nil))
+
+	return cmd
+}
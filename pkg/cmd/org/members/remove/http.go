@@ -0,0 +1,30 @@
+package remove
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+func removeOrgMember(httpClient *http.Client, host, org, username string) error {
+	path := fmt.Sprintf("orgs/%s/members/%s", org, username)
+	url := ghinstance.RESTPrefix(host) + path
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
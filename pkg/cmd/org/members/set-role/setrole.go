@@ -0,0 +1,79 @@
+package setrole
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type SetRoleOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org      string
+	Username string
+	Role     string
+}
+
+func NewCmdSetRole(f *cmdutil.Factory, runF func(*SetRoleOptions) error) *cobra.Command {
+	opts := &SetRoleOptions{
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "set-role <organization> <username>",
+		Short: "Change the role of an existing organization member",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+			opts.Username = args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return setRoleRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Role, "role", "", "", []string{"admin", "member"}, "The role to assign")
+	_ = cmd.MarkFlagRequired("role")
+
+	return cmd
+}
+
+func setRoleRun(opts *SetRoleOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	result, err := setMembership(httpClient, host, opts.Org, opts.Username, opts.Role)
+	if err != nil {
+		return err
+	}
+
+	if !opts.IO.IsStdoutTTY() {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	_, err = fmt.Fprintf(opts.IO.Out, "%s Set %s's role in %s to %s\n", cs.SuccessIcon(), cs.Bold(opts.Username), cs.Bold(opts.Org), result.Role)
+	return err
+}
@@ -0,0 +1,39 @@
+package org
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	applyPolicyCmd "github.com/cli/cli/v2/pkg/cmd/org/apply-policy"
+	auditLogCmd "github.com/cli/cli/v2/pkg/cmd/org/audit-log"
+	exportCmd "github.com/cli/cli/v2/pkg/cmd/org/export"
+	orgImportCmd "github.com/cli/cli/v2/pkg/cmd/org/import"
+	invitationCmd "github.com/cli/cli/v2/pkg/cmd/org/invitation"
+	membersCmd "github.com/cli/cli/v2/pkg/cmd/org/members"
+	secretCmd "github.com/cli/cli/v2/pkg/cmd/org/secret"
+	viewCmd "github.com/cli/cli/v2/pkg/cmd/org/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdOrg(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "org <command>",
+		Short: "Manage organizations",
+		Long:  "Work with GitHub organizations.",
+		Example: heredoc.Doc(`
+			$ gh org members list my-org
+			$ gh org members add my-org monalisa --role admin
+			$ gh org invitation list my-org
+		`),
+	}
+
+	cmd.AddCommand(viewCmd.NewCmdView(f, nil))
+	cmd.AddCommand(membersCmd.NewCmdMembers(f))
+	cmd.AddCommand(invitationCmd.NewCmdInvitation(f))
+	cmd.AddCommand(auditLogCmd.NewCmdAuditLog(f))
+	cmd.AddCommand(secretCmd.NewCmdSecret(f))
+	cmd.AddCommand(applyPolicyCmd.NewCmdApplyPolicy(f, nil))
+	cmd.AddCommand(exportCmd.NewCmdExport(f, nil))
+	cmd.AddCommand(orgImportCmd.NewCmdImport(f, nil))
+
+	return cmd
+}
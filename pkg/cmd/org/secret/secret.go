@@ -0,0 +1,18 @@
+package secret
+
+import (
+	syncCmd "github.com/cli/cli/v2/pkg/cmd/org/secret/sync"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdSecret(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret <command>",
+		Short: "Manage an organization's secrets",
+	}
+
+	cmd.AddCommand(syncCmd.NewCmdSync(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,259 @@
+package sync
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type remoteSecret struct {
+	Name                 string `json:"name"`
+	Visibility           string `json:"visibility"`
+	SelectedReposURL     string `json:"selected_repositories_url"`
+	SelectedRepositories []string
+}
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func listOrgSecrets(httpClient *http.Client, host, org string) (map[string]*remoteSecret, error) {
+	secrets := map[string]*remoteSecret{}
+
+	url := fmt.Sprintf("%sorgs/%s/actions/secrets?per_page=100", ghinstance.RESTPrefix(host), org)
+	for url != "" {
+		var page struct {
+			Secrets []*remoteSecret `json:"secrets"`
+		}
+		nextURL, err := getJSON(httpClient, url, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range page.Secrets {
+			secrets[s.Name] = s
+		}
+		url = nextURL
+	}
+
+	for _, s := range secrets {
+		if s.Visibility != "selected" || s.SelectedReposURL == "" {
+			continue
+		}
+		repos, err := listSelectedRepos(httpClient, s.SelectedReposURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list selected repositories for %s: %w", s.Name, err)
+		}
+		s.SelectedRepositories = repos
+	}
+
+	return secrets, nil
+}
+
+func listSelectedRepos(httpClient *http.Client, url string) ([]string, error) {
+	var repos []string
+	for url != "" {
+		var page struct {
+			Repositories []struct {
+				FullName string `json:"full_name"`
+			} `json:"repositories"`
+		}
+		nextURL, err := getJSON(httpClient, url+"?per_page=100", &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page.Repositories {
+			repos = append(repos, r.FullName)
+		}
+		url = nextURL
+	}
+	sort.Strings(repos)
+	return repos, nil
+}
+
+func getJSON(httpClient *http.Client, url string, data interface{}) (string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(data); err != nil {
+		return "", err
+	}
+
+	return findNextPage(resp.Header.Get("Link")), nil
+}
+
+func findNextPage(link string) string {
+	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+type pubKey struct {
+	ID  string `json:"key_id"`
+	Key string `json:"key"`
+}
+
+func getOrgPublicKey(httpClient *http.Client, host, org string) (*pubKey, error) {
+	url := fmt.Sprintf("%sorgs/%s/actions/secrets/public-key", ghinstance.RESTPrefix(host), org)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	var pk pubKey
+	if err := json.NewDecoder(resp.Body).Decode(&pk); err != nil {
+		return nil, err
+	}
+
+	return &pk, nil
+}
+
+type putSecretRequest struct {
+	EncryptedValue string  `json:"encrypted_value"`
+	KeyID          string  `json:"key_id"`
+	Visibility     string  `json:"visibility"`
+	Repositories   []int64 `json:"selected_repository_ids,omitempty"`
+}
+
+func putOrgSecret(httpClient *http.Client, host, org, name string, req putSecretRequest) error {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(req); err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%sorgs/%s/actions/secrets/%s", ghinstance.RESTPrefix(host), org, name)
+	httpReq, err := http.NewRequest("PUT", url, body)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
+
+func putOrgSecretRepositories(httpClient *http.Client, host, org, name string, repositoryIDs []int64) error {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string][]int64{"selected_repository_ids": repositoryIDs}); err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%sorgs/%s/actions/secrets/%s/repositories", ghinstance.RESTPrefix(host), org, name)
+	req, err := http.NewRequest("PUT", url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
+
+func deleteOrgSecret(httpClient *http.Client, host, org, name string) error {
+	url := fmt.Sprintf("%sorgs/%s/actions/secrets/%s", ghinstance.RESTPrefix(host), org, name)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
+
+// mapRepoNamesToIDs looks up the numeric database IDs for a list of
+// "owner/repo" names, which the org secrets API requires instead of names.
+func mapRepoNamesToIDs(client *api.Client, host string, repoNames []string) (map[string]int64, error) {
+	repos := make([]ghrepo.Interface, 0, len(repoNames))
+	for _, name := range repoNames {
+		repo, err := ghrepo.FromFullNameWithHost(name, host)
+		if err != nil {
+			return nil, fmt.Errorf("invalid repository name %q: %w", name, err)
+		}
+		repos = append(repos, repo)
+	}
+
+	queries := make([]string, 0, len(repos))
+	for i, repo := range repos {
+		queries = append(queries, fmt.Sprintf(`
+			repo_%03d: repository(owner: %q, name: %q) {
+				databaseId
+				nameWithOwner
+			}
+		`, i, repo.RepoOwner(), repo.RepoName()))
+	}
+
+	query := fmt.Sprintf(`query MapRepositoryNames { %s }`, strings.Join(queries, ""))
+
+	graphqlResult := make(map[string]*struct {
+		DatabaseID    int64  `json:"databaseId"`
+		NameWithOwner string `json:"nameWithOwner"`
+	})
+
+	if err := client.GraphQL(host, query, nil, &graphqlResult); err != nil {
+		return nil, fmt.Errorf("failed to look up repositories: %w", err)
+	}
+
+	result := make(map[string]int64, len(graphqlResult))
+	for _, r := range graphqlResult {
+		result[r.NameWithOwner] = r.DatabaseID
+	}
+	return result, nil
+}
@@ -0,0 +1,318 @@
+package sync
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/nacl/box"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	visibilityAll      = "all"
+	visibilityPrivate  = "private"
+	visibilitySelected = "selected"
+)
+
+type secretDefinition struct {
+	Name       string   `yaml:"name"`
+	Visibility string   `yaml:"visibility"`
+	Repos      []string `yaml:"repos"`
+}
+
+type secretsFile struct {
+	Secrets []secretDefinition `yaml:"secrets"`
+}
+
+type SyncOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org      string
+	Filename string
+	DryRun   bool
+	Prune    bool
+}
+
+func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Command {
+	opts := &SyncOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync <organization>",
+		Short: "Reconcile an organization's Actions secrets with a YAML definition file",
+		Long: heredoc.Doc(`
+			Create, update, and optionally prune an organization's Actions secrets
+			to match a YAML definition file, so secret visibility and repository
+			access can be managed as code.
+
+			Because GitHub never returns a secret's value, this command can only
+			diff the properties the API exposes: whether a secret exists, its
+			visibility, and its list of selected repositories. It cannot detect
+			when a secret's stored value has drifted from what it should be.
+
+			When a secret needs a value — because it doesn't exist yet, or its
+			visibility is changing — the value is read from an environment
+			variable named after the secret. Updating only the selected
+			repositories for an existing "selected" secret does not require a
+			value, since GitHub exposes a dedicated endpoint for that.
+
+			By default, secrets that exist in the organization but aren't listed
+			in the file are left alone. Pass '--prune' to delete them instead.
+
+			This command only manages Actions secrets. GitHub Actions variables
+			are not supported, as this version of gh has no 'gh variable'
+			command to read or write them.
+
+			The file has the following format:
+
+			    secrets:
+			      - name: DEPLOY_KEY
+			        visibility: all
+			      - name: NPM_TOKEN
+			        visibility: selected
+			        repos:
+			          - my-org/api
+			          - my-org/web
+		`),
+		Example: heredoc.Doc(`
+			# Preview what would change without applying it
+			$ gh org secret sync my-org --file secrets.yml --dry-run
+
+			# Apply the file and delete any secrets it doesn't mention
+			$ gh org secret sync my-org --file secrets.yml --prune
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return syncRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Filename, "file", "f", "", "The `file` to read secret definitions from (use \"-\" to read from standard input)")
+	_ = cmd.MarkFlagRequired("file")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the changes that would be made without making them")
+	cmd.Flags().BoolVar(&opts.Prune, "prune", false, "Delete organization secrets that aren't listed in the file")
+
+	return cmd
+}
+
+func syncRun(opts *SyncOptions) error {
+	content, err := cmdutil.ReadFile(opts.Filename, opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	var sf secretsFile
+	if err := yaml.Unmarshal(content, &sf); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", opts.Filename, err)
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	existing, err := listOrgSecrets(httpClient, host, opts.Org)
+	if err != nil {
+		return fmt.Errorf("failed to list existing secrets: %w", err)
+	}
+
+	var pk *pubKey
+	cs := opts.IO.ColorScheme()
+	wanted := map[string]bool{}
+
+	for _, def := range sf.Secrets {
+		wanted[def.Name] = true
+
+		visibility := def.Visibility
+		if visibility == "" {
+			visibility = visibilityPrivate
+		}
+
+		var repositoryIDs []int64
+		if visibility == visibilitySelected {
+			if len(def.Repos) == 0 {
+				return fmt.Errorf("secret %q has visibility \"selected\" but lists no repos", def.Name)
+			}
+			ids, err := mapRepoNamesToIDs(client, host, def.Repos)
+			if err != nil {
+				return err
+			}
+			for _, repo := range def.Repos {
+				id, ok := ids[repo]
+				if !ok {
+					return fmt.Errorf("could not resolve repository %q for secret %q", repo, def.Name)
+				}
+				repositoryIDs = append(repositoryIDs, id)
+			}
+		}
+
+		remote, ok := existing[def.Name]
+		if ok && remote.Visibility == visibility && sameRepos(remote.SelectedRepositories, def.Repos) {
+			continue
+		}
+
+		if ok && remote.Visibility == visibility && visibility == visibilitySelected {
+			if opts.DryRun {
+				fmt.Fprintf(opts.IO.Out, "Would update selected repositories for %s\n", def.Name)
+				continue
+			}
+			if err := putOrgSecretRepositories(httpClient, host, opts.Org, def.Name, repositoryIDs); err != nil {
+				return fmt.Errorf("failed to update repositories for secret %q: %w", def.Name, err)
+			}
+			if opts.IO.IsStdoutTTY() {
+				fmt.Fprintf(opts.IO.Out, "%s Updated repositories for %s\n", cs.SuccessIcon(), def.Name)
+			}
+			continue
+		}
+
+		if opts.DryRun {
+			if ok {
+				fmt.Fprintf(opts.IO.Out, "Would update %s\n", def.Name)
+			} else {
+				fmt.Fprintf(opts.IO.Out, "Would create %s\n", def.Name)
+			}
+			continue
+		}
+
+		value := os.Getenv(def.Name)
+		if value == "" {
+			return fmt.Errorf("secret %q needs a value but environment variable %q is not set", def.Name, def.Name)
+		}
+
+		if pk == nil {
+			pk, err = getOrgPublicKey(httpClient, host, opts.Org)
+			if err != nil {
+				return fmt.Errorf("failed to fetch organization public key: %w", err)
+			}
+		}
+
+		encrypted, err := encryptSecret(pk, value)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt secret %q: %w", def.Name, err)
+		}
+
+		err = putOrgSecret(httpClient, host, opts.Org, def.Name, putSecretRequest{
+			EncryptedValue: encrypted,
+			KeyID:          pk.ID,
+			Visibility:     visibility,
+			Repositories:   repositoryIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to sync secret %q: %w", def.Name, err)
+		}
+
+		if opts.IO.IsStdoutTTY() {
+			verb := "Created"
+			if ok {
+				verb = "Updated"
+			}
+			fmt.Fprintf(opts.IO.Out, "%s %s %s\n", cs.SuccessIcon(), verb, def.Name)
+		}
+	}
+
+	for name := range existing {
+		if wanted[name] {
+			continue
+		}
+
+		if !opts.Prune {
+			if opts.IO.IsStdoutTTY() {
+				fmt.Fprintf(opts.IO.Out, "%s is not listed in %s and was left alone; pass --prune to delete it\n", name, opts.Filename)
+			}
+			continue
+		}
+
+		if opts.DryRun {
+			fmt.Fprintf(opts.IO.Out, "Would delete %s\n", name)
+			continue
+		}
+
+		if !opts.IO.CanPrompt() {
+			return cmdutil.FlagErrorf("--prune requires confirmation; run interactively or omit --prune")
+		}
+
+		var confirmed bool
+		err := prompt.SurveyAskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Delete secret %s from %s?", name, opts.Org),
+			Default: false,
+		}, &confirmed)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			continue
+		}
+
+		if err := deleteOrgSecret(httpClient, host, opts.Org, name); err != nil {
+			return fmt.Errorf("failed to delete secret %q: %w", name, err)
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Deleted %s\n", cs.SuccessIcon(), name)
+		}
+	}
+
+	return nil
+}
+
+func sameRepos(remote []string, wanted []string) bool {
+	if len(remote) != len(wanted) {
+		return false
+	}
+	seen := map[string]bool{}
+	for _, r := range remote {
+		seen[r] = true
+	}
+	for _, w := range wanted {
+		if !seen[w] {
+			return false
+		}
+	}
+	return true
+}
+
+func encryptSecret(pk *pubKey, value string) (string, error) {
+	decodedPubKey, err := base64.StdEncoding.DecodeString(pk.Key)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode public key: %w", err)
+	}
+	var peersPubKey [32]byte
+	copy(peersPubKey[:], decodedPubKey[0:32])
+
+	eBody, err := box.SealAnonymous(nil, []byte(value), &peersPubKey, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt body: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(eBody), nil
+}
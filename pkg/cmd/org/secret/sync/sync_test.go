@@ -0,0 +1,132 @@
+package sync
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_syncRun_createsNewSecret(t *testing.T) {
+	io, stdin, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	stdin.WriteString(`
+secrets:
+  - name: DEPLOY_KEY
+    visibility: all
+`)
+
+	t.Setenv("DEPLOY_KEY", "shh")
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/actions/secrets"),
+		httpmock.StringResponse(`{"secrets": []}`))
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/actions/secrets/public-key"),
+		httpmock.StringResponse(`{"key_id": "123", "key": "CVM7fyyFrUP0fEnoqXFgnUVfyU4rOa1HHHVxn5dk8kw="}`))
+	reg.Register(
+		httpmock.REST("PUT", "orgs/my-org/actions/secrets/DEPLOY_KEY"),
+		httpmock.RESTPayload(204, ``, func(payload map[string]interface{}) {
+			assert.Equal(t, "all", payload["visibility"])
+			assert.Equal(t, "123", payload["key_id"])
+		}))
+
+	err := syncRun(&SyncOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:   func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:      "my-org",
+		Filename: "-",
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Created DEPLOY_KEY")
+}
+
+func Test_syncRun_missingValue(t *testing.T) {
+	io, stdin, _, _ := iostreams.Test()
+
+	stdin.WriteString(`
+secrets:
+  - name: DEPLOY_KEY
+    visibility: all
+`)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/actions/secrets"),
+		httpmock.StringResponse(`{"secrets": []}`))
+
+	err := syncRun(&SyncOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:   func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:      "my-org",
+		Filename: "-",
+	})
+	assert.EqualError(t, err, `secret "DEPLOY_KEY" needs a value but environment variable "DEPLOY_KEY" is not set`)
+}
+
+func Test_syncRun_dryRun(t *testing.T) {
+	io, stdin, stdout, _ := iostreams.Test()
+
+	stdin.WriteString(`
+secrets:
+  - name: DEPLOY_KEY
+    visibility: all
+`)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/actions/secrets"),
+		httpmock.StringResponse(`{"secrets": []}`))
+
+	err := syncRun(&SyncOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:   func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:      "my-org",
+		Filename: "-",
+		DryRun:   true,
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Would create DEPLOY_KEY")
+}
+
+func Test_syncRun_reportsUnlistedSecrets(t *testing.T) {
+	io, stdin, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	stdin.WriteString(`secrets: []`)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/actions/secrets"),
+		httpmock.StringResponse(`{"secrets": [{"name": "OLD_TOKEN", "visibility": "all"}]}`))
+
+	err := syncRun(&SyncOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:   func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:      "my-org",
+		Filename: "-",
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "OLD_TOKEN is not listed")
+}
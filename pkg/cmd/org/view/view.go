@@ -0,0 +1,147 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type browser interface {
+	Browse(string) error
+}
+
+type ViewOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+	Browser    browser
+	Exporter   cmdutil.Exporter
+
+	Login string
+	Web   bool
+}
+
+var Fields = []string{
+	"login",
+	"name",
+	"description",
+	"location",
+	"email",
+	"websiteUrl",
+	"createdAt",
+	"membersCount",
+	"pinnedItems",
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+		Browser:    f.Browser,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <organization>",
+		Short: "View an organization's profile",
+		Long: heredoc.Doc(`
+			Display a GitHub organization's profile, including its description,
+			member count, and pinned repositories.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ gh org view my-org
+			$ gh org view my-org --web
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Login = args[0]
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open the organization's profile in the browser")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, Fields)
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	hostname, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	if opts.Web {
+		url := fmt.Sprintf("https://%s/%s", hostname, opts.Login)
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(url))
+		}
+		return opts.Browser.Browse(url)
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	profile, err := api.OrganizationProfileByLogin(apiClient, hostname, opts.Login)
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, profile)
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s\n", cs.Bold(profile.Login))
+	if profile.Name != "" {
+		fmt.Fprintf(out, "%s\n", profile.Name)
+	}
+	if profile.Description != "" {
+		fmt.Fprintf(out, "\n%s\n", profile.Description)
+	}
+	fmt.Fprintln(out)
+	if profile.Location != "" {
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Location:"), profile.Location)
+	}
+	if profile.Email != "" {
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Email:"), profile.Email)
+	}
+	if profile.WebsiteURL != "" {
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Website:"), profile.WebsiteURL)
+	}
+	fmt.Fprintf(out, "%s %d\n", cs.Bold("Members:"), profile.MembersWithRole.TotalCount)
+
+	if len(profile.PinnedItems.Nodes) > 0 {
+		fmt.Fprintf(out, "\n%s\n", cs.Bold("Pinned repositories"))
+		tp := utils.NewTablePrinter(opts.IO)
+		for _, item := range profile.PinnedItems.Nodes {
+			tp.AddField(item.Repository.NameWithOwner, nil, cs.Bold)
+			tp.AddField(item.Repository.Description, nil, nil)
+			tp.AddField(fmt.Sprintf("%d", item.Repository.StargazerCount), nil, cs.Yellow)
+			tp.EndRow()
+		}
+		return tp.Render()
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_viewRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query OrganizationProfile\b`),
+		httpmock.StringResponse(`
+		{ "data": { "organization": {
+			"login": "my-org",
+			"name": "My Org",
+			"description": "We build things",
+			"membersWithRole": { "totalCount": 25 },
+			"pinnedItems": { "nodes": [
+				{ "nameWithOwner": "my-org/hello-world", "description": "my first repo", "stargazerCount": 42 }
+			] }
+		} } }`))
+
+	err := viewRun(&ViewOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Login: "my-org",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Contains(t, stdout.String(), "my-org")
+	assert.Contains(t, stdout.String(), "We build things")
+	assert.Contains(t, stdout.String(), "my-org/hello-world")
+}
@@ -101,6 +101,36 @@ func Test_checkoutRun(t *testing.T) {
 				cs.Register(`git config branch\.feature\.merge refs/pull/123/head`, 0, "")
 			},
 		},
+		{
+			name: "fork repo was deleted, head branch name matches base repo default branch",
+			opts: &CheckoutOptions{
+				SelectorArg: "123",
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("OWNER/REPO:feature", "hubot/REPO:feature")
+					pr.MaintainerCanModify = true
+					pr.HeadRepository = nil
+					finder := shared.NewMockFinder("123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git fetch origin refs/pull/123/head:hubot/feature`, 0, "")
+				cs.Register(`git config branch\.hubot/feature\.merge`, 1, "")
+				cs.Register(`git checkout hubot/feature`, 0, "")
+				cs.Register(`git config branch\.hubot/feature\.remote origin`, 0, "")
+				cs.Register(`git config branch\.hubot/feature\.pushRemote origin`, 0, "")
+				cs.Register(`git config branch\.hubot/feature\.merge refs/pull/123/head`, 0, "")
+			},
+		},
 		{
 			name: "with local branch rename and existing git remote",
 			opts: &CheckoutOptions{
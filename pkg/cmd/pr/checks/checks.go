@@ -2,13 +2,15 @@ package checks
 
 import (
 	"fmt"
-	"io"
-	"runtime"
+	"net/http"
+	"regexp"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	runShared "github.com/cli/cli/v2/pkg/cmd/run/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/utils"
@@ -22,8 +24,9 @@ type browser interface {
 }
 
 type ChecksOptions struct {
-	IO      *iostreams.IOStreams
-	Browser browser
+	IO         *iostreams.IOStreams
+	Browser    browser
+	HttpClient func() (*http.Client, error)
 
 	Finder shared.PRFinder
 
@@ -31,14 +34,17 @@ type ChecksOptions struct {
 	WebMode     bool
 	Interval    time.Duration
 	Watch       bool
+	FailFast    bool
+	LogFailed   bool
 }
 
 func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Command {
 	var interval int
 	opts := &ChecksOptions{
-		IO:       f.IOStreams,
-		Browser:  f.Browser,
-		Interval: defaultInterval,
+		IO:         f.IOStreams,
+		Browser:    f.Browser,
+		HttpClient: f.HttpClient,
+		Interval:   defaultInterval,
 	}
 
 	cmd := &cobra.Command{
@@ -63,6 +69,10 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("cannot use `--interval` flag without `--watch` flag")
 			}
 
+			if opts.FailFast && !opts.Watch {
+				return cmdutil.FlagErrorf("cannot use `--fail-fast` flag without `--watch` flag")
+			}
+
 			if intervalChanged {
 				var err error
 				opts.Interval, err = time.ParseDuration(fmt.Sprintf("%ds", interval))
@@ -86,6 +96,8 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the web browser to show details about checks")
 	cmd.Flags().BoolVarP(&opts.Watch, "watch", "", false, "Watch checks until they finish")
 	cmd.Flags().IntVarP(&interval, "interval", "i", 10, "Refresh interval in seconds when using `--watch` flag")
+	cmd.Flags().BoolVar(&opts.FailFast, "fail-fast", false, "Stop watching once any checks fail")
+	cmd.Flags().BoolVar(&opts.LogFailed, "log-failed", false, "Print the log for a failed check run once checks stop running")
 
 	return cmd
 }
@@ -130,13 +142,16 @@ func checksRun(opts *ChecksOptions) error {
 
 	var checks []check
 	var counts checkCounts
+	var baseRepo ghrepo.Interface
 
 	for {
 		findOptions := shared.FindOptions{
 			Selector: opts.SelectorArg,
 			Fields:   []string{"number", "headRefName", "statusCheckRollup"},
 		}
-		pr, _, err := opts.Finder.Find(findOptions)
+		var pr *api.PullRequest
+		var err error
+		pr, baseRepo, err = opts.Finder.Find(findOptions)
 		if err != nil {
 			return err
 		}
@@ -147,7 +162,7 @@ func checksRun(opts *ChecksOptions) error {
 		}
 
 		if counts.Pending != 0 && opts.Watch {
-			refreshScreen(opts.IO.Out)
+			runShared.RefreshScreen(opts.IO.Out)
 			cs := opts.IO.ColorScheme()
 			fmt.Fprintln(opts.IO.Out, cs.Boldf("Refreshing checks status every %v seconds. Press Ctrl+C to quit.\n", opts.Interval.Seconds()))
 		}
@@ -162,9 +177,19 @@ func checksRun(opts *ChecksOptions) error {
 			break
 		}
 
+		if opts.FailFast && counts.Failed > 0 {
+			break
+		}
+
 		time.Sleep(opts.Interval)
 	}
 
+	if opts.LogFailed && counts.Failed > 0 {
+		if err := printFailedCheckLog(opts, baseRepo, checks); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "failed to fetch log for failed check: %v\n", err)
+		}
+	}
+
 	if counts.Failed+counts.Pending > 0 {
 		return cmdutil.SilentError
 	}
@@ -172,14 +197,63 @@ func checksRun(opts *ChecksOptions) error {
 	return nil
 }
 
-func refreshScreen(w io.Writer) {
-	if runtime.GOOS == "windows" {
-		// Just clear whole screen; I wasn't able to get the nicer cursor movement thing working
-		fmt.Fprintf(w, "\x1b[2J")
-	} else {
-		// Move cursor to 0,0
-		fmt.Fprint(w, "\x1b[0;0H")
-		// Clear from cursor to bottom of screen
-		fmt.Fprint(w, "\x1b[J")
+// printFailedCheckLog streams the log of the first failed check run to opts.IO.Out, when that
+// check is backed by a GitHub Actions job whose run can be identified from its details URL.
+func printFailedCheckLog(opts *ChecksOptions, baseRepo ghrepo.Interface, checks []check) error {
+	var failed *check
+	for i := range checks {
+		if checks[i].Bucket == "fail" {
+			failed = &checks[i]
+			break
+		}
+	}
+	if failed == nil {
+		return nil
 	}
+
+	runID := actionsRunIDFromURL(failed.Link)
+	if runID == "" {
+		fmt.Fprintf(opts.IO.ErrOut, "%s is not a GitHub Actions check; its log cannot be streamed here. See %s\n", failed.Name, failed.Link)
+		return nil
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	run, err := runShared.GetRun(client, baseRepo, runID)
+	if err != nil {
+		return err
+	}
+
+	jobs, err := runShared.GetJobs(client, baseRepo, *run)
+	if err != nil {
+		return err
+	}
+
+	runLogZip, err := runShared.GetRunLog(runShared.RunLogCacheDir{}, httpClient, baseRepo, run)
+	if err != nil {
+		return err
+	}
+	defer runLogZip.Close()
+
+	runShared.AttachRunLog(runLogZip, jobs)
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintln(opts.IO.Out)
+	fmt.Fprintln(opts.IO.Out, cs.Bold(fmt.Sprintf("Log for %s", failed.Name)))
+	return runShared.DisplayRunLog(opts.IO.Out, jobs, true)
 }
+
+var actionsRunURLRE = regexp.MustCompile(`/actions/runs/(\d+)`)
+
+func actionsRunIDFromURL(url string) string {
+	m := actionsRunURLRE.FindStringSubmatch(url)
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
+
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"io"
+	"net/http"
 	"os"
 	"reflect"
 	"testing"
@@ -13,7 +14,9 @@ import (
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	runShared "github.com/cli/cli/v2/pkg/cmd/run/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
@@ -63,6 +66,28 @@ func TestNewCmdChecks(t *testing.T) {
 			cli:        "--interval 5",
 			wantsError: "cannot use `--interval` flag without `--watch` flag",
 		},
+		{
+			name: "watch flag and fail-fast flag",
+			cli:  "--watch --fail-fast",
+			wants: ChecksOptions{
+				Watch:    true,
+				FailFast: true,
+				Interval: time.Duration(10000000000),
+			},
+		},
+		{
+			name:       "fail-fast flag without watch flag",
+			cli:        "--fail-fast",
+			wantsError: "cannot use `--fail-fast` flag without `--watch` flag",
+		},
+		{
+			name: "log-failed flag",
+			cli:  "--log-failed",
+			wants: ChecksOptions{
+				LogFailed: true,
+				Interval:  time.Duration(10000000000),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -94,6 +119,8 @@ func TestNewCmdChecks(t *testing.T) {
 			assert.Equal(t, tt.wants.SelectorArg, gotOpts.SelectorArg)
 			assert.Equal(t, tt.wants.Watch, gotOpts.Watch)
 			assert.Equal(t, tt.wants.Interval, gotOpts.Interval)
+			assert.Equal(t, tt.wants.FailFast, gotOpts.FailFast)
+			assert.Equal(t, tt.wants.LogFailed, gotOpts.LogFailed)
 		})
 	}
 }
@@ -481,3 +508,79 @@ func TestEliminateDupulicates(t *testing.T) {
 		})
 	}
 }
+
+func Test_actionsRunIDFromURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{
+			name: "job details url",
+			url:  "https://github.com/OWNER/REPO/actions/runs/1234/jobs/20",
+			want: "1234",
+		},
+		{
+			name: "run url without job",
+			url:  "https://github.com/OWNER/REPO/actions/runs/1234",
+			want: "1234",
+		},
+		{
+			name: "non-actions url",
+			url:  "https://travis-ci.com/OWNER/REPO/builds/1234",
+			want: "",
+		},
+		{
+			name: "empty url",
+			url:  "",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, actionsRunIDFromURL(tt.url))
+		})
+	}
+}
+
+func Test_checksRun_logFailed(t *testing.T) {
+	ff, err := os.Open("./fixtures/someFailingActions.json")
+	require.NoError(t, err)
+	defer ff.Close()
+
+	var response *api.PullRequest
+	require.NoError(t, json.NewDecoder(ff).Decode(&response))
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+		httpmock.JSONResponse(runShared.FailedRun))
+	reg.Register(
+		httpmock.REST("GET", "runs/1234/jobs"),
+		httpmock.JSONResponse(runShared.JobsPayload{
+			Jobs: []runShared.Job{runShared.FailedJob},
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234/logs"),
+		httpmock.FileResponse("./fixtures/run_log.zip"))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ChecksOptions{
+		IO:          ios,
+		SelectorArg: "123",
+		LogFailed:   true,
+		Finder:      shared.NewMockFinder("123", response, ghrepo.New("OWNER", "REPO")),
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	err = checksRun(opts)
+	assert.EqualError(t, err, "SilentError")
+	assert.Contains(t, stdout.String(), "Log for sad tests")
+	assert.Contains(t, stdout.String(), "sad job\tquux the barf\tlog line 1")
+}
@@ -46,6 +46,7 @@ type CreateOptions struct {
 
 	Autofill    bool
 	WebMode     bool
+	Stack       bool
 	RecoverFile string
 
 	IsDraft    bool
@@ -54,6 +55,9 @@ type CreateOptions struct {
 	BaseBranch string
 	HeadBranch string
 
+	CommitRange string
+	BranchName  string
+
 	Reviewers []string
 	Assignees []string
 	Labels    []string
@@ -75,6 +79,7 @@ type CreateContext struct {
 	HeadBranchLabel    string
 	HeadRemote         *context.Remote
 	IsPushEnabled      bool
+	PushRef            string
 	Client             *api.Client
 }
 
@@ -109,12 +114,24 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			By default, users with write access to the base repository can push new commits to the
 			head branch of the pull request. Disable this with %[1]s--no-maintainer-edit%[1]s.
+
+			Use %[1]s--stack%[1]s to create a pull request for every local branch leading up to the
+			current one, where each branch's upstream is set to the branch below it (see
+			%[1]sgit branch --set-upstream-to%[1]s). The resulting pull requests are cross-linked in
+			their descriptions. Requires %[1]s--fill%[1]s.
+
+			Use %[1]s--commits%[1]s together with %[1]s--branch-name%[1]s to push a commit range to a
+			newly created branch and open a pull request from it, without requiring the current
+			branch to be the one containing those commits. This works from a detached HEAD or with
+			commits that only exist locally, such as after a partial cherry-pick.
 		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh pr create --title "The bug is fixed" --body "Everything works again"
 			$ gh pr create --reviewer monalisa,hubot  --reviewer myorg/team-name
 			$ gh pr create --project "Roadmap"
 			$ gh pr create --base develop --head monalisa:feature
+			$ gh pr create --fill --stack
+			$ gh pr create --commits abc123..def456 --branch-name auto/fix-x --fill
 		`),
 		Args: cmdutil.NoArgsQuoteReminder,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -143,6 +160,30 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return errors.New("the `--no-maintainer-edit` flag is not supported with `--web`")
 			}
 
+			if opts.Stack {
+				if opts.WebMode {
+					return errors.New("the `--stack` flag is not supported with `--web`")
+				}
+				if opts.HeadBranch != "" {
+					return errors.New("the `--stack` flag is not supported with `--head`")
+				}
+				if !opts.Autofill {
+					return cmdutil.FlagErrorf("`--fill` is required when using `--stack`")
+				}
+			}
+
+			if (opts.CommitRange == "") != (opts.BranchName == "") {
+				return cmdutil.FlagErrorf("`--commits` and `--branch-name` must be used together")
+			}
+			if opts.CommitRange != "" {
+				if opts.HeadBranch != "" {
+					return errors.New("the `--commits` flag is not supported with `--head`")
+				}
+				if opts.Stack {
+					return errors.New("the `--commits` flag is not supported with `--stack`")
+				}
+			}
+
 			opts.BodyProvided = cmd.Flags().Changed("body")
 			if bodyFile != "" {
 				b, err := cmdutil.ReadFile(bodyFile, opts.IO.In)
@@ -169,6 +210,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	fl.StringVarP(&opts.HeadBranch, "head", "H", "", "The `branch` that contains commits for your pull request (default: current branch)")
 	fl.BoolVarP(&opts.WebMode, "web", "w", false, "Open the web browser to create a pull request")
 	fl.BoolVarP(&opts.Autofill, "fill", "f", false, "Do not prompt for title/body and just use commit info")
+	fl.BoolVar(&opts.Stack, "stack", false, "Create a pull request for every local branch in the current stack, linking them together")
 	fl.StringSliceVarP(&opts.Reviewers, "reviewer", "r", nil, "Request reviews from people or teams by their `handle`")
 	fl.StringSliceVarP(&opts.Assignees, "assignee", "a", nil, "Assign people by their `login`. Use \"@me\" to self-assign.")
 	fl.StringSliceVarP(&opts.Labels, "label", "l", nil, "Add labels by `name`")
@@ -176,11 +218,17 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	fl.StringVarP(&opts.Milestone, "milestone", "m", "", "Add the pull request to a milestone by `name`")
 	fl.Bool("no-maintainer-edit", false, "Disable maintainer's ability to modify pull request")
 	fl.StringVar(&opts.RecoverFile, "recover", "", "Recover input from a failed run of create")
+	fl.StringVar(&opts.CommitRange, "commits", "", "Push the given commit `range` (e.g. \"abc123..def456\") to --branch-name instead of the current branch")
+	fl.StringVar(&opts.BranchName, "branch-name", "", "Name of the branch to create from --commits")
 
 	return cmd
 }
 
 func createRun(opts *CreateOptions) (err error) {
+	if opts.Stack {
+		return createStackRun(opts)
+	}
+
 	ctx, err := NewCreateContext(opts)
 	if err != nil {
 		return
@@ -257,7 +305,8 @@ func createRun(opts *CreateOptions) (err error) {
 		if err != nil {
 			return
 		}
-		return submitPR(*opts, *ctx, *state)
+		_, err = submitPR(*opts, *ctx, *state)
+		return
 	}
 
 	if opts.RecoverFile != "" {
@@ -350,7 +399,8 @@ func createRun(opts *CreateOptions) (err error) {
 	}
 
 	if action == shared.SubmitAction {
-		return submitPR(*opts, *ctx, *state)
+		_, err = submitPR(*opts, *ctx, *state)
+		return
 	}
 
 	err = errors.New("expected to cancel, preview, or submit")
@@ -493,9 +543,15 @@ func NewCreateContext(opts *CreateOptions) (*CreateContext, error) {
 	}
 
 	isPushEnabled := false
+	pushRef := "HEAD"
 	headBranch := opts.HeadBranch
 	headBranchLabel := opts.HeadBranch
-	if headBranch == "" {
+	if opts.CommitRange != "" {
+		pushRef = commitRangeTip(opts.CommitRange)
+		headBranch = opts.BranchName
+		headBranchLabel = opts.BranchName
+		isPushEnabled = true
+	} else if headBranch == "" {
 		headBranch, err = opts.Branch()
 		if err != nil {
 			return nil, fmt.Errorf("could not determine the current branch: %w", err)
@@ -616,13 +672,23 @@ func NewCreateContext(opts *CreateOptions) (*CreateContext, error) {
 		HeadBranchLabel:    headBranchLabel,
 		HeadRemote:         headRemote,
 		IsPushEnabled:      isPushEnabled,
+		PushRef:            pushRef,
 		RepoContext:        repoContext,
 		Client:             client,
 	}, nil
 
 }
 
-func submitPR(opts CreateOptions, ctx CreateContext, state shared.IssueMetadataState) error {
+// commitRangeTip returns the commit at the end of a "start..end" range, or the
+// range unchanged if it names a single commit.
+func commitRangeTip(commitRange string) string {
+	if idx := strings.LastIndex(commitRange, ".."); idx >= 0 {
+		return commitRange[idx+2:]
+	}
+	return commitRange
+}
+
+func submitPR(opts CreateOptions, ctx CreateContext, state shared.IssueMetadataState) (*api.PullRequest, error) {
 	client := ctx.Client
 
 	params := map[string]interface{}{
@@ -635,12 +701,12 @@ func submitPR(opts CreateOptions, ctx CreateContext, state shared.IssueMetadataS
 	}
 
 	if params["title"] == "" {
-		return errors.New("pull request title must not be blank")
+		return nil, errors.New("pull request title must not be blank")
 	}
 
 	err := shared.AddMetadataToIssueParams(client, ctx.BaseRepo, params, &state)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	opts.IO.StartProgressIndicator()
@@ -651,11 +717,11 @@ func submitPR(opts CreateOptions, ctx CreateContext, state shared.IssueMetadataS
 	}
 	if err != nil {
 		if pr != nil {
-			return fmt.Errorf("pull request update failed: %w", err)
+			return nil, fmt.Errorf("pull request update failed: %w", err)
 		}
-		return fmt.Errorf("pull request create failed: %w", err)
+		return nil, fmt.Errorf("pull request create failed: %w", err)
 	}
-	return nil
+	return pr, nil
 }
 
 func previewPR(opts CreateOptions, openURL string) error {
@@ -726,7 +792,7 @@ func handlePush(opts CreateOptions, ctx CreateContext) error {
 				defer r.Flush()
 				cmdErr := r
 				cmdOut := opts.IO.Out
-				if err := git.Push(headRemote.Name, fmt.Sprintf("HEAD:%s", ctx.HeadBranch), cmdOut, cmdErr); err != nil {
+				if err := git.Push(headRemote.Name, fmt.Sprintf("%s:%s", ctx.PushRef, ctx.HeadBranch), cmdOut, cmdErr); err != nil {
 					if didForkRepo && pushTries < maxPushTries {
 						pushTries++
 						// first wait 2 seconds after forking, then 4s, then 6s
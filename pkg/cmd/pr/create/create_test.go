@@ -393,6 +393,48 @@ func TestPRCreate(t *testing.T) {
 	assert.Equal(t, "\nCreating pull request for feature into master in OWNER/REPO\n\n", output.Stderr())
 }
 
+func TestPRCreate_CommitRange(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.StubRepoInfoResponse("OWNER", "REPO", "master")
+	http.StubRepoResponse("OWNER", "REPO")
+	http.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data": {"viewer": {"login": "OWNER"} } }`))
+	shared.RunCommandFinder("auto/fix-x", nil, nil)
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestCreate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+		`, func(input map[string]interface{}) {
+			assert.Equal(t, "master", input["baseRefName"].(string))
+			assert.Equal(t, "auto/fix-x", input["headRefName"].(string))
+		}))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git status --porcelain`, 0, "")
+	cs.Register(`git config --get-regexp.+branch\\\.auto/fix-x\\\.`, 0, "")
+	cs.Register(`git show-ref --verify -- HEAD refs/remotes/origin/auto/fix-x`, 0, "")
+	cs.Register(`git push --set-upstream origin def456:auto/fix-x`, 0, "")
+
+	//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
+	ask, cleanupAsk := prompt.InitAskStubber()
+	defer cleanupAsk()
+
+	ask.StubPrompt("Where should we push the 'auto/fix-x' branch?").AnswerDefault()
+
+	output, err := runCommand(http, nil, "wip", true, `-t "my title" -b "my body" --commits abc123..def456 --branch-name auto/fix-x`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/pull/12\n", output.String())
+	assert.Equal(t, "\nCreating pull request for auto/fix-x into master in OWNER/REPO\n\n", output.Stderr())
+}
+
 func TestPRCreate_NoMaintainerModify(t *testing.T) {
 	// TODO update this copypasta
 	http := initFakeHTTP()
@@ -0,0 +1,158 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+)
+
+// stackBranches returns the chain of local branches that current is stacked on top of,
+// ordered from the bottom of the stack up to and including current. A branch is
+// considered stacked on another when its upstream, as set by
+// `git branch --set-upstream-to=<parent>`, points at that other local branch rather
+// than a remote-tracking branch. git records such an upstream as `branch.<name>.remote`
+// set to "." (which git.ReadBranchConfig treats as unset, since "." isn't a named
+// remote) together with `branch.<name>.merge` pointing at the parent's ref.
+func stackBranches(current string) ([]string, error) {
+	branches := []string{current}
+	seen := map[string]bool{current: true}
+
+	branch := current
+	for {
+		cfg := git.ReadBranchConfig(branch)
+		if cfg.RemoteName != "" || cfg.RemoteURL != nil || !strings.HasPrefix(cfg.MergeRef, "refs/heads/") {
+			break
+		}
+
+		parent := strings.TrimPrefix(cfg.MergeRef, "refs/heads/")
+		if seen[parent] {
+			return nil, fmt.Errorf("branch %q is part of a circular stack", parent)
+		}
+		seen[parent] = true
+		branches = append([]string{parent}, branches...)
+		branch = parent
+	}
+
+	return branches, nil
+}
+
+// createStackRun creates a pull request for every local branch in the stack that the
+// current branch belongs to, starting from the bottom of the stack, and links the
+// resulting pull requests together by rewriting their bodies.
+func createStackRun(opts *CreateOptions) error {
+	currentBranch, err := opts.Branch()
+	if err != nil {
+		return fmt.Errorf("could not determine the current branch: %w", err)
+	}
+
+	branches, err := stackBranches(currentBranch)
+	if err != nil {
+		return err
+	}
+	if len(branches) < 2 {
+		return errors.New("no stacked branches found: the current branch's upstream must be set to another local branch (see `git branch --set-upstream-to`)")
+	}
+
+	defer func() {
+		if err := git.CheckoutBranch(currentBranch); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "warning: failed to switch back to %q: %s\n", currentBranch, err)
+		}
+	}()
+
+	var (
+		prs    []*api.PullRequest
+		client *api.Client
+		host   string
+	)
+
+	for i, branch := range branches {
+		if err := git.CheckoutBranch(branch); err != nil {
+			return fmt.Errorf("%s: %w", branch, err)
+		}
+
+		branchOpts := *opts
+		branchOpts.HeadBranch = ""
+		branchOpts.BaseBranch = ""
+		if i > 0 {
+			branchOpts.BaseBranch = branches[i-1]
+		}
+
+		ctx, err := NewCreateContext(&branchOpts)
+		if err != nil {
+			return fmt.Errorf("%s: %w", branch, err)
+		}
+
+		state, err := NewIssueState(*ctx, branchOpts)
+		if err != nil {
+			return fmt.Errorf("%s: %w", branch, err)
+		}
+
+		if err := handlePush(branchOpts, *ctx); err != nil {
+			return fmt.Errorf("%s: %w", branch, err)
+		}
+
+		pr, err := submitPR(branchOpts, *ctx, *state)
+		if err != nil {
+			return fmt.Errorf("%s: %w", branch, err)
+		}
+
+		prs = append(prs, pr)
+		client = ctx.Client
+		host = ctx.BaseRepo.RepoHost()
+	}
+
+	if len(prs) > 1 {
+		if err := linkStack(client, host, prs); err != nil {
+			return fmt.Errorf("failed to link stacked pull requests: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// linkStack rewrites the body of every pull request in prs to include a list of the
+// whole stack, so that reviewers can navigate between the stacked pull requests.
+func linkStack(client *api.Client, repoHost string, prs []*api.PullRequest) error {
+	for i, pr := range prs {
+		var body strings.Builder
+		body.WriteString(pr.Body)
+		if pr.Body != "" {
+			body.WriteString("\n\n")
+		}
+		body.WriteString("---\n\nThis pull request is part of a stack:\n\n")
+		for j, stacked := range prs {
+			if j == i {
+				fmt.Fprintf(&body, "* #%d (this PR)\n", stacked.Number)
+			} else {
+				fmt.Fprintf(&body, "* #%d\n", stacked.Number)
+			}
+		}
+
+		if err := updatePullRequestBody(client, repoHost, pr.ID, body.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func updatePullRequestBody(client *api.Client, repoHost, pullRequestID, body string) error {
+	query := `
+	mutation PullRequestUpdateStackBody($input: UpdatePullRequestInput!) {
+		updatePullRequest(input: $input) { clientMutationId }
+	}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"pullRequestId": pullRequestID,
+			"body":          body,
+		},
+	}
+	var result struct {
+		UpdatePullRequest struct {
+			ClientMutationID string
+		}
+	}
+	return client.GraphQL(repoHost, query, variables, &result)
+}
@@ -0,0 +1,44 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stackBranches_noStack(t *testing.T) {
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git config --get-regexp.+branch\\\.feature\\\.`, 0, "")
+
+	branches, err := stackBranches("feature")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature"}, branches)
+}
+
+func Test_stackBranches_chain(t *testing.T) {
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git config --get-regexp.+branch\\\.feature-3\\\.`, 0, "branch.feature-3.remote .\nbranch.feature-3.merge refs/heads/feature-2\n")
+	cs.Register(`git config --get-regexp.+branch\\\.feature-2\\\.`, 0, "branch.feature-2.remote .\nbranch.feature-2.merge refs/heads/feature-1\n")
+	cs.Register(`git config --get-regexp.+branch\\\.feature-1\\\.`, 0, "branch.feature-1.remote origin\nbranch.feature-1.merge refs/heads/feature-1\n")
+
+	branches, err := stackBranches("feature-3")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-1", "feature-2", "feature-3"}, branches)
+}
+
+func Test_stackBranches_circular(t *testing.T) {
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git config --get-regexp.+branch\\\.feature-b\\\.`, 0, "branch.feature-b.remote .\nbranch.feature-b.merge refs/heads/feature-a\n")
+	cs.Register(`git config --get-regexp.+branch\\\.feature-a\\\.`, 0, "branch.feature-a.remote .\nbranch.feature-a.merge refs/heads/feature-b\n")
+
+	_, err := stackBranches("feature-b")
+	assert.ErrorContains(t, err, "circular stack")
+}
@@ -27,6 +27,8 @@ type DiffOptions struct {
 	SelectorArg string
 	UseColor    bool
 	Patch       bool
+	SideBySide  bool
+	WordDiff    bool
 }
 
 func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Command {
@@ -44,7 +46,17 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 			View changes in a pull request. 
 
 			Without an argument, the pull request that belongs to the current branch
-			is selected.			
+			is selected.
+		`),
+		Example: heredoc.Doc(`
+			# view diff for the pull request of the current branch
+			$ gh pr diff
+
+			# view diff for a specific pull request side-by-side
+			$ gh pr diff 123 --side-by-side
+
+			# view diff for a specific pull request with changed words highlighted inline
+			$ gh pr diff 123 --word-diff
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -58,6 +70,17 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 				opts.SelectorArg = args[0]
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--side-by-side` or `--word-diff`",
+				opts.SideBySide,
+				opts.WordDiff,
+			); err != nil {
+				return err
+			}
+			if opts.Patch && (opts.SideBySide || opts.WordDiff) {
+				return cmdutil.FlagErrorf("`--patch` does not support `--side-by-side` or `--word-diff`")
+			}
+
 			switch colorFlag {
 			case "always":
 				opts.UseColor = true
@@ -78,6 +101,8 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 
 	cmdutil.StringEnumFlag(cmd, &colorFlag, "color", "", "auto", []string{"always", "never", "auto"}, "Use color in diff output")
 	cmd.Flags().BoolVar(&opts.Patch, "patch", false, "Display diff in patch format")
+	cmd.Flags().BoolVar(&opts.SideBySide, "side-by-side", false, "Display diff in side-by-side columns")
+	cmd.Flags().BoolVar(&opts.WordDiff, "word-diff", false, "Display diff with changed words highlighted inline")
 
 	return cmd
 }
@@ -109,12 +134,17 @@ func diffRun(opts *DiffOptions) error {
 		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
 	}
 
-	if !opts.UseColor {
+	switch {
+	case opts.SideBySide:
+		return sideBySideDiffLines(opts.IO.Out, diff, opts.IO.TerminalWidth(), opts.UseColor)
+	case opts.WordDiff:
+		return wordDiffLines(opts.IO.Out, diff, opts.UseColor)
+	case !opts.UseColor:
 		_, err = io.Copy(opts.IO.Out, diff)
 		return err
+	default:
+		return colorDiffLines(opts.IO.Out, diff)
 	}
-
-	return colorDiffLines(opts.IO.Out, diff)
 }
 
 func fetchDiff(httpClient *http.Client, baseRepo ghrepo.Interface, prNumber int, asPatch bool) (io.ReadCloser, error) {
@@ -85,6 +85,38 @@ func Test_NewCmdDiff(t *testing.T) {
 			isTTY:   true,
 			wantErr: "invalid argument \"doublerainbow\" for \"--color\" flag: valid values are {always|never|auto}",
 		},
+		{
+			name:  "side by side",
+			args:  "--side-by-side",
+			isTTY: true,
+			want: DiffOptions{
+				SelectorArg: "",
+				UseColor:    true,
+				SideBySide:  true,
+			},
+		},
+		{
+			name:  "word diff",
+			args:  "--word-diff",
+			isTTY: true,
+			want: DiffOptions{
+				SelectorArg: "",
+				UseColor:    true,
+				WordDiff:    true,
+			},
+		},
+		{
+			name:    "side by side and word diff together",
+			args:    "--side-by-side --word-diff",
+			isTTY:   true,
+			wantErr: "specify only one of `--side-by-side` or `--word-diff`",
+		},
+		{
+			name:    "side by side with patch",
+			args:    "--side-by-side --patch",
+			isTTY:   true,
+			wantErr: "`--patch` does not support `--side-by-side` or `--word-diff`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -123,6 +155,8 @@ func Test_NewCmdDiff(t *testing.T) {
 
 			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
 			assert.Equal(t, tt.want.UseColor, opts.UseColor)
+			assert.Equal(t, tt.want.SideBySide, opts.SideBySide)
+			assert.Equal(t, tt.want.WordDiff, opts.WordDiff)
 		})
 	}
 }
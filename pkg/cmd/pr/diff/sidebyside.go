@@ -0,0 +1,159 @@
+package diff
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+const minSideBySideWidth = 20
+
+// sideBySideDiffLines renders a unified diff as two columns, removed lines on
+// the left and added lines on the right, pairing them up the way consecutive
+// "-"/"+" lines in a hunk already do. Changed words within a paired line are
+// highlighted the same way wordDiffLine does.
+func sideBySideDiffLines(w io.Writer, r io.Reader, width int, useColor bool) error {
+	if width < minSideBySideWidth {
+		width = minSideBySideWidth
+	}
+	colWidth := (width - 3) / 2
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, lineBufferSize), 1024*1024)
+
+	var removals, additions []string
+	flush := func() error {
+		n := len(removals)
+		if len(additions) > n {
+			n = len(additions)
+		}
+		for i := 0; i < n; i++ {
+			var left, right string
+			hasLeft := i < len(removals)
+			hasRight := i < len(additions)
+			if hasLeft {
+				left = removals[i]
+			}
+			if hasRight {
+				right = additions[i]
+			}
+
+			leftFit, _ := fitWidth(left, colWidth)
+			rightFit, _ := fitWidth(right, colWidth)
+
+			leftOut, rightOut := leftFit, rightFit
+			switch {
+			case hasLeft && hasRight:
+				leftOut, rightOut = pairedWordDiff(leftFit, rightFit, useColor)
+			case hasLeft:
+				leftOut = colorSpan(leftFit, colorRemoval, useColor)
+			case hasRight:
+				rightOut = colorSpan(rightFit, colorAddition, useColor)
+			}
+
+			if _, err := fmt.Fprintf(w, "%s | %s\n", padTo(leftOut, visibleLen(leftOut), colWidth), rightOut); err != nil {
+				return err
+			}
+		}
+		removals, additions = nil, nil
+		return nil
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if isHeaderLine([]byte(line)) || strings.HasPrefix(line, "@@") {
+			if err := flush(); err != nil {
+				return err
+			}
+			if err := writeColoredLine(w, line, colorHeader, useColor); err != nil {
+				return err
+			}
+			continue
+		}
+		if isAdditionLine([]byte(line)) {
+			additions = append(additions, line[1:])
+			continue
+		}
+		if isRemovalLine([]byte(line)) {
+			removals = append(removals, line[1:])
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return err
+		}
+
+		ctx, _ := fitWidth(strings.TrimPrefix(line, " "), colWidth)
+		if _, err := fmt.Fprintf(w, "%s | %s\n", padTo(ctx, len([]rune(ctx)), colWidth), ctx); err != nil {
+			return err
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	return scanner.Err()
+}
+
+// pairedWordDiff highlights the words that differ between a removed and an
+// added line, returning the left (removal-colored) and right
+// (addition-colored) renderings to place side by side.
+func pairedWordDiff(oldLine, newLine string, useColor bool) (left, right string) {
+	oldWords := splitWords(oldLine)
+	newWords := splitWords(newLine)
+	matcher := difflib.NewMatcher(oldWords, newWords)
+
+	var leftSb, rightSb strings.Builder
+	for _, op := range matcher.GetOpCodes() {
+		switch op.Tag {
+		case 'e':
+			leftSb.WriteString(strings.Join(oldWords[op.I1:op.I2], ""))
+			rightSb.WriteString(strings.Join(newWords[op.J1:op.J2], ""))
+		case 'd':
+			writeWordSpan(&leftSb, oldWords[op.I1:op.I2], "[-", "-]", colorRemoval, useColor)
+		case 'i':
+			writeWordSpan(&rightSb, newWords[op.J1:op.J2], "{+", "+}", colorAddition, useColor)
+		case 'r':
+			writeWordSpan(&leftSb, oldWords[op.I1:op.I2], "[-", "-]", colorRemoval, useColor)
+			writeWordSpan(&rightSb, newWords[op.J1:op.J2], "{+", "+}", colorAddition, useColor)
+		}
+	}
+	return leftSb.String(), rightSb.String()
+}
+
+func colorSpan(s string, color []byte, useColor bool) string {
+	if !useColor || s == "" {
+		return s
+	}
+	return string(color) + s + string(colorReset)
+}
+
+// fitWidth truncates s to at most width runes and reports how many runes
+// remain, since ANSI escapes added afterward don't count toward the
+// terminal column width used for padding.
+func fitWidth(s string, width int) (fitted string, visibleLen int) {
+	runes := []rune(s)
+	if len(runes) > width {
+		runes = runes[:width]
+	}
+	return string(runes), len(runes)
+}
+
+func padTo(s string, visibleLen, width int) string {
+	if visibleLen < width {
+		return s + strings.Repeat(" ", width-visibleLen)
+	}
+	return s
+}
+
+var ansiEscapeRE = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// visibleLen reports how many runes of s will actually occupy terminal
+// columns, ignoring any ANSI color escapes added by colorSpan or
+// pairedWordDiff.
+func visibleLen(s string) int {
+	return len([]rune(ansiEscapeRE.ReplaceAllString(s, "")))
+}
@@ -0,0 +1,50 @@
+package diff
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func Test_sideBySideDiffLines(t *testing.T) {
+	inputs := []struct {
+		name     string
+		input    string
+		width    int
+		useColor bool
+		output   string
+	}{
+		{
+			name:     "paired change",
+			input:    "-old\n+new\n",
+			width:    20,
+			useColor: false,
+			output:   "[-old-]  | {+new+}\n",
+		},
+		{
+			name:     "context line mirrored on both sides",
+			input:    " same\n",
+			width:    20,
+			useColor: false,
+			output:   "same     | same\n",
+		},
+		{
+			name:     "pure addition has blank left side",
+			input:    "+added\n",
+			width:    20,
+			useColor: false,
+			output:   "         | added\n",
+		},
+	}
+	for _, tt := range inputs {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			if err := sideBySideDiffLines(&buf, strings.NewReader(tt.input), tt.width, tt.useColor); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := buf.String(); got != tt.output {
+				t.Errorf("expected: %q, got: %q", tt.output, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package diff
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// wordDiffLines rewrites a unified diff so that a modified line is shown once,
+// with the removed and added words highlighted inline, instead of as separate
+// "-" and "+" lines.
+func wordDiffLines(w io.Writer, r io.Reader, useColor bool) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, lineBufferSize), 1024*1024)
+
+	var removals, additions []string
+	flush := func() error {
+		n := len(removals)
+		if len(additions) > n {
+			n = len(additions)
+		}
+		for i := 0; i < n; i++ {
+			switch {
+			case i < len(removals) && i < len(additions):
+				if _, err := io.WriteString(w, wordDiffLine(removals[i], additions[i], useColor)+"\n"); err != nil {
+					return err
+				}
+			case i < len(removals):
+				if err := writeColoredLine(w, removals[i], colorRemoval, useColor); err != nil {
+					return err
+				}
+			default:
+				if err := writeColoredLine(w, additions[i], colorAddition, useColor); err != nil {
+					return err
+				}
+			}
+		}
+		removals, additions = nil, nil
+		return nil
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if isHeaderLine([]byte(line)) {
+			if err := flush(); err != nil {
+				return err
+			}
+			if err := writeColoredLine(w, line, colorHeader, useColor); err != nil {
+				return err
+			}
+			continue
+		}
+		if isAdditionLine([]byte(line)) {
+			additions = append(additions, line[1:])
+			continue
+		}
+		if isRemovalLine([]byte(line)) {
+			removals = append(removals, line[1:])
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, strings.TrimPrefix(line, " ")+"\n"); err != nil {
+			return err
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	return scanner.Err()
+}
+
+func writeColoredLine(w io.Writer, line string, color []byte, useColor bool) error {
+	if useColor {
+		if _, err := w.Write(color); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(w, line); err != nil {
+		return err
+	}
+	if useColor {
+		if _, err := w.Write(colorReset); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+var wordSplitRE = regexp.MustCompile(`\s+|[^\s]+`)
+
+// splitWords tokenizes a line into words and the whitespace between them, so
+// that joining the tokens back together reproduces the original line exactly.
+func splitWords(s string) []string {
+	return wordSplitRE.FindAllString(s, -1)
+}
+
+// wordDiffLine merges an old and new version of a line into one line with
+// the changed words marked, following the opcodes of a word-level sequence
+// match between them.
+func wordDiffLine(oldLine, newLine string, useColor bool) string {
+	oldWords := splitWords(oldLine)
+	newWords := splitWords(newLine)
+	matcher := difflib.NewMatcher(oldWords, newWords)
+
+	var sb strings.Builder
+	for _, op := range matcher.GetOpCodes() {
+		switch op.Tag {
+		case 'e':
+			sb.WriteString(strings.Join(newWords[op.J1:op.J2], ""))
+		case 'd':
+			writeWordSpan(&sb, oldWords[op.I1:op.I2], "[-", "-]", colorRemoval, useColor)
+		case 'i':
+			writeWordSpan(&sb, newWords[op.J1:op.J2], "{+", "+}", colorAddition, useColor)
+		case 'r':
+			writeWordSpan(&sb, oldWords[op.I1:op.I2], "[-", "-]", colorRemoval, useColor)
+			writeWordSpan(&sb, newWords[op.J1:op.J2], "{+", "+}", colorAddition, useColor)
+		}
+	}
+	return sb.String()
+}
+
+// writeWordSpan appends a span of changed words to sb, marked either with
+// ANSI color (when useColor is set) or with git's plain-text word-diff
+// markers otherwise. Whitespace-only spans are written unmarked, since
+// flagging them draws attention to a difference the reader can't see.
+func writeWordSpan(sb *strings.Builder, words []string, openMarker, closeMarker string, color []byte, useColor bool) {
+	if len(words) == 0 {
+		return
+	}
+	text := strings.Join(words, "")
+	if strings.TrimSpace(text) == "" {
+		sb.WriteString(text)
+		return
+	}
+	if useColor {
+		sb.Write(color)
+		sb.WriteString(text)
+		sb.Write(colorReset)
+	} else {
+		sb.WriteString(openMarker)
+		sb.WriteString(text)
+		sb.WriteString(closeMarker)
+	}
+}
@@ -0,0 +1,64 @@
+package diff
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func Test_wordDiffLines(t *testing.T) {
+	inputs := []struct {
+		name     string
+		input    string
+		useColor bool
+		output   string
+	}{
+		{
+			name:     "no color, changed word",
+			input:    "-the quick brown fox\n+the slow brown fox\n",
+			useColor: false,
+			output:   "the [-quick-]{+slow+} brown fox\n",
+		},
+		{
+			name:     "color, changed word",
+			input:    "-the quick brown fox\n+the slow brown fox\n",
+			useColor: true,
+			output:   "the \x1b[31mquick\x1b[m\x1b[32mslow\x1b[m brown fox\n",
+		},
+		{
+			name:     "pure addition",
+			input:    "+new line\n",
+			useColor: false,
+			output:   "new line\n",
+		},
+		{
+			name:     "pure removal, color",
+			input:    "-old line\n",
+			useColor: true,
+			output:   "\x1b[31mold line\x1b[m\n",
+		},
+		{
+			name:     "context line passes through",
+			input:    " unchanged\n",
+			useColor: false,
+			output:   "unchanged\n",
+		},
+		{
+			name:     "header line colored",
+			input:    "--- a/file\n+++ b/file\n",
+			useColor: true,
+			output:   "\x1b[1;38m--- a/file\x1b[m\n\x1b[1;38m+++ b/file\x1b[m\n",
+		},
+	}
+	for _, tt := range inputs {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			if err := wordDiffLines(&buf, strings.NewReader(tt.input), tt.useColor); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := buf.String(); got != tt.output {
+				t.Errorf("expected: %q, got: %q", tt.output, got)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -31,6 +32,9 @@ func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, err
 		HttpClient: func() (*http.Client, error) {
 			return &http.Client{Transport: rt}, nil
 		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
 		BaseRepo: func() (ghrepo.Interface, error) {
 			return ghrepo.New("OWNER", "REPO"), nil
 		},
@@ -123,6 +127,64 @@ No pull requests match your search in OWNER/REPO
 `, output.String())
 }
 
+func TestPRList_filteringWithSavedSearch(t *testing.T) {
+	reg := initFakeHTTP()
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query PullRequestSearch\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `is:open review:required repo:OWNER/REPO state:open type:pr`, params["q"].(string))
+		}))
+
+	mainBuf := bytes.Buffer{}
+	hostsBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, &hostsBuf)()
+
+	cfg := config.NewBlankConfig()
+	queriesCfg, err := cfg.Queries()
+	assert.NoError(t, err)
+	assert.NoError(t, queriesCfg.Add("needs-review", "prs", "is:open review:required"))
+
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		Browser:   &cmdutil.TestBrowser{},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdList(factory, nil)
+	argv, err := shlex.Split("--filter needs-review")
+	assert.NoError(t, err)
+	cmd.SetArgs(argv)
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, `
+No pull requests match your search in OWNER/REPO
+
+`, stdout.String())
+}
+
 func TestPRList_filteringRemoveDuplicate(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -84,6 +84,48 @@ func mergePullRequest(client *http.Client, payload mergePayload) error {
 	return gql.MutateNamed(context.Background(), "PullRequestMerge", &mutation, variables)
 }
 
+// TODO: drop after githubv4 gets updated
+type EnqueuePullRequestInput struct {
+	PullRequestID githubv4.ID `json:"pullRequestId"`
+}
+
+// TODO: drop after githubv4 gets updated
+type DequeuePullRequestInput struct {
+	ID githubv4.ID `json:"id"`
+}
+
+func enqueuePullRequest(client *http.Client, repo ghrepo.Interface, prID string) error {
+	var mutation struct {
+		EnqueuePullRequest struct {
+			MergeQueueEntry struct {
+				Position int
+			}
+		} `graphql:"enqueuePullRequest(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": EnqueuePullRequestInput{PullRequestID: githubv4.ID(prID)},
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), client)
+	return gql.MutateNamed(context.Background(), "PullRequestEnqueue", &mutation, variables)
+}
+
+func dequeuePullRequest(client *http.Client, repo ghrepo.Interface, prID string) error {
+	var mutation struct {
+		DequeuePullRequest struct {
+			ClientMutationId string
+		} `graphql:"dequeuePullRequest(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": DequeuePullRequestInput{ID: githubv4.ID(prID)},
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), client)
+	return gql.MutateNamed(context.Background(), "PullRequestDequeue", &mutation, variables)
+}
+
 func disableAutoMerge(client *http.Client, repo ghrepo.Interface, prID string) error {
 	var mutation struct {
 		DisablePullRequestAutoMerge struct {
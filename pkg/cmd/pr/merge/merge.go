@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
@@ -19,6 +20,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const defaultMergeQueueInterval time.Duration = 10 * time.Second
+
 type editor interface {
 	Edit(string, string) (string, error)
 }
@@ -38,6 +41,11 @@ type MergeOptions struct {
 	AutoMergeEnable  bool
 	AutoMergeDisable bool
 
+	Queue    bool
+	Dequeue  bool
+	Watch    bool
+	Interval time.Duration
+
 	Body    string
 	BodySet bool
 	Subject string
@@ -55,6 +63,7 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 		HttpClient: f.HttpClient,
 		Branch:     f.Branch,
 		Remotes:    f.Remotes,
+		Interval:   defaultMergeQueueInterval,
 	}
 
 	var (
@@ -64,6 +73,7 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	)
 
 	var bodyFile string
+	var interval int
 
 	cmd := &cobra.Command{
 		Use:   "merge [<number> | <url> | <branch>]",
@@ -100,12 +110,17 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 				methodFlags++
 			}
 			if methodFlags == 0 {
-				if !opts.IO.CanPrompt() {
+				if opts.Queue || opts.Dequeue {
+					// the merge queue uses the base branch's configured merge method
+				} else if !opts.IO.CanPrompt() {
 					return cmdutil.FlagErrorf("--merge, --rebase, or --squash required when not running interactively")
+				} else {
+					opts.InteractiveMode = true
 				}
-				opts.InteractiveMode = true
 			} else if methodFlags > 1 {
 				return cmdutil.FlagErrorf("only one of --merge, --rebase, or --squash can be enabled")
+			} else if opts.Queue || opts.Dequeue {
+				return cmdutil.FlagErrorf("cannot use `--merge`, `--rebase`, or `--squash` with `--queue` or `--dequeue`")
 			}
 
 			opts.IsDeleteBranchIndicated = cmd.Flags().Changed("delete-branch")
@@ -115,14 +130,31 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 			bodyFileProvided := bodyFile != ""
 
 			if err := cmdutil.MutuallyExclusive(
-				"specify only one of `--auto`, `--disable-auto`, or `--admin`",
+				"specify only one of `--auto`, `--disable-auto`, `--admin`, `--queue`, or `--dequeue`",
 				opts.AutoMergeEnable,
 				opts.AutoMergeDisable,
 				opts.UseAdmin,
+				opts.Queue,
+				opts.Dequeue,
 			); err != nil {
 				return err
 			}
 
+			intervalChanged := cmd.Flags().Changed("interval")
+			if opts.Watch && !opts.Queue {
+				return cmdutil.FlagErrorf("cannot use `--watch` flag without `--queue` flag")
+			}
+			if !opts.Watch && intervalChanged {
+				return cmdutil.FlagErrorf("cannot use `--interval` flag without `--watch` flag")
+			}
+			if intervalChanged {
+				var err error
+				opts.Interval, err = time.ParseDuration(fmt.Sprintf("%ds", interval))
+				if err != nil {
+					return cmdutil.FlagErrorf("could not parse `--interval` flag: %w", err)
+				}
+			}
+
 			if err := cmdutil.MutuallyExclusive(
 				"specify only one of `--body` or `--body-file`",
 				bodyProvided,
@@ -164,6 +196,10 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	cmd.Flags().BoolVarP(&flagSquash, "squash", "s", false, "Squash the commits into one commit and merge it into the base branch")
 	cmd.Flags().BoolVar(&opts.AutoMergeEnable, "auto", false, "Automatically merge only after necessary requirements are met")
 	cmd.Flags().BoolVar(&opts.AutoMergeDisable, "disable-auto", false, "Disable auto-merge for this pull request")
+	cmd.Flags().BoolVar(&opts.Queue, "queue", false, "Add this pull request to the base branch's merge queue")
+	cmd.Flags().BoolVar(&opts.Dequeue, "dequeue", false, "Remove this pull request from the base branch's merge queue")
+	cmd.Flags().BoolVar(&opts.Watch, "watch", false, "Watch the merge queue until this pull request merges or leaves the queue (use with `--queue`)")
+	cmd.Flags().IntVarP(&interval, "interval", "i", 10, "Refresh interval in seconds when using `--watch` flag")
 	return cmd
 }
 
@@ -172,7 +208,7 @@ func mergeRun(opts *MergeOptions) error {
 
 	findOptions := shared.FindOptions{
 		Selector: opts.SelectorArg,
-		Fields:   []string{"id", "number", "state", "title", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName"},
+		Fields:   []string{"id", "number", "state", "title", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName", "mergeQueueEnabled", "mergeQueueEntry"},
 	}
 	pr, baseRepo, err := opts.Finder.Find(findOptions)
 	if err != nil {
@@ -198,6 +234,39 @@ func mergeRun(opts *MergeOptions) error {
 		return nil
 	}
 
+	if opts.Dequeue {
+		if pr.MergeQueueEntry == nil {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d is not in the merge queue\n", cs.WarningIcon(), pr.Number)
+			return nil
+		}
+		if err := dequeuePullRequest(httpClient, baseRepo, pr.ID); err != nil {
+			return err
+		}
+		if isTerminal {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Removed pull request #%d from the merge queue\n", cs.SuccessIconWithColor(cs.Green), pr.Number)
+		}
+		return nil
+	}
+
+	if opts.Queue {
+		if pr.MergeQueueEntry != nil {
+			if isTerminal {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d is already in the merge queue\n", cs.Yellow("!"), pr.Number)
+			}
+		} else {
+			if err := enqueuePullRequest(httpClient, baseRepo, pr.ID); err != nil {
+				return err
+			}
+			if isTerminal {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Added pull request #%d to the merge queue\n", cs.SuccessIconWithColor(cs.Green), pr.Number)
+			}
+		}
+		if !opts.Watch {
+			return nil
+		}
+		return watchMergeQueue(opts, findOptions)
+	}
+
 	if opts.SelectorArg == "" && len(pr.Commits.Nodes) > 0 {
 		if localBranchLastCommit, err := git.LastCommit(); err == nil {
 			if localBranchLastCommit.Sha != pr.Commits.Nodes[len(pr.Commits.Nodes)-1].Commit.OID {
@@ -210,6 +279,10 @@ func mergeRun(opts *MergeOptions) error {
 	isPRAlreadyMerged := pr.State == "MERGED"
 	if reason := blockedReason(pr.MergeStateStatus, opts.UseAdmin); !opts.AutoMergeEnable && !isPRAlreadyMerged && reason != "" {
 		fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d is not mergeable: %s.\n", cs.FailureIcon(), pr.Number, reason)
+		if pr.BaseRef.BranchProtectionRule.RequiresMergeQueue {
+			fmt.Fprintf(opts.IO.ErrOut, "This base branch requires a merge queue; add the `--queue` flag to add the pull request to it.\n")
+			return cmdutil.SilentError
+		}
 		fmt.Fprintf(opts.IO.ErrOut, "To have the pull request merged after all the requirements have been met, add the `--auto` flag.\n")
 		if !opts.UseAdmin && allowsAdminOverride(pr.MergeStateStatus) {
 			// TODO: show this flag only to repo admins
@@ -380,6 +453,40 @@ func mergeRun(opts *MergeOptions) error {
 	return nil
 }
 
+// watchMergeQueue polls the pull request until it leaves the merge queue, either because it
+// merged or because it was removed, printing its position in the queue as it changes.
+func watchMergeQueue(opts *MergeOptions, findOptions shared.FindOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	if err := opts.IO.EnableVirtualTerminalProcessing(); err != nil {
+		return err
+	}
+
+	lastPosition := -1
+	for {
+		pr, _, err := opts.Finder.Find(findOptions)
+		if err != nil {
+			return err
+		}
+
+		if pr.MergeQueueEntry == nil {
+			if pr.State == "MERGED" {
+				fmt.Fprintf(opts.IO.Out, "%s Pull request #%d merged\n", cs.SuccessIconWithColor(cs.Magenta), pr.Number)
+				return nil
+			}
+			fmt.Fprintf(opts.IO.Out, "%s Pull request #%d left the merge queue\n", cs.WarningIcon(), pr.Number)
+			return cmdutil.SilentError
+		}
+
+		if pr.MergeQueueEntry.Position != lastPosition {
+			lastPosition = pr.MergeQueueEntry.Position
+			fmt.Fprintf(opts.IO.Out, "Pull request #%d is in position %d of the merge queue (%s)\n", pr.Number, lastPosition, pr.MergeQueueEntry.State)
+		}
+
+		time.Sleep(opts.Interval)
+	}
+}
+
 func mergeMethodSurvey(baseRepo *api.Repository) (PullRequestMergeMethod, error) {
 	type mergeOption struct {
 		title  string
@@ -147,6 +147,40 @@ func Test_NewCmdMerge(t *testing.T) {
 			isTTY:   false,
 			wantErr: "only one of --merge, --rebase, or --squash can be enabled",
 		},
+		{
+			name:  "queue",
+			args:  "123 --queue",
+			isTTY: false,
+			want: MergeOptions{
+				SelectorArg:          "123",
+				CanDeleteLocalBranch: true,
+				Queue:                true,
+			},
+		},
+		{
+			name:    "queue with merge method",
+			args:    "123 --queue --merge",
+			isTTY:   true,
+			wantErr: "cannot use `--merge`, `--rebase`, or `--squash` with `--queue` or `--dequeue`",
+		},
+		{
+			name:    "queue and dequeue",
+			args:    "123 --queue --dequeue",
+			isTTY:   true,
+			wantErr: "specify only one of `--auto`, `--disable-auto`, `--admin`, `--queue`, or `--dequeue`",
+		},
+		{
+			name:    "watch without queue",
+			args:    "123 --merge --watch",
+			isTTY:   true,
+			wantErr: "cannot use `--watch` flag without `--queue` flag",
+		},
+		{
+			name:    "interval without watch",
+			args:    "123 --queue --interval 5",
+			isTTY:   false,
+			wantErr: "cannot use `--interval` flag without `--watch` flag",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -193,6 +227,9 @@ func Test_NewCmdMerge(t *testing.T) {
 			assert.Equal(t, tt.want.InteractiveMode, opts.InteractiveMode)
 			assert.Equal(t, tt.want.Body, opts.Body)
 			assert.Equal(t, tt.want.BodySet, opts.BodySet)
+			assert.Equal(t, tt.want.Queue, opts.Queue)
+			assert.Equal(t, tt.want.Dequeue, opts.Dequeue)
+			assert.Equal(t, tt.want.Watch, opts.Watch)
 		})
 	}
 }
@@ -658,7 +695,7 @@ func Test_nonDivergingPullRequest(t *testing.T) {
 	stubCommit(pr, "COMMITSHA1")
 
 	prFinder := shared.RunCommandFinder("", pr, baseRepo("OWNER", "REPO", "master"))
-	prFinder.ExpectFields([]string{"id", "number", "state", "title", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName"})
+	prFinder.ExpectFields([]string{"id", "number", "state", "title", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName", "mergeQueueEnabled", "mergeQueueEntry"})
 
 	http.Register(
 		httpmock.GraphQL(`mutation PullRequestMerge\b`),
@@ -698,7 +735,7 @@ func Test_divergingPullRequestWarning(t *testing.T) {
 	stubCommit(pr, "COMMITSHA1")
 
 	prFinder := shared.RunCommandFinder("", pr, baseRepo("OWNER", "REPO", "master"))
-	prFinder.ExpectFields([]string{"id", "number", "state", "title", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName"})
+	prFinder.ExpectFields([]string{"id", "number", "state", "title", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName", "mergeQueueEnabled", "mergeQueueEntry"})
 
 	http.Register(
 		httpmock.GraphQL(`mutation PullRequestMerge\b`),
@@ -1280,6 +1317,136 @@ func TestMergeRun_disableAutoMerge(t *testing.T) {
 	assert.Equal(t, "✓ Auto-merge disabled for pull request #123\n", stderr.String())
 }
 
+func TestMergeRun_queue(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	tr := initFakeHTTP()
+	defer tr.Verify(t)
+	tr.Register(
+		httpmock.GraphQL(`mutation PullRequestEnqueue\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["pullRequestId"].(string))
+		}))
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	err := mergeRun(&MergeOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: tr}, nil
+		},
+		SelectorArg: "https://github.com/OWNER/REPO/pull/123",
+		Queue:       true,
+		Finder: shared.NewMockFinder(
+			"https://github.com/OWNER/REPO/pull/123",
+			&api.PullRequest{ID: "THE-ID", Number: 123},
+			ghrepo.New("OWNER", "REPO"),
+		),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Added pull request #123 to the merge queue\n", stderr.String())
+}
+
+func TestMergeRun_queue_alreadyQueued(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	tr := initFakeHTTP()
+	defer tr.Verify(t)
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	err := mergeRun(&MergeOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: tr}, nil
+		},
+		SelectorArg: "https://github.com/OWNER/REPO/pull/123",
+		Queue:       true,
+		Finder: shared.NewMockFinder(
+			"https://github.com/OWNER/REPO/pull/123",
+			&api.PullRequest{ID: "THE-ID", Number: 123, MergeQueueEntry: &api.PullRequestMergeQueueEntry{Position: 2, State: "QUEUED"}},
+			ghrepo.New("OWNER", "REPO"),
+		),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "! Pull request #123 is already in the merge queue\n", stderr.String())
+}
+
+func TestMergeRun_dequeue(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	tr := initFakeHTTP()
+	defer tr.Verify(t)
+	tr.Register(
+		httpmock.GraphQL(`mutation PullRequestDequeue\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["id"].(string))
+		}))
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	err := mergeRun(&MergeOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: tr}, nil
+		},
+		SelectorArg: "https://github.com/OWNER/REPO/pull/123",
+		Dequeue:     true,
+		Finder: shared.NewMockFinder(
+			"https://github.com/OWNER/REPO/pull/123",
+			&api.PullRequest{ID: "THE-ID", Number: 123, MergeQueueEntry: &api.PullRequestMergeQueueEntry{Position: 1, State: "QUEUED"}},
+			ghrepo.New("OWNER", "REPO"),
+		),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Removed pull request #123 from the merge queue\n", stderr.String())
+}
+
+func TestMergeRun_dequeue_notQueued(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	tr := initFakeHTTP()
+	defer tr.Verify(t)
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	err := mergeRun(&MergeOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: tr}, nil
+		},
+		SelectorArg: "https://github.com/OWNER/REPO/pull/123",
+		Dequeue:     true,
+		Finder: shared.NewMockFinder(
+			"https://github.com/OWNER/REPO/pull/123",
+			&api.PullRequest{ID: "THE-ID", Number: 123},
+			ghrepo.New("OWNER", "REPO"),
+		),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "! Pull request #123 is not in the merge queue\n", stderr.String())
+}
+
 type testEditor struct{}
 
 func (e testEditor) Edit(filename, text string) (string, error) {
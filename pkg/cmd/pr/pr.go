@@ -12,6 +12,7 @@ import (
 	cmdList "github.com/cli/cli/v2/pkg/cmd/pr/list"
 	cmdMerge "github.com/cli/cli/v2/pkg/cmd/pr/merge"
 	cmdReady "github.com/cli/cli/v2/pkg/cmd/pr/ready"
+	cmdRemind "github.com/cli/cli/v2/pkg/cmd/pr/remind"
 	cmdReopen "github.com/cli/cli/v2/pkg/cmd/pr/reopen"
 	cmdReview "github.com/cli/cli/v2/pkg/cmd/pr/review"
 	cmdStatus "github.com/cli/cli/v2/pkg/cmd/pr/status"
@@ -50,6 +51,7 @@ func NewCmdPR(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdMerge.NewCmdMerge(f, nil))
 	cmd.AddCommand(cmdReady.NewCmdReady(f, nil))
+	cmd.AddCommand(cmdRemind.NewCmdRemind(f, nil))
 	cmd.AddCommand(cmdReopen.NewCmdReopen(f, nil))
 	cmd.AddCommand(cmdReview.NewCmdReview(f, nil))
 	cmd.AddCommand(cmdStatus.NewCmdStatus(f, nil))
@@ -0,0 +1,81 @@
+package remind
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+func searchStalePullRequests(httpClient *http.Client, repo ghrepo.Interface, search string) ([]api.PullRequest, error) {
+	type response struct {
+		Search struct {
+			Nodes    []api.PullRequest
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   string
+			}
+		}
+	}
+
+	fragment := fmt.Sprintf("fragment pr on PullRequest{%s}", api.PullRequestGraphQL([]string{"id", "number", "title", "url", "updatedAt"}))
+	query := fragment + `
+		query PullRequestRemindSearch($q: String!, $endCursor: String) {
+			search(query: $q, type: ISSUE, first: 100, after: $endCursor) {
+				nodes {
+					...pr
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}`
+
+	q := fmt.Sprintf("repo:%s is:pr is:open %s", ghrepo.FullName(repo), search)
+	variables := map[string]interface{}{"q": q}
+
+	client := api.NewClientFromHTTP(httpClient)
+	var prs []api.PullRequest
+	for {
+		var data response
+		if err := client.GraphQL(repo.RepoHost(), query, variables, &data); err != nil {
+			return nil, err
+		}
+		prs = append(prs, data.Search.Nodes...)
+		if !data.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = data.Search.PageInfo.EndCursor
+	}
+
+	return prs, nil
+}
+
+func postSlackWebhook(httpClient *http.Client, webhookURL, text string) error {
+	payload, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
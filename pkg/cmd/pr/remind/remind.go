@@ -0,0 +1,167 @@
+package remind
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+const defaultMessage = `⏰ Friendly reminder that {{.URL}} is waiting for your review.`
+
+type RemindOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Search     string
+	OlderThan  string
+	Via        string
+	WebhookURL string
+	Message    string
+}
+
+func NewCmdRemind(f *cmdutil.Factory, runF func(*RemindOptions) error) *cobra.Command {
+	opts := &RemindOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remind",
+		Short: "Send reminders for pull requests awaiting review",
+		Long: heredoc.Doc(`
+			Find pull requests matching a search query and send a reminder for each one
+			that has gone without activity for longer than '--older-than'.
+
+			This is meant to be run on a schedule, e.g. from a GitHub Actions workflow,
+			to replace a hand-rolled bot that nags reviewers about stale review requests.
+		`),
+		Example: heredoc.Doc(`
+			# comment on PRs with a pending review request from your team that are over two days old
+			$ gh pr remind --search "review-requested:@team" --older-than 48h
+
+			# post the same reminders to a Slack channel instead
+			$ gh pr remind --search "review-requested:@team" --older-than 48h --via slack-webhook --webhook-url "$SLACK_WEBHOOK_URL"
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Search == "" {
+				return cmdutil.FlagErrorf("`--search` is required")
+			}
+
+			if opts.Via == "slack-webhook" && opts.WebhookURL == "" {
+				return cmdutil.FlagErrorf("`--webhook-url` is required when `--via` is `slack-webhook`")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return remindRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search `query` for pull requests to remind, e.g. \"review-requested:@team\"")
+	cmd.Flags().StringVar(&opts.OlderThan, "older-than", "", "Only remind about pull requests last updated before this long ago, e.g. \"48h\" or \"2d\"")
+	cmdutil.StringEnumFlag(cmd, &opts.Via, "via", "", "comment", []string{"comment", "slack-webhook"}, "Where to send the reminder")
+	cmd.Flags().StringVar(&opts.WebhookURL, "webhook-url", "", "Slack incoming webhook `url`, required when --via is slack-webhook")
+	cmd.Flags().StringVar(&opts.Message, "message", defaultMessage, "Reminder message `template`, evaluated per pull request")
+
+	return cmd
+}
+
+func remindRun(opts *RemindOptions) error {
+	tmpl, err := template.New("message").Parse(opts.Message)
+	if err != nil {
+		return fmt.Errorf("invalid --message template: %w", err)
+	}
+
+	var cutoff time.Time
+	if opts.OlderThan != "" {
+		d, err := parseOlderThan(opts.OlderThan)
+		if err != nil {
+			return fmt.Errorf("invalid --older-than duration: %w", err)
+		}
+		cutoff = time.Now().Add(-d)
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	prs, err := searchStalePullRequests(httpClient, baseRepo, opts.Search)
+	if err != nil {
+		return err
+	}
+
+	var due []api.PullRequest
+	for _, pr := range prs {
+		if opts.OlderThan == "" || pr.UpdatedAt.Before(cutoff) {
+			due = append(due, pr)
+		}
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	var sent int
+	for _, pr := range due {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, pr); err != nil {
+			return fmt.Errorf("invalid --message template: %w", err)
+		}
+		message := buf.String()
+
+		switch opts.Via {
+		case "slack-webhook":
+			if err := postSlackWebhook(httpClient, opts.WebhookURL, message); err != nil {
+				return fmt.Errorf("failed to notify %s: %w", pr.URL, err)
+			}
+		default:
+			if _, err := api.CommentCreate(apiClient, baseRepo.RepoHost(), api.CommentCreateInput{Body: message, SubjectId: pr.ID}); err != nil {
+				return fmt.Errorf("failed to comment on %s: %w", pr.URL, err)
+			}
+		}
+		sent++
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "Sent %d reminder(s)\n", sent)
+	}
+
+	return nil
+}
+
+// parseOlderThan accepts either a Go duration string (e.g. "48h") or a
+// whole number of days followed by "d" (e.g. "2d"), since the latter is the
+// more natural unit for review reminders.
+func parseOlderThan(s string) (time.Duration, error) {
+	if days := strings.TrimSuffix(s, "d"); days != s {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("expected a number of days before \"d\": %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
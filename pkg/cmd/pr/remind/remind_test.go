@@ -0,0 +1,150 @@
+package remind
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*RemindOptions, error) {
+	io, _, _, _ := iostreams.Test()
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	var gotOpts *RemindOptions
+	cmd := NewCmdRemind(factory, func(opts *RemindOptions) error {
+		gotOpts = opts
+		return nil
+	})
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return gotOpts, err
+}
+
+func TestNewCmdRemind(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wantOpts RemindOptions
+		wantErr  string
+	}{
+		{
+			name:    "missing search",
+			cli:     "",
+			wantErr: "`--search` is required",
+		},
+		{
+			name: "defaults",
+			cli:  `--search "review-requested:@team"`,
+			wantOpts: RemindOptions{
+				Search:  "review-requested:@team",
+				Via:     "comment",
+				Message: defaultMessage,
+			},
+		},
+		{
+			name:    "slack webhook without url",
+			cli:     `--search "review-requested:@team" --via slack-webhook`,
+			wantErr: "`--webhook-url` is required when `--via` is `slack-webhook`",
+		},
+		{
+			name: "slack webhook",
+			cli:  `--search "review-requested:@team" --older-than 2d --via slack-webhook --webhook-url https://hooks.example.com/T000`,
+			wantOpts: RemindOptions{
+				Search:     "review-requested:@team",
+				OlderThan:  "2d",
+				Via:        "slack-webhook",
+				WebhookURL: "https://hooks.example.com/T000",
+				Message:    defaultMessage,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotOpts, err := runCommand(nil, tt.cli)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantOpts.Search, gotOpts.Search)
+			assert.Equal(t, tt.wantOpts.OlderThan, gotOpts.OlderThan)
+			assert.Equal(t, tt.wantOpts.Via, gotOpts.Via)
+			assert.Equal(t, tt.wantOpts.WebhookURL, gotOpts.WebhookURL)
+			assert.Equal(t, tt.wantOpts.Message, gotOpts.Message)
+		})
+	}
+}
+
+func TestRemindRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query PullRequestRemindSearch\b`),
+		httpmock.StringResponse(`{ "data": { "search": { "nodes": [
+			{ "id": "PR_stale", "number": 1, "title": "Stale PR", "url": "https://github.com/OWNER/REPO/pull/1", "updatedAt": "2020-01-01T00:00:00Z" },
+			{ "id": "PR_fresh", "number": 2, "title": "Fresh PR", "url": "https://github.com/OWNER/REPO/pull/2", "updatedAt": "2099-01-01T00:00:00Z" }
+		], "pageInfo": { "hasNextPage": false } } } }`),
+	)
+	reg.Register(
+		httpmock.GraphQL(`mutation CommentCreate\b`),
+		httpmock.StringResponse(`{ "data": { "addComment": { "commentEdge": { "node": { "url": "https://github.com/OWNER/REPO/pull/1#comment" } } } } }`),
+	)
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &RemindOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Search:    "review-requested:@team",
+		OlderThan: "24h",
+		Via:       "comment",
+		Message:   defaultMessage,
+	}
+
+	err := remindRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "Sent 1 reminder(s)\n", stdout.String())
+}
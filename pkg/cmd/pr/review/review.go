@@ -16,6 +16,7 @@ import (
 	"github.com/cli/cli/v2/pkg/prompt"
 	"github.com/cli/cli/v2/pkg/surveyext"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 type ReviewOptions struct {
@@ -29,6 +30,7 @@ type ReviewOptions struct {
 	InteractiveMode bool
 	ReviewType      api.PullRequestReviewState
 	Body            string
+	Comments        []api.PullRequestReviewInlineComment
 }
 
 func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Command {
@@ -45,6 +47,7 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 	)
 
 	var bodyFile string
+	var commentsFile string
 
 	cmd := &cobra.Command{
 		Use:   "review [<number> | <url> | <branch>]",
@@ -53,6 +56,9 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 			Add a review to a pull request.
 
 			Without an argument, the pull request that belongs to the current branch is reviewed.
+
+			Inline comments can be supplied in bulk with '--comments-file', pointing at a YAML
+			file listing "path", "line", and "body" for each comment.
 		`),
 		Example: heredoc.Doc(`
 			# approve the pull request of the current branch
@@ -66,6 +72,9 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 
 			# request changes on a specific pull request
 			$ gh pr review 123 -r -b "needs more ASCII art"
+
+			# leave inline comments described in a file alongside a review
+			$ gh pr review 123 -c --comments-file review.yml
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -97,6 +106,18 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 				opts.Body = string(b)
 			}
 
+			if commentsFile != "" {
+				b, err := cmdutil.ReadFile(commentsFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				comments, err := parseReviewCommentsFile(b)
+				if err != nil {
+					return fmt.Errorf("failed to parse %s: %w", commentsFile, err)
+				}
+				opts.Comments = comments
+			}
+
 			found := 0
 			if flagApprove {
 				found++
@@ -140,10 +161,52 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&flagComment, "comment", "c", false, "Comment on a pull request")
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Specify the body of a review")
 	cmd.Flags().StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().StringVar(&commentsFile, "comments-file", "", "Read inline review comments from a YAML `file` (use \"-\" to read from standard input)")
 
 	return cmd
 }
 
+type reviewCommentsFile struct {
+	Comments []struct {
+		Path string `yaml:"path"`
+		Line int    `yaml:"line"`
+		Body string `yaml:"body"`
+	} `yaml:"comments"`
+}
+
+// parseReviewCommentsFile parses a YAML file of the form:
+//
+//	comments:
+//	  - path: main.go
+//	    line: 10
+//	    body: consider renaming this
+func parseReviewCommentsFile(b []byte) ([]api.PullRequestReviewInlineComment, error) {
+	var parsed reviewCommentsFile
+	if err := yaml.Unmarshal(b, &parsed); err != nil {
+		return nil, err
+	}
+
+	comments := make([]api.PullRequestReviewInlineComment, len(parsed.Comments))
+	for i, c := range parsed.Comments {
+		if c.Path == "" {
+			return nil, fmt.Errorf("comment %d: path is required", i)
+		}
+		if c.Line <= 0 {
+			return nil, fmt.Errorf("comment %d: line must be a positive number", i)
+		}
+		if c.Body == "" {
+			return nil, fmt.Errorf("comment %d: body is required", i)
+		}
+		comments[i] = api.PullRequestReviewInlineComment{
+			Path: c.Path,
+			Line: c.Line,
+			Body: c.Body,
+		}
+	}
+
+	return comments, nil
+}
+
 func reviewRun(opts *ReviewOptions) error {
 	findOptions := shared.FindOptions{
 		Selector: opts.SelectorArg,
@@ -174,6 +237,7 @@ func reviewRun(opts *ReviewOptions) error {
 			Body:  opts.Body,
 		}
 	}
+	reviewData.Comments = opts.Comments
 
 	httpClient, err := opts.HttpClient()
 	if err != nil {
@@ -29,6 +29,10 @@ func Test_NewCmdReview(t *testing.T) {
 	err := ioutil.WriteFile(tmpFile, []byte("a body from file"), 0600)
 	require.NoError(t, err)
 
+	badCommentsFile := filepath.Join(t.TempDir(), "bad-comments.yml")
+	err = ioutil.WriteFile(badCommentsFile, []byte("comments:\n  - path: main.go\n    line: 0\n    body: nope\n"), 0600)
+	require.NoError(t, err)
+
 	tests := []struct {
 		name    string
 		args    string
@@ -120,6 +124,12 @@ func Test_NewCmdReview(t *testing.T) {
 			isTTY:   true,
 			wantErr: "specify only one of `--body` or `--body-file`",
 		},
+		{
+			name:    "comments file with invalid entry",
+			args:    fmt.Sprintf("--approve --comments-file '%s'", badCommentsFile),
+			isTTY:   true,
+			wantErr: fmt.Sprintf("failed to parse %s: comment 0: line must be a positive number", badCommentsFile),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -255,6 +265,46 @@ func TestPRReview(t *testing.T) {
 	}
 }
 
+func TestPRReview_commentsFile(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "review.yml")
+	err := ioutil.WriteFile(tmpFile, []byte(heredoc.Doc(`
+		comments:
+		  - path: main.go
+		    line: 10
+		    body: consider renaming this
+		  - path: main.go
+		    line: 20
+		    body: this could use a comment
+	`)), 0600)
+	require.NoError(t, err)
+
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder("", &api.PullRequest{ID: "THE-ID"}, ghrepo.New("OWNER", "REPO"))
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestReviewAdd\b`),
+		httpmock.GraphQLMutation(`{"data": {} }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, map[string]interface{}{
+					"pullRequestId": "THE-ID",
+					"event":         "COMMENT",
+					"body":          "looks good",
+					"threads": []interface{}{
+						map[string]interface{}{"path": "main.go", "line": float64(10), "body": "consider renaming this"},
+						map[string]interface{}{"path": "main.go", "line": float64(20), "body": "this could use a comment"},
+					},
+				}, inputs)
+			}),
+	)
+
+	output, err := runCommand(http, nil, false, fmt.Sprintf(`--comment -b "looks good" --comments-file '%s'`, tmpFile))
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "", output.Stderr())
+}
+
 func TestPRReview_interactive(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
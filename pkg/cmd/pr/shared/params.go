@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/search"
 	"github.com/google/shlex"
@@ -148,19 +149,20 @@ func AddMetadataToIssueParams(client *api.Client, baseRepo ghrepo.Interface, par
 }
 
 type FilterOptions struct {
-	Assignee   string
-	Author     string
-	BaseBranch string
-	Draft      *bool
-	Entity     string
-	Fields     []string
-	HeadBranch string
-	Labels     []string
-	Mention    string
-	Milestone  string
-	Repo       string
-	Search     string
-	State      string
+	Assignee       string
+	Author         string
+	BaseBranch     string
+	Draft          *bool
+	Entity         string
+	Fields         []string
+	HeadBranch     string
+	Labels         []string
+	Mention        string
+	Milestone      string
+	Repo           string
+	Search         string
+	SearchComments bool
+	State          string
 }
 
 func (opts *FilterOptions) IsDefault() bool {
@@ -223,6 +225,9 @@ func SearchQueryBuild(options FilterOptions) string {
 			Type:      options.Entity,
 		},
 	}
+	if options.SearchComments {
+		q.Qualifiers.In = []string{"comments"}
+	}
 	if options.Search != "" {
 		return fmt.Sprintf("%s %s", options.Search, q.String())
 	}
@@ -244,6 +249,27 @@ func QueryHasStateClause(searchQuery string) bool {
 	return false
 }
 
+// SavedSearchQuery looks up a query previously saved with "gh search save" and returns its
+// search syntax, for use by the "--filter" flag of "gh issue list" and "gh pr list".
+func SavedSearchQuery(cfg func() (config.Config, error), name string) (string, error) {
+	c, err := cfg()
+	if err != nil {
+		return "", err
+	}
+
+	queriesCfg, err := c.Queries()
+	if err != nil {
+		return "", fmt.Errorf("couldn't read saved searches config: %w", err)
+	}
+
+	sq, ok := queriesCfg.Get(name)
+	if !ok {
+		return "", fmt.Errorf("no saved search named %q; create one with `gh search save`", name)
+	}
+
+	return sq.Query, nil
+}
+
 // MeReplacer resolves usages of `@me` to the handle of the currently logged in user.
 type MeReplacer struct {
 	apiClient *api.Client
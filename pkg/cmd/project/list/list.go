@@ -0,0 +1,123 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+
+	Owner    string
+	Limit    int
+	Exporter cmdutil.Exporter
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List projects for a user or organization",
+		Long: heredoc.Doc(`
+			List GitHub Projects belonging to a user or organization.
+
+			Without --owner, this lists the authenticated user's own projects.
+		`),
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Owner, "owner", "o", "", "Login of the user or organization to list projects for")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of projects to fetch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, []string{"number", "title", "url", "closed", "shortDescription"})
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	owner := opts.Owner
+	if owner == "" {
+		owner = "@me"
+	}
+
+	opts.IO.StartProgressIndicator()
+	payload, err := api.ProjectsV2ForOwner(apiClient, host, owner, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, payload.Projects)
+	}
+
+	if len(payload.Projects) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "No projects found for %s\n", owner)
+		return nil
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\nShowing %s for %s\n\n", utils.Pluralize(len(payload.Projects), "project"), owner)
+	}
+
+	return printProjects(opts.IO, payload.Projects)
+}
+
+func printProjects(io *iostreams.IOStreams, projects []api.ProjectV2) error {
+	cs := io.ColorScheme()
+	table := utils.NewTablePrinter(io)
+
+	for _, p := range projects {
+		table.AddField(fmt.Sprintf("#%d", p.Number), nil, cs.Cyan)
+		table.AddField(p.Title, nil, nil)
+		if p.Closed {
+			table.AddField("closed", nil, cs.Gray)
+		} else {
+			table.AddField("open", nil, cs.Green)
+		}
+		table.AddField(p.URL, nil, nil)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
@@ -0,0 +1,105 @@
+package list
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  ListOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "no argument",
+			input:  "",
+			output: ListOptions{Limit: 30},
+		},
+		{
+			name:   "owner flag",
+			input:  "--owner cli",
+			output: ListOptions{Limit: 30, Owner: "cli"},
+		},
+		{
+			name:    "invalid limit flag",
+			input:   "--limit 0",
+			wantErr: true,
+			errMsg:  "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: io}
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.output.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.output.Owner, gotOpts.Owner)
+		})
+	}
+}
+
+func TestListRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query ProjectList\b`),
+		httpmock.StringResponse(`{"data": {"repositoryOwner": {"projectsV2": {
+			"totalCount": 1,
+			"nodes": [
+				{"number": 3, "title": "Roadmap", "url": "https://github.com/orgs/cli/projects/3", "closed": false}
+			]
+		}}}}`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &ListOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Owner: "cli",
+		Limit: 30,
+	}
+
+	err := listRun(opts)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "#3")
+	assert.Contains(t, stdout.String(), "Roadmap")
+}
@@ -0,0 +1,27 @@
+package project
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	projectListCmd "github.com/cli/cli/v2/pkg/cmd/project/list"
+	projectViewDataCmd "github.com/cli/cli/v2/pkg/cmd/project/view-data"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdProject(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project <command>",
+		Short: "Work with GitHub Projects",
+		Long:  `Work with the new, ProjectsV2-based GitHub Projects boards.`,
+		Example: heredoc.Doc(`
+			$ gh project list
+			$ gh project list --owner cli
+			$ gh project view-data 3 --owner cli --view "Sprint Board" --format csv
+		`),
+	}
+
+	cmd.AddCommand(projectListCmd.NewCmdList(f, nil))
+	cmd.AddCommand(projectViewDataCmd.NewCmdViewData(f, nil))
+
+	return cmd
+}
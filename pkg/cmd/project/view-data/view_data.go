@@ -0,0 +1,219 @@
+package viewdata
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewDataOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+
+	Owner     string
+	Number    int
+	ViewName  string
+	ItemLimit int
+	Format    string
+}
+
+func NewCmdViewData(f *cmdutil.Factory, runF func(*ViewDataOptions) error) *cobra.Command {
+	opts := &ViewDataOptions{
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view-data <number>",
+		Short: "Export the data behind a saved project view",
+		Long: heredoc.Doc(`
+			Export the filter, grouping, and visible fields of a saved project view,
+			along with the current value of every field on the view's items and a
+			simple throughput count of items closed per day, for teams reporting out
+			of a project outside the GitHub UI.
+
+			Without --view, this uses the project's first saved view.
+
+			The view's filter is not applied to the exported items: all of the
+			project's items are exported (up to --item-limit), annotated with which
+			fields the view shows and groups by, since this CLI can't yet evaluate
+			the GitHub UI's view filter syntax itself.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			number, err := strconv.Atoi(args[0])
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid project number: %q", args[0])
+			}
+			opts.Number = number
+
+			if opts.ItemLimit < 1 {
+				return cmdutil.FlagErrorf("invalid item limit: %v", opts.ItemLimit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewDataRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Owner, "owner", "o", "", "Login of the user or organization that owns the project")
+	cmd.Flags().StringVar(&opts.ViewName, "view", "", "Name of the saved view to export (default: the project's first view)")
+	cmd.Flags().IntVar(&opts.ItemLimit, "item-limit", 100, "Maximum number of items to export")
+	cmdutil.StringEnumFlag(cmd, &opts.Format, "format", "", "json", []string{"json", "csv"}, "Output format")
+
+	return cmd
+}
+
+func viewDataRun(opts *ViewDataOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	owner := opts.Owner
+	if owner == "" {
+		owner = "@me"
+	}
+
+	opts.IO.StartProgressIndicator()
+	project, err := api.ProjectV2DetailForOwner(apiClient, host, owner, opts.Number, opts.ItemLimit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	view, err := selectView(project.Views, opts.ViewName)
+	if err != nil {
+		return err
+	}
+
+	switch opts.Format {
+	case "csv":
+		return printCSV(opts.IO, view, project.Items)
+	default:
+		return printJSON(opts.IO, view, project.Items)
+	}
+}
+
+func selectView(views []api.ProjectV2View, name string) (*api.ProjectV2View, error) {
+	if len(views) == 0 {
+		return nil, fmt.Errorf("this project has no saved views")
+	}
+	if name == "" {
+		return &views[0], nil
+	}
+	for i, v := range views {
+		if v.Name == name {
+			return &views[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no view named %q found on this project", name)
+}
+
+type viewDataExport struct {
+	View       string         `json:"view"`
+	Layout     string         `json:"layout"`
+	Filter     string         `json:"filter"`
+	Fields     []string       `json:"fields"`
+	GroupBy    []string       `json:"groupBy"`
+	Items      []itemExport   `json:"items"`
+	Throughput map[string]int `json:"throughputByClosedDate"`
+}
+
+type itemExport struct {
+	Title    string            `json:"title"`
+	Number   int               `json:"number"`
+	URL      string            `json:"url"`
+	State    string            `json:"state"`
+	Closed   bool              `json:"closed"`
+	ClosedAt string            `json:"closedAt,omitempty"`
+	Fields   map[string]string `json:"fields"`
+}
+
+func buildExport(view *api.ProjectV2View, items []api.ProjectV2Item) viewDataExport {
+	export := viewDataExport{
+		View:       view.Name,
+		Layout:     view.Layout,
+		Filter:     view.Filter,
+		Fields:     view.VisibleFieldNames(),
+		GroupBy:    view.GroupByFieldNames(),
+		Items:      make([]itemExport, len(items)),
+		Throughput: map[string]int{},
+	}
+
+	for i, item := range items {
+		fields := make(map[string]string, len(item.FieldValues))
+		for _, fv := range item.FieldValues {
+			fields[fv.FieldName] = fv.Value
+		}
+
+		ie := itemExport{
+			Title:  item.Title,
+			Number: item.Number,
+			URL:    item.URL,
+			State:  item.State,
+			Closed: item.Closed,
+			Fields: fields,
+		}
+		if item.ClosedAt != nil {
+			day := item.ClosedAt.Format("2006-01-02")
+			ie.ClosedAt = day
+			export.Throughput[day]++
+		}
+		export.Items[i] = ie
+	}
+
+	return export
+}
+
+func printJSON(io *iostreams.IOStreams, view *api.ProjectV2View, items []api.ProjectV2Item) error {
+	enc := json.NewEncoder(io.Out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(buildExport(view, items))
+}
+
+func printCSV(io *iostreams.IOStreams, view *api.ProjectV2View, items []api.ProjectV2Item) error {
+	export := buildExport(view, items)
+
+	w := csv.NewWriter(io.Out)
+	header := append([]string{"title", "number", "url", "state", "closedAt"}, export.Fields...)
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, item := range export.Items {
+		row := []string{item.Title, strconv.Itoa(item.Number), item.URL, item.State, item.ClosedAt}
+		for _, field := range export.Fields {
+			row = append(row, item.Fields[field])
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,168 @@
+package viewdata
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdViewData(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  ViewDataOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "number only",
+			input:  "3",
+			output: ViewDataOptions{Number: 3, ItemLimit: 100, Format: "json"},
+		},
+		{
+			name:   "view and format",
+			input:  `3 --owner cli --view "Sprint Board" --format csv`,
+			output: ViewDataOptions{Number: 3, Owner: "cli", ViewName: "Sprint Board", ItemLimit: 100, Format: "csv"},
+		},
+		{
+			name:    "invalid number",
+			input:   "nope",
+			wantErr: true,
+			errMsg:  `invalid project number: "nope"`,
+		},
+		{
+			name:    "invalid item limit",
+			input:   "3 --item-limit 0",
+			wantErr: true,
+			errMsg:  "invalid item limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: io}
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			var gotOpts *ViewDataOptions
+			cmd := NewCmdViewData(f, func(opts *ViewDataOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.output.Number, gotOpts.Number)
+			assert.Equal(t, tt.output.Owner, gotOpts.Owner)
+			assert.Equal(t, tt.output.ViewName, gotOpts.ViewName)
+			assert.Equal(t, tt.output.ItemLimit, gotOpts.ItemLimit)
+			assert.Equal(t, tt.output.Format, gotOpts.Format)
+		})
+	}
+}
+
+func TestViewDataRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query ProjectViewData\b`),
+		httpmock.StringResponse(`{"data": {"repositoryOwner": {"projectV2": {
+			"id": "PVT_1",
+			"number": 3,
+			"title": "Roadmap",
+			"views": {"nodes": [
+				{"id": "PVTV_1", "name": "Sprint Board", "layout": "BOARD_LAYOUT", "filter": "is:open",
+				 "fields": {"nodes": [{"name": "Title"}, {"name": "Status"}]},
+				 "groupByFields": {"nodes": [{"name": "Status"}]}}
+			]},
+			"items": {"nodes": [
+				{"id": "PVTI_1",
+				 "content": {"title": "Fix the thing", "number": 42, "url": "https://github.com/cli/cli/issues/42", "state": "CLOSED", "closed": true, "closedAt": "2026-08-01T00:00:00Z"},
+				 "fieldValues": {"nodes": [{"name": "Done", "field": {"name": "Status"}}]}}
+			]}
+		}}}}`))
+
+	io, _, stdout, _ := iostreams.Test()
+
+	opts := &ViewDataOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Owner:     "cli",
+		Number:    3,
+		ItemLimit: 100,
+		Format:    "json",
+	}
+
+	err := viewDataRun(opts)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), `"view": "Sprint Board"`)
+	assert.Contains(t, stdout.String(), `"Fix the thing"`)
+	assert.Contains(t, stdout.String(), `"2026-08-01": 1`)
+}
+
+func TestViewDataRun_csv(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query ProjectViewData\b`),
+		httpmock.StringResponse(`{"data": {"repositoryOwner": {"projectV2": {
+			"id": "PVT_1",
+			"number": 3,
+			"title": "Roadmap",
+			"views": {"nodes": [
+				{"id": "PVTV_1", "name": "Sprint Board", "layout": "TABLE_LAYOUT", "filter": "",
+				 "fields": {"nodes": [{"name": "Status"}]},
+				 "groupByFields": {"nodes": []}}
+			]},
+			"items": {"nodes": [
+				{"id": "PVTI_1",
+				 "content": {"title": "Fix the thing", "number": 42, "url": "https://github.com/cli/cli/issues/42", "state": "OPEN", "closed": false},
+				 "fieldValues": {"nodes": [{"name": "In Progress", "field": {"name": "Status"}}]}}
+			]}
+		}}}}`))
+
+	io, _, stdout, _ := iostreams.Test()
+
+	opts := &ViewDataOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Owner:     "cli",
+		Number:    3,
+		ItemLimit: 100,
+		Format:    "csv",
+	}
+
+	err := viewDataRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "title,number,url,state,closedAt,Status\nFix the thing,42,https://github.com/cli/cli/issues/42,OPEN,,In Progress\n", stdout.String())
+}
@@ -50,6 +50,8 @@ type CreateOptions struct {
 	Concurrency        int
 	DiscussionCategory string
 	GenerateNotes      bool
+	GenerateNotesFrom  string
+	NotesStartTag      string
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -83,6 +85,12 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			When using automatically generated release notes, a release title will also be automatically
 			generated unless a title was explicitly passed. Additional release notes can be prepended to
 			automatically generated notes by using the notes parameter.
+
+			Use %[1]s--generate-notes-from%[1]s to generate the notes locally from the commit log or
+			merged pull requests instead of calling the server-side generate-notes API, which is useful
+			on GitHub Enterprise Server instances where that API isn't available. When generating notes
+			from pull requests, sections are grouped using the categories defined in a repository's
+			%[1]s.github/release.yml%[1]s, if one exists.
 		`, "`"),
 		Example: heredoc.Doc(`
 			Interactively create a release
@@ -108,12 +116,29 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			Create a release and start a discussion
 			$ gh release create v1.2.3 --discussion-category "General"
+
+			Locally generate notes from merged pull requests, grouped by label
+			$ gh release create v1.2.3 --generate-notes-from prs
+
+			Locally generate notes from the commit log since a specific tag
+			$ gh release create v1.2.3 --generate-notes-from commits --notes-start-tag v1.0.0
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if cmd.Flags().Changed("discussion-category") && opts.Draft {
 				return errors.New("discussions for draft releases not supported")
 			}
 
+			if opts.GenerateNotesFrom != "" {
+				if opts.GenerateNotes {
+					return errors.New("specify only one of `--generate-notes` or `--generate-notes-from`")
+				}
+				if opts.GenerateNotesFrom != "prs" && opts.GenerateNotesFrom != "commits" {
+					return cmdutil.FlagErrorf("invalid value for --generate-notes-from: %q", opts.GenerateNotesFrom)
+				}
+			} else if opts.NotesStartTag != "" {
+				return errors.New("`--notes-start-tag` can only be used with `--generate-notes-from`")
+			}
+
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 			opts.RepoOverride, _ = cmd.Flags().GetString("repo")
@@ -132,9 +157,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("tag required when not running interactively")
 			}
 
-			opts.Concurrency = 5
-
-			opts.BodyProvided = cmd.Flags().Changed("notes") || opts.GenerateNotes
+			opts.BodyProvided = cmd.Flags().Changed("notes") || opts.GenerateNotes || opts.GenerateNotesFrom != ""
 			if notesFile != "" {
 				b, err := cmdutil.ReadFile(notesFile, opts.IO.In)
 				if err != nil {
@@ -159,6 +182,9 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&notesFile, "notes-file", "F", "", "Read release notes from `file` (use \"-\" to read from standard input)")
 	cmd.Flags().StringVarP(&opts.DiscussionCategory, "discussion-category", "", "", "Start a discussion of the specified category")
 	cmd.Flags().BoolVarP(&opts.GenerateNotes, "generate-notes", "", false, "Automatically generate title and notes for the release")
+	cmd.Flags().StringVar(&opts.GenerateNotesFrom, "generate-notes-from", "", "Locally generate release notes from `prs|commits`, without calling the generate-notes API")
+	cmd.Flags().StringVar(&opts.NotesStartTag, "notes-start-tag", "", "Tag to use as the starting point for --generate-notes-from (default: the previous tag)")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of simultaneous asset uploads")
 
 	return cmd
 }
@@ -238,6 +264,60 @@ func createRun(opts *CreateOptions) error {
 		}
 	}
 
+	if opts.GenerateNotesFrom != "" {
+		if opts.RepoOverride != "" {
+			return errors.New("`--generate-notes-from` requires a local git checkout of the repository")
+		}
+
+		headRef := opts.TagName
+		if tagDescription == "" {
+			if opts.Target != "" {
+				headRef = opts.Target
+			} else {
+				headRef = "HEAD"
+			}
+		}
+
+		startTag := opts.NotesStartTag
+		if startTag == "" {
+			prevTag, err := detectPreviousTag(headRef)
+			if err != nil {
+				return fmt.Errorf("could not detect previous tag: use `--notes-start-tag` to specify a starting point: %w", err)
+			}
+			startTag = prevTag
+		}
+
+		refRange := fmt.Sprintf("%s..%s", startTag, headRef)
+
+		var generated string
+		switch opts.GenerateNotesFrom {
+		case "commits":
+			commits, err := changelogForRange(refRange)
+			if err != nil {
+				return fmt.Errorf("could not generate changelog: %w", err)
+			}
+			generated = generateChangelog(commits)
+		case "prs":
+			prs, err := pullRequestsForRange(httpClient, baseRepo, refRange)
+			if err != nil {
+				return fmt.Errorf("could not generate changelog: %w", err)
+			}
+			cfg, err := loadReleaseConfig(httpClient, baseRepo)
+			if err != nil {
+				return err
+			}
+			generated = generatePRChangelog(prs, cfg)
+		}
+
+		if generated != "" {
+			if opts.Body != "" {
+				opts.Body = opts.Body + "\n\n" + generated
+			} else {
+				opts.Body = generated
+			}
+		}
+	}
+
 	if !opts.BodyProvided && opts.IO.CanPrompt() {
 		editorCommand, err := cmdutil.DetermineEditor(opts.Config)
 		if err != nil {
@@ -428,9 +508,7 @@ func createRun(opts *CreateOptions) error {
 			uploadURL = uploadURL[:idx]
 		}
 
-		opts.IO.StartProgressIndicator()
-		err = shared.ConcurrentUpload(httpClient, uploadURL, opts.Concurrency, opts.Assets)
-		opts.IO.StopProgressIndicator()
+		err = shared.ConcurrentUpload(opts.IO, httpClient, uploadURL, opts.Concurrency, opts.Assets)
 		if err != nil {
 			return err
 		}
@@ -222,6 +222,42 @@ func Test_NewCmdCreate(t *testing.T) {
 				GenerateNotes: true,
 			},
 		},
+		{
+			name:  "generate release notes from prs",
+			args:  "v1.2.3 --generate-notes-from prs",
+			isTTY: true,
+			want: CreateOptions{
+				TagName:           "v1.2.3",
+				Target:            "",
+				Name:              "",
+				Body:              "",
+				BodyProvided:      true,
+				Draft:             false,
+				Prerelease:        false,
+				RepoOverride:      "",
+				Concurrency:       5,
+				Assets:            []*shared.AssetForUpload(nil),
+				GenerateNotesFrom: "prs",
+			},
+		},
+		{
+			name:    "generate-notes and generate-notes-from are mutually exclusive",
+			args:    "v1.2.3 --generate-notes --generate-notes-from prs",
+			isTTY:   true,
+			wantErr: "specify only one of `--generate-notes` or `--generate-notes-from`",
+		},
+		{
+			name:    "invalid generate-notes-from value",
+			args:    "v1.2.3 --generate-notes-from changelog",
+			isTTY:   true,
+			wantErr: "invalid value for --generate-notes-from: \"changelog\"",
+		},
+		{
+			name:    "notes-start-tag requires generate-notes-from",
+			args:    "v1.2.3 --notes-start-tag v1.0.0",
+			isTTY:   true,
+			wantErr: "`--notes-start-tag` can only be used with `--generate-notes-from`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -896,3 +932,113 @@ func Test_createRun_interactive(t *testing.T) {
 		})
 	}
 }
+
+func Test_createRun_generateNotesFrom(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      *CreateOptions
+		httpStubs func(*httpmock.Registry)
+		runStubs  func(*run.CommandStubber)
+		wantBody  string
+	}{
+		{
+			name: "generate notes from commits",
+			opts: &CreateOptions{
+				TagName:           "v1.2.3",
+				GenerateNotesFrom: "commits",
+			},
+			runStubs: func(rs *run.CommandStubber) {
+				rs.Register(`git tag --list`, 1, "")
+				rs.Register(`git describe --tags --abbrev=0 HEAD\^`, 0, "v1.2.2\n")
+				rs.Register(`git .+log .+v1\.2\.2\.\.HEAD$`, 0, "commit subject\n\ncommit body\n")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("POST", "repos/OWNER/REPO/releases"), httpmock.StatusStringResponse(201, `{
+					"url": "https://api.github.com/releases/123",
+					"upload_url": "https://api.github.com/assets/upload",
+					"html_url": "https://github.com/OWNER/REPO/releases/tag/v1.2.3"
+				}`))
+			},
+			wantBody: "* commit subject\n\n  commit body\n  ",
+		},
+		{
+			name: "generate notes from prs grouped by label",
+			opts: &CreateOptions{
+				TagName:           "v1.2.3",
+				GenerateNotesFrom: "prs",
+			},
+			runStubs: func(rs *run.CommandStubber) {
+				rs.Register(`git tag --list`, 1, "")
+				rs.Register(`git describe --tags --abbrev=0 HEAD\^`, 0, "v1.2.2\n")
+				rs.Register(`git log .+v1\.2\.2\.\.HEAD$`, 0, "aaa111\nbbb222\n")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", "repos/OWNER/REPO/commits/aaa111/pulls"), httpmock.StatusStringResponse(200, `[
+					{"number": 10, "title": "Fix a crash", "merged": true, "labels": [{"name": "bug"}]}
+				]`))
+				reg.Register(httpmock.REST("GET", "repos/OWNER/REPO/commits/bbb222/pulls"), httpmock.StatusStringResponse(200, `[
+					{"number": 11, "title": "Add a widget", "merged": true, "labels": [{"name": "enhancement"}]}
+				]`))
+				reg.Register(httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/release.yml"), httpmock.StatusStringResponse(200, `{
+					"content": "Y2hhbmdlbG9nOgogIGNhdGVnb3JpZXM6CiAgICAtIHRpdGxlOiBCdWcgRml4ZXMKICAgICAgbGFiZWxzOiBbYnVnXQogICAgLSB0aXRsZTogTmV3IEZlYXR1cmVzCiAgICAgIGxhYmVsczogW2VuaGFuY2VtZW50XQo="
+				}`))
+				reg.Register(httpmock.REST("POST", "repos/OWNER/REPO/releases"), httpmock.StatusStringResponse(201, `{
+					"url": "https://api.github.com/releases/123",
+					"upload_url": "https://api.github.com/assets/upload",
+					"html_url": "https://github.com/OWNER/REPO/releases/tag/v1.2.3"
+				}`))
+			},
+			wantBody: "## Bug Fixes\n\n* Fix a crash (#10)\n\n## New Features\n\n* Add a widget (#11)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, stderr := iostreams.Test()
+			ios.SetStdoutTTY(false)
+			ios.SetStdinTTY(false)
+			ios.SetStderrTTY(false)
+			tt.opts.IO = ios
+			tt.opts.BodyProvided = true
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			rs, teardown := run.Stub()
+			defer teardown(t)
+			if tt.runStubs != nil {
+				tt.runStubs(rs)
+			}
+
+			err := createRun(tt.opts)
+			require.NoError(t, err)
+
+			var r *http.Request
+			for _, req := range reg.Requests {
+				if req.URL.Path == "/repos/OWNER/REPO/releases" {
+					r = req
+					break
+				}
+			}
+			if r == nil {
+				t.Fatalf("no http requests for creating a release found")
+			}
+			bb, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			var params map[string]interface{}
+			require.NoError(t, json.Unmarshal(bb, &params))
+			assert.Equal(t, tt.wantBody, params["body"])
+
+			assert.Equal(t, "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n", stdout.String())
+			assert.Equal(t, "", stderr.String())
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package create
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
+	"gopkg.in/yaml.v3"
+)
+
+type pullRequestForRelease struct {
+	Number int
+	Title  string
+	Labels []string
+}
+
+// releaseConfig mirrors the schema of .github/release.yml that GitHub uses to
+// group automatically generated release notes into sections.
+type releaseConfig struct {
+	Changelog struct {
+		Exclude struct {
+			Labels []string `yaml:"labels"`
+		} `yaml:"exclude"`
+		Categories []struct {
+			Title  string   `yaml:"title"`
+			Labels []string `yaml:"labels"`
+		} `yaml:"categories"`
+	} `yaml:"changelog"`
+}
+
+func commitSHAsForRange(refRange string) ([]string, error) {
+	cmd, err := git.GitCommand("log", "--first-parent", "--reverse", "--pretty=format:%H", refRange)
+	if err != nil {
+		return nil, err
+	}
+	b, err := run.PrepareCmd(cmd).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var shas []string
+	for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+		if line != "" {
+			shas = append(shas, line)
+		}
+	}
+	return shas, nil
+}
+
+func pullRequestsForRange(httpClient *http.Client, repo ghrepo.Interface, refRange string) ([]pullRequestForRelease, error) {
+	shas, err := commitSHAsForRange(refRange)
+	if err != nil {
+		return nil, err
+	}
+
+	var prs []pullRequestForRelease
+	seen := map[int]bool{}
+	for _, sha := range shas {
+		pr, err := pullRequestForCommit(httpClient, repo, sha)
+		if err != nil {
+			return nil, err
+		}
+		if pr == nil || seen[pr.Number] {
+			continue
+		}
+		seen[pr.Number] = true
+		prs = append(prs, *pr)
+	}
+
+	return prs, nil
+}
+
+func pullRequestForCommit(httpClient *http.Client, repo ghrepo.Interface, sha string) (*pullRequestForRelease, error) {
+	path := fmt.Sprintf("repos/%s/%s/commits/%s/pulls", repo.RepoOwner(), repo.RepoName(), sha)
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + path
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 {
+		return nil, nil
+	}
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	var results []struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		Labels []struct {
+			Name string `json:"name"`
+		} `json:"labels"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+
+	for _, r := range results {
+		if !r.Merged {
+			continue
+		}
+		labels := make([]string, len(r.Labels))
+		for i, l := range r.Labels {
+			labels[i] = l.Name
+		}
+		return &pullRequestForRelease{Number: r.Number, Title: r.Title, Labels: labels}, nil
+	}
+
+	return nil, nil
+}
+
+func loadReleaseConfig(httpClient *http.Client, repo ghrepo.Interface) (*releaseConfig, error) {
+	path := fmt.Sprintf("repos/%s/%s/contents/.github/release.yml", repo.RepoOwner(), repo.RepoName())
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + path
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 {
+		return nil, nil
+	}
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	var content struct {
+		Content string `json:"content"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&content); err != nil {
+		return nil, err
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(content.Content, "\n", ""))
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg releaseConfig
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("could not parse .github/release.yml: %w", err)
+	}
+
+	return &cfg, nil
+}
+
+func generatePRChangelog(prs []pullRequestForRelease, cfg *releaseConfig) string {
+	excluded := map[string]bool{}
+	var categories []struct {
+		Title  string
+		Labels []string
+	}
+	if cfg != nil {
+		for _, l := range cfg.Changelog.Exclude.Labels {
+			excluded[l] = true
+		}
+		for _, c := range cfg.Changelog.Categories {
+			categories = append(categories, struct {
+				Title  string
+				Labels []string
+			}{c.Title, c.Labels})
+		}
+	}
+	if len(categories) == 0 {
+		categories = []struct {
+			Title  string
+			Labels []string
+		}{{Title: "What's Changed", Labels: []string{"*"}}}
+	}
+
+	var sections []string
+	used := map[int]bool{}
+	for _, cat := range categories {
+		var lines []string
+		for _, pr := range prs {
+			if used[pr.Number] || hasAnyLabel(pr.Labels, excluded) {
+				continue
+			}
+			if !matchesCategory(pr.Labels, cat.Labels) {
+				continue
+			}
+			used[pr.Number] = true
+			lines = append(lines, fmt.Sprintf("* %s (#%d)", pr.Title, pr.Number))
+		}
+		if len(lines) > 0 {
+			sections = append(sections, fmt.Sprintf("## %s\n\n%s", cat.Title, strings.Join(lines, "\n")))
+		}
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+func matchesCategory(labels, categoryLabels []string) bool {
+	for _, cl := range categoryLabels {
+		if cl == "*" {
+			return true
+		}
+		for _, l := range labels {
+			if l == cl {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasAnyLabel(labels []string, set map[string]bool) bool {
+	for _, l := range labels {
+		if set[l] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_generatePRChangelog(t *testing.T) {
+	tests := []struct {
+		name string
+		prs  []pullRequestForRelease
+		cfg  *releaseConfig
+		want string
+	}{
+		{
+			name: "no config groups everything under What's Changed",
+			prs: []pullRequestForRelease{
+				{Number: 1, Title: "Fix a bug", Labels: []string{"bug"}},
+				{Number: 2, Title: "Add a feature", Labels: []string{"enhancement"}},
+			},
+			want: "## What's Changed\n\n* Fix a bug (#1)\n* Add a feature (#2)",
+		},
+		{
+			name: "config groups PRs into categories",
+			prs: []pullRequestForRelease{
+				{Number: 1, Title: "Fix a bug", Labels: []string{"bug"}},
+				{Number: 2, Title: "Add a feature", Labels: []string{"enhancement"}},
+				{Number: 3, Title: "Bump a dependency", Labels: []string{"dependencies"}},
+			},
+			cfg: &releaseConfig{Changelog: struct {
+				Exclude struct {
+					Labels []string `yaml:"labels"`
+				} `yaml:"exclude"`
+				Categories []struct {
+					Title  string   `yaml:"title"`
+					Labels []string `yaml:"labels"`
+				} `yaml:"categories"`
+			}{
+				Categories: []struct {
+					Title  string   `yaml:"title"`
+					Labels []string `yaml:"labels"`
+				}{
+					{Title: "Bug Fixes", Labels: []string{"bug"}},
+					{Title: "New Features", Labels: []string{"enhancement"}},
+				},
+			}},
+			want: "## Bug Fixes\n\n* Fix a bug (#1)\n\n## New Features\n\n* Add a feature (#2)",
+		},
+		{
+			name: "excluded labels drop the PR entirely",
+			prs: []pullRequestForRelease{
+				{Number: 1, Title: "Fix a bug", Labels: []string{"bug"}},
+				{Number: 2, Title: "Bump a dependency", Labels: []string{"dependencies"}},
+			},
+			cfg: &releaseConfig{Changelog: struct {
+				Exclude struct {
+					Labels []string `yaml:"labels"`
+				} `yaml:"exclude"`
+				Categories []struct {
+					Title  string   `yaml:"title"`
+					Labels []string `yaml:"labels"`
+				} `yaml:"categories"`
+			}{
+				Exclude: struct {
+					Labels []string `yaml:"labels"`
+				}{Labels: []string{"dependencies"}},
+				Categories: []struct {
+					Title  string   `yaml:"title"`
+					Labels []string `yaml:"labels"`
+				}{
+					{Title: "Everything", Labels: []string{"*"}},
+				},
+			}},
+			want: "## Everything\n\n* Fix a bug (#1)",
+		},
+		{
+			name: "PRs matching no category and no catch-all are dropped",
+			prs: []pullRequestForRelease{
+				{Number: 1, Title: "Fix a bug", Labels: []string{"bug"}},
+				{Number: 2, Title: "Uncategorized change", Labels: []string{"chore"}},
+			},
+			cfg: &releaseConfig{Changelog: struct {
+				Exclude struct {
+					Labels []string `yaml:"labels"`
+				} `yaml:"exclude"`
+				Categories []struct {
+					Title  string   `yaml:"title"`
+					Labels []string `yaml:"labels"`
+				} `yaml:"categories"`
+			}{
+				Categories: []struct {
+					Title  string   `yaml:"title"`
+					Labels []string `yaml:"labels"`
+				}{
+					{Title: "Bug Fixes", Labels: []string{"bug"}},
+				},
+			}},
+			want: "## Bug Fixes\n\n* Fix a bug (#1)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := generatePRChangelog(tt.prs, tt.cfg)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,14 +1,18 @@
 package download
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"mime"
 	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -32,6 +36,7 @@ type DownloadOptions struct {
 	Concurrency int
 
 	ArchiveType string
+	Digest      string
 }
 
 func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobra.Command {
@@ -61,6 +66,9 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 
 			# download the archive of the source code for a release
 			$ gh release download v1.2.3 --archive=zip
+
+			# download the asset with a specific checksum, verifying it after download
+			$ gh release download v1.2.3 --digest sha256:1234...
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -68,14 +76,14 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 			opts.BaseRepo = f.BaseRepo
 
 			if len(args) == 0 {
-				if len(opts.FilePatterns) == 0 && opts.ArchiveType == "" {
-					return cmdutil.FlagErrorf("`--pattern` or `--archive` is required when downloading the latest release")
+				if len(opts.FilePatterns) == 0 && opts.ArchiveType == "" && opts.Digest == "" {
+					return cmdutil.FlagErrorf("`--pattern`, `--archive`, or `--digest` is required when downloading the latest release")
 				}
 			} else {
 				opts.TagName = args[0]
 			}
 
-			// check archive type option validity
+			// check that exactly one selection method was used
 			if err := checkArchiveTypeOption(opts); err != nil {
 				return err
 			}
@@ -92,24 +100,22 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 	cmd.Flags().StringVarP(&opts.Destination, "dir", "D", ".", "The directory to download files into")
 	cmd.Flags().StringArrayVarP(&opts.FilePatterns, "pattern", "p", nil, "Download only assets that match a glob pattern")
 	cmd.Flags().StringVarP(&opts.ArchiveType, "archive", "A", "", "Download the source code archive in the specified `format` (zip or tar.gz)")
+	cmd.Flags().StringVar(&opts.Digest, "digest", "", "Download the asset whose checksum matches the provided `digest`, in sha256:<hex> form")
 
 	return cmd
 }
 
 func checkArchiveTypeOption(opts *DownloadOptions) error {
-	if len(opts.ArchiveType) == 0 {
-		return nil
-	}
-
 	if err := cmdutil.MutuallyExclusive(
-		"specify only one of '--pattern' or '--archive'",
-		true, // ArchiveType len > 0
+		"specify only one of `--pattern`, `--archive`, or `--digest`",
+		len(opts.ArchiveType) > 0,
 		len(opts.FilePatterns) > 0,
+		opts.Digest != "",
 	); err != nil {
 		return err
 	}
 
-	if opts.ArchiveType != "zip" && opts.ArchiveType != "tar.gz" {
+	if opts.ArchiveType != "" && opts.ArchiveType != "zip" && opts.ArchiveType != "tar.gz" {
 		return cmdutil.FlagErrorf("the value for `--archive` must be one of \"zip\" or \"tar.gz\"")
 	}
 	return nil
@@ -144,7 +150,8 @@ func downloadRun(opts *DownloadOptions) error {
 
 	var toDownload []shared.ReleaseAsset
 	isArchive := false
-	if opts.ArchiveType != "" {
+	switch {
+	case opts.ArchiveType != "":
 		var archiveURL = release.ZipballURL
 		if opts.ArchiveType == "tar.gz" {
 			archiveURL = release.TarballURL
@@ -152,7 +159,13 @@ func downloadRun(opts *DownloadOptions) error {
 		// create pseudo-Asset with no name and pointing to ZipBallURL or TarBallURL
 		toDownload = append(toDownload, shared.ReleaseAsset{APIURL: archiveURL})
 		isArchive = true
-	} else {
+	case opts.Digest != "":
+		asset, err := findAssetByDigest(release.Assets, opts.Digest)
+		if err != nil {
+			return err
+		}
+		toDownload = append(toDownload, *asset)
+	default:
 		for _, a := range release.Assets {
 			if len(opts.FilePatterns) > 0 && !matchAny(opts.FilePatterns, a.Name) {
 				continue
@@ -187,6 +200,17 @@ func matchAny(patterns []string, name string) bool {
 	return false
 }
 
+// findAssetByDigest locates the release asset whose recorded digest (as reported by the
+// GitHub API) matches the given "algorithm:hex" digest string.
+func findAssetByDigest(assets []shared.ReleaseAsset, digest string) (*shared.ReleaseAsset, error) {
+	for i := range assets {
+		if strings.EqualFold(assets[i].Digest, digest) {
+			return &assets[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no asset found with digest %q", digest)
+}
+
 func downloadAssets(httpClient *http.Client, toDownload []shared.ReleaseAsset, destDir string, numWorkers int, isArchive bool) error {
 	if numWorkers == 0 {
 		return errors.New("the number of concurrent workers needs to be greater than 0")
@@ -202,7 +226,7 @@ func downloadAssets(httpClient *http.Client, toDownload []shared.ReleaseAsset, d
 	for w := 1; w <= numWorkers; w++ {
 		go func() {
 			for a := range jobs {
-				results <- downloadAsset(httpClient, a.APIURL, destDir, a.Name, isArchive)
+				results <- downloadAsset(httpClient, a, destDir, isArchive)
 			}
 		}()
 	}
@@ -222,8 +246,8 @@ func downloadAssets(httpClient *http.Client, toDownload []shared.ReleaseAsset, d
 	return downloadError
 }
 
-func downloadAsset(httpClient *http.Client, assetURL, destinationDir string, fileName string, isArchive bool) error {
-	req, err := http.NewRequest("GET", assetURL, nil)
+func downloadAsset(httpClient *http.Client, asset shared.ReleaseAsset, destinationDir string, isArchive bool) error {
+	req, err := http.NewRequest("GET", asset.APIURL, nil)
 	if err != nil {
 		return err
 	}
@@ -254,6 +278,7 @@ func downloadAsset(httpClient *http.Client, assetURL, destinationDir string, fil
 		return api.HandleHTTPError(resp)
 	}
 
+	fileName := asset.Name
 	var destinationPath = filepath.Join(destinationDir, fileName)
 
 	if len(fileName) == 0 {
@@ -276,8 +301,37 @@ func downloadAsset(httpClient *http.Client, assetURL, destinationDir string, fil
 	}
 	defer f.Close()
 
-	_, err = io.Copy(f, resp.Body)
-	return err
+	var hasher hash.Hash
+	var wantHex string
+	if asset.Digest != "" {
+		parts := strings.SplitN(asset.Digest, ":", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return fmt.Errorf("asset %q has a malformed digest %q", asset.Name, asset.Digest)
+		}
+		if parts[0] != "sha256" {
+			return fmt.Errorf("asset %q has an unsupported digest algorithm %q", asset.Name, parts[0])
+		}
+		hasher = sha256.New()
+		wantHex = parts[1]
+	}
+
+	var w io.Writer = f
+	if hasher != nil {
+		w = io.MultiWriter(f, hasher)
+	}
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return err
+	}
+
+	if hasher != nil {
+		if gotHex := hex.EncodeToString(hasher.Sum(nil)); !strings.EqualFold(gotHex, wantHex) {
+			os.Remove(destinationPath)
+			return fmt.Errorf("asset %q failed digest verification: expected sha256:%s, got sha256:%s", asset.Name, wantHex, gotHex)
+		}
+	}
+
+	return nil
 }
 
 var codeloadLegacyRE = regexp.MustCompile(`^(/[^/]+/[^/]+/)legacy\.`)
@@ -286,7 +340,7 @@ var codeloadLegacyRE = regexp.MustCompile(`^(/[^/]+/[^/]+/)legacy\.`)
 // when you choose to download "Source code (zip/tar.gz)" from a tagged release on the web. The legacy URLs
 // look like this:
 //
-//   https://codeload.github.com/OWNER/REPO/legacy.zip/refs/tags/TAGNAME
+//	https://codeload.github.com/OWNER/REPO/legacy.zip/refs/tags/TAGNAME
 //
 // Removing the "legacy." part results in a valid Codeload URL for our desired archive format.
 func removeLegacyFromCodeloadPath(p string) string {
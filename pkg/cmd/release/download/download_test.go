@@ -2,6 +2,7 @@ package download
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -92,17 +93,35 @@ func Test_NewCmdDownload(t *testing.T) {
 				Concurrency:  5,
 			},
 		},
+		{
+			name:  "download by digest",
+			args:  "v1.2.3 --digest sha256:1234",
+			isTTY: true,
+			want: DownloadOptions{
+				TagName:      "v1.2.3",
+				FilePatterns: []string(nil),
+				Destination:  ".",
+				Digest:       "sha256:1234",
+				Concurrency:  5,
+			},
+		},
 		{
 			name:    "no arguments",
 			args:    "",
 			isTTY:   true,
-			wantErr: "`--pattern` or `--archive` is required when downloading the latest release",
+			wantErr: "`--pattern`, `--archive`, or `--digest` is required when downloading the latest release",
 		},
 		{
 			name:    "simultaneous pattern and archive arguments",
 			args:    "-p * -A zip",
 			isTTY:   true,
-			wantErr: "specify only one of '--pattern' or '--archive'",
+			wantErr: "specify only one of `--pattern`, `--archive`, or `--digest`",
+		},
+		{
+			name:    "simultaneous digest and pattern arguments",
+			args:    "v1.2.3 --digest sha256:1234 -p *",
+			isTTY:   true,
+			wantErr: "specify only one of `--pattern`, `--archive`, or `--digest`",
 		},
 		{
 			name:    "invalid archive argument",
@@ -155,13 +174,14 @@ func Test_NewCmdDownload(t *testing.T) {
 
 func Test_downloadRun(t *testing.T) {
 	tests := []struct {
-		name       string
-		isTTY      bool
-		opts       DownloadOptions
-		wantErr    string
-		wantStdout string
-		wantStderr string
-		wantFiles  []string
+		name                string
+		isTTY               bool
+		opts                DownloadOptions
+		includeCorruptAsset bool
+		wantErr             string
+		wantStdout          string
+		wantStderr          string
+		wantFiles           []string
 	}{
 		{
 			name:  "download all assets",
@@ -238,6 +258,48 @@ func Test_downloadRun(t *testing.T) {
 				"tmp/packages/tarball.tgz",
 			},
 		},
+		{
+			name:  "download asset by digest",
+			isTTY: true,
+			opts: DownloadOptions{
+				TagName:     "v1.2.3",
+				Digest:      "sha256:f8638b979b2f4f793ddb6dbd197e0ee25a7a6ea32b0ae22f5e3c5d119d839e75",
+				Destination: ".",
+				Concurrency: 2,
+			},
+			wantStdout: ``,
+			wantStderr: ``,
+			wantFiles: []string{
+				"linux.tgz",
+			},
+		},
+		{
+			name:  "digest does not match any asset",
+			isTTY: true,
+			opts: DownloadOptions{
+				TagName:     "v1.2.3",
+				Digest:      "sha256:0000000000000000000000000000000000000000000000000000000000000000",
+				Destination: ".",
+				Concurrency: 2,
+			},
+			wantStdout: ``,
+			wantStderr: ``,
+			wantErr:    `no asset found with digest "sha256:0000000000000000000000000000000000000000000000000000000000000000"`,
+		},
+		{
+			name:  "downloaded asset fails digest verification",
+			isTTY: true,
+			opts: DownloadOptions{
+				TagName:     "v1.2.3",
+				Digest:      "sha256:deadbeef",
+				Destination: ".",
+				Concurrency: 2,
+			},
+			includeCorruptAsset: true,
+			wantStdout:          ``,
+			wantStderr:          ``,
+			wantErr:             `asset "corrupt.bin" failed digest verification: expected sha256:deadbeef, got sha256:8cce10345c5e1de90d277b9869465f5972b828afbbbfd7ef08b1d835eedee993`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -250,18 +312,28 @@ func Test_downloadRun(t *testing.T) {
 			io.SetStderrTTY(tt.isTTY)
 
 			fakeHTTP := &httpmock.Registry{}
-			fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/tags/v1.2.3"), httpmock.StringResponse(`{
+			corruptAsset := ""
+			if tt.includeCorruptAsset {
+				corruptAsset = `,
+					{ "name": "corrupt.bin", "size": 78,
+					  "url": "https://api.github.com/assets/9012",
+					  "digest": "sha256:deadbeef" }`
+				fakeHTTP.Register(httpmock.REST("GET", "assets/9012"), httpmock.StringResponse(`9012`))
+			}
+
+			fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/tags/v1.2.3"), httpmock.StringResponse(fmt.Sprintf(`{
 				"assets": [
 					{ "name": "windows-32bit.zip", "size": 12,
 					  "url": "https://api.github.com/assets/1234" },
 					{ "name": "windows-64bit.zip", "size": 34,
 					  "url": "https://api.github.com/assets/3456" },
 					{ "name": "linux.tgz", "size": 56,
-					  "url": "https://api.github.com/assets/5678" }
+					  "url": "https://api.github.com/assets/5678",
+					  "digest": "sha256:f8638b979b2f4f793ddb6dbd197e0ee25a7a6ea32b0ae22f5e3c5d119d839e75" }%s
 				],
 				"tarball_url": "https://api.github.com/repos/OWNER/REPO/tarball/v1.2.3",
 				"zipball_url": "https://api.github.com/repos/OWNER/REPO/zipball/v1.2.3"
-			}`))
+			}`, corruptAsset)))
 			fakeHTTP.Register(httpmock.REST("GET", "assets/1234"), httpmock.StringResponse(`1234`))
 			fakeHTTP.Register(httpmock.REST("GET", "assets/3456"), httpmock.StringResponse(`3456`))
 			fakeHTTP.Register(httpmock.REST("GET", "assets/5678"), httpmock.StringResponse(`5678`))
@@ -7,6 +7,7 @@ import (
 	cmdDownload "github.com/cli/cli/v2/pkg/cmd/release/download"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/release/list"
 	cmdUpload "github.com/cli/cli/v2/pkg/cmd/release/upload"
+	cmdVerify "github.com/cli/cli/v2/pkg/cmd/release/verify"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/release/view"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -30,6 +31,7 @@ func NewCmdRelease(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdView.NewCmdView(f, nil))
 	cmd.AddCommand(cmdUpload.NewCmdUpload(f, nil))
+	cmd.AddCommand(cmdVerify.NewCmdVerify(f, nil))
 
 	return cmd
 }
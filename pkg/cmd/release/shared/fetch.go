@@ -66,6 +66,9 @@ type ReleaseAsset struct {
 	Size   int64
 	State  string
 	APIURL string `json:"url"`
+	// Digest is the asset's checksum as recorded by GitHub, in "algorithm:hex" form,
+	// e.g. "sha256:abcd...". Empty when GitHub has not computed one.
+	Digest string `json:"digest"`
 
 	CreatedAt          time.Time `json:"created_at"`
 	UpdatedAt          time.Time `json:"updated_at"`
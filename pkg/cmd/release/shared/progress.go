@@ -0,0 +1,79 @@
+package shared
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+// multiProgress renders one progress bar per concurrent upload worker, each
+// pinned to its own terminal row so that workers can report progress
+// without clobbering each other's output. It is a no-op when stderr isn't a
+// terminal, since redrawing progress bars makes no sense when piped.
+type multiProgress struct {
+	io   *iostreams.IOStreams
+	mu   sync.Mutex
+	rows int
+}
+
+func newMultiProgress(io *iostreams.IOStreams, rows int) *multiProgress {
+	mp := &multiProgress{io: io, rows: rows}
+	if mp.enabled() {
+		mp.mu.Lock()
+		for i := 0; i < rows; i++ {
+			fmt.Fprintln(io.ErrOut)
+		}
+		mp.mu.Unlock()
+	}
+	return mp
+}
+
+func (mp *multiProgress) enabled() bool {
+	return mp.io != nil && mp.io.ProgressIndicatorEnabled()
+}
+
+// update redraws the progress bar assigned to row, where row is in
+// [0, rows).
+func (mp *multiProgress) update(row int, label, status string, frac float64) {
+	if !mp.enabled() {
+		return
+	}
+
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	up := mp.rows - row
+	fmt.Fprintf(mp.io.ErrOut, "\x1b[%dA\r\x1b[2K%s\x1b[%dB\r", up, progressLine(label, status, frac), up)
+}
+
+func progressLine(label, status string, frac float64) string {
+	const width = 20
+	if frac < 0 {
+		frac = 0
+	} else if frac > 1 {
+		frac = 1
+	}
+	filled := int(frac * width)
+	bar := strings.Repeat("#", filled) + strings.Repeat("-", width-filled)
+	return fmt.Sprintf("%-30s [%s] %3.0f%% %s", label, bar, frac*100, status)
+}
+
+// progressReader wraps an io.Reader, invoking onRead with the cumulative
+// number of bytes read after every Read call.
+type progressReader struct {
+	reader io.Reader
+	read   int64
+	onRead func(read int64)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	if r.onRead != nil {
+		r.onRead(r.read)
+	}
+	return n, err
+}
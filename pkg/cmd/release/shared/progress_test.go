@@ -0,0 +1,55 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_progressLine(t *testing.T) {
+	tests := []struct {
+		name   string
+		frac   float64
+		wantIn string
+	}{
+		{name: "zero", frac: 0, wantIn: "  0%"},
+		{name: "half", frac: 0.5, wantIn: " 50%"},
+		{name: "complete", frac: 1, wantIn: "100%"},
+		{name: "clamps above one", frac: 42, wantIn: "100%"},
+		{name: "clamps below zero", frac: -1, wantIn: "  0%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line := progressLine("asset.zip", "uploading", tt.frac)
+			assert.Contains(t, line, tt.wantIn)
+			assert.Contains(t, line, "asset.zip")
+			assert.Contains(t, line, "uploading")
+		})
+	}
+}
+
+func Test_progressReader(t *testing.T) {
+	var reads []int64
+	r := &progressReader{
+		reader: strings.NewReader("hello world"),
+		onRead: func(read int64) {
+			reads = append(reads, read)
+		},
+	}
+
+	buf := make([]byte, 5)
+	n, err := r.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.Equal(t, []int64{5}, reads)
+}
+
+func Test_multiProgress_disabledWhenNotTTY(t *testing.T) {
+	io, _, _, stderr := iostreams.Test()
+	mp := newMultiProgress(io, 2)
+	mp.update(0, "asset.zip", "uploading", 0.5)
+	assert.Empty(t, stderr.String())
+}
@@ -3,6 +3,7 @@ package shared
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"mime"
@@ -14,6 +15,7 @@ import (
 	"time"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/iostreams"
 )
 
 type AssetForUpload struct {
@@ -91,7 +93,7 @@ func fileExt(fn string) string {
 	return path.Ext(fn)
 }
 
-func ConcurrentUpload(httpClient *http.Client, uploadURL string, numWorkers int, assets []*AssetForUpload) error {
+func ConcurrentUpload(io *iostreams.IOStreams, httpClient *http.Client, uploadURL string, numWorkers int, assets []*AssetForUpload) error {
 	if numWorkers == 0 {
 		return errors.New("the number of concurrent workers needs to be greater than 0")
 	}
@@ -103,10 +105,13 @@ func ConcurrentUpload(httpClient *http.Client, uploadURL string, numWorkers int,
 		numWorkers = len(assets)
 	}
 
-	for w := 1; w <= numWorkers; w++ {
+	bars := newMultiProgress(io, numWorkers)
+
+	for w := 0; w < numWorkers; w++ {
+		row := w
 		go func() {
 			for a := range jobs {
-				results <- uploadWithDelete(httpClient, uploadURL, a)
+				results <- uploadWithDelete(httpClient, uploadURL, a, bars, row)
 			}
 		}()
 	}
@@ -127,7 +132,7 @@ func ConcurrentUpload(httpClient *http.Client, uploadURL string, numWorkers int,
 
 const maxRetries = 3
 
-func uploadWithDelete(httpClient *http.Client, uploadURL string, a AssetForUpload) error {
+func uploadWithDelete(httpClient *http.Client, uploadURL string, a AssetForUpload, bars *multiProgress, row int) error {
 	if a.ExistingURL != "" {
 		err := deleteAsset(httpClient, a.ExistingURL)
 		if err != nil {
@@ -138,17 +143,18 @@ func uploadWithDelete(httpClient *http.Client, uploadURL string, a AssetForUploa
 	retries := 0
 	for {
 		var httpError api.HTTPError
-		_, err := uploadAsset(httpClient, uploadURL, a)
+		_, err := uploadAsset(httpClient, uploadURL, a, bars, row)
 		// retry upload several times upon receiving HTTP 5xx
-		if err == nil || !errors.As(err, &httpError) || httpError.StatusCode < 500 || retries < maxRetries {
+		if err == nil || !errors.As(err, &httpError) || httpError.StatusCode < 500 || retries >= maxRetries {
 			return err
 		}
 		retries++
+		bars.update(row, a.Name, fmt.Sprintf("retrying after server error (%d/%d)", retries, maxRetries), 0)
 		time.Sleep(time.Duration(retries) * time.Second)
 	}
 }
 
-func uploadAsset(httpClient *http.Client, uploadURL string, asset AssetForUpload) (*ReleaseAsset, error) {
+func uploadAsset(httpClient *http.Client, uploadURL string, asset AssetForUpload, bars *multiProgress, row int) (*ReleaseAsset, error) {
 	u, err := url.Parse(uploadURL)
 	if err != nil {
 		return nil, err
@@ -164,7 +170,14 @@ func uploadAsset(httpClient *http.Client, uploadURL string, asset AssetForUpload
 	}
 	defer f.Close()
 
-	req, err := http.NewRequest("POST", u.String(), f)
+	body := &progressReader{
+		reader: f,
+		onRead: func(read int64) {
+			bars.update(row, asset.Name, "uploading", float64(read)/float64(asset.Size))
+		},
+	}
+
+	req, err := http.NewRequest("POST", u.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -194,6 +207,8 @@ func uploadAsset(httpClient *http.Client, uploadURL string, asset AssetForUpload
 		return nil, err
 	}
 
+	bars.update(row, asset.Name, "done", 1)
+
 	return &newAsset, nil
 }
 
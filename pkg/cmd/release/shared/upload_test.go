@@ -1,6 +1,16 @@
 package shared
 
-import "testing"
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 func Test_typeForFilename(t *testing.T) {
 	tests := []struct {
@@ -67,3 +77,29 @@ func Test_typeForFilename(t *testing.T) {
 		})
 	}
 }
+
+func Test_uploadWithDelete_retriesOnServerError(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer srv.Close()
+
+	asset := AssetForUpload{
+		Name: "asset.zip",
+		Size: 4,
+		Open: func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewBufferString("data")), nil
+		},
+	}
+
+	err := uploadWithDelete(http.DefaultClient, srv.URL, asset, newMultiProgress(nil, 1), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+}
@@ -55,8 +55,6 @@ func NewCmdUpload(f *cmdutil.Factory, runF func(*UploadOptions) error) *cobra.Co
 				return err
 			}
 
-			opts.Concurrency = 5
-
 			if runF != nil {
 				return runF(opts)
 			}
@@ -65,6 +63,7 @@ func NewCmdUpload(f *cmdutil.Factory, runF func(*UploadOptions) error) *cobra.Co
 	}
 
 	cmd.Flags().BoolVar(&opts.OverwriteExisting, "clobber", false, "Overwrite existing assets of the same name")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of simultaneous asset uploads")
 
 	return cmd
 }
@@ -105,9 +104,7 @@ func uploadRun(opts *UploadOptions) error {
 		return fmt.Errorf("asset under the same name already exists: %v", existingNames)
 	}
 
-	opts.IO.StartProgressIndicator()
-	err = shared.ConcurrentUpload(httpClient, uploadURL, opts.Concurrency, opts.Assets)
-	opts.IO.StopProgressIndicator()
+	err = shared.ConcurrentUpload(opts.IO, httpClient, uploadURL, opts.Concurrency, opts.Assets)
 	if err != nil {
 		return err
 	}
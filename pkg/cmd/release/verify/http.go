@@ -0,0 +1,95 @@
+package verify
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// checksumAsset downloads the asset and computes its sha256 digest, comparing it against the
+// digest GitHub recorded for it at upload time. It does not persist the downloaded bytes.
+func checksumAsset(httpClient *http.Client, apiURL, wantDigest string) (string, bool, error) {
+	parts := strings.SplitN(wantDigest, ":", 2)
+	if len(parts) != 2 || parts[0] != "sha256" || parts[1] == "" {
+		return "", false, fmt.Errorf("unsupported digest %q", wantDigest)
+	}
+
+	req, err := http.NewRequest("GET", apiURL, nil)
+	if err != nil {
+		return "", false, err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return "", false, api.HandleHTTPError(resp)
+	}
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, resp.Body); err != nil {
+		return "", false, err
+	}
+
+	gotDigest := "sha256:" + hex.EncodeToString(hasher.Sum(nil))
+	return gotDigest, strings.EqualFold(gotDigest, wantDigest), nil
+}
+
+// Attestation is the subset of a GitHub artifact attestation that verify can report on without
+// performing full sigstore bundle validation.
+type Attestation struct {
+	BundleURL string `json:"bundle_url"`
+}
+
+// fetchAttestations looks up the artifact attestations GitHub has recorded for the given
+// subject digest. A 404 means the host does not support attestations or none have been
+// generated for this artifact; that is reported as zero attestations rather than an error.
+func fetchAttestations(httpClient *http.Client, repo ghrepo.Interface, digest string) ([]Attestation, error) {
+	path := fmt.Sprintf("repos/%s/%s/attestations/%s", repo.RepoOwner(), repo.RepoName(), digest)
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + path
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 {
+		return nil, nil
+	}
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Attestations []Attestation `json:"attestations"`
+	}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Attestations, nil
+}
@@ -0,0 +1,168 @@
+package verify
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type VerifyOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	TagName   string
+	AssetName string
+}
+
+type VerifyResult struct {
+	Asset            string `json:"asset"`
+	Digest           string `json:"digest"`
+	ChecksumVerified bool   `json:"checksumVerified"`
+	Attestations     int    `json:"attestations"`
+}
+
+func (r *VerifyResult) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "asset":
+			data[f] = r.Asset
+		case "digest":
+			data[f] = r.Digest
+		case "checksumVerified":
+			data[f] = r.ChecksumVerified
+		case "attestations":
+			data[f] = r.Attestations
+		}
+	}
+	return data
+}
+
+var verifyFields = []string{
+	"asset",
+	"digest",
+	"checksumVerified",
+	"attestations",
+}
+
+func NewCmdVerify(f *cmdutil.Factory, runF func(*VerifyOptions) error) *cobra.Command {
+	opts := &VerifyOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "verify <tag>",
+		Short: "Verify the checksum and attestations of a release asset",
+		Long: heredoc.Doc(`
+			Verify that a release asset has not been tampered with since it was uploaded.
+
+			This downloads the named asset and compares its checksum against the digest
+			GitHub recorded at upload time, and reports how many artifact attestations
+			GitHub has for it. It does not perform sigstore bundle signature verification;
+			use 'gh attestation verify' for that once available.
+		`),
+		Example: heredoc.Doc(`
+			$ gh release verify v1.2.3 --asset myapp-linux-amd64.tar.gz
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.TagName = args[0]
+
+			if opts.AssetName == "" {
+				return cmdutil.FlagErrorf("`--asset` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return verifyRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.AssetName, "asset", "", "Name of the release asset to verify")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, verifyFields)
+
+	return cmd
+}
+
+func verifyRun(opts *VerifyOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	release, err := shared.FetchRelease(httpClient, baseRepo, opts.TagName)
+	if err != nil {
+		return err
+	}
+
+	var asset *shared.ReleaseAsset
+	for i := range release.Assets {
+		if release.Assets[i].Name == opts.AssetName {
+			asset = &release.Assets[i]
+			break
+		}
+	}
+	if asset == nil {
+		return fmt.Errorf("no asset named %q found in release %q", opts.AssetName, opts.TagName)
+	}
+	if asset.Digest == "" {
+		return fmt.Errorf("GitHub has not recorded a checksum for asset %q; it cannot be verified", asset.Name)
+	}
+
+	opts.IO.StartProgressIndicator()
+	gotDigest, verified, err := checksumAsset(httpClient, asset.APIURL, asset.Digest)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	attestations, err := fetchAttestations(httpClient, baseRepo, gotDigest)
+	if err != nil {
+		return err
+	}
+
+	result := &VerifyResult{
+		Asset:            asset.Name,
+		Digest:           gotDigest,
+		ChecksumVerified: verified,
+		Attestations:     len(attestations),
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, result)
+	}
+
+	cs := opts.IO.ColorScheme()
+	if verified {
+		fmt.Fprintf(opts.IO.Out, "%s Checksum verified for %s (%s)\n", cs.SuccessIcon(), asset.Name, gotDigest)
+	} else {
+		fmt.Fprintf(opts.IO.Out, "%s Checksum mismatch for %s: expected %s, got %s\n", cs.FailureIcon(), asset.Name, asset.Digest, gotDigest)
+	}
+
+	if len(attestations) > 0 {
+		fmt.Fprintf(opts.IO.Out, "Found %d attestation(s) for this asset\n", len(attestations))
+	} else {
+		fmt.Fprintln(opts.IO.Out, "No attestations found for this asset")
+	}
+
+	if !verified {
+		return cmdutil.SilentError
+	}
+	return nil
+}
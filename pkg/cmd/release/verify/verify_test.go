@@ -0,0 +1,92 @@
+package verify
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_verifyRun(t *testing.T) {
+	tests := []struct {
+		name         string
+		assetName    string
+		wantErr      string
+		wantVerified bool
+		wantStdout   string
+	}{
+		{
+			name:         "checksum matches",
+			assetName:    "linux.tgz",
+			wantVerified: true,
+			wantStdout:   "✓ Checksum verified for linux.tgz (sha256:f8638b979b2f4f793ddb6dbd197e0ee25a7a6ea32b0ae22f5e3c5d119d839e75)\nFound 1 attestation(s) for this asset\n",
+		},
+		{
+			name:      "checksum mismatch",
+			assetName: "corrupt.bin",
+			wantErr:   "SilentError",
+		},
+		{
+			name:      "asset not found",
+			assetName: "does-not-exist",
+			wantErr:   `no asset named "does-not-exist" found in release "v1.2.3"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(true)
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+
+			reg.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/tags/v1.2.3"), httpmock.StringResponse(`{
+				"assets": [
+					{ "name": "linux.tgz", "size": 56, "url": "https://api.github.com/assets/5678",
+					  "digest": "sha256:f8638b979b2f4f793ddb6dbd197e0ee25a7a6ea32b0ae22f5e3c5d119d839e75" },
+					{ "name": "corrupt.bin", "size": 78, "url": "https://api.github.com/assets/9012",
+					  "digest": "sha256:deadbeef" }
+				]
+			}`))
+
+			if tt.assetName == "linux.tgz" {
+				reg.Register(httpmock.REST("GET", "assets/5678"), httpmock.StringResponse(`5678`))
+				reg.Register(httpmock.REST("GET", "repos/OWNER/REPO/attestations/sha256:f8638b979b2f4f793ddb6dbd197e0ee25a7a6ea32b0ae22f5e3c5d119d839e75"),
+					httpmock.StringResponse(`{"attestations": [{"bundle_url": "https://example.com/bundle"}]}`))
+			} else if tt.assetName == "corrupt.bin" {
+				reg.Register(httpmock.REST("GET", "assets/9012"), httpmock.StringResponse(`9012`))
+				reg.Register(httpmock.REST("GET", "repos/OWNER/REPO/attestations/sha256:8cce10345c5e1de90d277b9869465f5972b828afbbbfd7ef08b1d835eedee993"),
+					httpmock.StringResponse(`{"attestations": []}`))
+			}
+
+			err := verifyRun(&VerifyOptions{
+				IO: io,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+				TagName:   "v1.2.3",
+				AssetName: tt.assetName,
+			})
+
+			if tt.wantErr != "" {
+				if tt.wantErr == "SilentError" {
+					assert.Equal(t, cmdutil.SilentError, err)
+				} else {
+					assert.EqualError(t, err, tt.wantErr)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
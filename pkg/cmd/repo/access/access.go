@@ -0,0 +1,20 @@
+package access
+
+import (
+	cmdInvitation "github.com/cli/cli/v2/pkg/cmd/repo/access/invitation"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdAccess(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access <command>",
+		Short: "Manage collaborator access to a repository",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdInvitation.NewCmdInvitation(f))
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package cancel
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CancelOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	InvitationID string
+}
+
+func NewCmdCancel(f *cmdutil.Factory, runF func(*CancelOptions) error) *cobra.Command {
+	opts := &CancelOptions{
+		HTTPClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "cancel <invitation-id>",
+		Short: "Cancel a pending outgoing collaborator invitation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.InvitationID = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return cancelRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func cancelRun(opts *CancelOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if err := cancelInvitation(httpClient, repo, opts.InvitationID); err != nil {
+		return err
+	}
+
+	if !opts.IO.IsStdoutTTY() {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	_, err = fmt.Fprintf(opts.IO.Out, "%s Canceled invitation to %s\n", cs.SuccessIconWithColor(cs.Red), cs.Bold(ghrepo.FullName(repo)))
+	return err
+}
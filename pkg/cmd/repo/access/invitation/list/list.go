@@ -0,0 +1,90 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List pending outgoing collaborator invitations for a repository",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	invitations, err := repoInvitations(httpClient, repo)
+	if err != nil {
+		return fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	if len(invitations) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "No pending invitations for %s\n", ghrepo.FullName(repo))
+		}
+		return cmdutil.SilentError
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	now := time.Now()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, invitation := range invitations {
+		tp.AddField(fmt.Sprintf("%d", invitation.ID), nil, cs.Bold)
+		tp.AddField(invitation.Invitee.Login, nil, nil)
+		tp.AddField(invitation.Permission, nil, nil)
+		createdAt := invitation.CreatedAt.Format(time.RFC3339)
+		if tp.IsTTY() {
+			createdAt = utils.FuzzyAgoAbbr(now, invitation.CreatedAt)
+		}
+		tp.AddField(createdAt, nil, cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -24,6 +24,7 @@ type ArchiveOptions struct {
 	Confirmed  bool
 	IO         *iostreams.IOStreams
 	RepoArg    string
+	Exporter   cmdutil.Exporter
 }
 
 func NewCmdArchive(f *cmdutil.Factory, runF func(*ArchiveOptions) error) *cobra.Command {
@@ -39,14 +40,19 @@ func NewCmdArchive(f *cmdutil.Factory, runF func(*ArchiveOptions) error) *cobra.
 		Short: "Archive a repository",
 		Long: heredoc.Doc(`Archive a GitHub repository.
 
-With no argument, archives the current repository.`),
+With no argument, archives the current repository.
+
+Before archiving, this reports the open pull requests, active webhooks,
+scheduled workflows, and environments that will be frozen by the archive.
+Pass --json to print that report without archiving, so a decommissioning
+runbook can inspect it before deciding to proceed.`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.RepoArg = args[0]
 			}
 
-			if !opts.Confirmed && !opts.IO.CanPrompt() {
+			if opts.Exporter == nil && !opts.Confirmed && !opts.IO.CanPrompt() {
 				return cmdutil.FlagErrorf("--confirm required when not running interactively")
 			}
 			if runF != nil {
@@ -57,6 +63,7 @@ With no argument, archives the current repository.`),
 	}
 
 	cmd.Flags().BoolVarP(&opts.Confirmed, "confirm", "y", false, "Skip the confirmation prompt")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, DependentResourcesFields)
 	return cmd
 }
 
@@ -113,6 +120,17 @@ func archiveRun(opts *ArchiveOptions) error {
 		return nil
 	}
 
+	report, err := FetchDependentResources(httpClient, toArchive)
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, report)
+	}
+
+	printDependentResources(opts.IO, cs, fullName, report)
+
 	if !opts.Confirmed {
 		p := &survey.Confirm{
 			Message: fmt.Sprintf("Archive %s?", fullName),
@@ -141,3 +159,23 @@ func archiveRun(opts *ArchiveOptions) error {
 
 	return nil
 }
+
+func printDependentResources(io *iostreams.IOStreams, cs *iostreams.ColorScheme, fullName string, report *DependentResources) {
+	if report.Empty() || !io.IsStdoutTTY() {
+		return
+	}
+
+	fmt.Fprintf(io.Out, "%s Archiving %s will freeze:\n", cs.WarningIcon(), fullName)
+	if report.OpenPullRequests > 0 {
+		fmt.Fprintf(io.Out, "  - %d open pull request(s)\n", report.OpenPullRequests)
+	}
+	if report.ActiveWebhooks > 0 {
+		fmt.Fprintf(io.Out, "  - %d active webhook(s)\n", report.ActiveWebhooks)
+	}
+	if len(report.ScheduledWorkflows) > 0 {
+		fmt.Fprintf(io.Out, "  - scheduled workflow(s): %s\n", strings.Join(report.ScheduledWorkflows, ", "))
+	}
+	if len(report.Environments) > 0 {
+		fmt.Fprintf(io.Out, "  - environment(s): %s\n", strings.Join(report.Environments, ", "))
+	}
+}
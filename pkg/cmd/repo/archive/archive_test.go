@@ -2,10 +2,12 @@ package archive
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
@@ -15,6 +17,23 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+type testExporter struct {
+	fields []string
+}
+
+func (e *testExporter) Fields() []string {
+	return e.fields
+}
+
+func (e *testExporter) Write(io *iostreams.IOStreams, data interface{}) error {
+	report := data.(*DependentResources)
+	fmt.Fprintf(io.Out, "openPullRequests: %d\n", report.OpenPullRequests)
+	fmt.Fprintf(io.Out, "activeWebhooks: %d\n", report.ActiveWebhooks)
+	fmt.Fprintf(io.Out, "scheduledWorkflows: %v\n", report.ScheduledWorkflows)
+	fmt.Fprintf(io.Out, "environments: %v\n", report.Environments)
+	return nil
+}
+
 func TestNewCmdArchive(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -65,6 +84,21 @@ func TestNewCmdArchive(t *testing.T) {
 	}
 }
 
+func stubDependentResources(reg *httpmock.Registry) {
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryOpenPullRequestCount\b`),
+		httpmock.StringResponse(`{ "data": { "repository": { "pullRequests": { "totalCount": 0 } } } }`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/hooks"),
+		httpmock.StringResponse(`[]`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+		httpmock.StringResponse(`{ "workflows": [] }`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/environments"),
+		httpmock.StringResponse(`{ "environments": [] }`))
+}
+
 func Test_ArchiveRun(t *testing.T) {
 	queryResponse := `{ "data": { "repository": { "id": "THE-ID","isArchived": %s} } }`
 	tests := []struct {
@@ -89,6 +123,7 @@ func Test_ArchiveRun(t *testing.T) {
 				reg.Register(
 					httpmock.GraphQL(`query RepositoryInfo\b`),
 					httpmock.StringResponse(fmt.Sprintf(queryResponse, "false")))
+				stubDependentResources(reg)
 				reg.Register(
 					httpmock.GraphQL(`mutation ArchiveRepository\b`),
 					httpmock.StringResponse(`{}`))
@@ -107,6 +142,7 @@ func Test_ArchiveRun(t *testing.T) {
 				reg.Register(
 					httpmock.GraphQL(`query RepositoryInfo\b`),
 					httpmock.StringResponse(fmt.Sprintf(queryResponse, "false")))
+				stubDependentResources(reg)
 				reg.Register(
 					httpmock.GraphQL(`mutation ArchiveRepository\b`),
 					httpmock.StringResponse(`{}`))
@@ -122,6 +158,75 @@ func Test_ArchiveRun(t *testing.T) {
 					httpmock.StringResponse(fmt.Sprintf(queryResponse, "true")))
 			},
 		},
+		{
+			name: "warns about dependent resources before confirming",
+			opts: ArchiveOptions{RepoArg: "OWNER/REPO"},
+			askStubs: func(q *prompt.AskStubber) {
+				//nolint:staticcheck // SA1019: q.StubOne is deprecated: use StubPrompt
+				q.StubOne(true)
+			},
+			isTTY: true,
+			wantStdout: heredoc.Doc(`
+				! Archiving OWNER/REPO will freeze:
+				  - 2 open pull request(s)
+				  - scheduled workflow(s): ci
+				✓ Archived repository OWNER/REPO
+			`),
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(fmt.Sprintf(queryResponse, "false")))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryOpenPullRequestCount\b`),
+					httpmock.StringResponse(`{ "data": { "repository": { "pullRequests": { "totalCount": 2 } } } }`))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/hooks"),
+					httpmock.StringResponse(`[]`))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.StringResponse(`{ "workflows": [ { "id": 1, "name": "ci", "path": ".github/workflows/ci.yml", "state": "active" } ] }`))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/workflows/ci.yml"),
+					httpmock.StringResponse(fmt.Sprintf(`{ "content": %q }`, base64.StdEncoding.EncodeToString([]byte("on:\n  schedule:\n    - cron: '0 0 * * *'\n")))))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/environments"),
+					httpmock.StringResponse(`{ "environments": [] }`))
+				reg.Register(
+					httpmock.GraphQL(`mutation ArchiveRepository\b`),
+					httpmock.StringResponse(`{}`))
+			},
+		},
+		{
+			name: "json flag reports dependents without archiving",
+			opts: ArchiveOptions{
+				RepoArg:  "OWNER/REPO",
+				Exporter: &testExporter{fields: DependentResourcesFields},
+			},
+			isTTY: true,
+			wantStdout: heredoc.Doc(`
+				openPullRequests: 2
+				activeWebhooks: 0
+				scheduledWorkflows: []
+				environments: []
+			`),
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(fmt.Sprintf(queryResponse, "false")))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryOpenPullRequestCount\b`),
+					httpmock.StringResponse(`{ "data": { "repository": { "pullRequests": { "totalCount": 2 } } } }`))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/hooks"),
+					httpmock.StringResponse(`[]`))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.StringResponse(`{ "workflows": [] }`))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/environments"),
+					httpmock.StringResponse(`{ "environments": [] }`))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,195 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	workflowShared "github.com/cli/cli/v2/pkg/cmd/workflow/shared"
+)
+
+// DependentResources reports the GitHub resources that will be frozen when a repository is
+// archived: open pull requests can no longer be merged, webhooks stop firing, scheduled
+// workflow runs stop triggering, and environments become inaccessible to new deployments.
+type DependentResources struct {
+	OpenPullRequests   int      `json:"openPullRequests"`
+	ActiveWebhooks     int      `json:"activeWebhooks"`
+	ScheduledWorkflows []string `json:"scheduledWorkflows"`
+	Environments       []string `json:"environments"`
+}
+
+func (d *DependentResources) Empty() bool {
+	return d.OpenPullRequests == 0 && d.ActiveWebhooks == 0 && len(d.ScheduledWorkflows) == 0 && len(d.Environments) == 0
+}
+
+func (d *DependentResources) ExportData(fields []string) map[string]interface{} {
+	v := reflect.ValueOf(d).Elem()
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		sf := v.FieldByNameFunc(func(s string) bool {
+			return strings.EqualFold(f, s)
+		})
+		data[f] = sf.Interface()
+	}
+	return data
+}
+
+var DependentResourcesFields = []string{
+	"openPullRequests",
+	"activeWebhooks",
+	"scheduledWorkflows",
+	"environments",
+}
+
+// FetchDependentResources reports the resources that will be frozen by archiving repo, so that
+// callers can warn about them or gate a decommissioning runbook on the result.
+func FetchDependentResources(client *http.Client, repo ghrepo.Interface) (*DependentResources, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	report := &DependentResources{}
+
+	var err error
+	report.OpenPullRequests, err = openPullRequestCount(apiClient, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	report.ActiveWebhooks, err = activeWebhookCount(apiClient, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	report.ScheduledWorkflows, err = scheduledWorkflowNames(apiClient, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	report.Environments, err = environmentNames(apiClient, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func openPullRequestCount(client *api.Client, repo ghrepo.Interface) (int, error) {
+	var query struct {
+		Repository struct {
+			PullRequests struct {
+				TotalCount int
+			} `graphql:"pullRequests(states: OPEN)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"name":  repo.RepoName(),
+	}
+
+	if err := client.GraphQL(repo.RepoHost(), openPullRequestCountQuery, variables, &query); err != nil {
+		return 0, err
+	}
+
+	return query.Repository.PullRequests.TotalCount, nil
+}
+
+const openPullRequestCountQuery = `
+query RepositoryOpenPullRequestCount($owner: String!, $name: String!) {
+	repository(owner: $owner, name: $name) {
+		pullRequests(states: OPEN) {
+			totalCount
+		}
+	}
+}`
+
+type repoHook struct {
+	Active bool
+}
+
+func activeWebhookCount(client *api.Client, repo ghrepo.Interface) (int, error) {
+	var hooks []repoHook
+	path := fmt.Sprintf("repos/%s/hooks", ghrepo.FullName(repo))
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &hooks); err != nil {
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && (httpErr.StatusCode == 403 || httpErr.StatusCode == 404) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	count := 0
+	for _, h := range hooks {
+		if h.Active {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// scheduledWorkflowNames returns the names of active workflows whose definition declares a
+// `schedule` trigger. The workflow list API doesn't expose triggers, so each active workflow's
+// file is fetched and scanned for the trigger key.
+func scheduledWorkflowNames(client *api.Client, repo ghrepo.Interface) ([]string, error) {
+	workflows, err := workflowShared.GetWorkflows(client, repo, 0)
+	if err != nil {
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && (httpErr.StatusCode == 403 || httpErr.StatusCode == 404) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var scheduled []string
+	for _, w := range workflows {
+		if w.Disabled() {
+			continue
+		}
+		content, err := workflowShared.GetWorkflowContent(client, repo, w, "")
+		if err != nil {
+			return nil, err
+		}
+		if hasScheduleTrigger(content) {
+			scheduled = append(scheduled, w.Name)
+		}
+	}
+
+	return scheduled, nil
+}
+
+func hasScheduleTrigger(content []byte) bool {
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "schedule:") {
+			return true
+		}
+	}
+	return false
+}
+
+type repoEnvironment struct {
+	Name string
+}
+
+type repoEnvironmentsResponse struct {
+	Environments []repoEnvironment
+}
+
+func environmentNames(client *api.Client, repo ghrepo.Interface) ([]string, error) {
+	var resp repoEnvironmentsResponse
+	path := fmt.Sprintf("repos/%s/environments", ghrepo.FullName(repo))
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &resp); err != nil {
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && (httpErr.StatusCode == 403 || httpErr.StatusCode == 404) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range resp.Environments {
+		names = append(names, e.Name)
+	}
+	return names, nil
+}
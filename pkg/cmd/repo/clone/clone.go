@@ -21,8 +21,11 @@ type CloneOptions struct {
 	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
 
-	GitArgs    []string
-	Repository string
+	GitArgs     []string
+	Repository  string
+	Depth       int
+	Filter      string
+	SparsePaths []string
 }
 
 func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Command {
@@ -58,6 +61,10 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 		},
 	}
 
+	cmd.Flags().IntVar(&opts.Depth, "depth", 0, "Create a shallow clone with a history truncated to the specified number of commits")
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Use partial clone feature; see 'git help clone' for details")
+	cmd.Flags().StringArrayVar(&opts.SparsePaths, "sparse", nil, "Fetch only the specified directories")
+
 	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
 		if err == pflag.ErrHelp {
 			return err
@@ -149,11 +156,19 @@ func cloneRun(opts *CloneOptions) error {
 		canonicalCloneURL = strings.TrimSuffix(canonicalCloneURL, ".git") + ".wiki.git"
 	}
 
-	cloneDir, err := git.RunClone(canonicalCloneURL, opts.GitArgs)
+	gitArgs := append(opts.GitArgs, git.PartialCloneArgs(opts.Depth, opts.Filter, len(opts.SparsePaths) > 0)...)
+
+	cloneDir, err := git.RunClone(canonicalCloneURL, gitArgs)
 	if err != nil {
 		return err
 	}
 
+	if len(opts.SparsePaths) > 0 {
+		if err := git.SparseCheckoutSet(cloneDir, opts.SparsePaths); err != nil {
+			return err
+		}
+	}
+
 	// If the repo is a fork, add the parent as an upstream
 	if canonicalRepo.Parent != nil {
 		protocol, err := cfg.GetOrDefault(canonicalRepo.Parent.RepoHost(), "git_protocol")
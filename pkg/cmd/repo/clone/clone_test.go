@@ -54,8 +54,19 @@ func TestNewCmdClone(t *testing.T) {
 		},
 		{
 			name:    "unknown argument",
-			args:    "OWNER/REPO --depth 1",
-			wantErr: "unknown flag: --depth\nSeparate git clone flags with '--'.",
+			args:    "OWNER/REPO --recurse-submodules",
+			wantErr: "unknown flag: --recurse-submodules\nSeparate git clone flags with '--'.",
+		},
+		{
+			name: "depth, filter and sparse flags",
+			args: "OWNER/REPO --depth 1 --filter blob:none --sparse path1 --sparse path2",
+			wantOpts: CloneOptions{
+				Repository:  "OWNER/REPO",
+				GitArgs:     []string{},
+				Depth:       1,
+				Filter:      "blob:none",
+				SparsePaths: []string{"path1", "path2"},
+			},
 		},
 	}
 	for _, tt := range testCases {
@@ -90,6 +101,9 @@ func TestNewCmdClone(t *testing.T) {
 
 			assert.Equal(t, tt.wantOpts.Repository, opts.Repository)
 			assert.Equal(t, tt.wantOpts.GitArgs, opts.GitArgs)
+			assert.Equal(t, tt.wantOpts.Depth, opts.Depth)
+			assert.Equal(t, tt.wantOpts.Filter, opts.Filter)
+			assert.Equal(t, tt.wantOpts.SparsePaths, opts.SparsePaths)
 		})
 	}
 }
@@ -130,9 +144,10 @@ func runCloneCommand(httpClient *http.Client, cli string) (*test.CmdOut, error)
 
 func Test_RepoClone(t *testing.T) {
 	tests := []struct {
-		name string
-		args string
-		want string
+		name       string
+		args       string
+		want       string
+		extraStubs func(*run.CommandStubber)
 	}{
 		{
 			name: "shorthand",
@@ -154,6 +169,19 @@ func Test_RepoClone(t *testing.T) {
 			args: "OWNER/REPO target_directory -- -o upstream --depth 1",
 			want: "git clone -o upstream --depth 1 https://github.com/OWNER/REPO.git target_directory",
 		},
+		{
+			name: "depth and filter flags",
+			args: "OWNER/REPO --depth 1 --filter blob:none",
+			want: "git clone --depth=1 --filter=blob:none https://github.com/OWNER/REPO.git",
+		},
+		{
+			name: "sparse flag",
+			args: "OWNER/REPO --sparse path1 --sparse path2",
+			want: "git clone --sparse https://github.com/OWNER/REPO.git",
+			extraStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git -C REPO sparse-checkout set path1 path2`, 0, "")
+			},
+		},
 		{
 			name: "HTTPS URL",
 			args: "https://github.com/OWNER/REPO",
@@ -203,6 +231,9 @@ func Test_RepoClone(t *testing.T) {
 			cs.Register(`git clone`, 0, "", func(s []string) {
 				assert.Equal(t, tt.want, strings.Join(s, " "))
 			})
+			if tt.extraStubs != nil {
+				tt.extraStubs(cs)
+			}
 
 			output, err := runCloneCommand(httpClient, tt.args)
 			if err != nil {
@@ -0,0 +1,170 @@
+package check
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CheckOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	File  string
+	Ref   string
+	Paths []string
+}
+
+// defaultLocations are the paths GitHub recognizes a CODEOWNERS file at, in lookup order.
+var defaultLocations = []string{"CODEOWNERS", ".github/CODEOWNERS", "docs/CODEOWNERS"}
+
+func NewCmdCheck(f *cmdutil.Factory, runF func(*CheckOptions) error) *cobra.Command {
+	opts := &CheckOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "check [<path>...]",
+		Short: "Validate a repository's CODEOWNERS file",
+		Long: heredoc.Doc(`
+			Validate a CODEOWNERS file the same way GitHub does when you edit it on the
+			web: check its syntax, and confirm that every user or team it references
+			exists and has access to the repository.
+
+			With no arguments, looks for a CODEOWNERS file at its usual locations
+			(CODEOWNERS, .github/CODEOWNERS, or docs/CODEOWNERS) relative to the
+			repository root. Use "--file" to check a file at a different path.
+
+			Pass one or more paths to also report which of them have no matching
+			owner.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo codeowners check
+			$ gh repo codeowners check --file .github/CODEOWNERS
+			$ gh repo codeowners check cmd/gh/main.go pkg/cmd/repo/repo.go
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Paths = args
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return checkRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.File, "file", "", "Path to the CODEOWNERS file to validate")
+	cmd.Flags().StringVar(&opts.Ref, "branch", "", "Validate the CODEOWNERS file as it exists on `branch` (default: the default branch)")
+
+	return cmd
+}
+
+func checkRun(opts *CheckOptions) error {
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	remoteErrors, err := fetchCodeownersErrors(httpClient, repo, opts.Ref)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	if len(remoteErrors) == 0 {
+		fmt.Fprintf(out, "%s No errors found in CODEOWNERS\n", cs.SuccessIcon())
+	} else {
+		errorWord := "error"
+		if len(remoteErrors) != 1 {
+			errorWord = "errors"
+		}
+		fmt.Fprintf(out, "%s %d %s found in CODEOWNERS:\n\n", cs.FailureIcon(), len(remoteErrors), errorWord)
+		for _, e := range remoteErrors {
+			fmt.Fprintf(out, "%s:%d:%d: %s\n", e.Path, e.Line, e.Column, e.Message)
+			if e.Suggestion != "" {
+				fmt.Fprintf(out, "  %s\n", cs.Gray(e.Suggestion))
+			}
+		}
+	}
+
+	if len(opts.Paths) > 0 {
+		unowned, err := unownedPaths(opts, repo)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(out)
+		if len(unowned) == 0 {
+			fmt.Fprintf(out, "%s All given paths have an owner\n", cs.SuccessIcon())
+		} else {
+			fmt.Fprintf(out, "%s Unowned paths:\n\n", cs.FailureIcon())
+			for _, p := range unowned {
+				fmt.Fprintf(out, "  %s\n", p)
+			}
+		}
+	}
+
+	if len(remoteErrors) > 0 {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+// unownedPaths reports which of opts.Paths have no owner according to the local CODEOWNERS file.
+func unownedPaths(opts *CheckOptions, repo ghrepo.Interface) ([]string, error) {
+	file, err := locateCodeowners(opts.File)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	rules := parseCodeowners(file)
+
+	var unowned []string
+	for _, p := range opts.Paths {
+		owners := ownersFor(rules, filepath.ToSlash(p))
+		if len(owners) == 0 {
+			unowned = append(unowned, p)
+		}
+	}
+	return unowned, nil
+}
+
+func locateCodeowners(explicit string) (*os.File, error) {
+	if explicit != "" {
+		return os.Open(explicit)
+	}
+
+	dir, err := git.ToplevelDir()
+	if err != nil {
+		dir = "."
+	}
+
+	var lastErr error
+	for _, loc := range defaultLocations {
+		f, err := os.Open(filepath.Join(dir, loc))
+		if err == nil {
+			return f, nil
+		}
+		lastErr = err
+	}
+	return nil, fmt.Errorf("could not find a CODEOWNERS file: %w", lastErr)
+}
@@ -0,0 +1,96 @@
+package check
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRun_noErrors(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/codeowners/errors"),
+		httpmock.StringResponse(`{ "errors": [] }`))
+
+	err := checkRun(&CheckOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Contains(t, stdout.String(), "No errors found in CODEOWNERS")
+}
+
+func TestCheckRun_withErrors(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/codeowners/errors"),
+		httpmock.StringResponse(`{ "errors": [
+			{ "line": 3, "column": 5, "kind": "Invalid owner", "source": "*  @ghost", "message": "@ghost does not exist", "path": "CODEOWNERS" }
+		] }`))
+
+	err := checkRun(&CheckOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Contains(t, stdout.String(), "1 error found in CODEOWNERS")
+	assert.Contains(t, stdout.String(), "@ghost does not exist")
+}
+
+func TestCheckRun_unownedPaths(t *testing.T) {
+	dir := t.TempDir()
+	codeownersPath := filepath.Join(dir, "CODEOWNERS")
+	assert.NoError(t, os.WriteFile(codeownersPath, []byte("*.go @monalisa\n"), 0600))
+
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/codeowners/errors"),
+		httpmock.StringResponse(`{ "errors": [] }`))
+
+	err := checkRun(&CheckOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		File:  codeownersPath,
+		Paths: []string{"main.go", "README.md"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Contains(t, stdout.String(), "Unowned paths:")
+	assert.Contains(t, stdout.String(), "README.md")
+	assert.NotContains(t, stdout.String(), "  main.go\n")
+}
@@ -0,0 +1,42 @@
+package check
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// codeownersError is a single syntax or reference problem reported by GitHub, such as a
+// malformed pattern or an owner that doesn't exist or lacks access to the repository.
+type codeownersError struct {
+	Line       int    `json:"line"`
+	Column     int    `json:"column"`
+	Kind       string `json:"kind"`
+	Source     string `json:"source"`
+	Suggestion string `json:"suggestion"`
+	Message    string `json:"message"`
+	Path       string `json:"path"`
+}
+
+// fetchCodeownersErrors asks GitHub to validate the CODEOWNERS file at ref (the repository's
+// default branch when empty), the same validation surfaced in the web editor.
+func fetchCodeownersErrors(httpClient *http.Client, repo ghrepo.Interface, ref string) ([]codeownersError, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	path := fmt.Sprintf("repos/%s/%s/codeowners/errors", repo.RepoOwner(), repo.RepoName())
+	if ref != "" {
+		path += "?" + url.Values{"ref": {ref}}.Encode()
+	}
+
+	var result struct {
+		Errors []codeownersError `json:"errors"`
+	}
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Errors, nil
+}
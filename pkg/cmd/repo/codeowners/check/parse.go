@@ -0,0 +1,109 @@
+package check
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// rule is a single, parsed CODEOWNERS pattern and its owners, in file order.
+type rule struct {
+	line    int
+	pattern string
+	owners  []string
+}
+
+// parseCodeowners reads a CODEOWNERS file, skipping blank lines and comments.
+func parseCodeowners(r io.Reader) []rule {
+	var rules []rule
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		rules = append(rules, rule{
+			line:    lineNum,
+			pattern: fields[0],
+			owners:  fields[1:],
+		})
+	}
+	return rules
+}
+
+// ownersFor returns the owners of path, per the last matching rule, which is how CODEOWNERS
+// resolves conflicts (like .gitignore). An empty, non-nil slice means path matched a rule with
+// no owners listed (i.e. explicitly unowned); nil means no rule matched at all.
+func ownersFor(rules []rule, path string) []string {
+	var owners []string
+	matched := false
+	for _, r := range rules {
+		if matchPattern(r.pattern, path) {
+			matched = true
+			owners = r.owners
+		}
+	}
+	if !matched {
+		return nil
+	}
+	if owners == nil {
+		owners = []string{}
+	}
+	return owners
+}
+
+// matchPattern reports whether path matches a CODEOWNERS pattern, using the same gitignore-style
+// semantics GitHub documents: a leading "/" anchors the pattern to the repository root, a
+// trailing "/" matches a directory and everything under it, and "*" matches any run of
+// characters within a single path segment.
+func matchPattern(pattern, path string) bool {
+	path = strings.TrimPrefix(path, "/")
+	anchored := strings.HasPrefix(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+
+	if anchored || strings.Contains(pattern, "/") {
+		if globMatch(pattern, path) {
+			return true
+		}
+		return dirOnly && strings.HasPrefix(path, pattern+"/")
+	}
+
+	// Unanchored, slash-free pattern: matches the named file or directory at any depth. Since
+	// every path segment (including intermediate directories) is checked, a directory-only
+	// pattern naturally covers everything beneath a matching directory too.
+	for _, seg := range strings.Split(path, "/") {
+		if globMatch(pattern, seg) {
+			return true
+		}
+	}
+	return false
+}
+
+// globMatch is a small "*"-only glob matcher; CODEOWNERS patterns don't support "?" or "[]".
+func globMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(s, part)
+		if idx == -1 {
+			return false
+		}
+		s = s[idx+len(part):]
+	}
+
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
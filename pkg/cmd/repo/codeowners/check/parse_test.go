@@ -0,0 +1,45 @@
+package check
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*.go", "main.go", true},
+		{"*.go", "pkg/cmd/main.go", true},
+		{"/docs/*", "docs/README.md", true},
+		{"/docs/*", "pkg/docs/README.md", false},
+		{"docs/", "docs/guide/intro.md", true},
+		{"/build", "build", true},
+		{"/build", "pkg/build", false},
+		{"README.md", "README.md", true},
+		{"README.md", "pkg/README.md", true},
+	}
+
+	for _, tt := range tests {
+		got := matchPattern(tt.pattern, tt.path)
+		assert.Equalf(t, tt.want, got, "matchPattern(%q, %q)", tt.pattern, tt.path)
+	}
+}
+
+func TestOwnersFor(t *testing.T) {
+	rules := parseCodeowners(strings.NewReader(`
+# comment
+*       @org/everyone
+/docs/  @org/docs-team
+*.go    @monalisa
+`))
+
+	assert.Equal(t, []string{"@monalisa"}, ownersFor(rules, "main.go"))
+	assert.Equal(t, []string{"@org/docs-team"}, ownersFor(rules, "docs/README.md"))
+	assert.Equal(t, []string{"@org/everyone"}, ownersFor(rules, "Makefile"))
+	assert.Nil(t, ownersFor(nil, "main.go"))
+}
@@ -0,0 +1,20 @@
+package codeowners
+
+import (
+	cmdCheck "github.com/cli/cli/v2/pkg/cmd/repo/codeowners/check"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCodeowners(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "codeowners <command>",
+		Short: "Work with CODEOWNERS files",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdCheck.NewCmdCheck(f, nil))
+
+	return cmd
+}
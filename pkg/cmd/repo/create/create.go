@@ -45,6 +45,7 @@ type CreateOptions struct {
 	DisableIssues     bool
 	DisableWiki       bool
 	Interactive       bool
+	TemplateVars      []string
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -81,6 +82,9 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			# create a remote repository from the current directory
 			gh repo create my-project --private --source=. --remote=upstream
+
+			# create a repository from a template, substituting placeholders declared by the template
+			gh repo create my-project --public --clone --template owner/template --var NAME=my-project
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -143,6 +147,15 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("the `--template` option is not supported with `--homepage`, `--team`, `--disable-issues`, or `--disable-wiki`")
 			}
 
+			if len(opts.TemplateVars) > 0 {
+				if opts.Template == "" {
+					return cmdutil.FlagErrorf("the `--var` flag can only be used with `--template`")
+				}
+				if !opts.Clone {
+					return cmdutil.FlagErrorf("the `--var` flag can only be used with `--clone`")
+				}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -154,6 +167,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Homepage, "homepage", "h", "", "Repository home page `URL`")
 	cmd.Flags().StringVarP(&opts.Team, "team", "t", "", "The `name` of the organization team to be granted access")
 	cmd.Flags().StringVarP(&opts.Template, "template", "p", "", "Make the new repository based on a template `repository`")
+	cmd.Flags().StringArrayVar(&opts.TemplateVars, "var", nil, "Set a template placeholder to `NAME=value` (requires --template and --clone)")
 	cmd.Flags().BoolVar(&opts.Public, "public", false, "Make the new repository public")
 	cmd.Flags().BoolVar(&opts.Private, "private", false, "Make the new repository private")
 	cmd.Flags().BoolVar(&opts.Internal, "internal", false, "Make the new repository internal")
@@ -381,6 +395,20 @@ func createFromScratch(opts *CreateOptions) error {
 		} else if _, err := git.RunClone(remoteURL, []string{}); err != nil {
 			return err
 		}
+
+		if opts.Template != "" && len(opts.TemplateVars) > 0 {
+			vars := map[string]string{}
+			for _, v := range opts.TemplateVars {
+				name, value, err := parseVar(v)
+				if err != nil {
+					return err
+				}
+				vars[name] = value
+			}
+			if err := scaffoldTemplate(opts.IO, repo.RepoName(), vars); err != nil {
+				return fmt.Errorf("error scaffolding template: %w", err)
+			}
+		}
 	}
 
 	return nil
@@ -101,6 +101,29 @@ func TestNewCmdCreate(t *testing.T) {
 			wantsErr: true,
 			errMsg:   "the `--source` option is not supported with `--clone`, `--template`, `--license`, or `--gitignore`",
 		},
+		{
+			name: "template with var and clone",
+			cli:  "NEWREPO --public --template mytemplate --clone --var NAME=NEWREPO",
+			wantsOpts: CreateOptions{
+				Name:         "NEWREPO",
+				Public:       true,
+				Clone:        true,
+				Template:     "mytemplate",
+				TemplateVars: []string{"NAME=NEWREPO"},
+			},
+		},
+		{
+			name:     "var without template",
+			cli:      "NEWREPO --public --clone --var NAME=NEWREPO",
+			wantsErr: true,
+			errMsg:   "the `--var` flag can only be used with `--template`",
+		},
+		{
+			name:     "var without clone",
+			cli:      "NEWREPO --public --template mytemplate --var NAME=NEWREPO",
+			wantsErr: true,
+			errMsg:   "the `--var` flag can only be used with `--clone`",
+		},
 	}
 
 	for _, tt := range tests {
@@ -149,6 +172,8 @@ func TestNewCmdCreate(t *testing.T) {
 			assert.Equal(t, tt.wantsOpts.Internal, opts.Internal)
 			assert.Equal(t, tt.wantsOpts.Private, opts.Private)
 			assert.Equal(t, tt.wantsOpts.Clone, opts.Clone)
+			assert.Equal(t, tt.wantsOpts.Template, opts.Template)
+			assert.Equal(t, tt.wantsOpts.TemplateVars, opts.TemplateVars)
 		})
 	}
 }
@@ -0,0 +1,152 @@
+package create
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"gopkg.in/yaml.v3"
+)
+
+// templateManifestFile is the path, relative to the root of a template
+// repository, of the optional manifest describing how to scaffold it.
+const templateManifestFile = ".github/repo-template.yml"
+
+// templateManifest describes the variables a template repository accepts and
+// an optional script to run once those variables have been substituted.
+type templateManifest struct {
+	Vars []string `yaml:"vars"`
+	Init string   `yaml:"init"`
+}
+
+// parseVar splits a "NAME=value" argument as passed to `--var`.
+func parseVar(s string) (string, string, error) {
+	idx := strings.IndexRune(s, '=')
+	if idx == -1 {
+		return "", "", fmt.Errorf("%q is not in the form of NAME=value", s)
+	}
+	return s[:idx], s[idx+1:], nil
+}
+
+// scaffoldTemplate substitutes the given variables into the freshly cloned
+// repository at dir according to its manifest, then runs the manifest's init
+// script, if any. It is a no-op if the template carries no manifest.
+func scaffoldTemplate(io *iostreams.IOStreams, dir string, vars map[string]string) error {
+	manifestPath := filepath.Join(dir, templateManifestFile)
+	manifestBytes, err := os.ReadFile(manifestPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var manifest templateManifest
+	if err := yaml.Unmarshal(manifestBytes, &manifest); err != nil {
+		return fmt.Errorf("error parsing %s: %w", templateManifestFile, err)
+	}
+
+	var missing []string
+	for _, name := range manifest.Vars {
+		if _, ok := vars[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("missing required `--var`: %s", strings.Join(missing, ", "))
+	}
+
+	if err := substituteVars(dir, vars); err != nil {
+		return err
+	}
+
+	if err := os.Remove(manifestPath); err != nil {
+		return err
+	}
+
+	if manifest.Init == "" {
+		return nil
+	}
+
+	// manifest.Init is resolved relative to dir rather than passed to
+	// exec.Command as-is: a bare filename like "install.sh" (the natural way
+	// to write it in the manifest) would otherwise be looked up on $PATH
+	// instead of the cloned repository, since initCmd.Dir only affects the
+	// working directory the script runs in, not how its own path is found.
+	initPath := manifest.Init
+	if !filepath.IsAbs(initPath) {
+		initPath = filepath.Join(dir, initPath)
+	}
+	initCmd := exec.Command(initPath)
+	initCmd.Dir = dir
+	initCmd.Stdout = io.Out
+	initCmd.Stderr = io.ErrOut
+	if err := run.PrepareCmd(initCmd).Run(); err != nil {
+		return fmt.Errorf("error running %s: %w", manifest.Init, err)
+	}
+
+	return os.Remove(filepath.Join(dir, manifest.Init))
+}
+
+// substituteVars walks dir, replacing "{{NAME}}" placeholders in file
+// contents and file names with the corresponding value from vars.
+func substituteVars(dir string, vars map[string]string) error {
+	type file struct {
+		path string
+		mode os.FileMode
+	}
+	var files []file
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		files = append(files, file{path, info.Mode()})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		content, err := os.ReadFile(f.path)
+		if err != nil {
+			return err
+		}
+		replaced := replacePlaceholders(string(content), vars)
+		if replaced != string(content) {
+			if err := os.WriteFile(f.path, []byte(replaced), f.mode); err != nil {
+				return err
+			}
+		}
+
+		newPath := replacePlaceholders(f.path, vars)
+		if newPath != f.path {
+			if err := os.MkdirAll(filepath.Dir(newPath), 0755); err != nil {
+				return err
+			}
+			if err := os.Rename(f.path, newPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func replacePlaceholders(s string, vars map[string]string) string {
+	for name, value := range vars {
+		s = strings.ReplaceAll(s, fmt.Sprintf("{{%s}}", name), value)
+	}
+	return s
+}
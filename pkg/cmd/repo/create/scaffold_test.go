@@ -0,0 +1,112 @@
+package create
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseVar(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantName  string
+		wantValue string
+		wantErr   bool
+	}{
+		{name: "valid", input: "NAME=my-project", wantName: "NAME", wantValue: "my-project"},
+		{name: "empty value", input: "NAME=", wantName: "NAME", wantValue: ""},
+		{name: "no equals sign", input: "NAME", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, value, err := parseVar(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantValue, value)
+		})
+	}
+}
+
+func Test_scaffoldTemplate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".github"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".github", "repo-template.yml"), []byte(`
+vars:
+  - NAME
+init: ./init.sh
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "{{NAME}}.go"), []byte("package {{NAME}}\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "init.sh"), []byte("#!/bin/sh\ntouch initialized\n"), 0700))
+
+	io, _, _, _ := iostreams.Test()
+	err := scaffoldTemplate(io, dir, map[string]string{"NAME": "widget"})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(dir, "widget.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package widget\n", string(content))
+
+	_, err = os.Stat(filepath.Join(dir, ".github", "repo-template.yml"))
+	assert.True(t, os.IsNotExist(err), "expected manifest to be removed")
+
+	_, err = os.Stat(filepath.Join(dir, "init.sh"))
+	assert.True(t, os.IsNotExist(err), "expected init script to be removed")
+
+	_, err = os.Stat(filepath.Join(dir, "initialized"))
+	assert.NoError(t, err, "expected init script to have run")
+}
+
+func Test_scaffoldTemplate_bareInitFilename(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".github"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".github", "repo-template.yml"), []byte(`
+vars:
+  - NAME
+init: install.sh
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "{{NAME}}.go"), []byte("package {{NAME}}\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "install.sh"), []byte("#!/bin/sh\ntouch initialized\n"), 0700))
+
+	io, _, _, _ := iostreams.Test()
+	err := scaffoldTemplate(io, dir, map[string]string{"NAME": "widget"})
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(dir, "initialized"))
+	assert.NoError(t, err, "expected init script to have run from the cloned repo, not $PATH")
+}
+
+func Test_scaffoldTemplate_noManifest(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("hello"), 0600))
+
+	io, _, _, _ := iostreams.Test()
+	err := scaffoldTemplate(io, dir, map[string]string{"NAME": "widget"})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(dir, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+}
+
+func Test_scaffoldTemplate_missingVar(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".github"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".github", "repo-template.yml"), []byte(`
+vars:
+  - NAME
+`), 0600))
+
+	io, _, _, _ := iostreams.Test()
+	err := scaffoldTemplate(io, dir, map[string]string{})
+	assert.EqualError(t, err, "missing required `--var`: NAME")
+}
@@ -0,0 +1,168 @@
+package dependencydiff
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type Dependency struct {
+	ChangeType      string `json:"change_type"`
+	Ecosystem       string `json:"ecosystem"`
+	Name            string `json:"name"`
+	Version         string `json:"version"`
+	PackageURL      string `json:"package_url"`
+	License         string `json:"license"`
+	Vulnerabilities []struct {
+		Severity        string `json:"severity"`
+		AdvisoryGHSAID  string `json:"advisory_ghsa_id"`
+		AdvisorySummary string `json:"advisory_summary"`
+		AdvisoryURL     string `json:"advisory_url"`
+	} `json:"vulnerabilities"`
+}
+
+var jsonFields = []string{
+	"changeType", "ecosystem", "name", "version", "packageUrl", "license", "vulnerabilities",
+}
+
+func (d *Dependency) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "changeType":
+			data[f] = d.ChangeType
+		case "ecosystem":
+			data[f] = d.Ecosystem
+		case "name":
+			data[f] = d.Name
+		case "version":
+			data[f] = d.Version
+		case "packageUrl":
+			data[f] = d.PackageURL
+		case "license":
+			data[f] = d.License
+		case "vulnerabilities":
+			data[f] = d.Vulnerabilities
+		}
+	}
+	return data
+}
+
+type DependencyDiffOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	Base string
+	Head string
+}
+
+func NewCmdDependencyDiff(f *cmdutil.Factory, runF func(*DependencyDiffOptions) error) *cobra.Command {
+	opts := &DependencyDiffOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "dependency-diff",
+		Short: "Compare dependencies between two refs",
+		Long: heredoc.Doc(`
+			Show dependencies added, removed, and updated between two refs, along with any
+			vulnerable packages newly introduced by the change.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo dependency-diff --base v1.0.0 --head main
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Base == "" || opts.Head == "" {
+				return cmdutil.FlagErrorf("--base and --head are required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return dependencyDiffRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Base, "base", "", "The base ref to compare against")
+	cmd.Flags().StringVar(&opts.Head, "head", "", "The head ref to compare")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, jsonFields)
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	return cmd
+}
+
+func dependencyDiffRun(opts *DependencyDiffOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	deps, err := fetchDependencyDiff(httpClient, baseRepo, opts.Base, opts.Head)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, deps)
+	}
+
+	if len(deps) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "No dependency changes found between %s and %s\n", opts.Base, opts.Head)
+		return cmdutil.SilentError
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, dep := range deps {
+		change := dep.ChangeType
+		switch change {
+		case "added":
+			tp.AddField(change, nil, cs.Green)
+		case "removed":
+			tp.AddField(change, nil, cs.Red)
+		default:
+			tp.AddField(change, nil, nil)
+		}
+		tp.AddField(dep.Ecosystem, nil, nil)
+		tp.AddField(dep.Name, nil, cs.Bold)
+		tp.AddField(dep.Version, nil, nil)
+		if len(dep.Vulnerabilities) > 0 {
+			tp.AddField(fmt.Sprintf("%d vulnerability(s)", len(dep.Vulnerabilities)), nil, cs.Red)
+		} else {
+			tp.AddField("", nil, nil)
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func fetchDependencyDiff(httpClient *http.Client, repo ghrepo.Interface, base, head string) ([]Dependency, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	path := fmt.Sprintf("repos/%s/%s/dependency-graph/compare/%s...%s", repo.RepoOwner(), repo.RepoName(), base, head)
+
+	var deps []Dependency
+	err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &deps)
+	return deps, err
+}
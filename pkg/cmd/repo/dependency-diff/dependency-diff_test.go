@@ -0,0 +1,128 @@
+package dependencydiff
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdDependencyDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  DependencyDiffOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "base and head flags",
+			input:  "--base v1.0.0 --head main",
+			output: DependencyDiffOptions{Base: "v1.0.0", Head: "main"},
+		},
+		{
+			name:    "missing head",
+			input:   "--base v1.0.0",
+			wantErr: true,
+			errMsg:  "--base and --head are required",
+		},
+		{
+			name:    "missing base and head",
+			input:   "",
+			wantErr: true,
+			errMsg:  "--base and --head are required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *DependencyDiffOptions
+			cmd := NewCmdDependencyDiff(f, func(opts *DependencyDiffOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Base, gotOpts.Base)
+			assert.Equal(t, tt.output.Head, gotOpts.Head)
+		})
+	}
+}
+
+func Test_dependencyDiffRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/dependency-graph/compare/v1.0.0...main"),
+		httpmock.StringResponse(`[
+			{"change_type":"added","ecosystem":"npm","name":"left-pad","version":"1.3.0","vulnerabilities":[]},
+			{"change_type":"removed","ecosystem":"npm","name":"request","version":"2.88.0","vulnerabilities":[
+				{"severity":"high","advisory_ghsa_id":"GHSA-abcd","advisory_summary":"ReDoS","advisory_url":"https://example.com"}
+			]}
+		]`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	err := dependencyDiffRun(&DependencyDiffOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Base: "v1.0.0",
+		Head: "main",
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "left-pad")
+	assert.Contains(t, stdout.String(), "request")
+	assert.Contains(t, stdout.String(), "1 vulnerability(s)")
+}
+
+func Test_dependencyDiffRun_noChanges(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/dependency-graph/compare/v1.0.0...main"),
+		httpmock.StringResponse(`[]`))
+
+	io, _, _, stderr := iostreams.Test()
+
+	err := dependencyDiffRun(&DependencyDiffOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Base: "v1.0.0",
+		Head: "main",
+	})
+	assert.Equal(t, cmdutil.SilentError, err)
+	assert.Equal(t, "No dependency changes found between v1.0.0 and main\n", stderr.String())
+}
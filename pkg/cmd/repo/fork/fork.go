@@ -42,6 +42,9 @@ type ForkOptions struct {
 	Organization string
 	ForkName     string
 	Rename       bool
+	Depth        int
+	Filter       string
+	SparsePaths  []string
 }
 
 // TODO warn about useless flags (--remote, --remote-name) when running from outside a repository
@@ -120,6 +123,9 @@ func NewCmdFork(f *cmdutil.Factory, runF func(*ForkOptions) error) *cobra.Comman
 	cmd.Flags().StringVar(&opts.RemoteName, "remote-name", defaultRemoteName, "Specify the name for the new remote")
 	cmd.Flags().StringVar(&opts.Organization, "org", "", "Create the fork in an organization")
 	cmd.Flags().StringVar(&opts.ForkName, "fork-name", "", "Rename the forked repository")
+	cmd.Flags().IntVar(&opts.Depth, "depth", 0, "Create a shallow clone with a history truncated to the specified number of commits")
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Use partial clone feature; see 'git help clone' for details")
+	cmd.Flags().StringArrayVar(&opts.SparsePaths, "sparse", nil, "Fetch only the specified directories when cloning")
 
 	return cmd
 }
@@ -311,11 +317,18 @@ func forkRun(opts *ForkOptions) error {
 		}
 		if cloneDesired {
 			forkedRepoURL := ghrepo.FormatRemoteURL(forkedRepo, protocol)
-			cloneDir, err := git.RunClone(forkedRepoURL, opts.GitArgs)
+			gitArgs := append(opts.GitArgs, git.PartialCloneArgs(opts.Depth, opts.Filter, len(opts.SparsePaths) > 0)...)
+			cloneDir, err := git.RunClone(forkedRepoURL, gitArgs)
 			if err != nil {
 				return fmt.Errorf("failed to clone fork: %w", err)
 			}
 
+			if len(opts.SparsePaths) > 0 {
+				if err := git.SparseCheckoutSet(cloneDir, opts.SparsePaths); err != nil {
+					return err
+				}
+			}
+
 			upstreamURL := ghrepo.FormatRemoteURL(repoToFork, protocol)
 			err = git.AddUpstreamRemote(upstreamURL, cloneDir, []string{})
 			if err != nil {
@@ -128,9 +128,9 @@ func TestNewCmdFork(t *testing.T) {
 		},
 		{
 			name:    "git flags in wrong place",
-			cli:     "--depth 1 OWNER/REPO",
+			cli:     "--recurse-submodules OWNER/REPO",
 			wantErr: true,
-			errMsg:  "unknown flag: --depth\nSeparate git clone flags with `--`.",
+			errMsg:  "unknown flag: --recurse-submodules\nSeparate git clone flags with `--`.",
 		},
 		{
 			name: "with fork name",
@@ -142,6 +142,18 @@ func TestNewCmdFork(t *testing.T) {
 				Rename:     false,
 			},
 		},
+		{
+			name: "depth, filter and sparse flags",
+			cli:  "--clone --depth 1 --filter blob:none --sparse path1",
+			wants: ForkOptions{
+				RemoteName:  "origin",
+				Rename:      true,
+				Clone:       true,
+				Depth:       1,
+				Filter:      "blob:none",
+				SparsePaths: []string{"path1"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -182,6 +194,9 @@ func TestNewCmdFork(t *testing.T) {
 			assert.Equal(t, tt.wants.PromptClone, gotOpts.PromptClone)
 			assert.Equal(t, tt.wants.Organization, gotOpts.Organization)
 			assert.Equal(t, tt.wants.GitArgs, gotOpts.GitArgs)
+			assert.Equal(t, tt.wants.Depth, gotOpts.Depth)
+			assert.Equal(t, tt.wants.Filter, gotOpts.Filter)
+			assert.Equal(t, tt.wants.SparsePaths, gotOpts.SparsePaths)
 		})
 	}
 }
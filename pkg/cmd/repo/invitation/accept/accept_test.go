@@ -0,0 +1,34 @@
+package accept
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_acceptRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("PATCH", "user/repository_invitations/1234"),
+		httpmock.StringResponse(`{}`))
+
+	err := acceptRun(&AcceptOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:       func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		InvitationID: "1234",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "✓ Accepted repository invitation\n", stdout.String())
+}
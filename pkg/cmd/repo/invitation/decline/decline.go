@@ -0,0 +1,72 @@
+package decline
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DeclineOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	InvitationID string
+}
+
+func NewCmdDecline(f *cmdutil.Factory, runF func(*DeclineOptions) error) *cobra.Command {
+	opts := &DeclineOptions{
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "decline <invitation-id>",
+		Short: "Decline a pending repository invitation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.InvitationID = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return declineRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func declineRun(opts *DeclineOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	if err := declineInvitation(httpClient, host, opts.InvitationID); err != nil {
+		return err
+	}
+
+	if !opts.IO.IsStdoutTTY() {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	_, err = fmt.Fprintf(opts.IO.Out, "%s Declined repository invitation\n", cs.SuccessIconWithColor(cs.Red))
+	return err
+}
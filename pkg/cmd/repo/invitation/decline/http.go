@@ -0,0 +1,30 @@
+package decline
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+func declineInvitation(httpClient *http.Client, host, invitationID string) error {
+	path := fmt.Sprintf("user/repository_invitations/%s", invitationID)
+	url := ghinstance.RESTPrefix(host) + path
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package invitation
+
+import (
+	cmdAccept "github.com/cli/cli/v2/pkg/cmd/repo/invitation/accept"
+	cmdDecline "github.com/cli/cli/v2/pkg/cmd/repo/invitation/decline"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/repo/invitation/list"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdInvitation(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invitation <command>",
+		Short: "Manage repository invitations for the authenticated user",
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdAccept.NewCmdAccept(f, nil))
+	cmd.AddCommand(cmdDecline.NewCmdDecline(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,94 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List pending repository invitations for the authenticated user",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	invitations, err := userInvitations(httpClient, host)
+	if err != nil {
+		return fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	if len(invitations) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintln(opts.IO.ErrOut, "No pending repository invitations")
+		}
+		return cmdutil.SilentError
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	now := time.Now()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, invitation := range invitations {
+		tp.AddField(invitation.Repository.FullName, nil, cs.Bold)
+		tp.AddField(invitation.Permission, nil, nil)
+		tp.AddField(invitation.Inviter.Login, nil, nil)
+		createdAt := invitation.CreatedAt.Format(time.RFC3339)
+		if tp.IsTTY() {
+			createdAt = utils.FuzzyAgoAbbr(now, invitation.CreatedAt)
+		}
+		tp.AddField(createdAt, nil, cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
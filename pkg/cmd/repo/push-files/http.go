@@ -0,0 +1,148 @@
+package pushfiles
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type gitRef struct {
+	Object struct {
+		SHA string `json:"sha"`
+	} `json:"object"`
+}
+
+// branchSHA returns the commit SHA that a branch currently points at, or "" if the branch
+// does not exist yet.
+func branchSHA(client *api.Client, repo ghrepo.Interface, branch string) (string, error) {
+	var ref gitRef
+	path := fmt.Sprintf("repos/%s/%s/git/ref/heads/%s", repo.RepoOwner(), repo.RepoName(), branch)
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &ref)
+	var httpErr api.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return ref.Object.SHA, nil
+}
+
+type gitCommit struct {
+	Tree struct {
+		SHA string `json:"sha"`
+	} `json:"tree"`
+}
+
+func commitTreeSHA(client *api.Client, repo ghrepo.Interface, commitSHA string) (string, error) {
+	var commit gitCommit
+	path := fmt.Sprintf("repos/%s/%s/git/commits/%s", repo.RepoOwner(), repo.RepoName(), commitSHA)
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &commit)
+	return commit.Tree.SHA, err
+}
+
+func createBlob(client *api.Client, repo ghrepo.Interface, content []byte) (string, error) {
+	payload, err := json.Marshal(map[string]string{
+		"content":  base64.StdEncoding.EncodeToString(content),
+		"encoding": "base64",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var blob struct {
+		SHA string `json:"sha"`
+	}
+	path := fmt.Sprintf("repos/%s/%s/git/blobs", repo.RepoOwner(), repo.RepoName())
+	err = client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(payload), &blob)
+	return blob.SHA, err
+}
+
+type treeEntry struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+	Type string `json:"type"`
+	SHA  string `json:"sha"`
+}
+
+func createTree(client *api.Client, repo ghrepo.Interface, baseTreeSHA string, entries []treeEntry) (string, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"base_tree": baseTreeSHA,
+		"tree":      entries,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var tree struct {
+		SHA string `json:"sha"`
+	}
+	path := fmt.Sprintf("repos/%s/%s/git/trees", repo.RepoOwner(), repo.RepoName())
+	err = client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(payload), &tree)
+	return tree.SHA, err
+}
+
+func createCommit(client *api.Client, repo ghrepo.Interface, message, treeSHA, parentSHA string) (string, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"message": message,
+		"tree":    treeSHA,
+		"parents": []string{parentSHA},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var commit struct {
+		SHA string `json:"sha"`
+	}
+	path := fmt.Sprintf("repos/%s/%s/git/commits", repo.RepoOwner(), repo.RepoName())
+	err = client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(payload), &commit)
+	return commit.SHA, err
+}
+
+// updateBranch points branch at commitSHA, creating the branch if it does not already exist.
+func updateBranch(client *api.Client, repo ghrepo.Interface, branch, commitSHA string, exists bool) error {
+	if exists {
+		payload, err := json.Marshal(map[string]interface{}{"sha": commitSHA})
+		if err != nil {
+			return err
+		}
+		path := fmt.Sprintf("repos/%s/%s/git/refs/heads/%s", repo.RepoOwner(), repo.RepoName(), branch)
+		return client.REST(repo.RepoHost(), "PATCH", path, bytes.NewReader(payload), nil)
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"ref": "refs/heads/" + branch,
+		"sha": commitSHA,
+	})
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("repos/%s/%s/git/refs", repo.RepoOwner(), repo.RepoName())
+	return client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(payload), nil)
+}
+
+func createPullRequest(client *api.Client, repo ghrepo.Interface, title, body, head, base string) (string, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"title": title,
+		"body":  body,
+		"head":  head,
+		"base":  base,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var pr struct {
+		HTMLURL string `json:"html_url"`
+	}
+	path := fmt.Sprintf("repos/%s/%s/pulls", repo.RepoOwner(), repo.RepoName())
+	err = client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(payload), &pr)
+	return pr.HTMLURL, err
+}
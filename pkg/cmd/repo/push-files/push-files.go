@@ -0,0 +1,200 @@
+package pushfiles
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type PushFilesOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Filenames []string
+	Branch    string
+	Base      string
+	Message   string
+
+	CreatePR bool
+	PRTitle  string
+	PRBody   string
+}
+
+func NewCmdPushFiles(f *cmdutil.Factory, runF func(*PushFilesOptions) error) *cobra.Command {
+	opts := &PushFilesOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "push-files <files>... --branch <branch> --message <message>",
+		Short: "Commit local files to a branch without cloning",
+		Long: heredoc.Doc(`
+			Commit one or more local files directly to a branch of a GitHub repository
+			using the contents and Git data APIs, without requiring a local clone.
+
+			This is intended for bots and fleet-update scripts that need to patch many
+			repositories, where cloning each one first would be wasteful. Each file is
+			written to the same path it has locally, relative to the repository root;
+			use a relative path argument to target a different directory in the repo.
+
+			If the branch does not already exist, it is created from --base (or the
+			repository's default branch).
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo push-files .github/dependabot.yml --branch bot/dependabot -m "Add dependabot config"
+			$ gh repo push-files renovate.json --branch bot/renovate -m "Add renovate config" --create-pr
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Filenames = args
+
+			if opts.CreatePR && opts.PRTitle == "" {
+				opts.PRTitle = opts.Message
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return pushFilesRun(opts)
+		},
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Branch to commit to, created if it doesn't exist (required)")
+	cmd.Flags().StringVarP(&opts.Message, "message", "m", "", "Commit message (required)")
+	cmd.Flags().StringVar(&opts.Base, "base", "", "Branch to create --branch from if it doesn't exist (default: the repository's default branch)")
+	cmd.Flags().BoolVar(&opts.CreatePR, "create-pr", false, "Open a pull request for the branch after pushing")
+	cmd.Flags().StringVar(&opts.PRTitle, "pr-title", "", "Title for the pull request (default: the commit message)")
+	cmd.Flags().StringVar(&opts.PRBody, "pr-body", "", "Body for the pull request")
+	_ = cmd.MarkFlagRequired("branch")
+	_ = cmd.MarkFlagRequired("message")
+
+	return cmd
+}
+
+// repoPath returns the path a local file argument should be written to in
+// the repository tree: the argument itself, relative to the repo root, so
+// that e.g. ".github/dependabot.yml" lands in ".github/" rather than at the
+// repo root. Absolute paths have no meaningful repo-relative location, so
+// only their base name is used.
+func repoPath(f string) string {
+	if filepath.IsAbs(f) {
+		return filepath.Base(f)
+	}
+	return filepath.ToSlash(filepath.Clean(f))
+}
+
+func pushFilesRun(opts *PushFilesOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	parentSHA, err := branchSHA(apiClient, baseRepo, opts.Branch)
+	if err != nil {
+		return err
+	}
+	branchExists := parentSHA != ""
+
+	baseBranch := opts.Base
+	if baseBranch == "" && (!branchExists || opts.CreatePR) {
+		baseBranch, err = api.RepoDefaultBranch(apiClient, baseRepo)
+		if err != nil {
+			return fmt.Errorf("could not determine the default branch: %w", err)
+		}
+	}
+
+	if !branchExists {
+		parentSHA, err = branchSHA(apiClient, baseRepo, baseBranch)
+		if err != nil {
+			return err
+		}
+		if parentSHA == "" {
+			return fmt.Errorf("could not find branch %q to branch from", baseBranch)
+		}
+	}
+
+	baseTreeSHA, err := commitTreeSHA(apiClient, baseRepo, parentSHA)
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	entries := make([]treeEntry, len(opts.Filenames))
+	for i, f := range opts.Filenames {
+		content, err := ioutil.ReadFile(f)
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return err
+		}
+		blobSHA, err := createBlob(apiClient, baseRepo, content)
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return fmt.Errorf("failed to upload %s: %w", f, err)
+		}
+		entries[i] = treeEntry{
+			Path: repoPath(f),
+			Mode: "100644",
+			Type: "blob",
+			SHA:  blobSHA,
+		}
+	}
+
+	treeSHA, err := createTree(apiClient, baseRepo, baseTreeSHA, entries)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	commitSHA, err := createCommit(apiClient, baseRepo, opts.Message, treeSHA, parentSHA)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	err = updateBranch(apiClient, baseRepo, opts.Branch, commitSHA, branchExists)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.IsStdoutTTY() && !opts.IO.GetQuiet() {
+		fmt.Fprintf(opts.IO.Out, "%s Pushed %d file(s) to %s (%s)\n", cs.SuccessIcon(), len(entries), opts.Branch, commitSHA)
+	}
+
+	if !opts.CreatePR {
+		return nil
+	}
+
+	prURL, err := createPullRequest(apiClient, baseRepo, opts.PRTitle, opts.PRBody, opts.Branch, baseBranch)
+	if err != nil {
+		return fmt.Errorf("failed to create pull request: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() && !opts.IO.GetQuiet() {
+		fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.SuccessIcon(), prURL)
+	} else {
+		fmt.Fprintln(opts.IO.Out, prURL)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package pushfiles
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_pushFilesRun(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "dependabot.yml")
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte("version: 2\n"), 0600))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/ref/heads/bot/dependabot"),
+		httpmock.StatusStringResponse(404, `{"message": "Not Found"}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/ref/heads/main"),
+		httpmock.StringResponse(`{"object": {"sha": "base-sha"}}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/commits/base-sha"),
+		httpmock.StringResponse(`{"tree": {"sha": "base-tree-sha"}}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/blobs"),
+		httpmock.StringResponse(`{"sha": "blob-sha"}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/trees"),
+		httpmock.StringResponse(`{"sha": "tree-sha"}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/commits"),
+		httpmock.StringResponse(`{"sha": "commit-sha"}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/refs"),
+		httpmock.StringResponse(`{}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/pulls"),
+		httpmock.StringResponse(`{"html_url": "https://github.com/OWNER/REPO/pull/1"}`))
+
+	err := pushFilesRun(&PushFilesOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Filenames: []string{filePath},
+		Branch:    "bot/dependabot",
+		Base:      "main",
+		Message:   "Add dependabot config",
+		CreatePR:  true,
+		PRTitle:   "Add dependabot config",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Pushed 1 file(s) to bot/dependabot (commit-sha)\n✓ https://github.com/OWNER/REPO/pull/1\n", stdout.String())
+}
+
+func Test_pushFilesRun_branchExists(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "dependabot.yml")
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte("version: 2\n"), 0600))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/ref/heads/bot/dependabot"),
+		httpmock.StringResponse(`{"object": {"sha": "tip-sha"}}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/commits/tip-sha"),
+		httpmock.StringResponse(`{"tree": {"sha": "tip-tree-sha"}}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/blobs"),
+		httpmock.StringResponse(`{"sha": "blob-sha"}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/trees"),
+		httpmock.StringResponse(`{"sha": "tree-sha"}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/commits"),
+		httpmock.StringResponse(`{"sha": "commit-sha"}`))
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO/git/refs/heads/bot/dependabot"),
+		httpmock.StringResponse(`{}`))
+
+	err := pushFilesRun(&PushFilesOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Filenames: []string{filePath},
+		Branch:    "bot/dependabot",
+		Message:   "Add dependabot config",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Pushed 1 file(s) to bot/dependabot (commit-sha)\n", stdout.String())
+}
+
+func Test_pushFilesRun_relativePath(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { assert.NoError(t, os.Chdir("..")) })
+
+	assert.NoError(t, os.Mkdir(".github", 0700))
+	relPath := filepath.Join(".github", "dependabot.yml")
+	assert.NoError(t, ioutil.WriteFile(relPath, []byte("version: 2\n"), 0600))
+
+	io, _, _, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/ref/heads/bot/dependabot"),
+		httpmock.StringResponse(`{"object": {"sha": "tip-sha"}}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/commits/tip-sha"),
+		httpmock.StringResponse(`{"tree": {"sha": "tip-tree-sha"}}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/blobs"),
+		httpmock.StringResponse(`{"sha": "blob-sha"}`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/trees"),
+		func(req *http.Request) (*http.Response, error) {
+			bb, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			assert.Contains(t, string(bb), `"path":".github/dependabot.yml"`)
+			return &http.Response{
+				Request:    req,
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"sha": "tree-sha"}`)),
+			}, nil
+		})
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/commits"),
+		httpmock.StringResponse(`{"sha": "commit-sha"}`))
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO/git/refs/heads/bot/dependabot"),
+		httpmock.StringResponse(`{}`))
+
+	err := pushFilesRun(&PushFilesOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Filenames: []string{relPath},
+		Branch:    "bot/dependabot",
+		Message:   "Add dependabot config",
+	})
+	assert.NoError(t, err)
+}
+
+func Test_pushFilesRun_missingFile(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/ref/heads/bot/dependabot"),
+		httpmock.StringResponse(`{"object": {"sha": "tip-sha"}}`))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/git/commits/tip-sha"),
+		httpmock.StringResponse(`{"tree": {"sha": "tip-tree-sha"}}`))
+
+	err := pushFilesRun(&PushFilesOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Filenames: []string{filepath.Join(os.TempDir(), "does-not-exist-12345.yml")},
+		Branch:    "bot/dependabot",
+		Message:   "Add dependabot config",
+	})
+	assert.Error(t, err)
+}
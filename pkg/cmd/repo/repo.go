@@ -2,18 +2,28 @@ package repo
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	repoAccessCmd "github.com/cli/cli/v2/pkg/cmd/repo/access"
 	repoArchiveCmd "github.com/cli/cli/v2/pkg/cmd/repo/archive"
 	repoCloneCmd "github.com/cli/cli/v2/pkg/cmd/repo/clone"
+	codeownersCmd "github.com/cli/cli/v2/pkg/cmd/repo/codeowners"
 	repoCreateCmd "github.com/cli/cli/v2/pkg/cmd/repo/create"
 	creditsCmd "github.com/cli/cli/v2/pkg/cmd/repo/credits"
 	repoDeleteCmd "github.com/cli/cli/v2/pkg/cmd/repo/delete"
+	dependencyDiffCmd "github.com/cli/cli/v2/pkg/cmd/repo/dependency-diff"
 	deployKeyCmd "github.com/cli/cli/v2/pkg/cmd/repo/deploy-key"
 	repoEditCmd "github.com/cli/cli/v2/pkg/cmd/repo/edit"
 	repoForkCmd "github.com/cli/cli/v2/pkg/cmd/repo/fork"
 	gardenCmd "github.com/cli/cli/v2/pkg/cmd/repo/garden"
+	repoInvitationCmd "github.com/cli/cli/v2/pkg/cmd/repo/invitation"
 	repoListCmd "github.com/cli/cli/v2/pkg/cmd/repo/list"
+	pushFilesCmd "github.com/cli/cli/v2/pkg/cmd/repo/push-files"
 	repoRenameCmd "github.com/cli/cli/v2/pkg/cmd/repo/rename"
+	repoStarCmd "github.com/cli/cli/v2/pkg/cmd/repo/star"
+	repoStarsCmd "github.com/cli/cli/v2/pkg/cmd/repo/stars"
 	repoSyncCmd "github.com/cli/cli/v2/pkg/cmd/repo/sync"
+	templateFilesCmd "github.com/cli/cli/v2/pkg/cmd/repo/template-files"
+	repoUnarchiveCmd "github.com/cli/cli/v2/pkg/cmd/repo/unarchive"
+	repoUnstarCmd "github.com/cli/cli/v2/pkg/cmd/repo/unstar"
 	repoViewCmd "github.com/cli/cli/v2/pkg/cmd/repo/view"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -45,6 +55,7 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(repoCreateCmd.NewCmdCreate(f, nil))
 	cmd.AddCommand(repoEditCmd.NewCmdEdit(f, nil))
 	cmd.AddCommand(repoListCmd.NewCmdList(f, nil))
+	cmd.AddCommand(pushFilesCmd.NewCmdPushFiles(f, nil))
 	cmd.AddCommand(repoSyncCmd.NewCmdSync(f, nil))
 	cmd.AddCommand(creditsCmd.NewCmdRepoCredits(f, nil))
 	cmd.AddCommand(gardenCmd.NewCmdGarden(f, nil))
@@ -52,6 +63,15 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(repoRenameCmd.NewCmdRename(f, nil))
 	cmd.AddCommand(repoDeleteCmd.NewCmdDelete(f, nil))
 	cmd.AddCommand(repoArchiveCmd.NewCmdArchive(f, nil))
+	cmd.AddCommand(repoUnarchiveCmd.NewCmdUnarchive(f, nil))
+	cmd.AddCommand(dependencyDiffCmd.NewCmdDependencyDiff(f, nil))
+	cmd.AddCommand(repoStarCmd.NewCmdStar(f, nil))
+	cmd.AddCommand(repoUnstarCmd.NewCmdUnstar(f, nil))
+	cmd.AddCommand(repoStarsCmd.NewCmdStars(f, nil))
+	cmd.AddCommand(codeownersCmd.NewCmdCodeowners(f))
+	cmd.AddCommand(templateFilesCmd.NewCmdTemplateFiles(f))
+	cmd.AddCommand(repoInvitationCmd.NewCmdInvitation(f))
+	cmd.AddCommand(repoAccessCmd.NewCmdAccess(f))
 
 	return cmd
 }
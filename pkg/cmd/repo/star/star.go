@@ -0,0 +1,103 @@
+package star
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type StarOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+
+	RepoArg string
+}
+
+func NewCmdStar(f *cmdutil.Factory, runF func(*StarOptions) error) *cobra.Command {
+	opts := &StarOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "star [<repository>]",
+		Short: "Star a repository",
+		Long: `Star a GitHub repository.
+
+With no argument, stars the current repository.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+			if runF != nil {
+				return runF(opts)
+			}
+			return starRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func starRun(opts *StarOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var toStar ghrepo.Interface
+	if opts.RepoArg == "" {
+		toStar, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			cfg, err := opts.Config()
+			if err != nil {
+				return err
+			}
+
+			hostname, err := cfg.DefaultHost()
+			if err != nil {
+				return err
+			}
+
+			currentUser, err := api.CurrentLoginName(apiClient, hostname)
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+
+		toStar, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := apiClient.REST(toStar.RepoHost(), "PUT", fmt.Sprintf("user/starred/%s/%s", toStar.RepoOwner(), toStar.RepoName()), nil, nil); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Starred repository %s\n", cs.SuccessIconWithColor(cs.Green), ghrepo.FullName(toStar))
+	}
+
+	return nil
+}
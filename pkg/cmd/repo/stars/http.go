@@ -0,0 +1,79 @@
+package stars
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// Stargazer is a single star on a repository, including the time it was given.
+type Stargazer struct {
+	StarredAt time.Time `json:"starred_at"`
+	Login     string    `json:"login"`
+}
+
+// stargazerWithUser matches the shape returned when the star+json preview media type is
+// requested, which nests the stargazer's user under "user" alongside "starred_at".
+type stargazerWithUser struct {
+	StarredAt time.Time `json:"starred_at"`
+	User      struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+// fetchStargazers retrieves every stargazer of a repository, oldest first, together with
+// the time each one starred it.
+func fetchStargazers(httpClient *http.Client, repo ghrepo.Interface) ([]Stargazer, error) {
+	apiPath := fmt.Sprintf("%srepos/%s/%s/stargazers?per_page=100", ghinstance.RESTPrefix(repo.RepoHost()), repo.RepoOwner(), repo.RepoName())
+
+	var stargazers []Stargazer
+	for apiPath != "" {
+		req, err := http.NewRequest("GET", apiPath, nil)
+		if err != nil {
+			return nil, err
+		}
+		// the "star+json" preview is required for the API to include "starred_at"
+		req.Header.Set("Accept", "application/vnd.github.star+json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			defer resp.Body.Close()
+			return nil, api.HandleHTTPError(resp)
+		}
+
+		var page []stargazerWithUser
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, sg := range page {
+			stargazers = append(stargazers, Stargazer{StarredAt: sg.StarredAt, Login: sg.User.Login})
+		}
+
+		apiPath = findNextPage(resp.Header.Get("Link"))
+	}
+
+	return stargazers, nil
+}
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func findNextPage(link string) string {
+	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
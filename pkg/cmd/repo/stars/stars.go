@@ -0,0 +1,217 @@
+package stars
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	searchshared "github.com/cli/cli/v2/pkg/cmd/search/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type StarsOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+	Exporter   cmdutil.Exporter
+
+	RepoArg string
+	History bool
+	Format  string
+}
+
+// MonthlyStars is the number of stars a repository gained in a calendar month, along with
+// the running total as of the end of that month.
+type MonthlyStars struct {
+	Month      string `json:"month"`
+	NewStars   int    `json:"newStars"`
+	TotalStars int    `json:"totalStars"`
+}
+
+var Fields = []string{"month", "newStars", "totalStars"}
+
+func (m *MonthlyStars) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "month":
+			data[f] = m.Month
+		case "newStars":
+			data[f] = m.NewStars
+		case "totalStars":
+			data[f] = m.TotalStars
+		}
+	}
+	return data
+}
+
+func NewCmdStars(f *cmdutil.Factory, runF func(*StarsOptions) error) *cobra.Command {
+	opts := &StarsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stars [<repository>]",
+		Short: "Show a repository's star count",
+		Long: heredoc.Doc(`
+			Show a repository's star count.
+
+			With no argument, shows the current repository's star count.
+
+			With '--history', shows the number of stars the repository gained each month,
+			computed from the time each stargazer starred it.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo stars
+			$ gh repo stars cli/cli
+			$ gh repo stars --history
+			$ gh repo stars --history --format=csv > stars.csv
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--json` or `--format`",
+				cmd.Flags().Changed("json"), opts.Format != "",
+			); err != nil {
+				return err
+			}
+			if !opts.History && (cmd.Flags().Changed("json") || opts.Format != "") {
+				return cmdutil.FlagErrorf("`--json` and `--format` require `--history`")
+			}
+
+			if opts.Format != "" {
+				opts.Exporter = searchshared.FormatExporter(opts.Format, Fields)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return starsRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.History, "history", false, "Show the number of stars gained each month")
+	searchshared.AddFormatFlag(cmd, &opts.Format)
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, Fields)
+
+	return cmd
+}
+
+func starsRun(opts *StarsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var toShow ghrepo.Interface
+	if opts.RepoArg == "" {
+		toShow, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			cfg, err := opts.Config()
+			if err != nil {
+				return err
+			}
+
+			hostname, err := cfg.DefaultHost()
+			if err != nil {
+				return err
+			}
+
+			currentUser, err := api.CurrentLoginName(apiClient, hostname)
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+
+		toShow, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return err
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	stargazers, err := fetchStargazers(httpClient, toShow)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if !opts.History {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s has %d stars\n", ghrepo.FullName(toShow), len(stargazers))
+		} else {
+			fmt.Fprintln(opts.IO.Out, len(stargazers))
+		}
+		return nil
+	}
+
+	history := monthlyStarHistory(stargazers)
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, history)
+	}
+
+	if len(history) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No stars found")
+		return cmdutil.SilentError
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, row := range history {
+		tp.AddField(row.Month, nil, nil)
+		tp.AddField(fmt.Sprintf("%d", row.NewStars), nil, cs.Green)
+		tp.AddField(fmt.Sprintf("%d", row.TotalStars), nil, nil)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+// monthlyStarHistory buckets stargazers by the calendar month they starred the repository,
+// assuming stargazers are already sorted oldest first, and returns a running total per month.
+func monthlyStarHistory(stargazers []Stargazer) []MonthlyStars {
+	var history []MonthlyStars
+	var total int
+	var current *MonthlyStars
+
+	for _, sg := range stargazers {
+		month := sg.StarredAt.Format("2006-01")
+		if current == nil || current.Month != month {
+			if current != nil {
+				history = append(history, *current)
+			}
+			current = &MonthlyStars{Month: month}
+		}
+		current.NewStars++
+		total++
+		current.TotalStars = total
+	}
+	if current != nil {
+		history = append(history, *current)
+	}
+
+	return history
+}
@@ -0,0 +1,149 @@
+package stars
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdStars(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  StarsOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "no argument",
+			input:  "",
+			output: StarsOptions{},
+		},
+		{
+			name:   "history flag",
+			input:  "--history",
+			output: StarsOptions{History: true},
+		},
+		{
+			name:    "json without history",
+			input:   "--json month",
+			wantErr: true,
+			errMsg:  "`--json` and `--format` require `--history`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *StarsOptions
+			cmd := NewCmdStars(f, func(opts *StarsOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.History, gotOpts.History)
+		})
+	}
+}
+
+func Test_starsRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/stargazers"),
+		httpmock.StringResponse(`[{"starred_at":"2022-01-05T00:00:00Z","user":{"login":"monalisa"}}]`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	err := starsRun(&StarsOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1\n", stdout.String())
+}
+
+func Test_starsRun_history(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/stargazers"),
+		httpmock.StringResponse(`[
+			{"starred_at":"2022-01-05T00:00:00Z","user":{"login":"monalisa"}},
+			{"starred_at":"2022-01-20T00:00:00Z","user":{"login":"hubot"}},
+			{"starred_at":"2022-02-01T00:00:00Z","user":{"login":"octocat"}}
+		]`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	err := starsRun(&StarsOptions{
+		IO:      io,
+		History: true,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "2022-01\t2\t2\n2022-02\t1\t3\n", stdout.String())
+}
+
+func Test_monthlyStarHistory(t *testing.T) {
+	stargazers := []Stargazer{
+		{Login: "a", StarredAt: mustParseTime(t, "2022-01-05T00:00:00Z")},
+		{Login: "b", StarredAt: mustParseTime(t, "2022-01-20T00:00:00Z")},
+		{Login: "c", StarredAt: mustParseTime(t, "2022-02-01T00:00:00Z")},
+	}
+
+	history := monthlyStarHistory(stargazers)
+	assert.Equal(t, []MonthlyStars{
+		{Month: "2022-01", NewStars: 2, TotalStars: 2},
+		{Month: "2022-02", NewStars: 1, TotalStars: 3},
+	}, history)
+}
+
+func mustParseTime(t *testing.T, s string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, s)
+	assert.NoError(t, err)
+	return parsed
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/shurcooL/githubv4"
 )
 
 type commit struct {
@@ -62,6 +63,55 @@ func triggerUpstreamMerge(client *api.Client, repo ghrepo.Interface, branch stri
 	return response.BaseBranch, nil
 }
 
+// orgForks returns every fork owned by org, across all of its repositories.
+func orgForks(client *api.Client, hostname, org string) ([]ghrepo.Interface, error) {
+	type result struct {
+		Organization struct {
+			Login        string
+			Repositories struct {
+				Nodes []struct {
+					Name string
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			}
+		}
+	}
+
+	query := `query OrganizationForkList($org: String!, $endCursor: String) {
+		organization(login: $org) {
+			login
+			repositories(first: 100, after: $endCursor, isFork: true, ownerAffiliations: OWNER) {
+				nodes { name }
+				pageInfo { hasNextPage, endCursor }
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"org": githubv4.String(org),
+	}
+
+	var forks []ghrepo.Interface
+	for {
+		var res result
+		if err := client.GraphQL(hostname, query, variables, &res); err != nil {
+			return nil, err
+		}
+		for _, repo := range res.Organization.Repositories.Nodes {
+			forks = append(forks, ghrepo.NewWithHost(res.Organization.Login, repo.Name, hostname))
+		}
+		if !res.Organization.Repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = githubv4.String(res.Organization.Repositories.PageInfo.EndCursor)
+	}
+
+	return forks, nil
+}
+
 func syncFork(client *api.Client, repo ghrepo.Interface, branch, SHA string, force bool) error {
 	path := fmt.Sprintf("repos/%s/%s/git/refs/heads/%s", repo.RepoOwner(), repo.RepoName(), branch)
 	body := map[string]interface{}{
@@ -6,19 +6,23 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/context"
 	gitpkg "github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 type SyncOptions struct {
 	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 	Remotes    func() (context.Remotes, error)
@@ -27,11 +31,14 @@ type SyncOptions struct {
 	SrcArg     string
 	Branch     string
 	Force      bool
+	Org        string
+	AllForks   bool
 }
 
 func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Command {
 	opts := SyncOptions{
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		IO:         f.IOStreams,
 		BaseRepo:   f.BaseRepo,
 		Remotes:    f.Remotes,
@@ -65,12 +72,21 @@ func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Comman
 
 			# Sync remote repository from another remote repository
 			$ gh repo sync owner/repo --source owner2/repo2
+
+			# Sync the default branch of every fork in an organization from its parent
+			$ gh repo sync --org cli --all-forks
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.DestArg = args[0]
 			}
+			if opts.AllForks && opts.Org == "" {
+				return cmdutil.FlagErrorf("`--org` is required when using `--all-forks`")
+			}
+			if opts.AllForks && (opts.DestArg != "" || opts.SrcArg != "") {
+				return cmdutil.FlagErrorf("`--all-forks` is not supported with a destination or source repository")
+			}
 			if runF != nil {
 				return runF(&opts)
 			}
@@ -81,11 +97,15 @@ func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.SrcArg, "source", "s", "", "Source repository")
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Branch to sync (default: main branch)")
 	cmd.Flags().BoolVarP(&opts.Force, "force", "", false, "Hard reset the branch of the destination repository to match the source repository")
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Sync every fork owned by `organization`")
+	cmd.Flags().BoolVar(&opts.AllForks, "all-forks", false, "Sync the default branch of every fork in --org from its parent")
 	return cmd
 }
 
 func syncRun(opts *SyncOptions) error {
-	if opts.DestArg == "" {
+	if opts.AllForks {
+		return syncAllForks(opts)
+	} else if opts.DestArg == "" {
 		return syncLocalRepo(opts)
 	} else {
 		return syncRemoteRepo(opts)
@@ -215,6 +235,73 @@ func syncRemoteRepo(opts *SyncOptions) error {
 	return nil
 }
 
+func syncAllForks(opts *SyncOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel(fmt.Sprintf("Finding forks owned by %s", opts.Org))
+	forks, err := orgForks(apiClient, host, opts.Org)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(forks) == 0 {
+		return fmt.Errorf("no forks found for organization %s", opts.Org)
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel(fmt.Sprintf("Syncing %d forks", len(forks)))
+	var mu sync.Mutex
+	var synced, conflicts []string
+	var g errgroup.Group
+	for _, fork := range forks {
+		fork := fork
+		g.Go(func() error {
+			_, err := executeRemoteRepoSync(apiClient, fork, nil, opts)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if errors.Is(err, divergingError) {
+					conflicts = append(conflicts, ghrepo.FullName(fork))
+					return nil
+				}
+				conflicts = append(conflicts, fmt.Sprintf("%s (%s)", ghrepo.FullName(fork), err))
+				return nil
+			}
+			synced = append(synced, ghrepo.FullName(fork))
+			return nil
+		})
+	}
+	_ = g.Wait()
+	opts.IO.StopProgressIndicator()
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Synced %d of %d forks in %s\n", cs.SuccessIcon(), len(synced), len(forks), opts.Org)
+	}
+	if len(conflicts) > 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Could not sync %d fork(s), use `--force` to overwrite diverging branches:\n", cs.WarningIcon(), len(conflicts))
+		for _, name := range conflicts {
+			fmt.Fprintf(opts.IO.ErrOut, "  %s\n", name)
+		}
+		return cmdutil.SilentError
+	}
+
+	return nil
+}
+
 var divergingError = errors.New("diverging changes")
 var mismatchRemotesError = errors.New("branch remote does not match specified source")
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cli/cli/v2/context"
 	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
@@ -63,6 +64,29 @@ func TestNewCmdSync(t *testing.T) {
 				Force: true,
 			},
 		},
+		{
+			name:  "all forks",
+			tty:   true,
+			input: "--org cli --all-forks",
+			output: SyncOptions{
+				Org:      "cli",
+				AllForks: true,
+			},
+		},
+		{
+			name:    "all forks without org",
+			tty:     true,
+			input:   "--all-forks",
+			wantErr: true,
+			errMsg:  "`--org` is required when using `--all-forks`",
+		},
+		{
+			name:    "all forks with a destination repo",
+			tty:     true,
+			input:   "--org cli --all-forks cli/cli",
+			wantErr: true,
+			errMsg:  "`--all-forks` is not supported with a destination or source repository",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -96,6 +120,8 @@ func TestNewCmdSync(t *testing.T) {
 			assert.Equal(t, tt.output.SrcArg, gotOpts.SrcArg)
 			assert.Equal(t, tt.output.Branch, gotOpts.Branch)
 			assert.Equal(t, tt.output.Force, gotOpts.Force)
+			assert.Equal(t, tt.output.Org, gotOpts.Org)
+			assert.Equal(t, tt.output.AllForks, gotOpts.AllForks)
 		})
 	}
 }
@@ -424,6 +450,63 @@ func Test_SyncRun(t *testing.T) {
 			wantErr: true,
 			errMsg:  "can't sync because there are diverging changes; use `--force` to overwrite the destination branch",
 		},
+		{
+			name: "sync all forks in an org",
+			tty:  true,
+			opts: &SyncOptions{
+				AllForks: true,
+				Org:      "cli",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query OrganizationForkList\b`),
+					httpmock.StringResponse(`{"data":{"organization":{"login":"cli","repositories":{"nodes":[{"name":"REPO-FORK"}],"pageInfo":{"hasNextPage":false}}}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"defaultBranchRef":{"name": "trunk"}}}}`))
+				reg.Register(
+					httpmock.REST("POST", "repos/cli/REPO-FORK/merge-upstream"),
+					httpmock.StatusStringResponse(200, `{"base_branch": "OWNER:trunk"}`))
+			},
+			wantStdout: "✓ Synced 1 of 1 forks in cli\n",
+		},
+		{
+			name: "sync all forks in an org with a conflict",
+			tty:  true,
+			opts: &SyncOptions{
+				AllForks: true,
+				Org:      "cli",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query OrganizationForkList\b`),
+					httpmock.StringResponse(`{"data":{"organization":{"login":"cli","repositories":{"nodes":[{"name":"REPO-FORK"}],"pageInfo":{"hasNextPage":false}}}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryFindParent\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"parent":{"name":"REPO","owner":{"login": "cli"}}}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"defaultBranchRef":{"name": "trunk"}}}}`))
+				reg.Register(
+					httpmock.REST("POST", "repos/cli/REPO-FORK/merge-upstream"),
+					httpmock.StatusStringResponse(409, `{"message": "Merge conflict"}`))
+				reg.Register(
+					httpmock.REST("GET", "repos/cli/REPO/git/refs/heads/trunk"),
+					httpmock.StringResponse(`{"object":{"sha":"0xDEADBEEF"}}`))
+				reg.Register(
+					httpmock.REST("PATCH", "repos/cli/REPO-FORK/git/refs/heads/trunk"),
+					func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 422,
+							Request:    req,
+							Header:     map[string][]string{"Content-Type": {"application/json"}},
+							Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message":"Update is not a fast forward"}`)),
+						}, nil
+					})
+			},
+			wantErr: true,
+			errMsg:  "SilentError",
+		},
 	}
 	for _, tt := range tests {
 		reg := &httpmock.Registry{}
@@ -445,6 +528,10 @@ func Test_SyncRun(t *testing.T) {
 			return repo1, nil
 		}
 
+		tt.opts.Config = func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		}
+
 		tt.opts.Remotes = func() (context.Remotes, error) {
 			if tt.remotes == nil {
 				return []*context.Remote{
@@ -0,0 +1,216 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Type string
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Scaffold an issue, pull request, or issue form template",
+		Long: heredoc.Doc(`
+			Interactively scaffold a valid issue template, pull request template, or
+			issue form, then open a pull request that adds it to the repository.
+
+			This saves you from hand-writing the ISSUE_TEMPLATE front-matter or form
+			YAML schema, which GitHub silently ignores if it's malformed.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo template-files create --type form
+			$ gh repo template-files create --type issue
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if !opts.IO.CanPrompt() {
+				return cmdutil.FlagErrorf("`template-files create` requires an interactive terminal")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Type, "type", "t", "", []string{"issue", "pr", "form"}, "Type of template to scaffold")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if opts.Type == "" {
+		if err := prompt.SurveyAskOne(&survey.Select{
+			Message: "What type of template do you want to create?",
+			Options: []string{"issue", "pr", "form"},
+		}, &opts.Type); err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+	}
+
+	var name string
+	if err := prompt.SurveyAskOne(&survey.Input{
+		Message: "Template name:",
+	}, &name, survey.WithValidator(survey.Required)); err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+
+	var about string
+	if opts.Type != "pr" {
+		if err := prompt.SurveyAskOne(&survey.Input{
+			Message: "Short description shown in the template picker:",
+		}, &about, survey.WithValidator(survey.Required)); err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+	}
+
+	var labels string
+	if opts.Type != "pr" {
+		if err := prompt.SurveyAskOne(&survey.Input{
+			Message: "Labels to apply automatically (comma-separated, optional):",
+		}, &labels); err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+	}
+
+	fields := []string{"id", "defaultBranchRef"}
+	repoInfo, err := api.FetchRepository(apiClient, repo, fields)
+	if err != nil {
+		return err
+	}
+
+	baseCommit, err := api.LastCommit(apiClient, repo)
+	if err != nil {
+		return err
+	}
+
+	path, content := buildTemplate(opts.Type, name, about, labels)
+	branch := fmt.Sprintf("template-files/%s", slugify(name))
+
+	if err := createBranch(httpClient, repo, branch, baseCommit.OID); err != nil {
+		return err
+	}
+
+	commitMessage := fmt.Sprintf("Add %s template: %s", opts.Type, name)
+	if err := putTemplateFile(httpClient, repo, path, commitMessage, content, branch); err != nil {
+		return err
+	}
+
+	pr, err := api.CreatePullRequest(apiClient, repoInfo, map[string]interface{}{
+		"title":       commitMessage,
+		"body":        fmt.Sprintf("Adds `%s`, generated by `gh repo template-files create`.", path),
+		"baseRefName": repoInfo.DefaultBranchRef.Name,
+		"headRefName": branch,
+	})
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s Created pull request %s\n", cs.SuccessIcon(), pr.URL)
+	return nil
+}
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+func slugify(name string) string {
+	s := nonSlugChars.ReplaceAllString(strings.ToLower(name), "-")
+	return strings.Trim(s, "-")
+}
+
+func buildTemplate(templateType, name, about, labels string) (path string, content []byte) {
+	slug := slugify(name)
+
+	switch templateType {
+	case "pr":
+		path = ".github/PULL_REQUEST_TEMPLATE.md"
+		content = []byte(heredoc.Doc(`
+			## What does this change?
+
+			## How was it tested?
+		`))
+	case "form":
+		path = fmt.Sprintf(".github/ISSUE_TEMPLATE/%s.yml", slug)
+		content = []byte(fmt.Sprintf(`name: %s
+description: %s
+labels: [%s]
+body:
+  - type: textarea
+    id: description
+    attributes:
+      label: Description
+      description: Please describe the issue
+    validations:
+      required: true
+`, quoteYAML(name), quoteYAML(about), yamlLabelList(labels)))
+	default: // "issue"
+		path = fmt.Sprintf(".github/ISSUE_TEMPLATE/%s.md", slug)
+		content = []byte(fmt.Sprintf(`---
+name: %s
+about: %s
+title: ''
+labels: '%s'
+assignees: ''
+---
+
+`, name, about, labels))
+	}
+
+	return path, content
+}
+
+func quoteYAML(s string) string {
+	return fmt.Sprintf("%q", s)
+}
+
+func yamlLabelList(labels string) string {
+	if labels == "" {
+		return ""
+	}
+	var quoted []string
+	for _, l := range strings.Split(labels, ",") {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		quoted = append(quoted, quoteYAML(l))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,91 @@
+package create
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_createRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`{ "data": { "repository": {
+			"id": "REPO-ID",
+			"defaultBranchRef": { "name": "main" }
+		} } }`))
+	reg.Register(
+		httpmock.GraphQL(`query LastCommit\b`),
+		httpmock.StringResponse(`{ "data": { "repository": {
+			"defaultBranchRef": { "target": { "oid": "BASESHA" } }
+		} } }`))
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/git/refs"),
+		httpmock.RESTPayload(201, `{}`, func(payload map[string]interface{}) {
+			assert.Equal(t, "refs/heads/template-files/bug-report", payload["ref"])
+			assert.Equal(t, "BASESHA", payload["sha"])
+		}))
+	reg.Register(
+		httpmock.REST("PUT", "repos/OWNER/REPO/contents/.github/ISSUE_TEMPLATE/bug-report.md"),
+		httpmock.RESTPayload(201, `{}`, func(payload map[string]interface{}) {
+			assert.Equal(t, "template-files/bug-report", payload["branch"])
+		}))
+	reg.Register(
+		httpmock.GraphQL(`mutation PullRequestCreate\b`),
+		httpmock.StringResponse(`{ "data": { "createPullRequest": { "pullRequest": {
+			"id": "PR-ID",
+			"url": "https://github.com/OWNER/REPO/pull/1"
+		} } } }`))
+
+	as := prompt.NewAskStubber(t)
+	as.StubPrompt("Template name:").AnswerWith("Bug report")
+	as.StubPrompt("Short description shown in the template picker:").AnswerWith("File a bug report")
+	as.StubPrompt("Labels to apply automatically (comma-separated, optional):").AnswerWith("bug")
+
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdinTTY(true)
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &CreateOptions{
+		IO:   io,
+		Type: "issue",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := createRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Created pull request https://github.com/OWNER/REPO/pull/1\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func Test_buildTemplate(t *testing.T) {
+	tests := []struct {
+		name         string
+		templateType string
+		wantPath     string
+	}{
+		{name: "issue template", templateType: "issue", wantPath: ".github/ISSUE_TEMPLATE/bug-report.md"},
+		{name: "pr template", templateType: "pr", wantPath: ".github/PULL_REQUEST_TEMPLATE.md"},
+		{name: "form template", templateType: "form", wantPath: ".github/ISSUE_TEMPLATE/bug-report.yml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, content := buildTemplate(tt.templateType, "Bug report", "File a bug report", "bug")
+			assert.Equal(t, tt.wantPath, path)
+			assert.NotEmpty(t, content)
+		})
+	}
+}
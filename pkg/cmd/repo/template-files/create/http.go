@@ -0,0 +1,39 @@
+package create
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+func createBranch(client *http.Client, repo ghrepo.Interface, branch, sha string) error {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/git/refs", ghrepo.FullName(repo))
+	requestByte, err := json.Marshal(map[string]interface{}{
+		"ref": fmt.Sprintf("refs/heads/%s", branch),
+		"sha": sha,
+	})
+	if err != nil {
+		return err
+	}
+	return apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), nil)
+}
+
+func putTemplateFile(client *http.Client, repo ghrepo.Interface, filePath, message string, content []byte, branch string) error {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/contents/%s", ghrepo.FullName(repo), filePath)
+	requestByte, err := json.Marshal(map[string]interface{}{
+		"message": message,
+		"content": base64.StdEncoding.EncodeToString(content),
+		"branch":  branch,
+	})
+	if err != nil {
+		return err
+	}
+	return apiClient.REST(repo.RepoHost(), "PUT", path, bytes.NewReader(requestByte), nil)
+}
@@ -0,0 +1,20 @@
+package templatefiles
+
+import (
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/repo/template-files/create"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTemplateFiles(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template-files <command>",
+		Short: "Manage issue and pull request templates",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+
+	return cmd
+}
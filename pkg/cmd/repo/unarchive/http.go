@@ -0,0 +1,32 @@
+package unarchive
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
+)
+
+func unarchiveRepo(client *http.Client, repo *api.Repository) error {
+	var mutation struct {
+		UnarchiveRepository struct {
+			Repository struct {
+				ID string
+			}
+		} `graphql:"unarchiveRepository(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.UnarchiveRepositoryInput{
+			RepositoryID: repo.ID,
+		},
+	}
+
+	host := repo.RepoHost()
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(host), client)
+	err := gql.MutateNamed(context.Background(), "UnarchiveRepository", &mutation, variables)
+	return err
+}
@@ -0,0 +1,143 @@
+package unarchive
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdUnarchive(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+		output  UnarchiveOptions
+		errMsg  string
+	}{
+		{
+			name:    "no arguments no tty",
+			input:   "",
+			errMsg:  "--confirm required when not running interactively",
+			wantErr: true,
+		},
+		{
+			name:   "repo argument tty",
+			input:  "OWNER/REPO --confirm",
+			output: UnarchiveOptions{RepoArg: "OWNER/REPO", Confirmed: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *UnarchiveOptions
+			cmd := NewCmdUnarchive(f, func(opts *UnarchiveOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.RepoArg, gotOpts.RepoArg)
+			assert.Equal(t, tt.output.Confirmed, gotOpts.Confirmed)
+		})
+	}
+}
+
+func Test_UnarchiveRun(t *testing.T) {
+	queryResponse := `{ "data": { "repository": { "id": "THE-ID","isArchived": %s} } }`
+	tests := []struct {
+		name       string
+		opts       UnarchiveOptions
+		httpStubs  func(*httpmock.Registry)
+		askStubs   func(*prompt.AskStubber)
+		isTTY      bool
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:       "archived repo tty",
+			wantStdout: "✓ Unarchived repository OWNER/REPO\n",
+			askStubs: func(q *prompt.AskStubber) {
+				//nolint:staticcheck // SA1019: q.StubOne is deprecated: use StubPrompt
+				q.StubOne(true)
+			},
+			isTTY: true,
+			opts:  UnarchiveOptions{RepoArg: "OWNER/REPO"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(fmt.Sprintf(queryResponse, "true")))
+				reg.Register(
+					httpmock.GraphQL(`mutation UnarchiveRepository\b`),
+					httpmock.StringResponse(`{}`))
+			},
+		},
+		{
+			name:       "not archived repo tty",
+			wantStderr: "! Repository OWNER/REPO is not archived\n",
+			opts:       UnarchiveOptions{RepoArg: "OWNER/REPO"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(fmt.Sprintf(queryResponse, "false")))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		repo, _ := ghrepo.FromFullName("OWNER/REPO")
+		reg := &httpmock.Registry{}
+		if tt.httpStubs != nil {
+			tt.httpStubs(reg)
+		}
+
+		tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+			return repo, nil
+		}
+		tt.opts.HttpClient = func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		}
+		io, _, stdout, stderr := iostreams.Test()
+		tt.opts.IO = io
+
+		//nolint:staticcheck // SA1019: prompt.InitAskStubber is deprecated: use NewAskStubber
+		q, teardown := prompt.InitAskStubber()
+		defer teardown()
+		if tt.askStubs != nil {
+			tt.askStubs(q)
+		}
+
+		t.Run(tt.name, func(t *testing.T) {
+			defer reg.Verify(t)
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			err := unarchiveRun(&tt.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package unstar
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UnstarOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+
+	RepoArg string
+}
+
+func NewCmdUnstar(f *cmdutil.Factory, runF func(*UnstarOptions) error) *cobra.Command {
+	opts := &UnstarOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unstar [<repository>]",
+		Short: "Unstar a repository",
+		Long: `Unstar a GitHub repository.
+
+With no argument, unstars the current repository.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+			if runF != nil {
+				return runF(opts)
+			}
+			return unstarRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func unstarRun(opts *UnstarOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var toUnstar ghrepo.Interface
+	if opts.RepoArg == "" {
+		toUnstar, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			cfg, err := opts.Config()
+			if err != nil {
+				return err
+			}
+
+			hostname, err := cfg.DefaultHost()
+			if err != nil {
+				return err
+			}
+
+			currentUser, err := api.CurrentLoginName(apiClient, hostname)
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+
+		toUnstar, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := apiClient.REST(toUnstar.RepoHost(), "DELETE", fmt.Sprintf("user/starred/%s/%s", toUnstar.RepoOwner(), toUnstar.RepoName()), nil, nil); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Unstarred repository %s\n", cs.SuccessIconWithColor(cs.Green), ghrepo.FullName(toUnstar))
+	}
+
+	return nil
+}
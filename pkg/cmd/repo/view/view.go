@@ -187,7 +187,8 @@ func viewRun(opts *ViewOptions) error {
 		readmeContent = cs.Gray("This repository does not have a README")
 	} else if isMarkdownFile(readme.Filename) {
 		var err error
-		readmeContent, err = markdown.Render(readme.Content, markdown.WithIO(opts.IO), markdown.WithBaseURL(readme.BaseURL))
+		readmeContent, err = markdown.Render(readme.Content,
+			markdown.WithIO(opts.IO), markdown.WithBaseURL(readme.BaseURL), markdown.WithWrap(opts.IO.TerminalWidth()))
 		if err != nil {
 			return fmt.Errorf("error rendering markdown: %w", err)
 		}
@@ -48,7 +48,18 @@ var HelpTopics = map[string]map[string]string{
 			GH_BROWSER, BROWSER (in order of precedence): the web browser to use for opening links.
 
 			GH_DEBUG: set to a truthy value to enable verbose output on standard error. Set to "api"
-			to additionally log details of HTTP traffic.
+			to additionally log details of HTTP traffic, and to "json" to print that output as
+			JSON lines instead of text. Values can be combined, e.g. "api,json". Request/response
+			bodies are truncated to 10000 bytes by default; override with GH_DEBUG_MAX_BODY.
+			Credentials in logged headers are always redacted.
+
+			GH_LOG_FILE, --log-file: write GH_DEBUG output to a file instead of standard error,
+			useful for attaching diagnostics to a bug report.
+
+			GH_LANG: the language to show prompts and help text in, overriding the locale detected
+			from LC_ALL, LC_MESSAGES, LANG, and LANGUAGE. Only a handful of messages are currently
+			translated, and only into a few languages; everything else, including all --json
+			output and error identifiers, is unaffected and stays in English.
 
 			DEBUG (deprecated): set to "1", "true", or "yes" to enable verbose output on standard
 			error.
@@ -77,11 +88,42 @@ var HelpTopics = map[string]map[string]string{
 
 			GH_CONFIG_DIR: the directory where gh will store configuration files. Default:
 			"$XDG_CONFIG_HOME/gh" or "$HOME/.config/gh".
+
+			GH_OFFLINE: set to any value to avoid making network requests. Commands that only read
+			data are served from gh's on-disk HTTP cache, however stale, and print a warning when
+			they do; if nothing is cached for a request, or the request would write data, the
+			command fails immediately instead of reaching the network.
 		`),
 	},
 	"reference": {
 		"short": "A comprehensive reference of all gh commands",
 	},
+	"exit-codes": {
+		"short": "Exit codes used by gh",
+		"long": heredoc.Doc(`
+			gh follows normal conventions of returning a non-zero exit code on
+			failure, so scripts can branch on the exit code rather than parsing
+			error text. The meaning of an exit code, once in use, does not change.
+
+			0: Successful execution
+
+			1: Something went wrong, see the printed error message for specifics
+
+			2: The command was cancelled, e.g. by pressing Ctrl+C
+
+			3: The requested resource was not found on GitHub
+
+			4: Authentication is required
+
+			5: There was a problem with the arguments or flags passed to the command
+
+			6: The API rate limit for the authenticated user has been exceeded
+
+			The --quiet flag, available on every command, suppresses
+			non-essential output such as update notifications, so only the
+			output a script actually needs is written.
+		`),
+	},
 	"formatting": {
 		"short": "Formatting options for JSON data exported from gh",
 		"long": heredoc.Docf(`
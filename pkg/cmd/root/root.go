@@ -7,29 +7,52 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	codespacesAPI "github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/internal/i18n"
 	actionsCmd "github.com/cli/cli/v2/pkg/cmd/actions"
+	adminCmd "github.com/cli/cli/v2/pkg/cmd/admin"
 	aliasCmd "github.com/cli/cli/v2/pkg/cmd/alias"
 	apiCmd "github.com/cli/cli/v2/pkg/cmd/api"
+	appCmd "github.com/cli/cli/v2/pkg/cmd/app"
+	artifactCmd "github.com/cli/cli/v2/pkg/cmd/artifact"
 	authCmd "github.com/cli/cli/v2/pkg/cmd/auth"
 	browseCmd "github.com/cli/cli/v2/pkg/cmd/browse"
+	cacheCmd "github.com/cli/cli/v2/pkg/cmd/cache"
+	checkRunCmd "github.com/cli/cli/v2/pkg/cmd/check-run"
+	checksCmd "github.com/cli/cli/v2/pkg/cmd/checks"
+	codeScanningCmd "github.com/cli/cli/v2/pkg/cmd/codescanning"
+	fileCmd "github.com/cli/cli/v2/pkg/cmd/file"
 	codespaceCmd "github.com/cli/cli/v2/pkg/cmd/codespace"
+	commitCmd "github.com/cli/cli/v2/pkg/cmd/commit"
+	commitStatusCmd "github.com/cli/cli/v2/pkg/cmd/commit-status"
 	completionCmd "github.com/cli/cli/v2/pkg/cmd/completion"
 	configCmd "github.com/cli/cli/v2/pkg/cmd/config"
+	dashCmd "github.com/cli/cli/v2/pkg/cmd/dash"
+	discussionCmd "github.com/cli/cli/v2/pkg/cmd/discussion"
 	extensionCmd "github.com/cli/cli/v2/pkg/cmd/extension"
 	"github.com/cli/cli/v2/pkg/cmd/factory"
 	gistCmd "github.com/cli/cli/v2/pkg/cmd/gist"
 	gpgKeyCmd "github.com/cli/cli/v2/pkg/cmd/gpg-key"
+	initCmd "github.com/cli/cli/v2/pkg/cmd/init"
 	issueCmd "github.com/cli/cli/v2/pkg/cmd/issue"
 	labelCmd "github.com/cli/cli/v2/pkg/cmd/label"
+	milestoneCmd "github.com/cli/cli/v2/pkg/cmd/milestone"
+	notifyCmd "github.com/cli/cli/v2/pkg/cmd/notify"
+	orgCmd "github.com/cli/cli/v2/pkg/cmd/org"
 	prCmd "github.com/cli/cli/v2/pkg/cmd/pr"
+	projectCmd "github.com/cli/cli/v2/pkg/cmd/project"
 	releaseCmd "github.com/cli/cli/v2/pkg/cmd/release"
 	repoCmd "github.com/cli/cli/v2/pkg/cmd/repo"
 	creditsCmd "github.com/cli/cli/v2/pkg/cmd/repo/credits"
 	runCmd "github.com/cli/cli/v2/pkg/cmd/run"
 	searchCmd "github.com/cli/cli/v2/pkg/cmd/search"
 	secretCmd "github.com/cli/cli/v2/pkg/cmd/secret"
+	secretScanningCmd "github.com/cli/cli/v2/pkg/cmd/secretscanning"
+	serveCmd "github.com/cli/cli/v2/pkg/cmd/serve"
 	sshKeyCmd "github.com/cli/cli/v2/pkg/cmd/ssh-key"
+	starCmd "github.com/cli/cli/v2/pkg/cmd/star"
 	statusCmd "github.com/cli/cli/v2/pkg/cmd/status"
+	teamCmd "github.com/cli/cli/v2/pkg/cmd/team"
+	userCmd "github.com/cli/cli/v2/pkg/cmd/user"
 	versionCmd "github.com/cli/cli/v2/pkg/cmd/version"
 	workflowCmd "github.com/cli/cli/v2/pkg/cmd/workflow"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -39,8 +62,8 @@ import (
 func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "gh <command> <subcommand> [flags]",
-		Short: "GitHub CLI",
-		Long:  `Work seamlessly with GitHub from the command line.`,
+		Short: i18n.T("GitHub CLI"),
+		Long:  i18n.T("Work seamlessly with GitHub from the command line."),
 
 		SilenceErrors: true,
 		SilenceUsage:  true,
@@ -60,6 +83,12 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	cmd.SetErr(f.IOStreams.ErrOut)
 
 	cmd.PersistentFlags().Bool("help", false, "Show help for command")
+	// --log-file is handled before the command tree is built (see mainRun in cmd/gh/main.go,
+	// which sets GH_LOG_FILE from it) since the HTTP client that does the logging is constructed
+	// before flag parsing completes; it's declared here purely so it shows up in `gh help` and
+	// doesn't trip flag validation.
+	cmd.PersistentFlags().String("log-file", "", "Log HTTP traffic and other diagnostics to a file")
+	cmd.PersistentFlags().Bool("quiet", false, "Suppress non-essential output")
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
 		rootHelpFunc(f, cmd, args)
 	})
@@ -74,18 +103,26 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	// Child commands
 	cmd.AddCommand(versionCmd.NewCmdVersion(f, version, buildDate))
 	cmd.AddCommand(actionsCmd.NewCmdActions(f))
+	cmd.AddCommand(adminCmd.NewCmdAdmin(f))
 	cmd.AddCommand(aliasCmd.NewCmdAlias(f))
+	cmd.AddCommand(appCmd.NewCmdApp(f))
 	cmd.AddCommand(authCmd.NewCmdAuth(f))
 	cmd.AddCommand(configCmd.NewCmdConfig(f))
 	cmd.AddCommand(creditsCmd.NewCmdCredits(f, nil))
 	cmd.AddCommand(gistCmd.NewCmdGist(f))
 	cmd.AddCommand(gpgKeyCmd.NewCmdGPGKey(f))
+	cmd.AddCommand(initCmd.NewCmdInit(f, nil))
+	cmd.AddCommand(notifyCmd.NewCmdNotify(f))
+	cmd.AddCommand(orgCmd.NewCmdOrg(f))
 	cmd.AddCommand(completionCmd.NewCmdCompletion(f.IOStreams))
 	cmd.AddCommand(extensionCmd.NewCmdExtension(f))
 	cmd.AddCommand(searchCmd.NewCmdSearch(f))
 	cmd.AddCommand(secretCmd.NewCmdSecret(f))
 	cmd.AddCommand(sshKeyCmd.NewCmdSSHKey(f))
+	cmd.AddCommand(starCmd.NewCmdStar(f))
 	cmd.AddCommand(statusCmd.NewCmdStatus(f, nil))
+	cmd.AddCommand(teamCmd.NewCmdTeam(f))
+	cmd.AddCommand(userCmd.NewCmdUser(f))
 	cmd.AddCommand(newCodespaceCmd(f))
 
 	// the `api` command should not inherit any extra HTTP headers
@@ -98,17 +135,32 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) *cobra.Command {
 	repoResolvingCmdFactory := *f
 	repoResolvingCmdFactory.BaseRepo = factory.SmartBaseRepoFunc(f)
 
+	cmd.AddCommand(artifactCmd.NewCmdArtifact(&repoResolvingCmdFactory))
 	cmd.AddCommand(browseCmd.NewCmdBrowse(&repoResolvingCmdFactory, nil))
+	cmd.AddCommand(cacheCmd.NewCmdCache(&repoResolvingCmdFactory))
 	cmd.AddCommand(prCmd.NewCmdPR(&repoResolvingCmdFactory))
+	cmd.AddCommand(projectCmd.NewCmdProject(&repoResolvingCmdFactory))
 	cmd.AddCommand(issueCmd.NewCmdIssue(&repoResolvingCmdFactory))
 	cmd.AddCommand(releaseCmd.NewCmdRelease(&repoResolvingCmdFactory))
 	cmd.AddCommand(repoCmd.NewCmdRepo(&repoResolvingCmdFactory))
 	cmd.AddCommand(runCmd.NewCmdRun(&repoResolvingCmdFactory))
 	cmd.AddCommand(workflowCmd.NewCmdWorkflow(&repoResolvingCmdFactory))
 	cmd.AddCommand(labelCmd.NewCmdLabel(&repoResolvingCmdFactory))
+	cmd.AddCommand(milestoneCmd.NewCmdMilestone(&repoResolvingCmdFactory))
+	cmd.AddCommand(discussionCmd.NewCmdDiscussion(&repoResolvingCmdFactory))
+	cmd.AddCommand(commitCmd.NewCmdCommit(&repoResolvingCmdFactory))
+	cmd.AddCommand(commitStatusCmd.NewCmdCommitStatus(&repoResolvingCmdFactory))
+	cmd.AddCommand(checkRunCmd.NewCmdCheckRun(&repoResolvingCmdFactory))
+	cmd.AddCommand(checksCmd.NewCmdChecks(&repoResolvingCmdFactory))
+	cmd.AddCommand(codeScanningCmd.NewCmdCodeScanning(&repoResolvingCmdFactory))
+	cmd.AddCommand(secretScanningCmd.NewCmdSecretScanning(&repoResolvingCmdFactory))
+	cmd.AddCommand(fileCmd.NewCmdFile(&repoResolvingCmdFactory))
+	cmd.AddCommand(dashCmd.NewCmdDash(&repoResolvingCmdFactory, nil))
+	cmd.AddCommand(serveCmd.NewCmdServe(&repoResolvingCmdFactory, nil))
 
 	// Help topics
 	cmd.AddCommand(NewHelpTopic("environment"))
+	cmd.AddCommand(NewHelpTopic("exit-codes"))
 	cmd.AddCommand(NewHelpTopic("formatting"))
 	cmd.AddCommand(NewHelpTopic("mintty"))
 	referenceCmd := NewHelpTopic("reference")
@@ -24,11 +24,15 @@ type DownloadOptions struct {
 	RunID          string
 	DestinationDir string
 	Names          []string
+
+	FilePatterns    []string
+	ExcludePatterns []string
+	OutputToStdout  bool
 }
 
 type platform interface {
 	List(runID string) ([]shared.Artifact, error)
-	Download(url string, dir string) error
+	Download(url string, dir string, opts extractOptions) error
 }
 type prompter interface {
 	Prompt(message string, options []string, result interface{}) error
@@ -48,6 +52,10 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 			The contents of each artifact will be extracted under separate directories based on
 			the artifact name. If only a single artifact is specified, it will be extracted into
 			the current directory.
+
+			Use --include/--exclude to extract only files inside each artifact whose path matches
+			a glob pattern, and --stdout to print the single remaining matched file to standard
+			output instead of writing it to disk.
 		`),
 		Args: cobra.MaximumNArgs(1),
 		Example: heredoc.Doc(`
@@ -62,6 +70,9 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 
 		  # Select artifacts to download interactively
 		  $ gh run download
+
+		  # Print a single file from an artifact to stdout
+		  $ gh run download <run-id> -n <name> --include "report.json" --stdout
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -70,6 +81,10 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 				opts.DoPrompt = true
 			}
 
+			if opts.OutputToStdout && len(opts.Names) != 1 {
+				return cmdutil.FlagErrorf("specify exactly one `-n, --name` to use with `--stdout`")
+			}
+
 			// support `-R, --repo` override
 			baseRepo, err := f.BaseRepo()
 			if err != nil {
@@ -94,6 +109,9 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 
 	cmd.Flags().StringVarP(&opts.DestinationDir, "dir", "D", ".", "The directory to download artifacts into")
 	cmd.Flags().StringArrayVarP(&opts.Names, "name", "n", nil, "Only download artifacts that match any of the given names")
+	cmd.Flags().StringArrayVar(&opts.FilePatterns, "include", nil, "Only extract files from artifacts whose path matches a glob `pattern`")
+	cmd.Flags().StringArrayVar(&opts.ExcludePatterns, "exclude", nil, "Skip extracting files from artifacts whose path matches a glob `pattern`")
+	cmd.Flags().BoolVar(&opts.OutputToStdout, "stdout", false, "Print the contents of a single matching file to stdout instead of extracting it")
 
 	return cmd
 }
@@ -141,6 +159,14 @@ func runDownload(opts *DownloadOptions) error {
 	opts.IO.StartProgressIndicator()
 	defer opts.IO.StopProgressIndicator()
 
+	extractOpts := extractOptions{
+		includePatterns: opts.FilePatterns,
+		excludePatterns: opts.ExcludePatterns,
+	}
+	if opts.OutputToStdout {
+		extractOpts.toStdout = opts.IO.Out
+	}
+
 	// track downloaded artifacts and avoid re-downloading any of the same name
 	downloaded := set.NewStringSet()
 	for _, a := range artifacts {
@@ -157,7 +183,7 @@ func runDownload(opts *DownloadOptions) error {
 		if len(wantNames) != 1 {
 			destDir = filepath.Join(destDir, a.Name)
 		}
-		err := opts.Platform.Download(a.DownloadURL, destDir)
+		err := opts.Platform.Download(a.DownloadURL, destDir, extractOpts)
 		if err != nil {
 			return fmt.Errorf("error downloading %s: %w", a.Name, err)
 		}
@@ -69,6 +69,23 @@ func Test_NewCmdDownload(t *testing.T) {
 				DestinationDir: ".",
 			},
 		},
+		{
+			name:  "stdout with one name",
+			args:  "2345 -n one --include *.json --stdout",
+			isTTY: true,
+			want: DownloadOptions{
+				RunID:          "2345",
+				DoPrompt:       false,
+				Names:          []string{"one"},
+				DestinationDir: ".",
+			},
+		},
+		{
+			name:    "stdout without exactly one name",
+			args:    "2345 --stdout",
+			isTTY:   true,
+			wantErr: "specify exactly one `-n, --name` to use with `--stdout`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -151,8 +168,8 @@ func Test_runDownload(t *testing.T) {
 						Expired:     false,
 					},
 				}, nil)
-				p.On("Download", "http://download.com/artifact1.zip", filepath.FromSlash("tmp/artifact-1")).Return(nil)
-				p.On("Download", "http://download.com/artifact2.zip", filepath.FromSlash("tmp/artifact-2")).Return(nil)
+				p.On("Download", "http://download.com/artifact1.zip", filepath.FromSlash("tmp/artifact-1"), extractOptions{}).Return(nil)
+				p.On("Download", "http://download.com/artifact2.zip", filepath.FromSlash("tmp/artifact-2"), extractOptions{}).Return(nil)
 			},
 		},
 		{
@@ -232,7 +249,7 @@ func Test_runDownload(t *testing.T) {
 						Expired:     false,
 					},
 				}, nil)
-				p.On("Download", "http://download.com/artifact2.zip", ".").Return(nil)
+				p.On("Download", "http://download.com/artifact2.zip", ".", extractOptions{}).Return(nil)
 			},
 			mockPrompt: func(p *mockPrompter) {
 				p.On("Prompt", "Select artifacts to download:", []string{"artifact-1", "artifact-2"}, mock.AnythingOfType("*[]string")).
@@ -286,8 +303,8 @@ func (p *mockPlatform) List(runID string) ([]shared.Artifact, error) {
 	return args.Get(0).([]shared.Artifact), args.Error(1)
 }
 
-func (p *mockPlatform) Download(url string, dir string) error {
-	args := p.Called(url, dir)
+func (p *mockPlatform) Download(url string, dir string, opts extractOptions) error {
+	args := p.Called(url, dir, opts)
 	return args.Error(0)
 }
 
@@ -22,11 +22,11 @@ func (p *apiPlatform) List(runID string) ([]shared.Artifact, error) {
 	return shared.ListArtifacts(p.client, p.repo, runID)
 }
 
-func (p *apiPlatform) Download(url string, dir string) error {
-	return downloadArtifact(p.client, url, dir)
+func (p *apiPlatform) Download(url string, dir string, opts extractOptions) error {
+	return downloadArtifact(p.client, url, dir, opts)
 }
 
-func downloadArtifact(httpClient *http.Client, url, destDir string) error {
+func downloadArtifact(httpClient *http.Client, url, destDir string, opts extractOptions) error {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return err
@@ -62,7 +62,7 @@ func downloadArtifact(httpClient *http.Client, url, destDir string) error {
 	if err != nil {
 		return fmt.Errorf("error extracting zip archive: %w", err)
 	}
-	if err := extractZip(zipfile, destDir); err != nil {
+	if err := extractZip(zipfile, destDir, opts); err != nil {
 		return fmt.Errorf("error extracting zip archive: %w", err)
 	}
 
@@ -2,6 +2,7 @@ package download
 
 import (
 	"archive/zip"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -15,8 +16,30 @@ const (
 	execMode os.FileMode = 0755
 )
 
-func extractZip(zr *zip.Reader, destDir string) error {
+// extractOptions narrows down which files inside an artifact get written out,
+// and optionally redirects a single matching file to toStdout instead of disk.
+type extractOptions struct {
+	includePatterns []string
+	excludePatterns []string
+	toStdout        io.Writer
+}
+
+func extractZip(zr *zip.Reader, destDir string, opts extractOptions) error {
+	if opts.toStdout != nil {
+		return extractZipToStdout(zr, opts)
+	}
+
 	for _, zf := range zr.File {
+		if !zf.Mode().IsDir() {
+			matched, err := matchesFilters(zf.Name, opts)
+			if err != nil {
+				return err
+			}
+			if !matched {
+				continue
+			}
+		}
+
 		fpath := filepath.Join(destDir, filepath.FromSlash(zf.Name))
 		if !filepathDescendsFrom(fpath, destDir) {
 			continue
@@ -28,6 +51,79 @@ func extractZip(zr *zip.Reader, destDir string) error {
 	return nil
 }
 
+// extractZipToStdout finds the single file matching opts' filters and streams
+// it to opts.toStdout. It errors without writing anything if zero or more
+// than one file matches, so a failed match never leaves partial output behind.
+func extractZipToStdout(zr *zip.Reader, opts extractOptions) error {
+	var match *zip.File
+	for _, zf := range zr.File {
+		if zf.Mode().IsDir() {
+			continue
+		}
+		matched, err := matchesFilters(zf.Name, opts)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			continue
+		}
+		if match != nil {
+			return errors.New("multiple files match; --stdout requires exactly one match")
+		}
+		match = zf
+	}
+	if match == nil {
+		return errors.New("no file inside the artifact matches the given pattern")
+	}
+	if err := streamZipFile(match, opts.toStdout); err != nil {
+		return fmt.Errorf("error extracting %q: %w", match.Name, err)
+	}
+	return nil
+}
+
+// matchesFilters reports whether name should be extracted: it must match at
+// least one include pattern (if any were given) and none of the exclude
+// patterns.
+func matchesFilters(name string, opts extractOptions) (bool, error) {
+	if len(opts.includePatterns) > 0 {
+		included := false
+		for _, p := range opts.includePatterns {
+			ok, err := filepath.Match(p, name)
+			if err != nil {
+				return false, fmt.Errorf("invalid --include pattern: %w", err)
+			}
+			if ok {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false, nil
+		}
+	}
+	for _, p := range opts.excludePatterns {
+		ok, err := filepath.Match(p, name)
+		if err != nil {
+			return false, fmt.Errorf("invalid --exclude pattern: %w", err)
+		}
+		if ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func streamZipFile(zf *zip.File, w io.Writer) error {
+	f, err := zf.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
 func extractZipFile(zf *zip.File, dest string) error {
 	zm := zf.Mode()
 	if zm.IsDir() {
@@ -2,10 +2,12 @@ package download
 
 import (
 	"archive/zip"
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,13 +26,66 @@ func Test_extractZip(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, os.Chdir(extractPath))
 
-	err = extractZip(&zipFile.Reader, ".")
+	err = extractZip(&zipFile.Reader, ".", extractOptions{})
 	require.NoError(t, err)
 
 	_, err = os.Stat(filepath.Join("src", "main.go"))
 	require.NoError(t, err)
 }
 
+func Test_extractZip_includeExclude(t *testing.T) {
+	tmpDir := t.TempDir()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	zipFile, err := zip.OpenReader("./fixtures/myproject.zip")
+	require.NoError(t, err)
+	defer zipFile.Close()
+
+	require.NoError(t, os.Chdir(tmpDir))
+
+	err = extractZip(&zipFile.Reader, ".", extractOptions{
+		includePatterns: []string{"src/*"},
+		excludePatterns: []string{"src/util.go"},
+	})
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join("src", "main.go"))
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join("src", "util.go"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat("readme.md")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func Test_extractZip_toStdout(t *testing.T) {
+	zipFile, err := zip.OpenReader("./fixtures/myproject.zip")
+	require.NoError(t, err)
+	defer zipFile.Close()
+
+	var out bytes.Buffer
+	err = extractZip(&zipFile.Reader, ".", extractOptions{
+		includePatterns: []string{"readme.md"},
+		toStdout:        &out,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, out.String())
+}
+
+func Test_extractZip_toStdout_multipleMatches(t *testing.T) {
+	zipFile, err := zip.OpenReader("./fixtures/myproject.zip")
+	require.NoError(t, err)
+	defer zipFile.Close()
+
+	var out bytes.Buffer
+	err = extractZip(&zipFile.Reader, ".", extractOptions{
+		includePatterns: []string{"src/*"},
+		toStdout:        &out,
+	})
+	require.EqualError(t, err, "multiple files match; --stdout requires exactly one match")
+}
+
 func Test_filepathDescendsFrom(t *testing.T) {
 	type args struct {
 		p   string
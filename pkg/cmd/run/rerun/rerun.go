@@ -4,23 +4,31 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"path/filepath"
+	"time"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
 	"github.com/spf13/cobra"
 )
 
+const defaultWatchInterval = 3 * time.Second
+
 type RerunOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 
-	RunID      string
-	OnlyFailed bool
-	JobID      string
+	RunID          string
+	OnlyFailed     bool
+	JobID          string
+	JobNamePattern string
+	Watch          bool
 
 	Prompt bool
 }
@@ -34,7 +42,15 @@ func NewCmdRerun(f *cmdutil.Factory, runF func(*RerunOptions) error) *cobra.Comm
 	cmd := &cobra.Command{
 		Use:   "rerun [<run-id>]",
 		Short: "Rerun a failed run",
-		Args:  cobra.MaximumNArgs(1),
+		Long: heredoc.Doc(`
+			Rerun a failed run.
+
+			Without an argument, the run to rerun is selected from a list of recent failed runs.
+
+			Use --job-name to rerun only the failed jobs whose name matches a pattern, instead
+			of every failed job in the run.
+		`),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
@@ -53,6 +69,10 @@ func NewCmdRerun(f *cmdutil.Factory, runF func(*RerunOptions) error) *cobra.Comm
 				return cmdutil.FlagErrorf("specify only one of `<run-id>` or `--job`")
 			}
 
+			if opts.JobNamePattern != "" && opts.JobID != "" {
+				return cmdutil.FlagErrorf("specify only one of `--job-name` or `--job`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -62,6 +82,8 @@ func NewCmdRerun(f *cmdutil.Factory, runF func(*RerunOptions) error) *cobra.Comm
 
 	cmd.Flags().BoolVar(&opts.OnlyFailed, "failed", false, "Rerun only failed jobs, including dependencies")
 	cmd.Flags().StringVarP(&opts.JobID, "job", "j", "", "Rerun a specific job from a run, including dependencies")
+	cmd.Flags().StringVar(&opts.JobNamePattern, "job-name", "", "Rerun only failed jobs whose name matches this `pattern`")
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Watch the run until it completes, showing its progress")
 
 	return cmd
 }
@@ -115,6 +137,8 @@ func runRerun(opts *RerunOptions) error {
 		}
 	}
 
+	var runToWatch *shared.Run
+
 	if opts.JobID != "" {
 		err = rerunJob(client, repo, selectedJob)
 		if err != nil {
@@ -133,21 +157,131 @@ func runRerun(opts *RerunOptions) error {
 		if err != nil {
 			return fmt.Errorf("failed to get run: %w", err)
 		}
+		runToWatch = run
 
-		err = rerunRun(client, repo, run, opts.OnlyFailed)
-		if err != nil {
-			return err
+		if opts.JobNamePattern != "" {
+			matched, err := rerunFailedJobsByName(client, repo, run, opts.JobNamePattern)
+			if err != nil {
+				return err
+			}
+			if opts.IO.IsStdoutTTY() {
+				fmt.Fprintf(opts.IO.Out, "%s Requested rerun of %s matching %q on run %s\n",
+					cs.SuccessIcon(),
+					utils.Pluralize(len(matched), "job"),
+					opts.JobNamePattern,
+					cs.Cyanf("%d", run.ID))
+			}
+		} else {
+			err = rerunRun(client, repo, run, opts.OnlyFailed)
+			if err != nil {
+				return err
+			}
+			if opts.IO.IsStdoutTTY() {
+				onlyFailedMsg := ""
+				if opts.OnlyFailed {
+					onlyFailedMsg = "(failed jobs) "
+				}
+				fmt.Fprintf(opts.IO.Out, "%s Requested rerun %sof run %s\n",
+					cs.SuccessIcon(),
+					onlyFailedMsg,
+					cs.Cyanf("%d", run.ID))
+			}
 		}
-		if opts.IO.IsStdoutTTY() {
-			onlyFailedMsg := ""
-			if opts.OnlyFailed {
-				onlyFailedMsg = "(failed jobs) "
+	}
+
+	if opts.Watch {
+		if runToWatch == nil {
+			opts.IO.StartProgressIndicator()
+			runToWatch, err = shared.GetRun(client, repo, fmt.Sprintf("%d", selectedJob.RunID))
+			opts.IO.StopProgressIndicator()
+			if err != nil {
+				return fmt.Errorf("failed to get run: %w", err)
 			}
-			fmt.Fprintf(opts.IO.Out, "%s Requested rerun %sof run %s\n",
-				cs.SuccessIcon(),
-				onlyFailedMsg,
-				cs.Cyanf("%d", run.ID))
 		}
+		return watchRun(opts, client, repo, runToWatch)
+	}
+
+	return nil
+}
+
+// rerunFailedJobsByName reruns every failed job in run whose name matches pattern,
+// returning the jobs that were rerun.
+func rerunFailedJobsByName(client *api.Client, repo ghrepo.Interface, run *shared.Run, pattern string) ([]shared.Job, error) {
+	jobs, err := shared.GetJobs(client, repo, *run)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jobs: %w", err)
+	}
+
+	var matched []shared.Job
+	for _, job := range jobs {
+		if !shared.IsFailureState(job.Conclusion) {
+			continue
+		}
+		isMatch, err := filepath.Match(pattern, job.Name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid job name pattern: %w", err)
+		}
+		if isMatch {
+			matched = append(matched, job)
+		}
+	}
+
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("no failed jobs matching %q were found in run %d", pattern, run.ID)
+	}
+
+	for i := range matched {
+		if err := rerunJob(client, repo, &matched[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return matched, nil
+}
+
+// watchRun polls run until it completes, printing its progress.
+func watchRun(opts *RerunOptions, client *api.Client, repo ghrepo.Interface, run *shared.Run) error {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	if err := opts.IO.EnableVirtualTerminalProcessing(); err != nil {
+		return err
+	}
+
+	for run.Status != shared.Completed {
+		var err error
+		run, err = shared.GetRun(client, repo, fmt.Sprintf("%d", run.ID))
+		if err != nil {
+			return fmt.Errorf("failed to get run: %w", err)
+		}
+
+		jobs, err := shared.GetJobs(client, repo, *run)
+		if err != nil {
+			return fmt.Errorf("failed to get jobs: %w", err)
+		}
+
+		shared.RefreshScreen(out)
+		fmt.Fprintln(out, cs.Boldf("Refreshing run status every %.0f seconds. Press Ctrl+C to quit.", defaultWatchInterval.Seconds()))
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, shared.RenderRunHeader(cs, *run, "", ""))
+		fmt.Fprintln(out)
+
+		if len(jobs) > 0 {
+			fmt.Fprintln(out, cs.Bold("JOBS"))
+			fmt.Fprintln(out, shared.RenderJobs(cs, jobs, true))
+		}
+
+		if run.Status == shared.Completed {
+			break
+		}
+
+		time.Sleep(defaultWatchInterval)
+	}
+
+	symbol, symbolColor := shared.Symbol(cs, run.Status, run.Conclusion)
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "%s Run %s completed with '%s'\n", symbolColor(symbol), cs.Cyanf("%d", run.ID), run.Conclusion)
 	}
 
 	return nil
@@ -92,6 +92,12 @@ func TestNewCmdRerun(t *testing.T) {
 			cli:      "--job",
 			wantsErr: true,
 		},
+		{
+			name:     "with job and job-name fails",
+			tty:      true,
+			cli:      "--job 1234 --job-name sad",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -235,6 +241,44 @@ func TestRerun(t *testing.T) {
 			wantErr: true,
 			errOut:  "no recent runs have failed; please specify a specific `<run-id>`",
 		},
+		{
+			name: "with job name pattern",
+			tty:  true,
+			opts: &RerunOptions{
+				RunID:          "1234",
+				JobNamePattern: "sad *",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("GET", "runs/1234/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{Jobs: []shared.Job{shared.FailedJob}}))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/jobs/20/rerun"),
+					httpmock.StringResponse("{}"))
+			},
+			wantOut: "✓ Requested rerun of 1 job matching \"sad *\" on run 1234\n",
+		},
+		{
+			name: "with job name pattern matching nothing",
+			tty:  true,
+			opts: &RerunOptions{
+				RunID:          "1234",
+				JobNamePattern: "nope *",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("GET", "runs/1234/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{Jobs: []shared.Job{shared.FailedJob}}))
+			},
+			wantErr: true,
+			errOut:  `no failed jobs matching "nope *" were found in run 1234`,
+		},
 		{
 			name: "unrerunnable",
 			tty:  true,
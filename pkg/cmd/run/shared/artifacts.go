@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghinstance"
@@ -11,10 +12,12 @@ import (
 )
 
 type Artifact struct {
-	Name        string `json:"name"`
-	Size        uint64 `json:"size_in_bytes"`
-	DownloadURL string `json:"archive_download_url"`
-	Expired     bool   `json:"expired"`
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Size        uint64    `json:"size_in_bytes"`
+	DownloadURL string    `json:"archive_download_url"`
+	Expired     bool      `json:"expired"`
+	CreatedAt   time.Time `json:"created_at"`
 }
 
 func ListArtifacts(httpClient *http.Client, repo ghrepo.Interface, runID string) ([]Artifact, error) {
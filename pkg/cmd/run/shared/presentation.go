@@ -2,11 +2,28 @@ package shared
 
 import (
 	"fmt"
+	"io"
+	"runtime"
+	"sort"
 	"strings"
 
 	"github.com/cli/cli/v2/pkg/iostreams"
 )
 
+// RefreshScreen clears the terminal so a polling command like `gh run watch` or
+// `gh pr checks --watch` can redraw its output in place on every refresh.
+func RefreshScreen(w io.Writer) {
+	if runtime.GOOS == "windows" {
+		// Just clear whole screen; I wasn't able to get the nicer cursor movement thing working
+		fmt.Fprintf(w, "\x1b[2J")
+	} else {
+		// Move cursor to 0,0
+		fmt.Fprint(w, "\x1b[0;0H")
+		// Clear from cursor to bottom of screen
+		fmt.Fprint(w, "\x1b[J")
+	}
+}
+
 func RenderRunHeader(cs *iostreams.ColorScheme, run Run, ago, prNumber string) string {
 	title := fmt.Sprintf("%s %s%s",
 		cs.Bold(run.HeadBranch), run.Name, prNumber)
@@ -52,3 +69,33 @@ func RenderAnnotations(cs *iostreams.ColorScheme, annotations []Annotation) stri
 
 	return strings.Join(lines, "\n")
 }
+
+// RenderAnnotationsGroupedByFile renders annotations under a heading for each
+// file they were reported against, so that problems in the same file are
+// read together instead of interleaved by job.
+func RenderAnnotationsGroupedByFile(cs *iostreams.ColorScheme, annotations []Annotation) string {
+	var paths []string
+	byPath := map[string][]Annotation{}
+	for _, a := range annotations {
+		if _, ok := byPath[a.Path]; !ok {
+			paths = append(paths, a.Path)
+		}
+		byPath[a.Path] = append(byPath[a.Path], a)
+	}
+	sort.Strings(paths)
+
+	lines := []string{}
+	for _, path := range paths {
+		if path == "" {
+			lines = append(lines, cs.Bold("(no file)"))
+		} else {
+			lines = append(lines, cs.Bold(path))
+		}
+		for _, a := range byPath[path] {
+			lines = append(lines, fmt.Sprintf("  %s #%d %s", AnnotationSymbol(cs, a), a.StartLine, a.Message))
+			lines = append(lines, cs.Grayf("  %s\n", a.JobName))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
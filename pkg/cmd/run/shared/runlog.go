@@ -0,0 +1,160 @@
+package shared
+
+import (
+	"archive/zip"
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// RunLogCache stores and retrieves a run's downloaded log archive on disk, keyed by path.
+type RunLogCache interface {
+	Exists(string) bool
+	Create(string, io.ReadCloser) error
+	Open(string) (*zip.ReadCloser, error)
+}
+
+// RunLogCacheDir is a RunLogCache backed by a directory on disk.
+type RunLogCacheDir struct{}
+
+func (RunLogCacheDir) Exists(path string) bool {
+	if _, err := os.Stat(path); err != nil {
+		return false
+	}
+	return true
+}
+
+func (RunLogCacheDir) Create(path string, content io.ReadCloser) error {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return fmt.Errorf("could not create cache: %w", err)
+	}
+
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, content)
+	return err
+}
+
+func (RunLogCacheDir) Open(path string) (*zip.ReadCloser, error) {
+	return zip.OpenReader(path)
+}
+
+func getLog(httpClient *http.Client, logURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequest("GET", logURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == 404 {
+		return nil, errors.New("log not found")
+	} else if resp.StatusCode != 200 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	return resp.Body, nil
+}
+
+// GetRunLog downloads (or reads from cache) the zip archive of logs for run.
+func GetRunLog(cache RunLogCache, httpClient *http.Client, repo ghrepo.Interface, run *Run) (*zip.ReadCloser, error) {
+	filename := fmt.Sprintf("run-log-%d-%d.zip", run.ID, run.CreatedAt.Unix())
+	filepath := filepath.Join(os.TempDir(), "gh-cli-cache", filename)
+	if !cache.Exists(filepath) {
+		// Run log does not exist in cache so retrieve and store it
+		logURL := fmt.Sprintf("%srepos/%s/actions/runs/%d/logs",
+			ghinstance.RESTPrefix(repo.RepoHost()), ghrepo.FullName(repo), run.ID)
+
+		resp, err := getLog(httpClient, logURL)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Close()
+
+		err = cache.Create(filepath, resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cache.Open(filepath)
+}
+
+func logFilenameRegexp(job Job, step Step) *regexp.Regexp {
+	re := fmt.Sprintf(`%s\/%d_.*\.txt`, regexp.QuoteMeta(job.Name), step.Number)
+	return regexp.MustCompile(re)
+}
+
+// AttachRunLog takes a zip file of logs and a list of jobs.
+// Structure of zip file
+// zip/
+// ├── jobname1/
+// │   ├── 1_stepname.txt
+// │   ├── 2_anotherstepname.txt
+// │   ├── 3_stepstepname.txt
+// │   └── 4_laststepname.txt
+// └── jobname2/
+//     ├── 1_stepname.txt
+//     └── 2_somestepname.txt
+// It iterates through the list of jobs and trys to find the matching
+// log in the zip file. If the matching log is found it is attached
+// to the job.
+func AttachRunLog(rlz *zip.ReadCloser, jobs []Job) {
+	for i, job := range jobs {
+		for j, step := range job.Steps {
+			re := logFilenameRegexp(job, step)
+			for _, file := range rlz.File {
+				if re.MatchString(file.Name) {
+					jobs[i].Steps[j].Log = file
+					break
+				}
+			}
+		}
+	}
+}
+
+// DisplayRunLog writes the logs attached to jobs to w, one line at a time prefixed with the
+// job and step name. When failed is true, only steps that did not succeed are included.
+func DisplayRunLog(w io.Writer, jobs []Job, failed bool) error {
+	for _, job := range jobs {
+		steps := job.Steps
+		sort.Sort(steps)
+		for _, step := range steps {
+			if failed && !IsFailureState(step.Conclusion) {
+				continue
+			}
+			if step.Log == nil {
+				continue
+			}
+			prefix := fmt.Sprintf("%s\t%s\t", job.Name, step.Name)
+			f, err := step.Log.Open()
+			if err != nil {
+				return err
+			}
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				fmt.Fprintf(w, "%s%s\n", prefix, scanner.Text())
+			}
+			f.Close()
+		}
+	}
+
+	return nil
+}
@@ -55,6 +55,7 @@ var RunFields = []string{
 	"databaseId",
 	"workflowDatabaseId",
 	"url",
+	"annotations",
 }
 
 type Run struct {
@@ -72,6 +73,10 @@ type Run struct {
 	HeadSha        string `json:"head_sha"`
 	URL            string `json:"html_url"`
 	HeadRepository Repo   `json:"head_repository"`
+
+	// Annotations is populated by the caller, via GetAnnotations, before
+	// ExportData is invoked; it is not part of the workflow run API response.
+	Annotations []Annotation `json:"-"`
 }
 
 type Repo struct {
@@ -109,6 +114,8 @@ func (r *Run) ExportData(fields []string) map[string]interface{} {
 			data[f] = r.ID
 		case "workflowDatabaseId":
 			data[f] = r.WorkflowID
+		case "annotations":
+			data[f] = r.Annotations
 		default:
 			sf := fieldByName(v, f)
 			data[f] = sf.Interface()
@@ -145,11 +152,11 @@ func (s Steps) Less(i, j int) bool { return s[i].Number < s[j].Number }
 func (s Steps) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 
 type Annotation struct {
-	JobName   string
-	Message   string
-	Path      string
-	Level     Level `json:"annotation_level"`
-	StartLine int   `json:"start_line"`
+	JobName   string `json:"jobName"`
+	Message   string `json:"message"`
+	Path      string `json:"path"`
+	Level     Level  `json:"annotation_level"`
+	StartLine int    `json:"start_line"`
 }
 
 func AnnotationSymbol(cs *iostreams.ColorScheme, a Annotation) string {
@@ -1,28 +1,20 @@
 package view
 
 import (
-	"archive/zip"
-	"bufio"
-	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"os"
-	"path/filepath"
-	"regexp"
-	"sort"
 	"strconv"
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
-	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/cli/cli/v2/pkg/set"
 	"github.com/cli/cli/v2/utils"
 	"github.com/spf13/cobra"
 )
@@ -31,52 +23,21 @@ type browser interface {
 	Browse(string) error
 }
 
-type runLogCache interface {
-	Exists(string) bool
-	Create(string, io.ReadCloser) error
-	Open(string) (*zip.ReadCloser, error)
-}
-
-type rlc struct{}
-
-func (rlc) Exists(path string) bool {
-	if _, err := os.Stat(path); err != nil {
-		return false
-	}
-	return true
-}
-func (rlc) Create(path string, content io.ReadCloser) error {
-	err := os.MkdirAll(filepath.Dir(path), 0755)
-	if err != nil {
-		return fmt.Errorf("could not create cache: %w", err)
-	}
-
-	out, err := os.Create(path)
-	if err != nil {
-		return err
-	}
-	defer out.Close()
-	_, err = io.Copy(out, content)
-	return err
-}
-func (rlc) Open(path string) (*zip.ReadCloser, error) {
-	return zip.OpenReader(path)
-}
-
 type ViewOptions struct {
 	HttpClient  func() (*http.Client, error)
 	IO          *iostreams.IOStreams
 	BaseRepo    func() (ghrepo.Interface, error)
 	Browser     browser
-	RunLogCache runLogCache
+	RunLogCache shared.RunLogCache
 
-	RunID      string
-	JobID      string
-	Verbose    bool
-	ExitStatus bool
-	Log        bool
-	LogFailed  bool
-	Web        bool
+	RunID       string
+	JobID       string
+	Verbose     bool
+	ExitStatus  bool
+	Log         bool
+	LogFailed   bool
+	Web         bool
+	Annotations bool
 
 	Prompt   bool
 	Exporter cmdutil.Exporter
@@ -90,7 +51,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 		HttpClient:  f.HttpClient,
 		Now:         time.Now,
 		Browser:     f.Browser,
-		RunLogCache: rlc{},
+		RunLogCache: shared.RunLogCacheDir{},
 	}
 
 	cmd := &cobra.Command{
@@ -112,6 +73,9 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 			# Exit non-zero if a run failed
 			$ gh run view 0451 --exit-status && echo "run pending or passed"
+
+			# View annotations for a specific run
+			$ gh run view 12345 --annotations
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
@@ -143,6 +107,10 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				return cmdutil.FlagErrorf("specify only one of --log or --log-failed")
 			}
 
+			if opts.Annotations && (opts.Web || opts.Log || opts.LogFailed) {
+				return cmdutil.FlagErrorf("specify only one of --annotations, --web, --log, or --log-failed")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -156,6 +124,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	cmd.Flags().BoolVar(&opts.Log, "log", false, "View full log for either a run or specific job")
 	cmd.Flags().BoolVar(&opts.LogFailed, "log-failed", false, "View the log for any failed steps in a run or specific job")
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open run in the browser")
+	cmd.Flags().BoolVar(&opts.Annotations, "annotations", false, "Display annotations for the run, grouped by file")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.RunFields)
 
 	return cmd
@@ -236,6 +205,36 @@ func runView(opts *ViewOptions) error {
 		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
 	}
 
+	wantsAnnotations := opts.Annotations || (opts.Exporter == nil && !opts.Web && !opts.Log && !opts.LogFailed)
+	if opts.Exporter != nil {
+		fields := set.NewStringSet()
+		fields.AddValues(opts.Exporter.Fields())
+		wantsAnnotations = fields.Contains("annotations")
+	}
+
+	if wantsAnnotations || (!opts.Web && (opts.Exporter == nil || opts.Log || opts.LogFailed)) {
+		if selectedJob == nil && len(jobs) == 0 {
+			opts.IO.StartProgressIndicator()
+			jobs, err = shared.GetJobs(client, repo, *run)
+			opts.IO.StopProgressIndicator()
+			if err != nil {
+				return fmt.Errorf("failed to get jobs: %w", err)
+			}
+		} else if selectedJob != nil {
+			jobs = []shared.Job{*selectedJob}
+		}
+	}
+
+	if wantsAnnotations {
+		opts.IO.StartProgressIndicator()
+		annotations, annotationErr := getAnnotations(client, repo, jobs)
+		opts.IO.StopProgressIndicator()
+		if annotationErr != nil {
+			return fmt.Errorf("failed to get annotations: %w", annotationErr)
+		}
+		run.Annotations = annotations
+	}
+
 	if opts.Exporter != nil {
 		return opts.Exporter.Write(opts.IO, run)
 	}
@@ -252,15 +251,13 @@ func runView(opts *ViewOptions) error {
 		return opts.Browser.Browse(url)
 	}
 
-	if selectedJob == nil && len(jobs) == 0 {
-		opts.IO.StartProgressIndicator()
-		jobs, err = shared.GetJobs(client, repo, *run)
-		opts.IO.StopProgressIndicator()
-		if err != nil {
-			return fmt.Errorf("failed to get jobs: %w", err)
+	if opts.Annotations {
+		if len(run.Annotations) == 0 {
+			fmt.Fprintln(opts.IO.Out, "no annotations found")
+			return nil
 		}
-	} else if selectedJob != nil {
-		jobs = []shared.Job{*selectedJob}
+		fmt.Fprintln(opts.IO.Out, shared.RenderAnnotationsGroupedByFile(cs, run.Annotations))
+		return nil
 	}
 
 	if opts.Log || opts.LogFailed {
@@ -273,16 +270,16 @@ func runView(opts *ViewOptions) error {
 		}
 
 		opts.IO.StartProgressIndicator()
-		runLogZip, err := getRunLog(opts.RunLogCache, httpClient, repo, run)
+		runLogZip, err := shared.GetRunLog(opts.RunLogCache, httpClient, repo, run)
 		opts.IO.StopProgressIndicator()
 		if err != nil {
 			return fmt.Errorf("failed to get run log: %w", err)
 		}
 		defer runLogZip.Close()
 
-		attachRunLog(runLogZip, jobs)
+		shared.AttachRunLog(runLogZip, jobs)
 
-		return displayRunLog(opts.IO.Out, jobs, opts.LogFailed)
+		return shared.DisplayRunLog(opts.IO.Out, jobs, opts.LogFailed)
 	}
 
 	prNumber := ""
@@ -299,23 +296,7 @@ func runView(opts *ViewOptions) error {
 		}
 	}
 
-	var annotations []shared.Annotation
-
-	var annotationErr error
-	var as []shared.Annotation
-	for _, job := range jobs {
-		as, annotationErr = shared.GetAnnotations(client, repo, job)
-		if annotationErr != nil {
-			break
-		}
-		annotations = append(annotations, as...)
-	}
-
-	opts.IO.StopProgressIndicator()
-
-	if annotationErr != nil {
-		return fmt.Errorf("failed to get annotations: %w", annotationErr)
-	}
+	annotations := run.Annotations
 
 	out := opts.IO.Out
 
@@ -395,49 +376,6 @@ func runView(opts *ViewOptions) error {
 	return nil
 }
 
-func getLog(httpClient *http.Client, logURL string) (io.ReadCloser, error) {
-	req, err := http.NewRequest("GET", logURL, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode == 404 {
-		return nil, errors.New("log not found")
-	} else if resp.StatusCode != 200 {
-		return nil, api.HandleHTTPError(resp)
-	}
-
-	return resp.Body, nil
-}
-
-func getRunLog(cache runLogCache, httpClient *http.Client, repo ghrepo.Interface, run *shared.Run) (*zip.ReadCloser, error) {
-	filename := fmt.Sprintf("run-log-%d-%d.zip", run.ID, run.CreatedAt.Unix())
-	filepath := filepath.Join(os.TempDir(), "gh-cli-cache", filename)
-	if !cache.Exists(filepath) {
-		// Run log does not exist in cache so retrieve and store it
-		logURL := fmt.Sprintf("%srepos/%s/actions/runs/%d/logs",
-			ghinstance.RESTPrefix(repo.RepoHost()), ghrepo.FullName(repo), run.ID)
-
-		resp, err := getLog(httpClient, logURL)
-		if err != nil {
-			return nil, err
-		}
-		defer resp.Close()
-
-		err = cache.Create(filepath, resp)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return cache.Open(filepath)
-}
-
 func promptForJob(cs *iostreams.ColorScheme, jobs []shared.Job) (*shared.Job, error) {
 	candidates := []string{"View all jobs in this run"}
 	for _, job := range jobs {
@@ -463,62 +401,14 @@ func promptForJob(cs *iostreams.ColorScheme, jobs []shared.Job) (*shared.Job, er
 	return nil, nil
 }
 
-func logFilenameRegexp(job shared.Job, step shared.Step) *regexp.Regexp {
-	re := fmt.Sprintf(`%s\/%d_.*\.txt`, regexp.QuoteMeta(job.Name), step.Number)
-	return regexp.MustCompile(re)
-}
-
-// This function takes a zip file of logs and a list of jobs.
-// Structure of zip file
-// zip/
-// ├── jobname1/
-// │   ├── 1_stepname.txt
-// │   ├── 2_anotherstepname.txt
-// │   ├── 3_stepstepname.txt
-// │   └── 4_laststepname.txt
-// └── jobname2/
-//     ├── 1_stepname.txt
-//     └── 2_somestepname.txt
-// It iterates through the list of jobs and trys to find the matching
-// log in the zip file. If the matching log is found it is attached
-// to the job.
-func attachRunLog(rlz *zip.ReadCloser, jobs []shared.Job) {
-	for i, job := range jobs {
-		for j, step := range job.Steps {
-			re := logFilenameRegexp(job, step)
-			for _, file := range rlz.File {
-				if re.MatchString(file.Name) {
-					jobs[i].Steps[j].Log = file
-					break
-				}
-			}
-		}
-	}
-}
-
-func displayRunLog(w io.Writer, jobs []shared.Job, failed bool) error {
+func getAnnotations(client *api.Client, repo ghrepo.Interface, jobs []shared.Job) ([]shared.Annotation, error) {
+	var annotations []shared.Annotation
 	for _, job := range jobs {
-		steps := job.Steps
-		sort.Sort(steps)
-		for _, step := range steps {
-			if failed && !shared.IsFailureState(step.Conclusion) {
-				continue
-			}
-			if step.Log == nil {
-				continue
-			}
-			prefix := fmt.Sprintf("%s\t%s\t", job.Name, step.Name)
-			f, err := step.Log.Open()
-			if err != nil {
-				return err
-			}
-			scanner := bufio.NewScanner(f)
-			for scanner.Scan() {
-				fmt.Fprintf(w, "%s%s\n", prefix, scanner.Text())
-			}
-			f.Close()
+		as, err := shared.GetAnnotations(client, repo, job)
+		if err != nil {
+			return nil, err
 		}
+		annotations = append(annotations, as...)
 	}
-
-	return nil
+	return annotations, nil
 }
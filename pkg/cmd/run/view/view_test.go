@@ -69,6 +69,20 @@ func TestNewCmdView(t *testing.T) {
 			cli:      "--log --log-failed",
 			wantsErr: true,
 		},
+		{
+			name:     "disallow annotations and web",
+			tty:      true,
+			cli:      "--annotations --web",
+			wantsErr: true,
+		},
+		{
+			name: "annotations passed",
+			cli:  "1234 --annotations",
+			wants: ViewOptions{
+				RunID:       "1234",
+				Annotations: true,
+			},
+		},
 		{
 			name: "exit status",
 			cli:  "--exit-status 1234",
@@ -153,6 +167,7 @@ func TestNewCmdView(t *testing.T) {
 			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
 			assert.Equal(t, tt.wants.ExitStatus, gotOpts.ExitStatus)
 			assert.Equal(t, tt.wants.Verbose, gotOpts.Verbose)
+			assert.Equal(t, tt.wants.Annotations, gotOpts.Annotations)
 		})
 	}
 }
@@ -345,6 +360,52 @@ func TestViewRun(t *testing.T) {
 			},
 			wantOut: "\nX trunk failed · 1234\nTriggered via push about 59 minutes ago\n\nJOBS\n✓ cool job in 4m34s (ID 10)\n  ✓ fob the barz\n  ✓ barz the fob\nX sad job in 4m34s (ID 20)\n  ✓ barf the quux\n  X quux the barf\n\nANNOTATIONS\nX the job is sad\nsad job: blaze.py#420\n\n\nTo see what failed, try: gh run view 1234 --log-failed\nView this run on GitHub: https://github.com/runs/1234\n",
 		},
+		{
+			name: "annotations flag, grouped by file",
+			opts: &ViewOptions{
+				RunID:       "1234",
+				Annotations: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("GET", "runs/1234/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							shared.FailedJob,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/check-runs/20/annotations"),
+					httpmock.JSONResponse(shared.FailedJobAnnotations))
+			},
+			wantOut: "blaze.py\n  X #420 the job is sad\n  sad job\n\n",
+		},
+		{
+			name: "annotations flag, no annotations",
+			opts: &ViewOptions{
+				RunID:       "3",
+				Annotations: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3"),
+					httpmock.JSONResponse(shared.SuccessfulRun))
+				reg.Register(
+					httpmock.REST("GET", "runs/3/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							shared.SuccessfulJob,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/check-runs/10/annotations"),
+					httpmock.JSONResponse([]shared.Annotation{}))
+			},
+			wantOut: "no annotations found\n",
+		},
 		{
 			name: "prompts for choice, one job",
 			tty:  true,
@@ -967,7 +1028,7 @@ func Test_attachRunLog(t *testing.T) {
 	defer rlz.Close()
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			attachRunLog(rlz, []shared.Job{tt.job})
+			shared.AttachRunLog(rlz, []shared.Job{tt.job})
 			for _, step := range tt.job.Steps {
 				log := step.Log
 				logPresent := log != nil
@@ -3,7 +3,6 @@ package watch
 import (
 	"fmt"
 	"net/http"
-	"runtime"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
@@ -29,6 +28,9 @@ type WatchOptions struct {
 
 	Prompt bool
 
+	All    bool
+	Branch string
+
 	Now func() time.Time
 }
 
@@ -42,18 +44,40 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 	cmd := &cobra.Command{
 		Use:   "watch <run-id>",
 		Short: "Watch a run until it completes, showing its progress",
+		Long: heredoc.Doc(`
+			Watch a run until it completes, showing its progress.
+
+			With --all, instead watch every in-progress run for the repository (or for
+			--branch, just those on one branch) as a live-updating table. The dashboard
+			refreshes until no watched run is still in progress; use 'gh run watch <run-id>'
+			or 'gh run view <run-id> --log' afterwards to drill into a specific run, and
+			'gh run cancel <run-id>' to cancel one.
+		`),
 		Example: heredoc.Doc(`
 			# Watch a run until it's done
 			gh run watch
 
 			# Run some other command when the run is finished
 			gh run watch && notify-send "run is done!"
+
+			# Watch every in-progress run for the repository
+			gh run watch --all
+
+			# Watch every in-progress run on a branch
+			gh run watch --all --branch main
 		`),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
-			if len(args) > 0 {
+			if opts.All {
+				if len(args) > 0 {
+					return cmdutil.FlagErrorf("cannot specify a run ID with `--all`")
+				}
+			} else if opts.Branch != "" {
+				return cmdutil.FlagErrorf("cannot use `--branch` without `--all`")
+			} else if len(args) > 0 {
 				opts.RunID = args[0]
 			} else if !opts.IO.CanPrompt() {
 				return cmdutil.FlagErrorf("run ID required when not running interactively")
@@ -65,11 +89,16 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 				return runF(opts)
 			}
 
+			if opts.All {
+				return watchAllRun(opts)
+			}
 			return watchRun(opts)
 		},
 	}
 	cmd.Flags().BoolVar(&opts.ExitStatus, "exit-status", false, "Exit with non-zero status if run fails")
 	cmd.Flags().IntVarP(&opts.Interval, "interval", "i", defaultInterval, "Refresh interval in seconds")
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Watch every in-progress run for the repository, as a live dashboard")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Only watch runs for a given branch (requires --all)")
 
 	return cmd
 }
@@ -212,15 +241,7 @@ func renderRun(opts WatchOptions, client *api.Client, repo ghrepo.Interface, run
 		return nil, fmt.Errorf("failed to get annotations: %w", annotationErr)
 	}
 
-	if runtime.GOOS == "windows" {
-		// Just clear whole screen; I wasn't able to get the nicer cursor movement thing working
-		fmt.Fprintf(opts.IO.Out, "\x1b[2J")
-	} else {
-		// Move cursor to 0,0
-		fmt.Fprint(opts.IO.Out, "\x1b[0;0H")
-		// Clear from cursor to bottom of screen
-		fmt.Fprint(opts.IO.Out, "\x1b[J")
-	}
+	shared.RefreshScreen(opts.IO.Out)
 
 	fmt.Fprintln(out, cs.Boldf("Refreshing run status every %d seconds. Press Ctrl+C to quit.", opts.Interval))
 	fmt.Fprintln(out)
@@ -243,3 +264,96 @@ func renderRun(opts WatchOptions, client *api.Client, repo ghrepo.Interface, run
 
 	return run, nil
 }
+
+func watchAllRun(opts *WatchOptions) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	if err := opts.IO.EnableVirtualTerminalProcessing(); err == nil {
+		// clear entire screen
+		fmt.Fprintf(opts.IO.Out, "\x1b[2J")
+	}
+
+	filters := &shared.FilterOptions{Branch: opts.Branch}
+
+	duration, err := time.ParseDuration(fmt.Sprintf("%ds", opts.Interval))
+	if err != nil {
+		return fmt.Errorf("could not parse interval: %w", err)
+	}
+
+	var runs []shared.Run
+	for {
+		runs, err = shared.GetRunsWithFilter(client, repo, filters, 50, func(run shared.Run) bool {
+			return run.Status != shared.Completed
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get runs: %w", err)
+		}
+
+		renderAllRuns(*opts, runs)
+
+		if len(runs) == 0 {
+			break
+		}
+
+		time.Sleep(duration)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintln(opts.IO.Out, "No more in-progress runs to watch")
+	}
+
+	return nil
+}
+
+func renderAllRuns(opts WatchOptions, runs []shared.Run) {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	shared.RefreshScreen(out)
+
+	if opts.Branch != "" {
+		fmt.Fprintln(out, cs.Boldf("Refreshing in-progress runs for %s every %d seconds. Press Ctrl+C to quit.", opts.Branch, opts.Interval))
+	} else {
+		fmt.Fprintln(out, cs.Boldf("Refreshing in-progress runs every %d seconds. Press Ctrl+C to quit.", opts.Interval))
+	}
+	fmt.Fprintln(out)
+
+	if len(runs) == 0 {
+		fmt.Fprintln(out, "No in-progress runs found")
+		return
+	}
+
+	tp := utils.NewTablePrinter(opts.IO)
+	tp.AddField("STATUS", nil, nil)
+	tp.AddField("NAME", nil, nil)
+	tp.AddField("WORKFLOW", nil, nil)
+	tp.AddField("BRANCH", nil, nil)
+	tp.AddField("EVENT", nil, nil)
+	tp.AddField("ID", nil, nil)
+	tp.AddField("ELAPSED", nil, nil)
+	tp.EndRow()
+
+	for _, run := range runs {
+		symbol, symbolColor := shared.Symbol(cs, run.Status, run.Conclusion)
+		tp.AddField(symbol, nil, symbolColor)
+		tp.AddField(run.CommitMsg(), nil, cs.Bold)
+		tp.AddField(run.Name, nil, nil)
+		tp.AddField(run.HeadBranch, nil, cs.Bold)
+		tp.AddField(string(run.Event), nil, nil)
+		tp.AddField(fmt.Sprintf("%d", run.ID), nil, cs.Cyan)
+		elapsed := opts.Now().Sub(run.CreatedAt)
+		tp.AddField(elapsed.Truncate(time.Second).String(), nil, nil)
+		tp.EndRow()
+	}
+
+	_ = tp.Render()
+}
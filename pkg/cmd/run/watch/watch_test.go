@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"runtime"
 	"testing"
 	"time"
@@ -56,6 +57,33 @@ func TestNewCmdWatch(t *testing.T) {
 				ExitStatus: true,
 			},
 		},
+		{
+			name: "all",
+			cli:  "--all",
+			wants: WatchOptions{
+				Interval: defaultInterval,
+				All:      true,
+			},
+		},
+		{
+			name: "all with branch",
+			cli:  "--all --branch trunk",
+			wants: WatchOptions{
+				Interval: defaultInterval,
+				All:      true,
+				Branch:   "trunk",
+			},
+		},
+		{
+			name:     "all with run ID",
+			cli:      "1234 --all",
+			wantsErr: true,
+		},
+		{
+			name:     "branch without all",
+			cli:      "--branch trunk",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -93,6 +121,8 @@ func TestNewCmdWatch(t *testing.T) {
 			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
 			assert.Equal(t, tt.wants.ExitStatus, gotOpts.ExitStatus)
 			assert.Equal(t, tt.wants.Interval, gotOpts.Interval)
+			assert.Equal(t, tt.wants.All, gotOpts.All)
+			assert.Equal(t, tt.wants.Branch, gotOpts.Branch)
 		})
 	}
 }
@@ -338,3 +368,80 @@ func TestWatchRun(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchAllRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("screen-clearing escape sequences differ on windows")
+	}
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+		httpmock.JSONResponse(shared.RunsPayload{
+			WorkflowRuns: []shared.Run{
+				shared.TestRun("run", 1, shared.InProgress, ""),
+				shared.TestRun("more runs", 2, shared.InProgress, ""),
+			},
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+		httpmock.JSONResponse(shared.RunsPayload{WorkflowRuns: []shared.Run{}}))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	opts := &WatchOptions{
+		Interval: 0,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		IO: io,
+		Now: func() time.Time {
+			notnow, _ := time.Parse("2006-01-02 15:04:05", "2021-02-23 05:50:00")
+			return notnow
+		},
+	}
+
+	err := watchAllRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "\x1b[2J\x1b[0;0H\x1b[JRefreshing in-progress runs every 0 seconds. Press Ctrl+C to quit.\n\nSTATUS\tNAME\tWORKFLOW\tBRANCH\tEVENT\tID\tELAPSED\n*\tcool commit\trun\ttrunk\tpush\t1\t59m0s\n*\tcool commit\tmore runs\ttrunk\tpush\t2\t59m0s\n\x1b[0;0H\x1b[JRefreshing in-progress runs every 0 seconds. Press Ctrl+C to quit.\n\nNo in-progress runs found\n", stdout.String())
+}
+
+func TestWatchAllRun_branch(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("screen-clearing escape sequences differ on windows")
+	}
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.QueryMatcher("GET", "repos/OWNER/REPO/actions/runs", url.Values{"branch": []string{"trunk"}}),
+		httpmock.JSONResponse(shared.RunsPayload{WorkflowRuns: []shared.Run{}}))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	opts := &WatchOptions{
+		Interval: 0,
+		Branch:   "trunk",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+		IO: io,
+		Now: func() time.Time {
+			notnow, _ := time.Parse("2006-01-02 15:04:05", "2021-02-23 05:50:00")
+			return notnow
+		},
+	}
+
+	err := watchAllRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "\x1b[2J\x1b[0;0H\x1b[JRefreshing in-progress runs for trunk every 0 seconds. Press Ctrl+C to quit.\n\nNo in-progress runs found\n", stdout.String())
+}
@@ -0,0 +1,360 @@
+package all
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	prCheckoutCmd "github.com/cli/cli/v2/pkg/cmd/pr/checkout"
+	repoCloneCmd "github.com/cli/cli/v2/pkg/cmd/repo/clone"
+	"github.com/cli/cli/v2/pkg/cmd/search/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/cli/cli/v2/pkg/text"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+var searchTypes = []string{"repositories", "code", "issues", "prs"}
+
+type AllOptions struct {
+	Browser  cmdutil.Browser
+	Factory  *cmdutil.Factory
+	IO       *iostreams.IOStreams
+	Searcher search.Searcher
+
+	Keywords    []string
+	Limit       int
+	Types       []string
+	Interactive bool
+}
+
+// pickable is one result offered to the user in interactive mode.
+type pickable struct {
+	label string
+	kind  string // "repositories", "code", "issues", or "prs"
+	url   string
+	repo  string // "owner/name", set for repositories and prs
+}
+
+type groupResult struct {
+	searchType string
+	repos      search.RepositoriesResult
+	code       search.CodeResult
+	issues     search.IssuesResult
+	err        error
+}
+
+func NewCmdAll(f *cmdutil.Factory, runF func(*AllOptions) error) *cobra.Command {
+	opts := &AllOptions{
+		Browser: f.Browser,
+		Factory: f,
+		IO:      f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "all <query>",
+		Short: "Search across repositories, code, issues, and pull requests",
+		Long: heredoc.Doc(`
+			Search across GitHub repositories, code, issues, and pull requests at once.
+
+			The query is run against each type concurrently, and results are grouped
+			by type. This is meant for a quick "where is this thing?" lookup; use
+			"gh search repos", "gh search code", "gh search issues", or "gh search prs"
+			directly when you need the full set of qualifier flags for a single type.
+
+			GitHub's search API does not currently support searching commits or
+			discussions, so those are not included.
+    `),
+		Example: heredoc.Doc(`
+			# search everything for "flaky test"
+			$ gh search all "flaky test"
+
+			# only search repositories and issues
+			$ gh search all --types repositories,issues "flaky test"
+
+			# pick a result to open, clone, or check out
+			$ gh search all --interactive "flaky test"
+    `),
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if opts.Limit < 1 || opts.Limit > shared.SearchMaxResults {
+				return cmdutil.FlagErrorf("`--limit` must be between 1 and 1000")
+			}
+			if len(opts.Types) == 0 {
+				opts.Types = searchTypes
+			}
+			opts.Keywords = args
+
+			if runF != nil {
+				return runF(opts)
+			}
+			var err error
+			opts.Searcher, err = shared.Searcher(f)
+			if err != nil {
+				return err
+			}
+			return allRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 5, "Maximum number of results to fetch per type")
+	cmdutil.StringSliceEnumFlag(cmd, &opts.Types, "types", "t", nil, searchTypes, "Limit search to specific types")
+	cmd.Flags().BoolVarP(&opts.Interactive, "interactive", "i", false, "Pick a result to open in the browser, clone, or check out")
+
+	return cmd
+}
+
+func allRun(opts *AllOptions) error {
+	io := opts.IO
+	if opts.Interactive && !io.CanPrompt() {
+		return cmdutil.FlagErrorf("`--interactive` requires an interactive terminal")
+	}
+
+	io.StartProgressIndicator()
+	results := runSearches(opts)
+	io.StopProgressIndicator()
+
+	if opts.Interactive {
+		return interactiveSelect(opts, results)
+	}
+
+	if err := io.StartPager(); err == nil {
+		defer io.StopPager()
+	} else {
+		fmt.Fprintf(io.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	var failed []string
+	for _, r := range results {
+		if r.err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", r.searchType, r.err))
+			continue
+		}
+		if err := displayGroup(io, r); err != nil {
+			return err
+		}
+	}
+
+	if len(failed) > 0 {
+		fmt.Fprintf(io.ErrOut, "warning: some searches failed:\n")
+		for _, f := range failed {
+			fmt.Fprintf(io.ErrOut, "  %s\n", f)
+		}
+	}
+	if len(failed) == len(results) {
+		return fmt.Errorf("all searches failed")
+	}
+
+	return nil
+}
+
+func runSearches(opts *AllOptions) []groupResult {
+	results := make([]groupResult, len(opts.Types))
+	var wg sync.WaitGroup
+	wg.Add(len(opts.Types))
+	for i, searchType := range opts.Types {
+		go func(i int, searchType string) {
+			defer wg.Done()
+			results[i] = runSearch(opts, searchType)
+		}(i, searchType)
+	}
+	wg.Wait()
+	return results
+}
+
+func runSearch(opts *AllOptions, searchType string) groupResult {
+	r := groupResult{searchType: searchType}
+	switch searchType {
+	case "repositories":
+		r.repos, r.err = opts.Searcher.Repositories(search.Query{
+			Kind:     search.KindRepositories,
+			Keywords: opts.Keywords,
+			Limit:    opts.Limit,
+		})
+	case "code":
+		r.code, r.err = opts.Searcher.Code(search.Query{
+			Kind:     search.KindCode,
+			Keywords: opts.Keywords,
+			Limit:    opts.Limit,
+		})
+	case "issues":
+		r.issues, r.err = opts.Searcher.Issues(search.Query{
+			Kind:       search.KindIssues,
+			Keywords:   opts.Keywords,
+			Limit:      opts.Limit,
+			Qualifiers: search.Qualifiers{Type: "issue"},
+		})
+	case "prs":
+		r.issues, r.err = opts.Searcher.Issues(search.Query{
+			Kind:       search.KindIssues,
+			Keywords:   opts.Keywords,
+			Limit:      opts.Limit,
+			Qualifiers: search.Qualifiers{Type: "pr"},
+		})
+	}
+	return r
+}
+
+func displayGroup(io *iostreams.IOStreams, r groupResult) error {
+	cs := io.ColorScheme()
+
+	switch r.searchType {
+	case "repositories":
+		fmt.Fprintf(io.Out, "\n%s\n", cs.Bold(fmt.Sprintf("Repositories (%d)", r.repos.Total)))
+		tp := utils.NewTablePrinter(io)
+		for _, repo := range r.repos.Items {
+			tp.AddField(repo.FullName, nil, cs.Bold)
+			tp.AddField(text.ReplaceExcessiveWhitespace(repo.Description), nil, nil)
+			tp.EndRow()
+		}
+		return tp.Render()
+	case "code":
+		fmt.Fprintf(io.Out, "\n%s\n", cs.Bold(fmt.Sprintf("Code (%d)", r.code.Total)))
+		tp := utils.NewTablePrinter(io)
+		for _, item := range r.code.Items {
+			tp.AddField(item.Repository.FullName, nil, cs.Bold)
+			tp.AddField(item.Path, nil, nil)
+			tp.EndRow()
+		}
+		return tp.Render()
+	case "issues", "prs":
+		label := "Issues"
+		if r.searchType == "prs" {
+			label = "Pull requests"
+		}
+		fmt.Fprintf(io.Out, "\n%s\n", cs.Bold(fmt.Sprintf("%s (%d)", label, r.issues.Total)))
+		tp := utils.NewTablePrinter(io)
+		for _, issue := range r.issues.Items {
+			comp := strings.Split(issue.RepositoryURL, "/")
+			name := comp[len(comp)-2:]
+			tp.AddField(strings.Join(name, "/"), nil, nil)
+			issueNum := "#" + strconv.Itoa(issue.Number)
+			tp.AddField(issueNum, nil, cs.ColorFromString(shared.ColorForIssueState(issue.State)))
+			tp.AddField(text.ReplaceExcessiveWhitespace(issue.Title), nil, nil)
+			now := time.Now()
+			tp.AddField(utils.FuzzyAgo(now.Sub(issue.UpdatedAt)), nil, cs.Gray)
+			tp.EndRow()
+		}
+		return tp.Render()
+	}
+	return nil
+}
+
+// buildPickables flattens a set of search results into the list of items offered by
+// --interactive, preserving the order in which their groups were searched.
+func buildPickables(results []groupResult) []pickable {
+	var items []pickable
+	for _, r := range results {
+		if r.err != nil {
+			continue
+		}
+		switch r.searchType {
+		case "repositories":
+			for _, repo := range r.repos.Items {
+				items = append(items, pickable{
+					label: fmt.Sprintf("[repo] %s  %s", repo.FullName, text.ReplaceExcessiveWhitespace(repo.Description)),
+					kind:  r.searchType,
+					url:   repo.URL,
+					repo:  repo.FullName,
+				})
+			}
+		case "code":
+			for _, item := range r.code.Items {
+				items = append(items, pickable{
+					label: fmt.Sprintf("[code] %s %s", item.Repository.FullName, item.Path),
+					kind:  r.searchType,
+					url:   item.URL,
+				})
+			}
+		case "issues", "prs":
+			prefix := "issue"
+			if r.searchType == "prs" {
+				prefix = "pr"
+			}
+			for _, issue := range r.issues.Items {
+				comp := strings.Split(issue.RepositoryURL, "/")
+				name := strings.Join(comp[len(comp)-2:], "/")
+				items = append(items, pickable{
+					label: fmt.Sprintf("[%s] %s#%d %s", prefix, name, issue.Number, text.ReplaceExcessiveWhitespace(issue.Title)),
+					kind:  r.searchType,
+					url:   issue.URL,
+					repo:  name,
+				})
+			}
+		}
+	}
+	return items
+}
+
+// interactiveSelect lets the user pick a single result and open it in the browser,
+// clone it, or check it out, reusing the existing "repo clone" and "pr checkout"
+// commands rather than reimplementing their git plumbing.
+func interactiveSelect(opts *AllOptions, results []groupResult) error {
+	items := buildPickables(results)
+	if len(items) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "no results to pick from")
+		return nil
+	}
+
+	labels := make([]string, len(items))
+	for i, item := range items {
+		labels[i] = item.label
+	}
+
+	var choice int
+	if err := prompt.SurveyAskOne(&survey.Select{
+		Message: "Pick a result",
+		Options: labels,
+	}, &choice); err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+	item := items[choice]
+
+	actions := []string{"Open in browser"}
+	switch item.kind {
+	case "repositories":
+		actions = append(actions, "Clone locally")
+	case "prs":
+		actions = append(actions, "Check out locally")
+	}
+	actions = append(actions, "Cancel")
+
+	var action string
+	if err := prompt.SurveyAskOne(&survey.Select{
+		Message: "What do you want to do?",
+		Options: actions,
+	}, &action); err != nil {
+		return fmt.Errorf("could not prompt: %w", err)
+	}
+
+	switch action {
+	case "Open in browser":
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(item.url))
+		}
+		return opts.Browser.Browse(item.url)
+	case "Clone locally":
+		cmd := repoCloneCmd.NewCmdClone(opts.Factory, nil)
+		cmd.SetArgs([]string{item.repo})
+		cmd.SetIn(opts.IO.In)
+		cmd.SetOut(opts.IO.Out)
+		cmd.SetErr(opts.IO.ErrOut)
+		return cmd.Execute()
+	case "Check out locally":
+		cmd := prCheckoutCmd.NewCmdCheckout(opts.Factory, nil)
+		cmd.SetArgs([]string{item.url})
+		cmd.SetIn(opts.IO.In)
+		cmd.SetOut(opts.IO.Out)
+		cmd.SetErr(opts.IO.ErrOut)
+		return cmd.Execute()
+	default:
+		return nil
+	}
+}
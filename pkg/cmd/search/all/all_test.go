@@ -0,0 +1,245 @@
+package all
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+var errNetwork = errors.New("network error")
+
+func TestNewCmdAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  AllOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no arguments",
+			input:   "",
+			wantErr: true,
+			errMsg:  "accepts 1 arg(s), received 0",
+		},
+		{
+			name:  "query argument",
+			input: `"flaky test"`,
+			output: AllOptions{
+				Keywords: []string{"flaky test"},
+				Limit:    5,
+				Types:    searchTypes,
+			},
+		},
+		{
+			name:  "types flag",
+			input: `"flaky test" --types repositories,issues`,
+			output: AllOptions{
+				Keywords: []string{"flaky test"},
+				Limit:    5,
+				Types:    []string{"repositories", "issues"},
+			},
+		},
+		{
+			name:    "invalid limit flag",
+			input:   `"flaky test" --limit 1001`,
+			wantErr: true,
+			errMsg:  "`--limit` must be between 1 and 1000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: io}
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			var gotOpts *AllOptions
+			cmd := NewCmdAll(f, func(opts *AllOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Keywords, gotOpts.Keywords)
+			assert.Equal(t, tt.output.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.output.Types, gotOpts.Types)
+		})
+	}
+}
+
+func TestAllRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	searcher := &search.SearcherMock{
+		RepositoriesFunc: func(query search.Query) (search.RepositoriesResult, error) {
+			return search.RepositoriesResult{
+				Total: 1,
+				Items: []search.Repository{{FullName: "cli/cli", Description: "GitHub CLI"}},
+			}, nil
+		},
+		IssuesFunc: func(query search.Query) (search.IssuesResult, error) {
+			if query.Qualifiers.Type == "pr" {
+				return search.IssuesResult{}, nil
+			}
+			return search.IssuesResult{
+				Total: 1,
+				Items: []search.Issue{{Number: 123, Title: "flaky test", State: "open", RepositoryURL: "https://api.github.com/repos/cli/cli"}},
+			}, nil
+		},
+		CodeFunc: func(query search.Query) (search.CodeResult, error) {
+			return search.CodeResult{}, nil
+		},
+	}
+
+	opts := &AllOptions{
+		IO:       io,
+		Searcher: searcher,
+		Keywords: []string{"flaky test"},
+		Limit:    5,
+		Types:    searchTypes,
+	}
+
+	err := allRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Contains(t, stdout.String(), "Repositories (1)")
+	assert.Contains(t, stdout.String(), "cli/cli")
+	assert.Contains(t, stdout.String(), "Issues (1)")
+	assert.Contains(t, stdout.String(), "flaky test")
+	assert.Contains(t, stdout.String(), "Pull requests (0)")
+	assert.Contains(t, stdout.String(), "Code (0)")
+}
+
+func TestAllRun_partialFailure(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	searcher := &search.SearcherMock{
+		RepositoriesFunc: func(query search.Query) (search.RepositoriesResult, error) {
+			return search.RepositoriesResult{}, nil
+		},
+		CodeFunc: func(query search.Query) (search.CodeResult, error) {
+			return search.CodeResult{}, errNetwork
+		},
+	}
+
+	opts := &AllOptions{
+		IO:       io,
+		Searcher: searcher,
+		Keywords: []string{"flaky test"},
+		Limit:    5,
+		Types:    []string{"repositories", "code"},
+	}
+
+	err := allRun(opts)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Repositories (0)")
+	assert.Contains(t, stderr.String(), "warning: some searches failed")
+	assert.Contains(t, stderr.String(), "code: network error")
+}
+
+func TestAllRun_interactive(t *testing.T) {
+	searcher := &search.SearcherMock{
+		RepositoriesFunc: func(query search.Query) (search.RepositoriesResult, error) {
+			return search.RepositoriesResult{
+				Total: 1,
+				Items: []search.Repository{{FullName: "cli/cli", Description: "GitHub CLI", URL: "https://github.com/cli/cli"}},
+			}, nil
+		},
+		IssuesFunc: func(query search.Query) (search.IssuesResult, error) {
+			return search.IssuesResult{}, nil
+		},
+		CodeFunc: func(query search.Query) (search.CodeResult, error) {
+			return search.CodeResult{}, nil
+		},
+	}
+
+	t.Run("opens the chosen result in the browser", func(t *testing.T) {
+		io, _, _, stderr := iostreams.Test()
+		io.SetStdinTTY(true)
+		io.SetStdoutTTY(true)
+
+		ask := prompt.NewAskStubber(t)
+		ask.StubPrompt("Pick a result").AnswerWith("[repo] cli/cli  GitHub CLI")
+		ask.StubPrompt("What do you want to do?").AnswerWith("Open in browser")
+
+		browser := &cmdutil.TestBrowser{}
+		opts := &AllOptions{
+			Browser:     browser,
+			IO:          io,
+			Searcher:    searcher,
+			Keywords:    []string{"flaky test"},
+			Limit:       5,
+			Types:       []string{"repositories", "code", "issues"},
+			Interactive: true,
+		}
+
+		err := allRun(opts)
+		assert.NoError(t, err)
+		browser.Verify(t, "https://github.com/cli/cli")
+		assert.Contains(t, stderr.String(), "Opening github.com/cli/cli in your browser")
+	})
+
+	t.Run("cancelling does nothing", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+		io.SetStdinTTY(true)
+		io.SetStdoutTTY(true)
+
+		ask := prompt.NewAskStubber(t)
+		ask.StubPrompt("Pick a result").AnswerWith("[repo] cli/cli  GitHub CLI")
+		ask.StubPrompt("What do you want to do?").AnswerWith("Cancel")
+
+		browser := &cmdutil.TestBrowser{}
+		opts := &AllOptions{
+			Browser:     browser,
+			IO:          io,
+			Searcher:    searcher,
+			Keywords:    []string{"flaky test"},
+			Limit:       5,
+			Types:       []string{"repositories", "code", "issues"},
+			Interactive: true,
+		}
+
+		err := allRun(opts)
+		assert.NoError(t, err)
+		browser.Verify(t, "")
+	})
+
+	t.Run("requires a TTY", func(t *testing.T) {
+		io, _, _, _ := iostreams.Test()
+
+		opts := &AllOptions{
+			IO:          io,
+			Searcher:    searcher,
+			Keywords:    []string{"flaky test"},
+			Limit:       5,
+			Types:       []string{"repositories", "code", "issues"},
+			Interactive: true,
+		}
+
+		err := allRun(opts)
+		assert.EqualError(t, err, "`--interactive` requires an interactive terminal")
+	})
+}
@@ -0,0 +1,315 @@
+package code
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/search/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type CodeOptions struct {
+	Browser    cmdutil.Browser
+	Exporter   cmdutil.Exporter
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Query      search.Query
+	Searcher   search.Searcher
+	WebMode    bool
+
+	Context   int
+	FilesOnly bool
+	Matcher   *regexp.Regexp
+	Regexp    bool
+
+	AllPages bool
+}
+
+func NewCmdCode(f *cmdutil.Factory, runF func(*CodeOptions) error) *cobra.Command {
+	var format string
+	opts := &CodeOptions{
+		Browser:    f.Browser,
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+		Query:      search.Query{Kind: search.KindCode},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "code [<query>]",
+		Short: "Search for code",
+		Long: heredoc.Doc(`
+			Search for code on GitHub.
+
+			The command supports constructing queries using the GitHub search syntax,
+			using the parameter and qualifier flags, or a combination of the two.
+
+			GitHub's code search does not support regular expressions, so passing
+			--regexp re-fetches each candidate file GitHub returns and matches your
+			pattern against its contents locally, printing only the lines that
+			actually match along with surrounding context.
+
+			GitHub search syntax is documented at:
+			<https://docs.github.com/search-github/searching-on-github/searching-code>
+    `),
+		Example: heredoc.Doc(`
+			# search code matching "react" and "lifecycle"
+			$ gh search code react lifecycle
+
+			# search code matching "error handling" in Go files
+			$ gh search code "error handling" --language=go
+
+			# search code that defines a function named "run" in the cli/cli repo
+			$ gh search code "func run" --repo=cli/cli
+
+			# search for lines matching a regular expression, with context lines
+			$ gh search code --regexp "func \w+Run\(" --language=go --context=3
+
+			# export every matching file as JSON Lines for a reporting script
+			$ gh search code --language=go --all-pages --format jsonl
+    `),
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) == 0 && c.Flags().NFlag() == 0 {
+				return cmdutil.FlagErrorf("specify search keywords or flags")
+			}
+			if opts.Query.Limit < 1 || opts.Query.Limit > shared.SearchMaxResults {
+				return cmdutil.FlagErrorf("`--limit` must be between 1 and 1000")
+			}
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--json`, `--web`, or `--format`",
+				c.Flags().Changed("json"), opts.WebMode, format != "",
+			); err != nil {
+				return err
+			}
+			if opts.AllPages {
+				opts.Query.Limit = shared.SearchMaxResults
+			}
+			if format != "" {
+				opts.Exporter = shared.FormatExporter(format, search.CodeFields)
+			}
+			if opts.FilesOnly && !opts.Regexp {
+				return cmdutil.FlagErrorf("`--files-only` requires `--regexp`")
+			}
+			if opts.Regexp {
+				if len(args) == 0 {
+					return cmdutil.FlagErrorf("specify a regular expression")
+				}
+				pattern := strings.Join(args, " ")
+				re, err := regexp.Compile(pattern)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid regular expression: %w", err)
+				}
+				opts.Matcher = re
+				if hint := literalHint(pattern); hint != "" {
+					args = []string{hint}
+				} else {
+					args = nil
+				}
+			}
+			opts.Query.Keywords = args
+			if runF != nil {
+				return runF(opts)
+			}
+			var err error
+			opts.Searcher, err = shared.Searcher(f)
+			if err != nil {
+				return err
+			}
+			return codeRun(opts)
+		},
+	}
+
+	// Output flags
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, search.CodeFields)
+	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the search query in the web browser")
+	shared.AddFormatFlag(cmd, &format)
+
+	// Query parameter flags
+	cmd.Flags().IntVarP(&opts.Query.Limit, "limit", "L", 30, "Maximum number of code results to fetch")
+	cmd.Flags().BoolVar(&opts.AllPages, "all-pages", false, "Fetch the full result window (up to 1,000 results), ignoring the --limit flag")
+
+	// Query qualifier flags
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Extension, "extension", "", "Filter on files with a given `extension`")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Filename, "filename", "", "Filter on a given file `name`")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Language, "language", "", "Filter based on the coding language")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Org, "owner", "", "Filter on owner")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Path, "path", "", "Filter on files within a given `path`")
+	cmd.Flags().StringSliceVar(&opts.Query.Qualifiers.Repo, "repo", nil, "Filter on repository")
+
+	// Regex mode flags
+	cmd.Flags().BoolVarP(&opts.Regexp, "regexp", "X", false, "Treat the search query as a regular expression, matched client-side against file contents")
+	cmd.Flags().IntVarP(&opts.Context, "context", "C", 2, "Number of lines of context to show around each regular expression match")
+	cmd.Flags().BoolVar(&opts.FilesOnly, "files-only", false, "Only print matching file names, not matching lines")
+
+	return cmd
+}
+
+func codeRun(opts *CodeOptions) error {
+	io := opts.IO
+	if opts.WebMode {
+		url := opts.Searcher.URL(opts.Query)
+		if io.IsStdoutTTY() {
+			fmt.Fprintf(io.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(url))
+		}
+		return opts.Browser.Browse(url)
+	}
+	io.StartProgressIndicator()
+	result, err := opts.Searcher.Code(opts.Query)
+	io.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+	if err := io.StartPager(); err == nil {
+		defer io.StopPager()
+	} else {
+		fmt.Fprintf(io.ErrOut, "failed to start pager: %v\n", err)
+	}
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(io, result.Items)
+	}
+	if opts.Regexp {
+		return displayRegexpResults(opts, result)
+	}
+	return displayResults(io, result)
+}
+
+func displayResults(io *iostreams.IOStreams, results search.CodeResult) error {
+	cs := io.ColorScheme()
+	tp := utils.NewTablePrinter(io)
+	for _, code := range results.Items {
+		tp.AddField(code.Repository.FullName, nil, cs.Bold)
+		tp.AddField(code.Path, nil, nil)
+		tp.EndRow()
+	}
+	if io.IsStdoutTTY() {
+		header := "No code results matched your search\n"
+		if len(results.Items) > 0 {
+			header = fmt.Sprintf("Showing %d of %d code results\n\n", len(results.Items), results.Total)
+		}
+		fmt.Fprintf(io.Out, "\n%s", header)
+	}
+	return tp.Render()
+}
+
+// displayRegexpResults re-fetches the raw content of every candidate file GitHub's code search
+// returned and matches opts.Matcher against it locally, since GitHub's code search does not
+// support regular expressions itself.
+func displayRegexpResults(opts *CodeOptions, results search.CodeResult) error {
+	io := opts.IO
+	cs := io.ColorScheme()
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	matchedFiles := 0
+	for _, item := range results.Items {
+		content, err := fetchRawContent(client, item.URL)
+		if err != nil {
+			// Skip files we can't fetch raw content for, such as files in private
+			// repositories the raw content host can't authenticate, rather than
+			// failing the whole search.
+			continue
+		}
+
+		lines := strings.Split(content, "\n")
+		var matchedLines []int
+		for i, line := range lines {
+			if opts.Matcher.MatchString(line) {
+				matchedLines = append(matchedLines, i)
+			}
+		}
+		if len(matchedLines) == 0 {
+			continue
+		}
+
+		matchedFiles++
+		fmt.Fprintf(io.Out, "%s\n", cs.Bold(fmt.Sprintf("%s %s", item.Repository.FullName, item.Path)))
+		if !opts.FilesOnly {
+			printed := map[int]bool{}
+			for _, ln := range matchedLines {
+				start, end := contextRange(ln, opts.Context, len(lines))
+				for i := start; i <= end; i++ {
+					if printed[i] {
+						continue
+					}
+					printed[i] = true
+					text := lines[i]
+					if i == ln {
+						text = opts.Matcher.ReplaceAllStringFunc(text, func(m string) string {
+							return cs.Bold(m)
+						})
+					}
+					fmt.Fprintf(io.Out, "%d: %s\n", i+1, text)
+				}
+			}
+		}
+		fmt.Fprintln(io.Out)
+	}
+
+	if io.IsStdoutTTY() {
+		fmt.Fprintf(io.ErrOut, "%d file(s) matched\n", matchedFiles)
+	}
+	return nil
+}
+
+func contextRange(line, context, lineCount int) (start, end int) {
+	start = line - context
+	if start < 0 {
+		start = 0
+	}
+	end = line + context
+	if end >= lineCount {
+		end = lineCount - 1
+	}
+	return start, end
+}
+
+// fetchRawContent downloads the unrendered contents of a file given its GitHub blob URL, e.g.
+// https://github.com/owner/repo/blob/sha/path/to/file.go.
+func fetchRawContent(client *http.Client, blobURL string) (string, error) {
+	req, err := http.NewRequest("GET", rawURL(blobURL), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("failed to fetch %s: %s", blobURL, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+func rawURL(blobURL string) string {
+	u := strings.Replace(blobURL, "github.com/", "raw.githubusercontent.com/", 1)
+	return strings.Replace(u, "/blob/", "/", 1)
+}
+
+// literalHint extracts the longest run of non-regexp-metacharacter text from pattern, for use as
+// a keyword that narrows GitHub's code search before regexp matching happens locally.
+var regexpMetaRE = regexp.MustCompile(`[\\^$.|?*+()\[\]{}]+`)
+
+func literalHint(pattern string) string {
+	var best string
+	for _, run := range regexpMetaRE.Split(pattern, -1) {
+		run = strings.TrimSpace(run)
+		if len(run) > len(best) {
+			best = run
+		}
+	}
+	return best
+}
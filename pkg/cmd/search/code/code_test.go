@@ -0,0 +1,234 @@
+package code
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  CodeOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no arguments",
+			input:   "",
+			wantErr: true,
+			errMsg:  "specify search keywords or flags",
+		},
+		{
+			name:  "keyword arguments",
+			input: "some search terms",
+			output: CodeOptions{
+				Query: search.Query{Keywords: []string{"some", "search", "terms"}, Kind: "code", Limit: 30},
+			},
+		},
+		{
+			name:    "invalid limit flag",
+			input:   "--limit 1001 foo",
+			wantErr: true,
+			errMsg:  "`--limit` must be between 1 and 1000",
+		},
+		{
+			name:  "qualifier flags",
+			input: `foo --extension=go --filename=main.go --language=go --owner=cli --path=pkg --repo=cli/cli`,
+			output: CodeOptions{
+				Query: search.Query{
+					Keywords: []string{"foo"},
+					Kind:     "code",
+					Limit:    30,
+					Qualifiers: search.Qualifiers{
+						Extension: "go",
+						Filename:  "main.go",
+						Language:  "go",
+						Org:       "cli",
+						Path:      "pkg",
+						Repo:      []string{"cli/cli"},
+					},
+				},
+			},
+		},
+		{
+			name:  "regexp flag",
+			input: `--regexp func\ \\w+Run`,
+			output: CodeOptions{
+				Query:   search.Query{Keywords: []string{"func"}, Kind: "code", Limit: 30},
+				Regexp:  true,
+				Context: 2,
+			},
+		},
+		{
+			name:    "regexp flag without pattern",
+			input:   "--regexp",
+			wantErr: true,
+			errMsg:  "specify a regular expression",
+		},
+		{
+			name:    "files-only without regexp",
+			input:   "foo --files-only",
+			wantErr: true,
+			errMsg:  "`--files-only` requires `--regexp`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *CodeOptions
+			cmd := NewCmdCode(f, func(opts *CodeOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Query, gotOpts.Query)
+			assert.Equal(t, tt.output.Regexp, gotOpts.Regexp)
+		})
+	}
+}
+
+func TestCodeRun(t *testing.T) {
+	query := search.Query{
+		Keywords: []string{"cli"},
+		Kind:     "code",
+		Limit:    30,
+	}
+	tests := []struct {
+		name       string
+		opts       *CodeOptions
+		tty        bool
+		wantErr    bool
+		errMsg     string
+		wantStdout string
+	}{
+		{
+			name: "displays results tty",
+			opts: &CodeOptions{
+				Query: query,
+				Searcher: &search.SearcherMock{
+					CodeFunc: func(query search.Query) (search.CodeResult, error) {
+						return search.CodeResult{
+							Items: []search.CodeItem{
+								{Name: "main.go", Path: "cmd/main.go", Repository: search.CodeRepository{FullName: "cli/cli"}},
+							},
+							Total: 1,
+						}, nil
+					},
+				},
+			},
+			tty:        true,
+			wantStdout: "\nShowing 1 of 1 code results\n\ncli/cli  cmd/main.go\n",
+		},
+		{
+			name: "displays no results tty",
+			opts: &CodeOptions{
+				Query: query,
+				Searcher: &search.SearcherMock{
+					CodeFunc: func(query search.Query) (search.CodeResult, error) {
+						return search.CodeResult{}, nil
+					},
+				},
+			},
+			tty:        true,
+			wantStdout: "\nNo code results matched your search\n",
+		},
+		{
+			name: "displays search error",
+			opts: &CodeOptions{
+				Query: query,
+				Searcher: &search.SearcherMock{
+					CodeFunc: func(query search.Query) (search.CodeResult, error) {
+						return search.CodeResult{}, fmt.Errorf("error with query")
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "error with query",
+		},
+	}
+	for _, tt := range tests {
+		io, _, stdout, _ := iostreams.Test()
+		io.SetStdinTTY(tt.tty)
+		io.SetStdoutTTY(tt.tty)
+		io.SetStderrTTY(tt.tty)
+		tt.opts.IO = io
+		t.Run(tt.name, func(t *testing.T) {
+			err := codeRun(tt.opts)
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
+
+func TestCodeRun_regexp(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "cli/cli/abc123/cmd/main.go"),
+		httpmock.StringResponse("package main\n\nfunc doRun() error {\n\treturn nil\n}\n"),
+	)
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &CodeOptions{
+		IO:      io,
+		Context: 1,
+		Matcher: regexp.MustCompile(`func \w+Run\(`),
+		Regexp:  true,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Searcher: &search.SearcherMock{
+			CodeFunc: func(query search.Query) (search.CodeResult, error) {
+				return search.CodeResult{
+					Items: []search.CodeItem{
+						{
+							Name:       "main.go",
+							Path:       "cmd/main.go",
+							URL:        "https://github.com/cli/cli/blob/abc123/cmd/main.go",
+							Repository: search.CodeRepository{FullName: "cli/cli"},
+						},
+					},
+					Total: 1,
+				}, nil
+			},
+		},
+	}
+
+	err := codeRun(opts)
+	reg.Verify(t)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "cli/cli cmd/main.go")
+	assert.Contains(t, stdout.String(), "func doRun() error {")
+}
@@ -0,0 +1,189 @@
+package commits
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/search/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/cli/cli/v2/pkg/text"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type CommitsOptions struct {
+	Browser  cmdutil.Browser
+	Exporter cmdutil.Exporter
+	IO       *iostreams.IOStreams
+	Query    search.Query
+	Searcher search.Searcher
+	WebMode  bool
+
+	AllPages bool
+}
+
+func NewCmdCommits(f *cmdutil.Factory, runF func(*CommitsOptions) error) *cobra.Command {
+	var order string
+	var sort string
+	var format string
+	opts := &CommitsOptions{
+		Browser: f.Browser,
+		IO:      f.IOStreams,
+		Query:   search.Query{Kind: search.KindCommits},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "commits [<query>]",
+		Short: "Search for commits",
+		Long: heredoc.Doc(`
+			Search for commits on GitHub.
+
+			The command supports constructing queries using the GitHub search syntax,
+			using the parameter and qualifier flags, or a combination of the two.
+
+			GitHub search syntax is documented at:
+			<https://docs.github.com/search-github/searching-on-github/searching-commits>
+    `),
+		Example: heredoc.Doc(`
+			# search commits matching set of keywords "fix" and "bug"
+			$ gh search commits fix bug
+
+			# search commits matching phrase "bug fix"
+			$ gh search commits "bug fix"
+
+			# search commits authored by user within a given repository
+			$ gh search commits --author=mislav --repo=cli/cli
+
+			# search commits committed before a certain date
+			$ gh search commits --committer-date="<2022-01-01"
+
+			# export every matching commit as JSON Lines for a reporting script
+			$ gh search commits --repo=cli/cli --all-pages --format jsonl
+    `),
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) == 0 && c.Flags().NFlag() == 0 {
+				return cmdutil.FlagErrorf("specify search keywords or flags")
+			}
+			if opts.Query.Limit < 1 || opts.Query.Limit > shared.SearchMaxResults {
+				return cmdutil.FlagErrorf("`--limit` must be between 1 and 1000")
+			}
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--json`, `--web`, or `--format`",
+				c.Flags().Changed("json"), opts.WebMode, format != "",
+			); err != nil {
+				return err
+			}
+			if opts.AllPages {
+				opts.Query.Limit = shared.SearchMaxResults
+			}
+			if format != "" {
+				opts.Exporter = shared.FormatExporter(format, search.CommitFields)
+			}
+			if c.Flags().Changed("order") {
+				opts.Query.Order = order
+			}
+			if c.Flags().Changed("sort") {
+				opts.Query.Sort = sort
+			}
+			opts.Query.Keywords = args
+			if runF != nil {
+				return runF(opts)
+			}
+			var err error
+			opts.Searcher, err = shared.Searcher(f)
+			if err != nil {
+				return err
+			}
+			return commitsRun(opts)
+		},
+	}
+
+	// Output flags
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, search.CommitFields)
+	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the search query in the web browser")
+	shared.AddFormatFlag(cmd, &format)
+
+	// Query parameter flags
+	cmd.Flags().IntVarP(&opts.Query.Limit, "limit", "L", 30, "Maximum number of commits to fetch")
+	cmd.Flags().BoolVar(&opts.AllPages, "all-pages", false, "Fetch the full result window (up to 1,000 results), ignoring the --limit flag")
+	cmdutil.StringEnumFlag(cmd, &order, "order", "", "desc", []string{"asc", "desc"}, "Order of commits returned, ignored unless '--sort' flag is specified")
+	cmdutil.StringEnumFlag(cmd, &sort, "sort", "", "best-match", []string{"author-date", "committer-date"}, "Sort fetched commits")
+
+	// Query qualifier flags
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Author, "author", "", "Filter based on committed by a given GitHub `username`")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.AuthorName, "author-name", "", "Filter on the author's `name`")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.AuthorEmail, "author-email", "", "Filter on the author's `email`")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.AuthorDate, "author-date", "", "Filter based on authored `date`")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Committer, "committer", "", "Filter based on committed by a given GitHub `username`")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.CommitterName, "committer-name", "", "Filter on the committer's `name`")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.CommitterEmail, "committer-email", "", "Filter on the committer's `email`")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.CommitterDate, "committer-date", "", "Filter based on committed `date`")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Hash, "hash", "", "Filter on a given commit `hash`")
+	cmdutil.NilBoolFlag(cmd, &opts.Query.Qualifiers.Merge, "merge", "", "Filter on merge commits")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Org, "owner", "", "Filter on organization")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Parent, "parent", "", "Filter on a commit's parent `hash`")
+	cmd.Flags().StringSliceVar(&opts.Query.Qualifiers.Repo, "repo", nil, "Filter on repository")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Tree, "tree", "", "Filter on a commit's tree `hash`")
+	cmdutil.StringSliceEnumFlag(cmd, &opts.Query.Qualifiers.Is, "visibility", "", nil, []string{"public", "private", "internal"}, "Filter based on repository visibility")
+
+	return cmd
+}
+
+func commitsRun(opts *CommitsOptions) error {
+	io := opts.IO
+	if opts.WebMode {
+		url := opts.Searcher.URL(opts.Query)
+		if io.IsStdoutTTY() {
+			fmt.Fprintf(io.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(url))
+		}
+		return opts.Browser.Browse(url)
+	}
+	io.StartProgressIndicator()
+	result, err := opts.Searcher.Commits(opts.Query)
+	io.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+	if err := io.StartPager(); err == nil {
+		defer io.StopPager()
+	} else {
+		fmt.Fprintf(io.ErrOut, "failed to start pager: %v\n", err)
+	}
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(io, result.Items)
+	}
+	return displayResults(io, result)
+}
+
+func displayResults(io *iostreams.IOStreams, results search.CommitsResult) error {
+	cs := io.ColorScheme()
+	tp := utils.NewTablePrinter(io)
+	for _, commit := range results.Items {
+		sha := commit.SHA
+		if len(sha) > 7 {
+			sha = sha[:7]
+		}
+		tp.AddField(commit.Repository.FullName, nil, cs.Bold)
+		tp.AddField(sha, nil, cs.Yellow)
+		message := strings.SplitN(commit.Commit.Message, "\n", 2)[0]
+		tp.AddField(text.ReplaceExcessiveWhitespace(message), nil, nil)
+		if tp.IsTTY() {
+			tp.AddField(utils.FuzzyAgoAbbr(time.Now(), commit.Commit.Author.Date), nil, cs.Gray)
+		} else {
+			tp.AddField(commit.Commit.Author.Date.Format(time.RFC3339), nil, nil)
+		}
+		tp.EndRow()
+	}
+	if io.IsStdoutTTY() {
+		header := "No commits matched your search\n"
+		if len(results.Items) > 0 {
+			header = fmt.Sprintf("Showing %d of %d commits\n\n", len(results.Items), results.Total)
+		}
+		fmt.Fprintf(io.Out, "\n%s", header)
+	}
+	return tp.Render()
+}
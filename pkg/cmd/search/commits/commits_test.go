@@ -0,0 +1,296 @@
+package commits
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmd/search/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdCommits(t *testing.T) {
+	var trueBool = true
+	tests := []struct {
+		name       string
+		input      string
+		output     CommitsOptions
+		wantErr    bool
+		errMsg     string
+		wantFormat bool
+	}{
+		{
+			name:    "no arguments",
+			input:   "",
+			wantErr: true,
+			errMsg:  "specify search keywords or flags",
+		},
+		{
+			name:  "keyword arguments",
+			input: "fix bug",
+			output: CommitsOptions{
+				Query: search.Query{Keywords: []string{"fix", "bug"}, Kind: "commits", Limit: 30},
+			},
+		},
+		{
+			name:  "web flag",
+			input: "--web",
+			output: CommitsOptions{
+				Query:   search.Query{Keywords: []string{}, Kind: "commits", Limit: 30},
+				WebMode: true,
+			},
+		},
+		{
+			name:   "limit flag",
+			input:  "--limit 10",
+			output: CommitsOptions{Query: search.Query{Keywords: []string{}, Kind: "commits", Limit: 10}},
+		},
+		{
+			name:    "invalid limit flag",
+			input:   "--limit 1001",
+			wantErr: true,
+			errMsg:  "`--limit` must be between 1 and 1000",
+		},
+		{
+			name:  "all-pages flag",
+			input: "--all-pages",
+			output: CommitsOptions{
+				Query:    search.Query{Keywords: []string{}, Kind: "commits", Limit: 1000},
+				AllPages: true,
+			},
+		},
+		{
+			name:  "format flag",
+			input: "--format csv",
+			output: CommitsOptions{
+				Query: search.Query{Keywords: []string{}, Kind: "commits", Limit: 30},
+			},
+			wantFormat: true,
+		},
+		{
+			name:    "format and json are mutually exclusive",
+			input:   "--format csv --json sha",
+			wantErr: true,
+			errMsg:  "specify only one of `--json`, `--web`, or `--format`",
+		},
+		{
+			name:  "order flag",
+			input: "--order asc",
+			output: CommitsOptions{
+				Query: search.Query{Keywords: []string{}, Kind: "commits", Limit: 30, Order: "asc"},
+			},
+		},
+		{
+			name:    "invalid order flag",
+			input:   "--order invalid",
+			wantErr: true,
+			errMsg:  "invalid argument \"invalid\" for \"--order\" flag: valid values are {asc|desc}",
+		},
+		{
+			name: "qualifier flags",
+			input: `
+      --author=author
+      --author-name=name
+      --author-email=email
+      --author-date=date
+      --committer=committer
+      --committer-name=name2
+      --committer-email=email2
+      --committer-date=date2
+      --hash=hash
+      --merge
+      --owner=owner
+      --parent=parent
+      --repo=owner/repo
+      --tree=tree
+      --visibility=public
+      `,
+			output: CommitsOptions{
+				Query: search.Query{
+					Keywords: []string{},
+					Kind:     "commits",
+					Limit:    30,
+					Qualifiers: search.Qualifiers{
+						Author:         "author",
+						AuthorName:     "name",
+						AuthorEmail:    "email",
+						AuthorDate:     "date",
+						Committer:      "committer",
+						CommitterName:  "name2",
+						CommitterEmail: "email2",
+						CommitterDate:  "date2",
+						Hash:           "hash",
+						Merge:          &trueBool,
+						Org:            "owner",
+						Parent:         "parent",
+						Repo:           []string{"owner/repo"},
+						Tree:           "tree",
+						Is:             []string{"public"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *CommitsOptions
+			cmd := NewCmdCommits(f, func(opts *CommitsOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Query, gotOpts.Query)
+			assert.Equal(t, tt.output.WebMode, gotOpts.WebMode)
+			assert.Equal(t, tt.output.AllPages, gotOpts.AllPages)
+			assert.Equal(t, tt.wantFormat, gotOpts.Exporter != nil)
+		})
+	}
+}
+
+func TestCommitsRun(t *testing.T) {
+	var query = search.Query{
+		Keywords: []string{"fix"},
+		Kind:     "commits",
+		Limit:    30,
+		Qualifiers: search.Qualifiers{
+			Repo: []string{"cli/cli"},
+		},
+	}
+	var authorDate = time.Date(2021, 2, 28, 12, 30, 0, 0, time.UTC)
+	tests := []struct {
+		errMsg     string
+		name       string
+		opts       *CommitsOptions
+		tty        bool
+		wantErr    bool
+		wantStderr string
+		wantStdout string
+	}{
+		{
+			name: "displays results tty",
+			opts: &CommitsOptions{
+				Query: query,
+				Searcher: &search.SearcherMock{
+					CommitsFunc: func(query search.Query) (search.CommitsResult, error) {
+						return search.CommitsResult{
+							IncompleteResults: false,
+							Items: []search.Commit{
+								{
+									SHA:        "abcdefg1234567",
+									Repository: search.CommitRepository{FullName: "cli/cli"},
+									Commit: search.CommitDetails{
+										Message: "Fix flaky test\n\nmore detail",
+										Author:  search.CommitUser{Date: authorDate},
+									},
+								},
+							},
+							Total: 100,
+						}, nil
+					},
+				},
+			},
+			tty:        true,
+			wantStdout: "\nShowing 1 of 100 commits\n\ncli/cli  abcdefg  Fix flaky test  Feb 28, 2021\n",
+		},
+		{
+			name: "displays no results tty",
+			opts: &CommitsOptions{
+				Query: query,
+				Searcher: &search.SearcherMock{
+					CommitsFunc: func(query search.Query) (search.CommitsResult, error) {
+						return search.CommitsResult{}, nil
+					},
+				},
+			},
+			tty:        true,
+			wantStdout: "\nNo commits matched your search\n",
+		},
+		{
+			name: "exports csv",
+			opts: &CommitsOptions{
+				Query:    query,
+				Exporter: shared.FormatExporter("csv", []string{"sha", "repo"}),
+				Searcher: &search.SearcherMock{
+					CommitsFunc: func(query search.Query) (search.CommitsResult, error) {
+						return search.CommitsResult{
+							Items: []search.Commit{
+								{SHA: "abcdefg1234567", Repository: search.CommitRepository{FullName: "cli/cli"}},
+							},
+							Total: 1,
+						}, nil
+					},
+				},
+			},
+			tty:        true,
+			wantStdout: "sha,repo\nabcdefg1234567,cli/cli\n",
+		},
+		{
+			name: "displays search error",
+			opts: &CommitsOptions{
+				Query: query,
+				Searcher: &search.SearcherMock{
+					CommitsFunc: func(query search.Query) (search.CommitsResult, error) {
+						return search.CommitsResult{}, fmt.Errorf("error with query")
+					},
+				},
+			},
+			errMsg:  "error with query",
+			wantErr: true,
+		},
+		{
+			name: "opens browser for web mode tty",
+			opts: &CommitsOptions{
+				Browser: &cmdutil.TestBrowser{},
+				Query:   query,
+				Searcher: &search.SearcherMock{
+					URLFunc: func(query search.Query) string {
+						return "https://github.com/search?type=commits&q=fix"
+					},
+				},
+				WebMode: true,
+			},
+			tty:        true,
+			wantStderr: "Opening github.com/search in your browser.\n",
+		},
+	}
+	for _, tt := range tests {
+		io, _, stdout, stderr := iostreams.Test()
+		io.SetStdinTTY(tt.tty)
+		io.SetStdoutTTY(tt.tty)
+		io.SetStderrTTY(tt.tty)
+		tt.opts.IO = io
+		t.Run(tt.name, func(t *testing.T) {
+			err := commitsRun(tt.opts)
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			} else if err != nil {
+				t.Fatalf("commitsRun unexpected error: %v", err)
+			}
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
@@ -13,7 +13,7 @@ import (
 func NewCmdPrs(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *cobra.Command {
 	var locked, merged bool
 	var noAssignee, noLabel, noMilestone, noProject bool
-	var order, sort string
+	var order, sort, format string
 	var appAuthor string
 	opts := &shared.IssuesOptions{
 		Browser: f.Browser,
@@ -50,6 +50,9 @@ func NewCmdPrs(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *cobr
 
 			# search pull requests with numerous reactions
 			$ gh search prs --reactions=">100"
+
+			# export every matching pull request as CSV for a reporting script
+			$ gh search prs --repo=cli/cli --all-pages --format csv
     `),
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) == 0 && c.Flags().NFlag() == 0 {
@@ -58,6 +61,18 @@ func NewCmdPrs(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *cobr
 			if opts.Query.Limit < 1 || opts.Query.Limit > shared.SearchMaxResults {
 				return cmdutil.FlagErrorf("`--limit` must be between 1 and 1000")
 			}
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--json`, `--web`, or `--format`",
+				c.Flags().Changed("json"), opts.WebMode, format != "",
+			); err != nil {
+				return err
+			}
+			if opts.AllPages {
+				opts.Query.Limit = shared.SearchMaxResults
+			}
+			if format != "" {
+				opts.Exporter = shared.FormatExporter(format, search.IssueFields)
+			}
 			if c.Flags().Changed("author") && c.Flags().Changed("app") {
 				return cmdutil.FlagErrorf("specify only `--author` or `--app`")
 			}
@@ -112,9 +127,11 @@ func NewCmdPrs(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *cobr
 	// Output flags
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, search.IssueFields)
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the search query in the web browser")
+	shared.AddFormatFlag(cmd, &format)
 
 	// Query parameter flags
 	cmd.Flags().IntVarP(&opts.Query.Limit, "limit", "L", 30, "Maximum number of results to fetch")
+	cmd.Flags().BoolVar(&opts.AllPages, "all-pages", false, "Fetch the full result window (up to 1,000 results), ignoring the --limit flag")
 	cmdutil.StringEnumFlag(cmd, &order, "order", "", "desc", []string{"asc", "desc"}, "Order of results returned, ignored unless '--sort' flag is specified")
 	cmdutil.StringEnumFlag(cmd, &sort, "sort", "", "best-match",
 		[]string{
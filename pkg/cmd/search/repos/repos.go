@@ -22,11 +22,14 @@ type ReposOptions struct {
 	Query    search.Query
 	Searcher search.Searcher
 	WebMode  bool
+
+	AllPages bool
 }
 
 func NewCmdRepos(f *cmdutil.Factory, runF func(*ReposOptions) error) *cobra.Command {
 	var order string
 	var sort string
+	var format string
 	opts := &ReposOptions{
 		Browser: f.Browser,
 		IO:      f.IOStreams,
@@ -60,6 +63,9 @@ func NewCmdRepos(f *cmdutil.Factory, runF func(*ReposOptions) error) *cobra.Comm
 
 			# search repositories by coding language and number of good first issues
 			$ gh search repos --language=go --good-first-issues=">=10"
+
+			# export every matching repository as CSV for a reporting script
+			$ gh search repos --language=go --all-pages --format csv
     `),
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) == 0 && c.Flags().NFlag() == 0 {
@@ -68,6 +74,18 @@ func NewCmdRepos(f *cmdutil.Factory, runF func(*ReposOptions) error) *cobra.Comm
 			if opts.Query.Limit < 1 || opts.Query.Limit > shared.SearchMaxResults {
 				return cmdutil.FlagErrorf("`--limit` must be between 1 and 1000")
 			}
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--json`, `--web`, or `--format`",
+				c.Flags().Changed("json"), opts.WebMode, format != "",
+			); err != nil {
+				return err
+			}
+			if opts.AllPages {
+				opts.Query.Limit = shared.SearchMaxResults
+			}
+			if format != "" {
+				opts.Exporter = shared.FormatExporter(format, search.RepositoryFields)
+			}
 			if c.Flags().Changed("order") {
 				opts.Query.Order = order
 			}
@@ -90,9 +108,11 @@ func NewCmdRepos(f *cmdutil.Factory, runF func(*ReposOptions) error) *cobra.Comm
 	// Output flags
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, search.RepositoryFields)
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the search query in the web browser")
+	shared.AddFormatFlag(cmd, &format)
 
 	// Query parameter flags
 	cmd.Flags().IntVarP(&opts.Query.Limit, "limit", "L", 30, "Maximum number of repositories to fetch")
+	cmd.Flags().BoolVar(&opts.AllPages, "all-pages", false, "Fetch the full result window (up to 1,000 results), ignoring the --limit flag")
 	cmdutil.StringEnumFlag(cmd, &order, "order", "", "desc", []string{"asc", "desc"}, "Order of repositories returned, ignored unless '--sort' flag is specified")
 	cmdutil.StringEnumFlag(cmd, &sort, "sort", "", "best-match", []string{"forks", "help-wanted-issues", "stars", "updated"}, "Sort fetched repositories")
 
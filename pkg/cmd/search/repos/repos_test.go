@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cli/cli/v2/pkg/cmd/search/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/search"
@@ -16,11 +17,12 @@ import (
 func TestNewCmdRepos(t *testing.T) {
 	var trueBool = true
 	tests := []struct {
-		name    string
-		input   string
-		output  ReposOptions
-		wantErr bool
-		errMsg  string
+		name       string
+		input      string
+		output     ReposOptions
+		wantErr    bool
+		errMsg     string
+		wantFormat bool
 	}{
 		{
 			name:    "no arguments",
@@ -54,6 +56,28 @@ func TestNewCmdRepos(t *testing.T) {
 			wantErr: true,
 			errMsg:  "`--limit` must be between 1 and 1000",
 		},
+		{
+			name:  "all-pages flag",
+			input: "--all-pages",
+			output: ReposOptions{
+				Query:    search.Query{Keywords: []string{}, Kind: "repositories", Limit: 1000},
+				AllPages: true,
+			},
+		},
+		{
+			name:  "format flag",
+			input: "--format csv",
+			output: ReposOptions{
+				Query: search.Query{Keywords: []string{}, Kind: "repositories", Limit: 30},
+			},
+			wantFormat: true,
+		},
+		{
+			name:    "format and json are mutually exclusive",
+			input:   "--format csv --json fullName",
+			wantErr: true,
+			errMsg:  "specify only one of `--json`, `--web`, or `--format`",
+		},
 		{
 			name:  "order flag",
 			input: "--order asc",
@@ -143,6 +167,8 @@ func TestNewCmdRepos(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, tt.output.Query, gotOpts.Query)
 			assert.Equal(t, tt.output.WebMode, gotOpts.WebMode)
+			assert.Equal(t, tt.output.AllPages, gotOpts.AllPages)
+			assert.Equal(t, tt.wantFormat, gotOpts.Exporter != nil)
 		})
 	}
 }
@@ -232,6 +258,25 @@ func TestReposRun(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "exports csv",
+			opts: &ReposOptions{
+				Query:    query,
+				Exporter: shared.FormatExporter("csv", []string{"fullName", "description"}),
+				Searcher: &search.SearcherMock{
+					RepositoriesFunc: func(query search.Query) (search.RepositoriesResult, error) {
+						return search.RepositoriesResult{
+							Items: []search.Repository{
+								{FullName: "cli/cli", Description: "GitHub CLI"},
+							},
+							Total: 1,
+						}, nil
+					},
+				},
+			},
+			tty:        true,
+			wantStdout: "fullName,description\ncli/cli,GitHub CLI\n",
+		},
 		{
 			name: "displays search error",
 			opts: &ReposOptions{
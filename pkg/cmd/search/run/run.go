@@ -0,0 +1,100 @@
+package run
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	searchCodeCmd "github.com/cli/cli/v2/pkg/cmd/search/code"
+	searchIssuesCmd "github.com/cli/cli/v2/pkg/cmd/search/issues"
+	searchPrsCmd "github.com/cli/cli/v2/pkg/cmd/search/prs"
+	searchReposCmd "github.com/cli/cli/v2/pkg/cmd/search/repos"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+)
+
+type RunOptions struct {
+	Config func() (config.Config, error)
+	IO     *iostreams.IOStreams
+
+	Factory *cmdutil.Factory
+	Name    string
+}
+
+func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command {
+	opts := &RunOptions{
+		IO:      f.IOStreams,
+		Config:  f.Config,
+		Factory: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "run <name>",
+		Short: "Run a saved search query",
+		Long: heredoc.Doc(`
+			Run a search query previously saved with "gh search save".
+		`),
+		Example: heredoc.Doc(`
+			$ gh search save my-triage --type issues --query "is:open label:triage"
+			$ gh search run my-triage
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot run search, no name given"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Name = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return runRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runRun(opts *RunOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	queriesCfg, err := cfg.Queries()
+	if err != nil {
+		return err
+	}
+
+	sq, ok := queriesCfg.Get(opts.Name)
+	if !ok {
+		return fmt.Errorf("no saved search named %q; create one with `gh search save`", opts.Name)
+	}
+
+	var cmd *cobra.Command
+	switch sq.Type {
+	case "code":
+		cmd = searchCodeCmd.NewCmdCode(opts.Factory, nil)
+	case "issues":
+		cmd = searchIssuesCmd.NewCmdIssues(opts.Factory, nil)
+	case "prs":
+		cmd = searchPrsCmd.NewCmdPrs(opts.Factory, nil)
+	case "repos":
+		cmd = searchReposCmd.NewCmdRepos(opts.Factory, nil)
+	default:
+		return fmt.Errorf("saved search %q has unrecognized type %q", opts.Name, sq.Type)
+	}
+
+	queryArgs, err := shlex.Split(sq.Query)
+	if err != nil {
+		return fmt.Errorf("could not parse saved query: %w", err)
+	}
+
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs(queryArgs)
+	cmd.SetIn(opts.IO.In)
+	cmd.SetOut(opts.IO.Out)
+	cmd.SetErr(opts.IO.ErrOut)
+
+	return cmd.Execute()
+}
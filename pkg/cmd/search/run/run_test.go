@@ -0,0 +1,75 @@
+package run
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunRun(t *testing.T) {
+	mainBuf := bytes.Buffer{}
+	hostsBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, &hostsBuf)()
+
+	cfg := config.NewBlankConfig()
+	queriesCfg, err := cfg.Queries()
+	require.NoError(t, err)
+	require.NoError(t, queriesCfg.Add("my-repos", "repos", "stars:>100"))
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "search/repositories"),
+		httpmock.JSONResponse(struct {
+			IncompleteResults bool
+			Items             []interface{}
+			Total             int `json:"total_count"`
+		}{}),
+	)
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	f := &cmdutil.Factory{
+		IOStreams: io,
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	opts := &RunOptions{
+		IO:      io,
+		Factory: f,
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+		Name: "my-repos",
+	}
+
+	err = runRun(opts)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "No repositories matched your search")
+}
+
+func TestRunRun_missing(t *testing.T) {
+	cfg := config.NewBlankConfig()
+	opts := &RunOptions{
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+		Name: "does-not-exist",
+	}
+
+	err := runRun(opts)
+	assert.EqualError(t, err, `no saved search named "does-not-exist"; create one with `+"`gh search save`")
+}
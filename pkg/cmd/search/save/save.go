@@ -0,0 +1,79 @@
+package save
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type SaveOptions struct {
+	Config func() (config.Config, error)
+	IO     *iostreams.IOStreams
+
+	Name  string
+	Type  string
+	Query string
+}
+
+func NewCmdSave(f *cmdutil.Factory, runF func(*SaveOptions) error) *cobra.Command {
+	opts := &SaveOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "save <name>",
+		Short: "Save a search query for later use",
+		Long: heredoc.Doc(`
+			Save a search query under a name so it can be reused later with "gh search run"
+			or applied with the "--filter" flag of "gh issue list" and "gh pr list".
+		`),
+		Example: heredoc.Doc(`
+			$ gh search save my-triage --type issues --query "is:open label:triage"
+			$ gh search run my-triage
+			$ gh issue list --filter my-triage
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot save search, no name given"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Name = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return saveRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Type, "type", "t", "repos", []string{"code", "issues", "prs", "repos"}, "The kind of search to save")
+	cmd.Flags().StringVarP(&opts.Query, "query", "q", "", "Search query")
+	_ = cmd.MarkFlagRequired("query")
+
+	return cmd
+}
+
+func saveRun(opts *SaveOptions) error {
+	cs := opts.IO.ColorScheme()
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	queriesCfg, err := cfg.Queries()
+	if err != nil {
+		return err
+	}
+
+	if err := queriesCfg.Add(opts.Name, opts.Type, opts.Query); err != nil {
+		return fmt.Errorf("could not save search: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Saved search %s\n", cs.SuccessIcon(), cs.Bold(opts.Name))
+	}
+
+	return nil
+}
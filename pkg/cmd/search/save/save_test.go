@@ -0,0 +1,119 @@
+package save
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+
+func TestNewCmdSave(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  SaveOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no name",
+			input:   "--query is:open",
+			wantErr: true,
+			errMsg:  "cannot save search, no name given",
+		},
+		{
+			name:    "no query",
+			input:   "my-triage",
+			wantErr: true,
+			errMsg:  "required flag(s) \"query\" not set",
+		},
+		{
+			name:  "type and query",
+			input: "my-triage --type issues --query is:open",
+			output: SaveOptions{
+				Name:  "my-triage",
+				Type:  "issues",
+				Query: "is:open",
+			},
+		},
+		{
+			name:  "default type",
+			input: "my-repos --query stars:>100",
+			output: SaveOptions{
+				Name:  "my-repos",
+				Type:  "repos",
+				Query: "stars:>100",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: io}
+
+			argv, err := shlex.Split(tt.input)
+			require.NoError(t, err)
+
+			var gotOpts *SaveOptions
+			cmd := NewCmdSave(f, func(opts *SaveOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.output.Name, gotOpts.Name)
+			assert.Equal(t, tt.output.Type, gotOpts.Type)
+			assert.Equal(t, tt.output.Query, gotOpts.Query)
+		})
+	}
+}
+
+func TestSaveRun(t *testing.T) {
+	mainBuf := bytes.Buffer{}
+	hostsBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, &hostsBuf)()
+
+	io, _, _, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	cfg := config.NewBlankConfig()
+	opts := &SaveOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+		Name:  "my-triage",
+		Type:  "issues",
+		Query: "is:open label:triage",
+	}
+
+	err := saveRun(opts)
+	require.NoError(t, err)
+	assert.Contains(t, stderr.String(), "Saved search my-triage")
+
+	queriesCfg, err := cfg.Queries()
+	require.NoError(t, err)
+	sq, ok := queriesCfg.Get("my-triage")
+	require.True(t, ok)
+	assert.Equal(t, "issues", sq.Type)
+	assert.Equal(t, "is:open label:triage", sq.Query)
+}
@@ -4,9 +4,14 @@ import (
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 
+	searchAllCmd "github.com/cli/cli/v2/pkg/cmd/search/all"
+	searchCodeCmd "github.com/cli/cli/v2/pkg/cmd/search/code"
+	searchCommitsCmd "github.com/cli/cli/v2/pkg/cmd/search/commits"
 	searchIssuesCmd "github.com/cli/cli/v2/pkg/cmd/search/issues"
 	searchPrsCmd "github.com/cli/cli/v2/pkg/cmd/search/prs"
 	searchReposCmd "github.com/cli/cli/v2/pkg/cmd/search/repos"
+	searchRunCmd "github.com/cli/cli/v2/pkg/cmd/search/run"
+	searchSaveCmd "github.com/cli/cli/v2/pkg/cmd/search/save"
 )
 
 func NewCmdSearch(f *cmdutil.Factory) *cobra.Command {
@@ -16,9 +21,14 @@ func NewCmdSearch(f *cmdutil.Factory) *cobra.Command {
 		Long:  "Search across all of GitHub.",
 	}
 
+	cmd.AddCommand(searchAllCmd.NewCmdAll(f, nil))
+	cmd.AddCommand(searchCodeCmd.NewCmdCode(f, nil))
+	cmd.AddCommand(searchCommitsCmd.NewCmdCommits(f, nil))
 	cmd.AddCommand(searchIssuesCmd.NewCmdIssues(f, nil))
 	cmd.AddCommand(searchPrsCmd.NewCmdPrs(f, nil))
 	cmd.AddCommand(searchReposCmd.NewCmdRepos(f, nil))
+	cmd.AddCommand(searchRunCmd.NewCmdRun(f, nil))
+	cmd.AddCommand(searchSaveCmd.NewCmdSave(f, nil))
 
 	return cmd
 }
@@ -1,7 +1,10 @@
 package shared
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +14,7 @@ import (
 	"github.com/cli/cli/v2/pkg/search"
 	"github.com/cli/cli/v2/pkg/text"
 	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
 )
 
 type EntityType int
@@ -33,6 +37,8 @@ type IssuesOptions struct {
 	Query    search.Query
 	Searcher search.Searcher
 	WebMode  bool
+
+	AllPages bool
 }
 
 func Searcher(f *cmdutil.Factory) (search.Searcher, error) {
@@ -51,6 +57,98 @@ func Searcher(f *cmdutil.Factory) (search.Searcher, error) {
 	return search.NewSearcher(client, host), nil
 }
 
+// AddFormatFlag registers the --format flag used by the search commands to export
+// their full result set as CSV or JSON Lines, for commands whose JSON export is the
+// default field list. It is independent of --json: callers are responsible for
+// rejecting combinations that don't make sense, e.g. via cmdutil.MutuallyExclusive.
+func AddFormatFlag(cmd *cobra.Command, format *string) {
+	cmdutil.StringEnumFlag(cmd, format, "format", "", "", []string{"csv", "jsonl"},
+		"Export the full result set as `format` instead of printing a table")
+}
+
+type exportable interface {
+	ExportData([]string) map[string]interface{}
+}
+
+// FormatExporter returns a cmdutil.Exporter that writes results as CSV or JSON
+// Lines, one row/line per result, rather than buffering everything into a single
+// JSON document the way the --json exporter does.
+func FormatExporter(format string, fields []string) cmdutil.Exporter {
+	return &formatExporter{format: format, fields: fields}
+}
+
+type formatExporter struct {
+	format string
+	fields []string
+}
+
+func (e *formatExporter) Fields() []string {
+	return e.fields
+}
+
+func (e *formatExporter) Write(io *iostreams.IOStreams, data interface{}) error {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("cannot export %T as %s", data, e.format)
+	}
+	switch e.format {
+	case "csv":
+		return e.writeCSV(io, v)
+	case "jsonl":
+		return e.writeJSONL(io, v)
+	default:
+		return fmt.Errorf("unsupported format: %q", e.format)
+	}
+}
+
+func (e *formatExporter) writeCSV(io *iostreams.IOStreams, v reflect.Value) error {
+	w := csv.NewWriter(io.Out)
+	if err := w.Write(e.fields); err != nil {
+		return err
+	}
+	for i := 0; i < v.Len(); i++ {
+		item, ok := v.Index(i).Interface().(exportable)
+		if !ok {
+			return fmt.Errorf("cannot export %T as csv", v.Index(i).Interface())
+		}
+		data := item.ExportData(e.fields)
+		row := make([]string, len(e.fields))
+		for col, f := range e.fields {
+			row[col] = csvValue(data[f])
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func (e *formatExporter) writeJSONL(io *iostreams.IOStreams, v reflect.Value) error {
+	enc := json.NewEncoder(io.Out)
+	enc.SetEscapeHTML(false)
+	for i := 0; i < v.Len(); i++ {
+		item, ok := v.Index(i).Interface().(exportable)
+		if !ok {
+			return fmt.Errorf("cannot export %T as jsonl", v.Index(i).Interface())
+		}
+		if err := enc.Encode(item.ExportData(e.fields)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func csvValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	if b, err := json.Marshal(v); err == nil {
+		return string(b)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
 func SearchIssues(opts *IssuesOptions) error {
 	io := opts.IO
 	if opts.WebMode {
@@ -95,7 +193,7 @@ func displayIssueResults(io *iostreams.IOStreams, et EntityType, results search.
 		if tp.IsTTY() {
 			issueNum = "#" + issueNum
 		}
-		tp.AddField(issueNum, nil, cs.ColorFromString(colorForIssueState(issue.State)))
+		tp.AddField(issueNum, nil, cs.ColorFromString(ColorForIssueState(issue.State)))
 		if !tp.IsTTY() {
 			tp.AddField(issue.State, nil, nil)
 		}
@@ -151,7 +249,7 @@ func listIssueLabels(issue *search.Issue, cs *iostreams.ColorScheme, colorize bo
 	return strings.Join(labelNames, ", ")
 }
 
-func colorForIssueState(state string) string {
+func ColorForIssueState(state string) string {
 	switch state {
 	case "open":
 		return "green"
@@ -218,3 +218,20 @@ func TestSearchIssues(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatExporterJSONL(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	items := []search.Issue{
+		{Number: 1, Title: "one"},
+		{Number: 2, Title: "two"},
+	}
+	err := FormatExporter("jsonl", []string{"number", "title"}).Write(io, items)
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"number\":1,\"title\":\"one\"}\n{\"number\":2,\"title\":\"two\"}\n", stdout.String())
+}
+
+func TestFormatExporterUnknownFormat(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	err := FormatExporter("xml", []string{"number"}).Write(io, []search.Issue{{Number: 1}})
+	assert.EqualError(t, err, `unsupported format: "xml"`)
+}
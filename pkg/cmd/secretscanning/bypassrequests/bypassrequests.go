@@ -0,0 +1,99 @@
+package bypassrequests
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/secretscanning/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type BypassRequestsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	Status string
+	Limit  int
+}
+
+func NewCmdBypassRequests(f *cmdutil.Factory, runF func(*BypassRequestsOptions) error) *cobra.Command {
+	opts := &BypassRequestsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "bypass-requests",
+		Short: "List push protection bypass requests",
+		Long: heredoc.Doc(`
+			List requests to bypass push protection for a secret detected in a push,
+			for auditing who requested a bypass and why.
+		`),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ gh secret-scanning bypass-requests --status=pending
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return bypassRequestsRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Status, "status", "", "", []string{"pending", "approved", "denied", "cancelled"}, "Filter by request status")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of requests to fetch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.BypassRequestFields)
+
+	return cmd
+}
+
+func bypassRequestsRun(opts *BypassRequestsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	requests, err := shared.ListBypassRequests(httpClient, baseRepo, opts.Status, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, requests)
+	}
+
+	if len(requests) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No push protection bypass requests found")
+		return cmdutil.SilentError
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, r := range requests {
+		tp.AddField(fmt.Sprintf("#%d", r.Number), nil, cs.Yellow)
+		tp.AddField(r.RequesterLogin, nil, nil)
+		tp.AddField(r.SecretType, nil, nil)
+		tp.AddField(r.Reason, nil, nil)
+		tp.AddField(r.Status, nil, nil)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,114 @@
+package bypassrequests
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdBypassRequests(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  BypassRequestsOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "no argument",
+			input:  "",
+			output: BypassRequestsOptions{Limit: 30},
+		},
+		{
+			name:   "status flag",
+			input:  "--status pending",
+			output: BypassRequestsOptions{Limit: 30, Status: "pending"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *BypassRequestsOptions
+			cmd := NewCmdBypassRequests(f, func(opts *BypassRequestsOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.output.Status, gotOpts.Status)
+		})
+	}
+}
+
+func Test_bypassRequestsRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/secret-scanning/push-protection-bypasses"),
+		httpmock.StringResponse(`[{"number":1,"reason":"false_positive","requester_login":"monalisa","status":"pending","secret_type":"github_pat"}]`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	err := bypassRequestsRun(&BypassRequestsOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Limit: 30,
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "#1")
+	assert.Contains(t, stdout.String(), "monalisa")
+	assert.Contains(t, stdout.String(), "pending")
+}
+
+func Test_bypassRequestsRun_none(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/secret-scanning/push-protection-bypasses"),
+		httpmock.StringResponse(`[]`))
+
+	io, _, _, stderr := iostreams.Test()
+
+	err := bypassRequestsRun(&BypassRequestsOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Limit: 30,
+	})
+	assert.Equal(t, cmdutil.SilentError, err)
+	assert.Equal(t, "No push protection bypass requests found\n", stderr.String())
+}
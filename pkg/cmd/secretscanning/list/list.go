@@ -0,0 +1,160 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	searchshared "github.com/cli/cli/v2/pkg/cmd/search/shared"
+	"github.com/cli/cli/v2/pkg/cmd/secretscanning/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	Org        string
+	State      string
+	Resolution string
+	SecretType string
+	Limit      int
+	Format     string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List secret scanning alerts",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Long: heredoc.Doc(`
+			List secret scanning alerts for a repository, or for every repository in an
+			organization when --org is given.
+		`),
+		Example: heredoc.Doc(`
+			$ gh secret-scanning list --state=open
+			$ gh secret-scanning list --resolution=revoked
+			$ gh secret-scanning list --org=my-org --state=open
+			$ gh secret-scanning list --format=csv > alerts.csv
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--json` or `--format`",
+				cmd.Flags().Changed("json"), opts.Format != "",
+			); err != nil {
+				return err
+			}
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--org` or `--repo`",
+				opts.Org != "", cmd.Flags().Changed("repo"),
+			); err != nil {
+				return err
+			}
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if opts.Org == "" {
+				opts.BaseRepo = f.BaseRepo
+			}
+
+			if opts.Format != "" {
+				opts.Exporter = searchshared.FormatExporter(opts.Format, shared.Fields)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "List alerts across every repository in an `organization`")
+	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "", "", []string{"open", "resolved"}, "Filter by alert state")
+	cmdutil.StringEnumFlag(cmd, &opts.Resolution, "resolution", "", "", shared.ResolveReasons, "Filter by resolution")
+	cmd.Flags().StringVar(&opts.SecretType, "secret-type", "", "Filter by a comma-separated list of secret `types`")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of alerts to fetch")
+	searchshared.AddFormatFlag(cmd, &opts.Format)
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.Fields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	listOpts := shared.ListOptions{
+		State:      opts.State,
+		Resolution: opts.Resolution,
+		SecretType: opts.SecretType,
+		Limit:      opts.Limit,
+	}
+
+	var alerts []shared.Alert
+	opts.IO.StartProgressIndicator()
+	if opts.Org != "" {
+		var cfg config.Config
+		cfg, err = opts.Config()
+		if err == nil {
+			var host string
+			host, err = cfg.DefaultHost()
+			if err == nil {
+				alerts, err = shared.ListOrgAlerts(httpClient, host, opts.Org, listOpts)
+			}
+		}
+	} else {
+		var baseRepo ghrepo.Interface
+		baseRepo, err = opts.BaseRepo()
+		if err == nil {
+			alerts, err = shared.ListAlerts(httpClient, baseRepo, listOpts)
+		}
+	}
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, alerts)
+	}
+
+	if len(alerts) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No secret scanning alerts found")
+		return cmdutil.SilentError
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, alert := range alerts {
+		tp.AddField(fmt.Sprintf("#%d", alert.Number), nil, cs.Yellow)
+		tp.AddField(alert.SecretTypeDisplayName, nil, nil)
+		tp.AddField(alert.State, nil, nil)
+		var bypassed string
+		if alert.PushProtectionBypassed {
+			bypassed = "Bypassed"
+		}
+		tp.AddField(bypassed, nil, cs.Red)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,140 @@
+package list
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  ListOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "no argument",
+			input:  "",
+			output: ListOptions{Limit: 30},
+		},
+		{
+			name:   "state flag",
+			input:  "--state open",
+			output: ListOptions{Limit: 30, State: "open"},
+		},
+		{
+			name:   "resolution flag",
+			input:  "--resolution revoked",
+			output: ListOptions{Limit: 30, Resolution: "revoked"},
+		},
+		{
+			name:    "invalid limit flag",
+			input:   "--limit 0",
+			wantErr: true,
+			errMsg:  "invalid limit: 0",
+		},
+		{
+			name:    "org and repo are mutually exclusive",
+			input:   "--org my-org --repo OWNER/REPO",
+			wantErr: true,
+			errMsg:  "specify only one of `--org` or `--repo`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.Flags().StringP("repo", "R", "", "")
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.output.State, gotOpts.State)
+			assert.Equal(t, tt.output.Resolution, gotOpts.Resolution)
+		})
+	}
+}
+
+func Test_listRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/secret-scanning/alerts"),
+		httpmock.StringResponse(`[{"number":1,"state":"open","secret_type":"github_pat","secret_type_display_name":"GitHub Personal Access Token","push_protection_bypassed":true}]`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	err := listRun(&ListOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Limit: 30,
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "#1")
+	assert.Contains(t, stdout.String(), "GitHub Personal Access Token")
+	assert.Contains(t, stdout.String(), "Bypassed")
+}
+
+func Test_listRun_noAlerts(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/secret-scanning/alerts"),
+		httpmock.StringResponse(`[]`))
+
+	io, _, _, stderr := iostreams.Test()
+
+	err := listRun(&ListOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Limit: 30,
+	})
+	assert.Equal(t, cmdutil.SilentError, err)
+	assert.Equal(t, "No secret scanning alerts found\n", stderr.String())
+}
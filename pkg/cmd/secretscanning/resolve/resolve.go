@@ -0,0 +1,90 @@
+package resolve
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/secretscanning/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ResolveOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	AlertNumber int
+	Resolution  string
+	Comment     string
+}
+
+func NewCmdResolve(f *cmdutil.Factory, runF func(*ResolveOptions) error) *cobra.Command {
+	opts := &ResolveOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "resolve <alert-number>",
+		Short: "Resolve a secret scanning alert",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ gh secret-scanning resolve 123 --resolution=revoked
+			$ gh secret-scanning resolve 123 --resolution=false_positive --comment="test fixture"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			alertNumber, err := strconv.Atoi(args[0])
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid alert number: %q", args[0])
+			}
+			opts.AlertNumber = alertNumber
+
+			if opts.Resolution == "" {
+				return cmdutil.FlagErrorf("`--resolution` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return resolveRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Resolution, "resolution", "", "", shared.ResolveReasons, "Reason for resolving the alert")
+	cmd.Flags().StringVar(&opts.Comment, "comment", "", "Optional comment explaining the resolution")
+
+	return cmd
+}
+
+func resolveRun(opts *ResolveOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	alert, err := shared.ResolveAlert(httpClient, baseRepo, opts.AlertNumber, opts.Resolution, opts.Comment)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Resolved alert #%d (%s)\n", cs.SuccessIcon(), alert.Number, opts.Resolution)
+	}
+
+	return nil
+}
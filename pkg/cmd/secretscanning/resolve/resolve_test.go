@@ -0,0 +1,113 @@
+package resolve
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdResolve(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  ResolveOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no argument",
+			input:   "--resolution=revoked",
+			wantErr: true,
+			errMsg:  "accepts 1 arg(s), received 0",
+		},
+		{
+			name:    "no resolution",
+			input:   "123",
+			wantErr: true,
+			errMsg:  "`--resolution` is required",
+		},
+		{
+			name:    "invalid alert number",
+			input:   "abc --resolution=revoked",
+			wantErr: true,
+			errMsg:  `invalid alert number: "abc"`,
+		},
+		{
+			name:  "valid",
+			input: `123 --resolution=revoked --comment="rotated the key"`,
+			output: ResolveOptions{
+				AlertNumber: 123,
+				Resolution:  "revoked",
+				Comment:     "rotated the key",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *ResolveOptions
+			cmd := NewCmdResolve(f, func(opts *ResolveOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.AlertNumber, gotOpts.AlertNumber)
+			assert.Equal(t, tt.output.Resolution, gotOpts.Resolution)
+			assert.Equal(t, tt.output.Comment, gotOpts.Comment)
+		})
+	}
+}
+
+func TestResolveRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO/secret-scanning/alerts/123"),
+		httpmock.StringResponse(`{"number":123,"state":"resolved","resolution":"revoked"}`),
+	)
+	defer reg.Verify(t)
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &ResolveOptions{
+		AlertNumber: 123,
+		Resolution:  "revoked",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		IO: io,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := resolveRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Resolved alert #123 (revoked)\n", stdout.String())
+}
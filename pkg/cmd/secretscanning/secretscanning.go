@@ -0,0 +1,30 @@
+package secretscanning
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdBypassRequests "github.com/cli/cli/v2/pkg/cmd/secretscanning/bypassrequests"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/secretscanning/list"
+	cmdResolve "github.com/cli/cli/v2/pkg/cmd/secretscanning/resolve"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdSecretScanning(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret-scanning <command>",
+		Short: "List and manage secret scanning alerts",
+		Long:  "Triage secret scanning alerts: list and filter them by state or resolution, resolve one with a reason, or review pending push protection bypass requests.",
+		Example: heredoc.Doc(`
+			$ gh secret-scanning list --state=open
+			$ gh secret-scanning resolve 123 --resolution=revoked
+			$ gh secret-scanning bypass-requests --status=pending
+		`),
+	}
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdResolve.NewCmdResolve(f, nil))
+	cmd.AddCommand(cmdBypassRequests.NewCmdBypassRequests(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,200 @@
+// Package shared contains helpers for working with secret scanning alerts and push
+// protection bypass requests that are reused across the `gh secret-scanning` leaf
+// commands.
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// Alert is a single secret scanning alert, as returned by the secret scanning REST API.
+type Alert struct {
+	Number                 int       `json:"number"`
+	State                  string    `json:"state"`
+	Resolution             string    `json:"resolution"`
+	ResolutionComment      string    `json:"resolution_comment"`
+	SecretType             string    `json:"secret_type"`
+	SecretTypeDisplayName  string    `json:"secret_type_display_name"`
+	PushProtectionBypassed bool      `json:"push_protection_bypassed"`
+	CreatedAt              time.Time `json:"created_at"`
+	URL                    string    `json:"html_url"`
+}
+
+// Fields are the field names accepted by the `--json` flag for `gh secret-scanning list`.
+var Fields = []string{
+	"number", "state", "resolution", "resolutionComment", "secretType",
+	"pushProtectionBypassed", "createdAt", "url",
+}
+
+// ExportData implements cmdutil.Exporter for a secret scanning alert.
+func (a *Alert) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "number":
+			data[f] = a.Number
+		case "state":
+			data[f] = a.State
+		case "resolution":
+			data[f] = a.Resolution
+		case "resolutionComment":
+			data[f] = a.ResolutionComment
+		case "secretType":
+			data[f] = a.SecretTypeDisplayName
+		case "pushProtectionBypassed":
+			data[f] = a.PushProtectionBypassed
+		case "createdAt":
+			data[f] = a.CreatedAt
+		case "url":
+			data[f] = a.URL
+		}
+	}
+	return data
+}
+
+// ListOptions configures a call to ListAlerts.
+type ListOptions struct {
+	State      string
+	Resolution string
+	SecretType string
+	Limit      int
+}
+
+// ListAlerts lists secret scanning alerts for a repository, most recently created first.
+func ListAlerts(httpClient *http.Client, repo ghrepo.Interface, opts ListOptions) ([]Alert, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	query := listQuery(opts)
+	path := fmt.Sprintf("repos/%s/%s/secret-scanning/alerts?%s", repo.RepoOwner(), repo.RepoName(), query.Encode())
+
+	var alerts []Alert
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &alerts); err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+// ListOrgAlerts lists secret scanning alerts across every repository in an organization.
+func ListOrgAlerts(httpClient *http.Client, hostname, org string, opts ListOptions) ([]Alert, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	query := listQuery(opts)
+	path := fmt.Sprintf("orgs/%s/secret-scanning/alerts?%s", org, query.Encode())
+
+	var alerts []Alert
+	if err := apiClient.REST(hostname, "GET", path, nil, &alerts); err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+func listQuery(opts ListOptions) url.Values {
+	query := url.Values{}
+	query.Set("per_page", fmt.Sprintf("%d", opts.Limit))
+	if opts.State != "" {
+		query.Set("state", opts.State)
+	}
+	if opts.Resolution != "" {
+		query.Set("resolution", opts.Resolution)
+	}
+	if opts.SecretType != "" {
+		query.Set("secret_type", opts.SecretType)
+	}
+	return query
+}
+
+// ResolveReasons are the values GitHub accepts for a resolved alert's resolution.
+var ResolveReasons = []string{"false_positive", "wont_fix", "revoked", "used_in_tests"}
+
+// ResolveAlert marks a secret scanning alert as resolved, recording why it was resolved.
+func ResolveAlert(httpClient *http.Client, repo ghrepo.Interface, alertNumber int, resolution, comment string) (*Alert, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	params := map[string]string{
+		"state":      "resolved",
+		"resolution": resolution,
+	}
+	if comment != "" {
+		params["resolution_comment"] = comment
+	}
+	requestByte, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var alert Alert
+	path := fmt.Sprintf("repos/%s/%s/secret-scanning/alerts/%d", repo.RepoOwner(), repo.RepoName(), alertNumber)
+	if err := apiClient.REST(repo.RepoHost(), "PATCH", path, bytes.NewReader(requestByte), &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// BypassRequest is a request to bypass push protection for a detected secret, as
+// returned by the secret scanning REST API.
+type BypassRequest struct {
+	Number         int       `json:"number"`
+	Reason         string    `json:"reason"`
+	RequesterLogin string    `json:"requester_login"`
+	Status         string    `json:"status"`
+	SecretType     string    `json:"secret_type"`
+	CreatedAt      time.Time `json:"created_at"`
+	URL            string    `json:"html_url"`
+}
+
+// BypassRequestFields are the field names accepted by the `--json` flag for
+// `gh secret-scanning bypass-requests`.
+var BypassRequestFields = []string{
+	"number", "reason", "requesterLogin", "status", "secretType", "createdAt", "url",
+}
+
+// ExportData implements cmdutil.Exporter for a push protection bypass request.
+func (b *BypassRequest) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "number":
+			data[f] = b.Number
+		case "reason":
+			data[f] = b.Reason
+		case "requesterLogin":
+			data[f] = b.RequesterLogin
+		case "status":
+			data[f] = b.Status
+		case "secretType":
+			data[f] = b.SecretType
+		case "createdAt":
+			data[f] = b.CreatedAt
+		case "url":
+			data[f] = b.URL
+		}
+	}
+	return data
+}
+
+// ListBypassRequests lists push protection bypass requests for a repository, most
+// recently created first.
+func ListBypassRequests(httpClient *http.Client, repo ghrepo.Interface, status string, limit int) ([]BypassRequest, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	query := url.Values{}
+	query.Set("per_page", fmt.Sprintf("%d", limit))
+	if status != "" {
+		query.Set("status", status)
+	}
+	path := fmt.Sprintf("repos/%s/%s/secret-scanning/push-protection-bypasses?%s", repo.RepoOwner(), repo.RepoName(), query.Encode())
+
+	var requests []BypassRequest
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &requests); err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
@@ -0,0 +1,260 @@
+package serve
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ServeOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+
+	SocketPath string
+}
+
+func NewCmdServe(f *cmdutil.Factory, runF func(*ServeOptions) error) *cobra.Command {
+	opts := &ServeOptions{
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Expose gh's authenticated API client over a local socket",
+		Long: heredoc.Doc(`
+			Start a local JSON-RPC server on a unix socket that editor plugins and other
+			integrations can talk to instead of shelling out to gh for every request.
+			This lets them reuse gh's stored authentication and HTTP cache rather than
+			each needing its own.
+
+			Requests are newline-delimited JSON objects of the form
+			{"id": 1, "method": "...", "params": {...}}, and responses are
+			{"id": 1, "result": ...} or {"id": 1, "error": "..."}.
+
+			Two methods are currently supported:
+
+			- "repo.current" takes no params and returns the host, owner, and name of
+			  the repository resolved from the current directory.
+			- "api" takes {"method", "path", "body"} (method and body are optional,
+			  defaulting to GET and no body) and performs that request against the
+			  REST API, returning {"status", "body"}.
+
+			The socket is created with permissions restricted to the current user.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.SocketPath == "" {
+				dir := os.Getenv("XDG_RUNTIME_DIR")
+				if dir == "" {
+					dir = os.TempDir()
+				}
+				opts.SocketPath = filepath.Join(dir, "gh-serve.sock")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return serveRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.SocketPath, "socket", "", "Path to the unix socket to listen on (default: $XDG_RUNTIME_DIR/gh-serve.sock)")
+
+	return cmd
+}
+
+type rpcRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type rpcResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result interface{}     `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+type apiParams struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body"`
+}
+
+type apiResult struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body"`
+}
+
+func serveRun(opts *ServeOptions) error {
+	if err := os.Remove(opts.SocketPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("could not remove stale socket: %w", err)
+	}
+
+	listener, err := net.Listen("unix", opts.SocketPath)
+	if err != nil {
+		return fmt.Errorf("could not listen on %s: %w", opts.SocketPath, err)
+	}
+	defer listener.Close()
+	defer os.Remove(opts.SocketPath)
+
+	if err := os.Chmod(opts.SocketPath, 0600); err != nil {
+		return fmt.Errorf("could not set socket permissions: %w", err)
+	}
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigc
+		listener.Close()
+	}()
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "%s Listening on %s\n", cs.SuccessIcon(), opts.SocketPath)
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if isClosedListenerError(err) {
+				return nil
+			}
+			return err
+		}
+
+		go handleConn(opts, conn)
+	}
+}
+
+func isClosedListenerError(err error) bool {
+	return err != nil && bytes.Contains([]byte(err.Error()), []byte("use of closed network connection"))
+}
+
+// maxRequestSize bounds a single JSON-RPC line. The "api" method proxies
+// arbitrary REST request bodies (file contents, issue/PR text, etc.), so the
+// default 64KB bufio.Scanner token limit is easily exceeded.
+const maxRequestSize = 10 * 1024 * 1024
+
+func handleConn(opts *ServeOptions, conn net.Conn) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 4096), maxRequestSize)
+	enc := json.NewEncoder(conn)
+
+	for scanner.Scan() {
+		var req rpcRequest
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			_ = enc.Encode(rpcResponse{Error: fmt.Sprintf("invalid request: %s", err)})
+			continue
+		}
+
+		result, err := dispatch(opts, req.Method, req.Params)
+		resp := rpcResponse{ID: req.ID}
+		if err != nil {
+			resp.Error = err.Error()
+		} else {
+			resp.Result = result
+		}
+		_ = enc.Encode(resp)
+	}
+}
+
+func dispatch(opts *ServeOptions, method string, params json.RawMessage) (interface{}, error) {
+	switch method {
+	case "repo.current":
+		return currentRepo(opts)
+	case "api":
+		return apiRequest(opts, params)
+	default:
+		return nil, fmt.Errorf("unknown method %q", method)
+	}
+}
+
+func currentRepo(opts *ServeOptions) (interface{}, error) {
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		Host  string `json:"host"`
+		Owner string `json:"owner"`
+		Name  string `json:"name"`
+	}{repo.RepoHost(), repo.RepoOwner(), repo.RepoName()}, nil
+}
+
+func apiRequest(opts *ServeOptions, rawParams json.RawMessage) (interface{}, error) {
+	var params apiParams
+	if err := json.Unmarshal(rawParams, &params); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+	if params.Path == "" {
+		return nil, fmt.Errorf("params.path is required")
+	}
+	if params.Method == "" {
+		params.Method = "GET"
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return nil, err
+	}
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return nil, err
+	}
+
+	var body *bytes.Reader
+	if len(params.Body) > 0 {
+		body = bytes.NewReader(params.Body)
+	} else {
+		body = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(params.Method, ghinstance.RESTPrefix(host)+params.Path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody := &bytes.Buffer{}
+	if _, err := respBody.ReadFrom(resp.Body); err != nil {
+		return nil, err
+	}
+
+	if !json.Valid(respBody.Bytes()) {
+		respBody = bytes.NewBufferString(fmt.Sprintf("%q", respBody.String()))
+	}
+
+	return apiResult{Status: resp.StatusCode, Body: respBody.Bytes()}, nil
+}
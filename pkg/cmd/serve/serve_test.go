@@ -0,0 +1,153 @@
+package serve
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdServe(t *testing.T) {
+	tests := []struct {
+		name string
+		cli  string
+		want string
+	}{
+		{
+			name: "default socket",
+			cli:  "",
+			want: "",
+		},
+		{
+			name: "custom socket",
+			cli:  "--socket /tmp/custom.sock",
+			want: "/tmp/custom.sock",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: io}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ServeOptions
+			cmd := NewCmdServe(f, func(opts *ServeOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			if tt.want == "" {
+				assert.Contains(t, gotOpts.SocketPath, "gh-serve.sock")
+			} else {
+				assert.Equal(t, tt.want, gotOpts.SocketPath)
+			}
+		})
+	}
+}
+
+func TestServeRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/cli/cli"),
+		httpmock.StringResponse(`{"full_name": "cli/cli"}`))
+
+	io, _, _, _ := iostreams.Test()
+	repo, err := ghrepo.FromFullName("cli/cli")
+	assert.NoError(t, err)
+
+	opts := &ServeOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo:   func() (ghrepo.Interface, error) { return repo, nil },
+		SocketPath: filepath.Join(t.TempDir(), "gh-serve-test.sock"),
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- serveRun(opts) }()
+
+	var conn net.Conn
+	for i := 0; i < 100; i++ {
+		conn, err = net.Dial("unix", opts.SocketPath)
+		if err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	enc := json.NewEncoder(conn)
+	scanner := bufio.NewScanner(conn)
+
+	t.Run("repo.current", func(t *testing.T) {
+		assert.NoError(t, enc.Encode(map[string]interface{}{"id": 1, "method": "repo.current"}))
+		assert.True(t, scanner.Scan())
+
+		var resp rpcResponse
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &resp))
+		assert.Empty(t, resp.Error)
+
+		result, ok := resp.Result.(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "github.com", result["host"])
+		assert.Equal(t, "cli", result["owner"])
+		assert.Equal(t, "cli", result["name"])
+	})
+
+	t.Run("api", func(t *testing.T) {
+		assert.NoError(t, enc.Encode(map[string]interface{}{
+			"id":     2,
+			"method": "api",
+			"params": map[string]interface{}{"path": "repos/cli/cli"},
+		}))
+		assert.True(t, scanner.Scan())
+
+		var resp rpcResponse
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &resp))
+		assert.Empty(t, resp.Error)
+
+		result, ok := resp.Result.(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, float64(200), result["status"])
+		body, ok := result["body"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "cli/cli", body["full_name"])
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		assert.NoError(t, enc.Encode(map[string]interface{}{"id": 3, "method": "nope"}))
+		assert.True(t, scanner.Scan())
+
+		var resp rpcResponse
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &resp))
+		assert.Contains(t, resp.Error, "unknown method")
+	})
+}
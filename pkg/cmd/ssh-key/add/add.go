@@ -19,6 +19,7 @@ type AddOptions struct {
 
 	KeyFile string
 	Title   string
+	KeyType string
 }
 
 func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command {
@@ -50,6 +51,7 @@ func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command
 	}
 
 	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title for the new key")
+	cmdutil.StringEnumFlag(cmd, &opts.KeyType, "type", "", "authentication", []string{"authentication", "signing"}, "Type of the SSH key to add")
 	return cmd
 }
 
@@ -82,7 +84,7 @@ func runAdd(opts *AddOptions) error {
 		return err
 	}
 
-	err = SSHKeyUpload(httpClient, hostname, keyReader, opts.Title)
+	err = SSHKeyUpload(httpClient, hostname, keyReader, opts.Title, opts.KeyType)
 	if err != nil {
 		return err
 	}
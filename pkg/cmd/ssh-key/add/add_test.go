@@ -35,6 +35,40 @@ func Test_runAdd(t *testing.T) {
 		},
 		KeyFile: "-",
 		Title:   "my sacred key",
+		KeyType: "authentication",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Public key added to your account\n", stderr.String())
+}
+
+func Test_runAdd_signingKey(t *testing.T) {
+	io, stdin, stdout, stderr := iostreams.Test()
+	io.SetStdinTTY(false)
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	stdin.WriteString("PUBKEY")
+
+	tr := httpmock.Registry{}
+	defer tr.Verify(t)
+
+	tr.Register(
+		httpmock.REST("POST", "user/ssh_signing_keys"),
+		httpmock.StringResponse(`{}`))
+
+	err := runAdd(&AddOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &tr}, nil
+		},
+		KeyFile: "-",
+		Title:   "my sacred key",
+		KeyType: "signing",
 	})
 	assert.NoError(t, err)
 
@@ -11,8 +11,12 @@ import (
 	"github.com/cli/cli/v2/internal/ghinstance"
 )
 
-func SSHKeyUpload(httpClient *http.Client, hostname string, keyFile io.Reader, title string) error {
-	url := ghinstance.RESTPrefix(hostname) + "user/keys"
+func SSHKeyUpload(httpClient *http.Client, hostname string, keyFile io.Reader, title, keyType string) error {
+	keysPath := "keys"
+	if keyType == "signing" {
+		keysPath = "ssh_signing_keys"
+	}
+	url := ghinstance.RESTPrefix(hostname) + "user/" + keysPath
 
 	keyBytes, err := ioutil.ReadAll(keyFile)
 	if err != nil {
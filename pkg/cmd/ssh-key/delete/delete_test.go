@@ -0,0 +1,71 @@
+package delete
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_deleteRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdinTTY(false)
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	tr := httpmock.Registry{}
+	defer tr.Verify(t)
+
+	tr.Register(
+		httpmock.REST("DELETE", "user/keys/1234"),
+		httpmock.StringResponse(`{}`))
+
+	err := deleteRun(&DeleteOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &tr}, nil
+		},
+		KeyID:   "1234",
+		KeyType: "authentication",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Public key deleted from your account\n", stderr.String())
+}
+
+func Test_deleteRun_signingKey(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdinTTY(false)
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	tr := httpmock.Registry{}
+	defer tr.Verify(t)
+
+	tr.Register(
+		httpmock.REST("DELETE", "user/ssh_signing_keys/1234"),
+		httpmock.StringResponse(`{}`))
+
+	err := deleteRun(&DeleteOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &tr}, nil
+		},
+		KeyID:   "1234",
+		KeyType: "signing",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Public key deleted from your account\n", stderr.String())
+}
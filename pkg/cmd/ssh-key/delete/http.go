@@ -0,0 +1,37 @@
+package delete
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+func deleteSSHKey(httpClient *http.Client, hostname, keyID, keyType string) error {
+	keysPath := "keys"
+	if keyType == "signing" {
+		keysPath = "ssh_signing_keys"
+	}
+
+	url := fmt.Sprintf("%suser/%s/%s", ghinstance.RESTPrefix(hostname), keysPath, keyID)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	_, err = io.Copy(ioutil.Discard, resp.Body)
+	return err
+}
@@ -17,10 +17,15 @@ type sshKey struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
-func userKeys(httpClient *http.Client, host, userHandle string) ([]sshKey, error) {
-	resource := "user/keys"
+func userKeys(httpClient *http.Client, host, userHandle, keyType string) ([]sshKey, error) {
+	keysPath := "keys"
+	if keyType == "signing" {
+		keysPath = "ssh_signing_keys"
+	}
+
+	resource := "user/" + keysPath
 	if userHandle != "" {
-		resource = fmt.Sprintf("users/%s/keys", userHandle)
+		resource = fmt.Sprintf("users/%s/%s", userHandle, keysPath)
 	}
 	url := fmt.Sprintf("%s%s?per_page=%d", ghinstance.RESTPrefix(host), resource, 100)
 	req, err := http.NewRequest("GET", url, nil)
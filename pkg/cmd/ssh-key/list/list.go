@@ -16,6 +16,8 @@ type ListOptions struct {
 	IO         *iostreams.IOStreams
 	Config     func() (config.Config, error)
 	HTTPClient func() (*http.Client, error)
+
+	KeyType string
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -38,6 +40,8 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 		},
 	}
 
+	cmdutil.StringEnumFlag(cmd, &opts.KeyType, "type", "t", "authentication", []string{"authentication", "signing"}, "Type of key to list")
+
 	return cmd
 }
 
@@ -57,7 +61,7 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
-	sshKeys, err := userKeys(apiClient, host, "")
+	sshKeys, err := userKeys(apiClient, host, "", opts.KeyType)
 	if err != nil {
 		return err
 	}
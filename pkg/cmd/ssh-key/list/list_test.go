@@ -103,6 +103,33 @@ func TestListRun(t *testing.T) {
 			wantStderr: "No SSH keys present in GitHub account.\n",
 			wantErr:    true,
 		},
+		{
+			name: "signing keys",
+			opts: ListOptions{
+				KeyType: "signing",
+				HTTPClient: func() (*http.Client, error) {
+					createdAt, _ := time.Parse(time.RFC3339, "2020-08-31T15:44:24+02:00")
+					reg := &httpmock.Registry{}
+					reg.Register(
+						httpmock.REST("GET", "user/ssh_signing_keys"),
+						httpmock.StringResponse(fmt.Sprintf(`[
+							{
+								"id": 1234,
+								"key": "ssh-rsa AAAABbBB123",
+								"title": "Mac",
+								"created_at": "%[1]s"
+							}
+						]`, createdAt.Format(time.RFC3339))),
+					)
+					return &http.Client{Transport: reg}, nil
+				},
+			},
+			isTTY: false,
+			wantStdout: heredoc.Doc(`
+				Mac	ssh-rsa AAAABbBB123	2020-08-31T15:44:24+02:00
+			`),
+			wantStderr: "",
+		},
 	}
 
 	for _, tt := range tests {
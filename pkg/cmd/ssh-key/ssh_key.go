@@ -2,6 +2,7 @@ package key
 
 import (
 	cmdAdd "github.com/cli/cli/v2/pkg/cmd/ssh-key/add"
+	cmdDelete "github.com/cli/cli/v2/pkg/cmd/ssh-key/delete"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/ssh-key/list"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -16,6 +17,7 @@ func NewCmdSSHKey(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,84 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+// StarredRepo is a repository starred by a user.
+type StarredRepo struct {
+	Name           string   `json:"name"`
+	FullName       string   `json:"full_name"`
+	Description    string   `json:"description"`
+	StargazerCount int      `json:"stargazers_count"`
+	Topics         []string `json:"topics"`
+	URL            string   `json:"html_url"`
+}
+
+func userStarredRepos(httpClient *http.Client, host string, limit int) ([]StarredRepo, error) {
+	var repos []StarredRepo
+
+	apiPath := fmt.Sprintf("%suser/starred?per_page=%d", ghinstance.RESTPrefix(host), perPage(limit))
+	for apiPath != "" && len(repos) < limit {
+		req, err := http.NewRequest("GET", apiPath, nil)
+		if err != nil {
+			return nil, err
+		}
+		// "mercy-preview" is still needed for some GitHub Enterprise versions to include topics
+		req.Header.Set("Accept", "application/vnd.github.mercy-preview+json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode > 299 {
+			defer resp.Body.Close()
+			return nil, api.HandleHTTPError(resp)
+		}
+
+		b, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		var page []StarredRepo
+		if err := json.Unmarshal(b, &page); err != nil {
+			return nil, err
+		}
+		repos = append(repos, page...)
+
+		apiPath = findNextPage(resp.Header.Get("Link"))
+	}
+
+	if len(repos) > limit {
+		repos = repos[:limit]
+	}
+
+	return repos, nil
+}
+
+func perPage(limit int) int {
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func findNextPage(link string) string {
+	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
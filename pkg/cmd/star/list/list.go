@@ -0,0 +1,153 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+	Exporter   cmdutil.Exporter
+
+	Topics []string
+	Limit  int
+}
+
+var Fields = []string{"name", "nameWithOwner", "description", "stargazerCount", "topics", "url"}
+
+func (r *StarredRepo) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "name":
+			data[f] = r.Name
+		case "nameWithOwner":
+			data[f] = r.FullName
+		case "description":
+			data[f] = r.Description
+		case "stargazerCount":
+			data[f] = r.StargazerCount
+		case "topics":
+			data[f] = r.Topics
+		case "url":
+			data[f] = r.URL
+		}
+	}
+	return data
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List repositories you've starred",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Long: heredoc.Doc(`
+			List repositories starred by the authenticated user, most recently starred first.
+		`),
+		Example: heredoc.Doc(`
+			$ gh star list
+			$ gh star list --topic cli --topic go
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&opts.Topics, "topic", nil, "Filter by `topic`")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of starred repositories to fetch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, Fields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	repos, err := userStarredRepos(httpClient, host, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(opts.Topics) > 0 {
+		repos = filterByTopic(repos, opts.Topics)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, repos)
+	}
+
+	if len(repos) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No starred repositories found")
+		return cmdutil.SilentError
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, repo := range repos {
+		tp.AddField(repo.FullName, nil, cs.Bold)
+		tp.AddField(repo.Description, nil, nil)
+		tp.AddField(fmt.Sprintf("%d", repo.StargazerCount), nil, cs.Yellow)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+// filterByTopic keeps only the repos that have at least one of the given topics.
+func filterByTopic(repos []StarredRepo, topics []string) []StarredRepo {
+	var filtered []StarredRepo
+	for _, repo := range repos {
+		if hasAnyTopic(repo.Topics, topics) {
+			filtered = append(filtered, repo)
+		}
+	}
+	return filtered
+}
+
+func hasAnyTopic(repoTopics, wanted []string) bool {
+	for _, w := range wanted {
+		for _, t := range repoTopics {
+			if t == w {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package star
+
+import (
+	cmdList "github.com/cli/cli/v2/pkg/cmd/star/list"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdStar(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "star <command>",
+		Short: "List repositories starred by a user",
+		Long:  "List repositories that the authenticated user has starred.",
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,101 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org         string
+	Name        string
+	Description string
+	Privacy     string
+	Parent      string
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <organization>",
+		Short: "Create a team",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if opts.Name == "" {
+				return cmdutil.FlagErrorf("`--name` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Name, "name", "", "The `name` of the team")
+	cmd.Flags().StringVar(&opts.Description, "description", "", "A `description` of the team")
+	cmd.Flags().StringVar(&opts.Parent, "parent", "", "The `slug` of a parent team to nest this team under")
+	cmdutil.StringEnumFlag(cmd, &opts.Privacy, "privacy", "", "secret", []string{"secret", "closed"}, "The level of privacy the team should have")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	req := createTeamRequest{
+		Name:        opts.Name,
+		Description: opts.Description,
+		Privacy:     opts.Privacy,
+	}
+
+	if opts.Parent != "" {
+		parentID, err := findTeamIDBySlug(httpClient, host, opts.Org, opts.Parent)
+		if err != nil {
+			return fmt.Errorf("failed to find parent team %q: %w", opts.Parent, err)
+		}
+		req.ParentTeamID = parentID
+	}
+
+	t, err := createTeam(httpClient, host, opts.Org, req)
+	if err != nil {
+		return fmt.Errorf("failed to create team: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created team %s/%s\n", cs.SuccessIcon(), opts.Org, t.Slug)
+	} else {
+		fmt.Fprintln(opts.IO.Out, t.Slug)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package create
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_createRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "orgs/my-org/teams"),
+		httpmock.StringResponse(`{"slug": "engineering"}`))
+
+	err := createRun(&CreateOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:    "my-org",
+		Name:   "Engineering",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "✓ Created team my-org/engineering\n", stdout.String())
+}
+
+func Test_createRun_withParent(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams/engineering"),
+		httpmock.StringResponse(`{"id": 1}`))
+	reg.Register(
+		httpmock.REST("POST", "orgs/my-org/teams"),
+		httpmock.RESTPayload(200, `{"slug": "backend"}`, func(payload map[string]interface{}) {
+			assert.EqualValues(t, 1, payload["parent_team_id"])
+		}))
+
+	err := createRun(&CreateOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:    "my-org",
+		Name:   "Backend",
+		Parent: "engineering",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Created team my-org/backend\n", stdout.String())
+}
@@ -0,0 +1,80 @@
+package create
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type team struct {
+	Slug string `json:"slug"`
+}
+
+type createTeamRequest struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	Privacy      string `json:"privacy,omitempty"`
+	ParentTeamID int64  `json:"parent_team_id,omitempty"`
+}
+
+func createTeam(httpClient *http.Client, host, org string, req createTeamRequest) (*team, error) {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(req); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%sorgs/%s/teams", ghinstance.RESTPrefix(host), org)
+	httpReq, err := http.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	var t team
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func findTeamIDBySlug(httpClient *http.Client, host, org, slug string) (int64, error) {
+	url := fmt.Sprintf("%sorgs/%s/teams/%s", ghinstance.RESTPrefix(host), org, slug)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return 0, api.HandleHTTPError(resp)
+	}
+
+	var t struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return 0, err
+	}
+
+	return t.ID, nil
+}
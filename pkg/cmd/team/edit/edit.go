@@ -0,0 +1,97 @@
+package edit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type EditOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org  string
+	Slug string
+
+	Name        string
+	Description string
+	Privacy     string
+}
+
+func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	var privacy string
+
+	cmd := &cobra.Command{
+		Use:   "edit <organization> <team-slug>",
+		Short: "Edit a team",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+			opts.Slug = args[1]
+
+			if !cmd.Flags().Changed("name") && !cmd.Flags().Changed("description") && !cmd.Flags().Changed("privacy") {
+				return cmdutil.FlagErrorf("specify at least one of `--name`, `--description`, or `--privacy`")
+			}
+
+			if cmd.Flags().Changed("privacy") {
+				opts.Privacy = privacy
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return editRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Name, "name", "", "Rename the team to `name`")
+	cmd.Flags().StringVar(&opts.Description, "description", "", "Set the team's `description`")
+	cmdutil.StringEnumFlag(cmd, &privacy, "privacy", "", "", []string{"secret", "closed"}, "Set the team's privacy level")
+
+	return cmd
+}
+
+func editRun(opts *EditOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	req := editTeamRequest{
+		Name:        opts.Name,
+		Description: opts.Description,
+		Privacy:     opts.Privacy,
+	}
+
+	if err := editTeam(httpClient, host, opts.Org, opts.Slug, req); err != nil {
+		return fmt.Errorf("failed to edit team: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Edited team %s/%s\n", cs.SuccessIcon(), opts.Org, opts.Slug)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package edit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_editRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("PATCH", "orgs/my-org/teams/engineering"),
+		httpmock.StringResponse(`{}`))
+
+	err := editRun(&EditOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:      func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:         "my-org",
+		Slug:        "engineering",
+		Description: "Updated description",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "✓ Edited team my-org/engineering\n", stdout.String())
+}
@@ -0,0 +1,109 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type Team struct {
+	ID          int64  `json:"id"`
+	Slug        string `json:"slug"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Privacy     string `json:"privacy"`
+	Permission  string `json:"permission"`
+	Parent      *Team  `json:"parent"`
+}
+
+func (t Team) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "parent":
+			if t.Parent != nil {
+				data[f] = t.Parent.Slug
+			} else {
+				data[f] = nil
+			}
+		default:
+			data[f] = fieldByName(t, f)
+		}
+	}
+	return data
+}
+
+func fieldByName(t Team, field string) interface{} {
+	switch field {
+	case "id":
+		return t.ID
+	case "slug":
+		return t.Slug
+	case "name":
+		return t.Name
+	case "description":
+		return t.Description
+	case "privacy":
+		return t.Privacy
+	case "permission":
+		return t.Permission
+	}
+	return nil
+}
+
+var TeamFields = []string{"description", "id", "name", "parent", "permission", "privacy", "slug"}
+
+func orgTeams(httpClient *http.Client, host, org string) ([]Team, error) {
+	path := fmt.Sprintf("orgs/%s/teams?per_page=100", org)
+	url := ghinstance.RESTPrefix(host) + path
+
+	var teams []Team
+	for url != "" {
+		page, next, err := getTeamsPage(httpClient, url)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, page...)
+		url = next
+	}
+	return teams, nil
+}
+
+func getTeamsPage(httpClient *http.Client, url string) ([]Team, string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, "", api.HandleHTTPError(resp)
+	}
+
+	var teams []Team
+	if err := json.NewDecoder(resp.Body).Decode(&teams); err != nil {
+		return nil, "", err
+	}
+
+	return teams, findNextPage(resp.Header.Get("Link")), nil
+}
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func findNextPage(link string) string {
+	for _, m := range linkRE.FindAllStringSubmatch(link, -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
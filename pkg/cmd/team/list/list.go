@@ -0,0 +1,142 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	Exporter   cmdutil.Exporter
+
+	Org string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list <organization>",
+		Short:   "List teams in an organization",
+		Long:    "List teams in an organization, with nested teams shown under their parent.",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, TeamFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	teams, err := orgTeams(httpClient, host, opts.Org)
+	if err != nil {
+		return fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, teams)
+	}
+
+	if len(teams) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "No teams found in %s\n", opts.Org)
+		}
+		return cmdutil.SilentError
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, team := range sortNested(teams) {
+		name := team.team.Slug
+		if team.depth > 0 {
+			name = strings.Repeat("  ", team.depth) + "└─ " + name
+		}
+		tp.AddField(name, nil, cs.Bold)
+		tp.AddField(team.team.Privacy, nil, cs.Gray)
+		tp.AddField(team.team.Description, nil, nil)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+type nestedTeam struct {
+	team  Team
+	depth int
+}
+
+// sortNested orders teams so that each team is listed after its parent,
+// with child teams indented beneath it.
+func sortNested(teams []Team) []nestedTeam {
+	bySlug := make(map[string]Team, len(teams))
+	childrenOf := make(map[string][]Team)
+	var roots []Team
+	for _, t := range teams {
+		bySlug[t.Slug] = t
+	}
+	for _, t := range teams {
+		if t.Parent != nil {
+			if _, ok := bySlug[t.Parent.Slug]; ok {
+				childrenOf[t.Parent.Slug] = append(childrenOf[t.Parent.Slug], t)
+				continue
+			}
+		}
+		roots = append(roots, t)
+	}
+
+	var out []nestedTeam
+	var walk func(t Team, depth int)
+	walk = func(t Team, depth int) {
+		out = append(out, nestedTeam{team: t, depth: depth})
+		for _, c := range childrenOf[t.Slug] {
+			walk(c, depth+1)
+		}
+	}
+	for _, t := range roots {
+		walk(t, 0)
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams"),
+		httpmock.StringResponse(`[
+			{"slug": "engineering", "name": "Engineering", "privacy": "closed", "description": "Everyone"},
+			{"slug": "backend", "name": "Backend", "privacy": "closed", "description": "", "parent": {"slug": "engineering", "name": "Engineering"}}
+		]`))
+
+	err := listRun(&ListOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:    "my-org",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "engineering   closed  Everyone\n  └─ backend  closed  \n", stdout.String())
+}
+
+func TestListRun_noTeams(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams"),
+		httpmock.StringResponse(`[]`))
+
+	err := listRun(&ListOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:    "my-org",
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "No teams found in my-org\n", stderr.String())
+}
@@ -0,0 +1,79 @@
+package add
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AddOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org      string
+	Slug     string
+	Username string
+	Role     string
+}
+
+func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command {
+	opts := &AddOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <organization> <team-slug> <username>",
+		Short: "Add a member to a team",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+			opts.Slug = args[1]
+			opts.Username = args[2]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return addRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Role, "role", "", "member", []string{"member", "maintainer"}, "The role to give the new team member")
+
+	return cmd
+}
+
+func addRun(opts *AddOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	if _, err := addTeamMember(httpClient, host, opts.Org, opts.Slug, opts.Username, opts.Role); err != nil {
+		return fmt.Errorf("failed to add team member: %w", err)
+	}
+
+	if !opts.IO.IsStdoutTTY() {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	_, err = fmt.Fprintf(opts.IO.Out, "%s Added %s to %s/%s as %s\n", cs.SuccessIcon(), opts.Username, opts.Org, opts.Slug, opts.Role)
+	return err
+}
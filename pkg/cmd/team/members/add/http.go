@@ -0,0 +1,47 @@
+package add
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type membership struct {
+	State string `json:"state"`
+	Role  string `json:"role"`
+}
+
+func addTeamMember(httpClient *http.Client, host, org, slug, username, role string) (*membership, error) {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]string{"role": role}); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%sorgs/%s/teams/%s/memberships/%s", ghinstance.RESTPrefix(host), org, slug, username)
+	req, err := http.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	var m membership
+	if err := json.NewDecoder(resp.Body).Decode(&m); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
@@ -0,0 +1,20 @@
+package members
+
+import (
+	addCmd "github.com/cli/cli/v2/pkg/cmd/team/members/add"
+	removeCmd "github.com/cli/cli/v2/pkg/cmd/team/members/remove"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdMembers(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members <command>",
+		Short: "Manage team members",
+	}
+
+	cmd.AddCommand(addCmd.NewCmdAdd(f, nil))
+	cmd.AddCommand(removeCmd.NewCmdRemove(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,29 @@
+package remove
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+func removeTeamMember(httpClient *http.Client, host, org, slug, username string) error {
+	url := fmt.Sprintf("%sorgs/%s/teams/%s/memberships/%s", ghinstance.RESTPrefix(host), org, slug, username)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package remove
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_removeRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("DELETE", "orgs/my-org/teams/engineering/memberships/monalisa"),
+		httpmock.StringResponse(`{}`))
+
+	err := removeRun(&RemoveOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:   func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:      "my-org",
+		Slug:     "engineering",
+		Username: "monalisa",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "✓ Removed monalisa from my-org/engineering\n", stdout.String())
+}
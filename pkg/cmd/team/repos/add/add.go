@@ -0,0 +1,85 @@
+package add
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AddOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org        string
+	Slug       string
+	Repository string
+	Permission string
+}
+
+func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command {
+	opts := &AddOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <organization> <team-slug> <owner/repo>",
+		Short: "Grant a team access to a repository",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+			opts.Slug = args[1]
+			opts.Repository = args[2]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return addRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Permission, "permission", "", "push", []string{"pull", "triage", "push", "maintain", "admin"}, "The permission to grant the team on the repository")
+
+	return cmd
+}
+
+func addRun(opts *AddOptions) error {
+	repo, err := ghrepo.FromFullName(opts.Repository)
+	if err != nil {
+		return cmdutil.FlagErrorf("argument error: %w", err)
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	if err := addTeamRepo(httpClient, host, opts.Org, opts.Slug, repo.RepoOwner(), repo.RepoName(), opts.Permission); err != nil {
+		return fmt.Errorf("failed to add repository to team: %w", err)
+	}
+
+	if !opts.IO.IsStdoutTTY() {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	_, err = fmt.Fprintf(opts.IO.Out, "%s Granted %s/%s %s access to %s\n", cs.SuccessIcon(), opts.Org, opts.Slug, opts.Permission, ghrepo.FullName(repo))
+	return err
+}
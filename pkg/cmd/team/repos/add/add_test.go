@@ -0,0 +1,37 @@
+package add
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_addRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("PUT", "orgs/my-org/teams/engineering/repos/my-org/my-repo"),
+		httpmock.StringResponse(`{}`))
+
+	err := addRun(&AddOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:     func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:        "my-org",
+		Slug:       "engineering",
+		Repository: "my-org/my-repo",
+		Permission: "push",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "✓ Granted my-org/engineering push access to my-org/my-repo\n", stdout.String())
+}
@@ -0,0 +1,37 @@
+package add
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+func addTeamRepo(httpClient *http.Client, host, org, slug, owner, repo, permission string) error {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]string{"permission": permission}); err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%sorgs/%s/teams/%s/repos/%s/%s", ghinstance.RESTPrefix(host), org, slug, owner, repo)
+	req, err := http.NewRequest("PUT", url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package repos
+
+import (
+	addCmd "github.com/cli/cli/v2/pkg/cmd/team/repos/add"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdRepos(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repos <command>",
+		Short: "Manage a team's repositories",
+	}
+
+	cmd.AddCommand(addCmd.NewCmdAdd(f, nil))
+
+	return cmd
+}
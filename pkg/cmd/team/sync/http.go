@@ -0,0 +1,140 @@
+package sync
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type remoteTeam struct {
+	ID   int64  `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+func listRemoteTeams(httpClient *http.Client, host, org string) ([]remoteTeam, error) {
+	url := fmt.Sprintf("%sorgs/%s/teams?per_page=100", ghinstance.RESTPrefix(host), org)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	var teams []remoteTeam
+	if err := json.NewDecoder(resp.Body).Decode(&teams); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+type upsertTeamRequest struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	Privacy      string `json:"privacy,omitempty"`
+	ParentTeamID int64  `json:"parent_team_id,omitempty"`
+}
+
+func createRemoteTeam(httpClient *http.Client, host, org string, req upsertTeamRequest) (*remoteTeam, error) {
+	return doUpsert(httpClient, "POST", fmt.Sprintf("%sorgs/%s/teams", ghinstance.RESTPrefix(host), org), req)
+}
+
+func updateRemoteTeam(httpClient *http.Client, host, org, slug string, req upsertTeamRequest) (*remoteTeam, error) {
+	return doUpsert(httpClient, "PATCH", fmt.Sprintf("%sorgs/%s/teams/%s", ghinstance.RESTPrefix(host), org, slug), req)
+}
+
+func doUpsert(httpClient *http.Client, method, url string, req upsertTeamRequest) (*remoteTeam, error) {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(req); err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	var t remoteTeam
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func setMembership(httpClient *http.Client, host, org, slug, username, role string) error {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]string{"role": role}); err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%sorgs/%s/teams/%s/memberships/%s", ghinstance.RESTPrefix(host), org, slug, username)
+	req, err := http.NewRequest("PUT", url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
+
+func setRepoPermission(httpClient *http.Client, host, org, slug, owner, repo, permission string) error {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]string{"permission": permission}); err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%sorgs/%s/teams/%s/repos/%s/%s", ghinstance.RESTPrefix(host), org, slug, owner, repo)
+	req, err := http.NewRequest("PUT", url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
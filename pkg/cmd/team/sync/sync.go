@@ -0,0 +1,197 @@
+package sync
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type teamMember struct {
+	Login string `yaml:"login"`
+	Role  string `yaml:"role"`
+}
+
+type teamRepo struct {
+	Name       string `yaml:"name"`
+	Permission string `yaml:"permission"`
+}
+
+type teamDefinition struct {
+	Name        string       `yaml:"name"`
+	Description string       `yaml:"description"`
+	Privacy     string       `yaml:"privacy"`
+	Parent      string       `yaml:"parent"`
+	Members     []teamMember `yaml:"members"`
+	Repos       []teamRepo   `yaml:"repos"`
+}
+
+type teamsFile struct {
+	Teams []teamDefinition `yaml:"teams"`
+}
+
+type SyncOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org      string
+	Filename string
+}
+
+func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Command {
+	opts := &SyncOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync <organization>",
+		Short: "Create or update teams from a YAML definition file",
+		Long: heredoc.Doc(`
+			Create or update an organization's teams to match a YAML definition
+			file, so team structure can be managed as code.
+
+			Teams, members, and repository access listed in the file are created
+			or updated to match. List a team's "parent" before the team itself so
+			that nested teams can be resolved. This command is additive only: it
+			never removes a team, member, or repository access that isn't listed
+			in the file.
+
+			The file has the following format:
+
+			    teams:
+			      - name: Engineering
+			        description: Everyone building the product
+			        privacy: closed
+			        members:
+			          - login: monalisa
+			            role: maintainer
+			        repos:
+			          - name: my-org/my-repo
+			            permission: push
+			      - name: Backend
+			        parent: Engineering
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return syncRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Filename, "file", "f", "", "The `file` to read team definitions from (use \"-\" to read from standard input)")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func syncRun(opts *SyncOptions) error {
+	content, err := cmdutil.ReadFile(opts.Filename, opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	var tf teamsFile
+	if err := yaml.Unmarshal(content, &tf); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", opts.Filename, err)
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	existing, err := listRemoteTeams(httpClient, host, opts.Org)
+	if err != nil {
+		return fmt.Errorf("failed to list existing teams: %w", err)
+	}
+
+	slugByName := map[string]string{}
+	idBySlug := map[string]int64{}
+	for _, t := range existing {
+		slugByName[t.Name] = t.Slug
+		idBySlug[t.Slug] = t.ID
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	for _, def := range tf.Teams {
+		req := upsertTeamRequest{
+			Name:        def.Name,
+			Description: def.Description,
+			Privacy:     def.Privacy,
+		}
+
+		if def.Parent != "" {
+			parentSlug, ok := slugByName[def.Parent]
+			if !ok {
+				return fmt.Errorf("parent team %q for %q was not found; list it earlier in the file", def.Parent, def.Name)
+			}
+			req.ParentTeamID = idBySlug[parentSlug]
+		}
+
+		var t *remoteTeam
+		if slug, ok := slugByName[def.Name]; ok {
+			t, err = updateRemoteTeam(httpClient, host, opts.Org, slug, req)
+		} else {
+			t, err = createRemoteTeam(httpClient, host, opts.Org, req)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to sync team %q: %w", def.Name, err)
+		}
+		slugByName[t.Name] = t.Slug
+		idBySlug[t.Slug] = t.ID
+
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Synced team %s/%s\n", cs.SuccessIcon(), opts.Org, t.Slug)
+		}
+
+		for _, m := range def.Members {
+			role := m.Role
+			if role == "" {
+				role = "member"
+			}
+			if err := setMembership(httpClient, host, opts.Org, t.Slug, m.Login, role); err != nil {
+				return fmt.Errorf("failed to add %s to team %q: %w", m.Login, def.Name, err)
+			}
+		}
+
+		for _, r := range def.Repos {
+			repo, err := ghrepo.FromFullName(r.Name)
+			if err != nil {
+				return fmt.Errorf("invalid repository %q for team %q: %w", r.Name, def.Name, err)
+			}
+			permission := r.Permission
+			if permission == "" {
+				permission = "push"
+			}
+			if err := setRepoPermission(httpClient, host, opts.Org, t.Slug, repo.RepoOwner(), repo.RepoName(), permission); err != nil {
+				return fmt.Errorf("failed to grant team %q access to %s: %w", def.Name, r.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package sync
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_syncRun(t *testing.T) {
+	io, stdin, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	stdin.WriteString(`
+teams:
+  - name: Engineering
+    description: Everyone building the product
+    privacy: closed
+    members:
+      - login: monalisa
+        role: maintainer
+    repos:
+      - name: my-org/my-repo
+        permission: push
+  - name: Backend
+    parent: Engineering
+`)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams"),
+		httpmock.StringResponse(`[]`))
+	reg.Register(
+		httpmock.REST("POST", "orgs/my-org/teams"),
+		httpmock.StringResponse(`{"id": 1, "slug": "engineering", "name": "Engineering"}`))
+	reg.Register(
+		httpmock.REST("PUT", "orgs/my-org/teams/engineering/memberships/monalisa"),
+		httpmock.StringResponse(`{}`))
+	reg.Register(
+		httpmock.REST("PUT", "orgs/my-org/teams/engineering/repos/my-org/my-repo"),
+		httpmock.StringResponse(`{}`))
+	reg.Register(
+		httpmock.REST("POST", "orgs/my-org/teams"),
+		httpmock.RESTPayload(200, `{"id": 2, "slug": "backend", "name": "Backend"}`, func(payload map[string]interface{}) {
+			assert.EqualValues(t, 1, payload["parent_team_id"])
+		}))
+
+	err := syncRun(&SyncOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config:   func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:      "my-org",
+		Filename: "-",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.True(t, strings.Contains(stdout.String(), "Synced team my-org/engineering"))
+	assert.True(t, strings.Contains(stdout.String(), "Synced team my-org/backend"))
+}
@@ -0,0 +1,40 @@
+package team
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	createCmd "github.com/cli/cli/v2/pkg/cmd/team/create"
+	deleteCmd "github.com/cli/cli/v2/pkg/cmd/team/delete"
+	editCmd "github.com/cli/cli/v2/pkg/cmd/team/edit"
+	listCmd "github.com/cli/cli/v2/pkg/cmd/team/list"
+	membersCmd "github.com/cli/cli/v2/pkg/cmd/team/members"
+	reposCmd "github.com/cli/cli/v2/pkg/cmd/team/repos"
+	syncCmd "github.com/cli/cli/v2/pkg/cmd/team/sync"
+	viewCmd "github.com/cli/cli/v2/pkg/cmd/team/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTeam(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "team <command>",
+		Short: "Manage organization teams",
+		Long:  "Work with GitHub organization teams.",
+		Example: heredoc.Doc(`
+			$ gh team list my-org
+			$ gh team create my-org --name Engineering
+			$ gh team members add my-org engineering monalisa
+			$ gh team sync my-org --file teams.yml
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f, nil))
+	cmd.AddCommand(viewCmd.NewCmdView(f, nil))
+	cmd.AddCommand(createCmd.NewCmdCreate(f, nil))
+	cmd.AddCommand(editCmd.NewCmdEdit(f, nil))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f, nil))
+	cmd.AddCommand(membersCmd.NewCmdMembers(f))
+	cmd.AddCommand(reposCmd.NewCmdRepos(f))
+	cmd.AddCommand(syncCmd.NewCmdSync(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,50 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type Team struct {
+	ID           int64  `json:"id"`
+	Slug         string `json:"slug"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Privacy      string `json:"privacy"`
+	Permission   string `json:"permission"`
+	MembersCount int    `json:"members_count"`
+	ReposCount   int    `json:"repos_count"`
+	Parent       *struct {
+		Slug string `json:"slug"`
+	} `json:"parent"`
+}
+
+func getTeam(httpClient *http.Client, host, org, slug string) (*Team, error) {
+	url := fmt.Sprintf("%sorgs/%s/teams/%s", ghinstance.RESTPrefix(host), org, slug)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	var team Team
+	if err := json.NewDecoder(resp.Body).Decode(&team); err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
@@ -0,0 +1,85 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Org  string
+	Slug string
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <organization> <team-slug>",
+		Short: "View a team",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+			opts.Slug = args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	team, err := getTeam(httpClient, host, opts.Org, opts.Slug)
+	if err != nil {
+		return fmt.Errorf("failed to view team: %w", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s\n", cs.Bold(team.Name))
+	if team.Description != "" {
+		fmt.Fprintf(out, "%s\n", team.Description)
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "Slug: %s\n", team.Slug)
+	fmt.Fprintf(out, "Privacy: %s\n", team.Privacy)
+	if team.Parent != nil {
+		fmt.Fprintf(out, "Parent: %s\n", team.Parent.Slug)
+	}
+	fmt.Fprintf(out, "Members: %d\n", team.MembersCount)
+	fmt.Fprintf(out, "Repositories: %d\n", team.ReposCount)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_viewRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams/engineering"),
+		httpmock.StringResponse(`{
+			"slug": "engineering",
+			"name": "Engineering",
+			"description": "Everyone building the product",
+			"privacy": "closed",
+			"members_count": 12,
+			"repos_count": 4
+		}`))
+
+	err := viewRun(&ViewOptions{
+		IO: io,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) { return config.NewBlankConfig(), nil },
+		Org:    "my-org",
+		Slug:   "engineering",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, "Engineering\nEveryone building the product\n\nSlug: engineering\nPrivacy: closed\nMembers: 12\nRepositories: 4\n", stdout.String())
+}
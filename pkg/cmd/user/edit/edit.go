@@ -0,0 +1,105 @@
+package edit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type EditOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+
+	Name     string
+	Bio      string
+	Company  string
+	Location string
+	Blog     string
+}
+
+func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Edit your GitHub profile",
+		Long: heredoc.Doc(`
+			Edit fields of the authenticated user's GitHub profile.
+
+			Pass at least one flag to set the corresponding field.
+		`),
+		Args: cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ gh user edit --bio "Building things with Go"
+			$ gh user edit --company "Acme Inc." --location "Berlin, Germany"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("name") &&
+				!cmd.Flags().Changed("bio") &&
+				!cmd.Flags().Changed("company") &&
+				!cmd.Flags().Changed("location") &&
+				!cmd.Flags().Changed("blog") {
+				return cmdutil.FlagErrorf("specify at least one of `--name`, `--bio`, `--company`, `--location`, or `--blog`")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return editRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Name, "name", "", "Set your display `name`")
+	cmd.Flags().StringVar(&opts.Bio, "bio", "", "Set your profile `bio`")
+	cmd.Flags().StringVar(&opts.Company, "company", "", "Set your `company`")
+	cmd.Flags().StringVar(&opts.Location, "location", "", "Set your `location`")
+	cmd.Flags().StringVar(&opts.Blog, "blog", "", "Set your website `url`")
+
+	return cmd
+}
+
+func editRun(opts *EditOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	req := editUserRequest{
+		Name:     opts.Name,
+		Bio:      opts.Bio,
+		Company:  opts.Company,
+		Location: opts.Location,
+		Blog:     opts.Blog,
+	}
+
+	if err := editUser(httpClient, host, req); err != nil {
+		return fmt.Errorf("failed to edit profile: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Edited profile\n", cs.SuccessIcon())
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package edit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+type editUserRequest struct {
+	Name     string `json:"name,omitempty"`
+	Bio      string `json:"bio,omitempty"`
+	Company  string `json:"company,omitempty"`
+	Location string `json:"location,omitempty"`
+	Blog     string `json:"blog,omitempty"`
+}
+
+func editUser(httpClient *http.Client, host string, req editUserRequest) error {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(req); err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%suser", ghinstance.RESTPrefix(host))
+	httpReq, err := http.NewRequest("PATCH", url, body)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package user
+
+import (
+	cmdEdit "github.com/cli/cli/v2/pkg/cmd/user/edit"
+	cmdView "github.com/cli/cli/v2/pkg/cmd/user/view"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdUser(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user <command>",
+		Short: "Interact with GitHub user profiles",
+		Long:  "Work with GitHub user profiles.",
+	}
+
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_viewRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query UserProfile\b`),
+		httpmock.StringResponse(`
+		{ "data": { "user": {
+			"login": "monalisa",
+			"name": "Mona Lisa",
+			"bio": "Octocat's best friend",
+			"followers": { "totalCount": 100 },
+			"following": { "totalCount": 10 },
+			"pinnedItems": { "nodes": [
+				{ "nameWithOwner": "monalisa/hello-world", "description": "my first repo", "stargazerCount": 42 }
+			] },
+			"contributionsCollection": { "contributionCalendar": { "totalContributions": 500 } }
+		} } }`))
+
+	err := viewRun(&ViewOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Login: "monalisa",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Contains(t, stdout.String(), "monalisa")
+	assert.Contains(t, stdout.String(), "Octocat's best friend")
+	assert.Contains(t, stdout.String(), "monalisa/hello-world")
+}
@@ -0,0 +1,149 @@
+package lint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type LintOptions struct {
+	IO *iostreams.IOStreams
+
+	Path     string
+	JSON     bool
+	Annotate bool
+}
+
+func NewCmdLint(f *cmdutil.Factory, runF func(*LintOptions) error) *cobra.Command {
+	opts := &LintOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "lint [<path>]",
+		Short: "Validate a workflow file",
+		Long: heredoc.Doc(`
+			Validate a workflow file against common mistakes: unknown event names,
+			"needs" referencing a job that isn't defined, third-party actions that
+			aren't pinned to a full commit SHA, and deprecated workflow commands.
+
+			This only inspects the YAML on disk; it doesn't call out to GitHub, so
+			it works the same with or without a repository checked out.
+
+			If <path> is a directory, every .yml and .yaml file directly inside it
+			is linted. With no argument, .github/workflows is linted.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Example: heredoc.Doc(`
+			# Lint every workflow under .github/workflows
+			$ gh workflow lint
+
+			# Lint a single workflow file
+			$ gh workflow lint .github/workflows/ci.yml
+
+			# Print findings as JSON, e.g. for a custom report
+			$ gh workflow lint --json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Path = args[0]
+			} else {
+				opts.Path = filepath.Join(".github", "workflows")
+			}
+
+			if opts.JSON && opts.Annotate {
+				return cmdutil.FlagErrorf("specify only one of `--json` or `--annotate`")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return runLint(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output findings as JSON")
+	cmd.Flags().BoolVar(&opts.Annotate, "annotate", false, "Output findings as GitHub Actions workflow command annotations")
+
+	return cmd
+}
+
+type fileFindings struct {
+	Path     string    `json:"path"`
+	Findings []Finding `json:"findings"`
+}
+
+func runLint(opts *LintOptions) error {
+	paths, err := workflowPaths(opts.Path)
+	if err != nil {
+		return err
+	}
+	sort.Strings(paths)
+
+	var results []fileFindings
+	hasError := false
+	for _, path := range paths {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		findings, err := Lint(content)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		for _, f := range findings {
+			if f.Severity == SeverityError {
+				hasError = true
+			}
+		}
+		results = append(results, fileFindings{Path: path, Findings: findings})
+	}
+
+	switch {
+	case opts.JSON:
+		if err := printJSON(opts, results); err != nil {
+			return err
+		}
+	case opts.Annotate:
+		printAnnotations(opts, results)
+	default:
+		printText(opts, results)
+	}
+
+	if hasError {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+// workflowPaths resolves path to the list of workflow files it refers to: the
+// file itself, or every .yml/.yaml file directly inside it if it's a directory.
+func workflowPaths(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var matches []string
+	for _, ext := range []string{"yml", "yaml"} {
+		found, err := filepath.Glob(filepath.Join(path, "*."+ext))
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, found...)
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no workflow files found in %s", path)
+	}
+	return matches, nil
+}
@@ -0,0 +1,179 @@
+package lint
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdLint(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    LintOptions
+		wantsErr string
+	}{
+		{
+			name:  "no argument",
+			cli:   "",
+			wants: LintOptions{Path: filepath.Join(".github", "workflows")},
+		},
+		{
+			name:  "with path",
+			cli:   "ci.yml",
+			wants: LintOptions{Path: "ci.yml"},
+		},
+		{
+			name:     "json and annotate together",
+			cli:      "--json --annotate",
+			wantsErr: "specify only one of `--json` or `--annotate`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{IOStreams: io}
+
+			var opts *LintOptions
+			cmd := NewCmdLint(f, func(o *LintOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				require.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Path, opts.Path)
+		})
+	}
+}
+
+func TestRunLint(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "clean.yml"), []byte(`
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v2
+`), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "broken.yml"), []byte(`
+on: [push, fake_event]
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo hi
+`), 0600))
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &LintOptions{IO: io, Path: dir}
+
+	err := runLint(opts)
+	require.Equal(t, cmdutil.SilentError, err)
+	assert.Contains(t, stdout.String(), "broken.yml")
+	assert.Contains(t, stdout.String(), "unknown-event")
+	assert.Contains(t, stdout.String(), "1 problem(s) found across 2 workflow file(s)")
+}
+
+func TestRunLint_clean(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "clean.yml"), []byte(`
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v2
+`), 0600))
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &LintOptions{IO: io, Path: dir}
+
+	require.NoError(t, runLint(opts))
+	assert.Contains(t, stdout.String(), "No problems found")
+}
+
+func TestRunLint_noWorkflows(t *testing.T) {
+	dir := t.TempDir()
+	io, _, _, _ := iostreams.Test()
+	opts := &LintOptions{IO: io, Path: dir}
+
+	err := runLint(opts)
+	require.EqualError(t, err, "no workflow files found in "+dir)
+}
+
+func TestRunLint_json(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "broken.yml"), []byte(`
+on: [push, fake_event]
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo hi
+`), 0600))
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &LintOptions{IO: io, Path: dir, JSON: true}
+
+	err := runLint(opts)
+	require.Equal(t, cmdutil.SilentError, err)
+	assert.Contains(t, stdout.String(), `"rule": "unknown-event"`)
+}
+
+func TestRunLint_annotate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "broken.yml"), []byte(`
+on: [push, fake_event]
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo hi
+`), 0600))
+
+	io, _, stdout, _ := iostreams.Test()
+	opts := &LintOptions{IO: io, Path: dir, Annotate: true}
+
+	err := runLint(opts)
+	require.Equal(t, cmdutil.SilentError, err)
+	assert.Contains(t, stdout.String(), "::error file=")
+	assert.Contains(t, stdout.String(), "unknown-event")
+}
+
+func TestWorkflowPaths_singleFile(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "ci.yml")
+	require.NoError(t, ioutil.WriteFile(file, []byte("on: push\njobs: {}\n"), 0600))
+
+	paths, err := workflowPaths(file)
+	require.NoError(t, err)
+	assert.Equal(t, []string{file}, paths)
+}
+
+func TestWorkflowPaths_missing(t *testing.T) {
+	_, err := workflowPaths(filepath.Join(t.TempDir(), "nope.yml"))
+	assert.True(t, os.IsNotExist(err))
+}
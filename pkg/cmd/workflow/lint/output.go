@@ -0,0 +1,52 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func printJSON(opts *LintOptions, results []fileFindings) error {
+	enc := json.NewEncoder(opts.IO.Out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(results)
+}
+
+// printAnnotations prints each finding as a GitHub Actions workflow command,
+// so running `gh workflow lint --annotate` from within a workflow step
+// surfaces the findings as check annotations.
+func printAnnotations(opts *LintOptions, results []fileFindings) {
+	out := opts.IO.Out
+	for _, r := range results {
+		for _, f := range r.Findings {
+			fmt.Fprintf(out, "::%s file=%s,line=%d::%s (%s)\n", f.Severity, r.Path, f.Line, f.Message, f.Rule)
+		}
+	}
+}
+
+func printText(opts *LintOptions, results []fileFindings) {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	total := 0
+	for _, r := range results {
+		if len(r.Findings) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "%s\n", cs.Bold(r.Path))
+		for _, f := range r.Findings {
+			icon := cs.WarningIcon()
+			if f.Severity == SeverityError {
+				icon = cs.FailureIcon()
+			}
+			fmt.Fprintf(out, "  %s line %d: %s [%s]\n", icon, f.Line, f.Message, f.Rule)
+			total++
+		}
+		fmt.Fprintln(out)
+	}
+
+	if total == 0 {
+		fmt.Fprintf(out, "%s No problems found in %d workflow file(s)\n", cs.SuccessIcon(), len(results))
+		return
+	}
+	fmt.Fprintf(out, "%d problem(s) found across %d workflow file(s)\n", total, len(results))
+}
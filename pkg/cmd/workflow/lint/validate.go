@@ -0,0 +1,226 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Severity classifies how serious a Finding is. Only SeverityError causes the
+// lint command to exit non-zero.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Finding is a single problem found in a workflow file.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	Line     int      `json:"line"`
+}
+
+// knownEvents lists the event names GitHub Actions recognizes under a
+// workflow's top-level "on:" key.
+var knownEvents = map[string]bool{
+	"branch_protection_rule": true, "check_run": true, "check_suite": true,
+	"commit_comment": true, "create": true, "delete": true, "deployment": true,
+	"deployment_status": true, "discussion": true, "discussion_comment": true,
+	"fork": true, "gollum": true, "issue_comment": true, "issues": true,
+	"label": true, "merge_group": true, "milestone": true, "page_build": true,
+	"project": true, "project_card": true, "project_column": true, "public": true,
+	"pull_request": true, "pull_request_review": true, "pull_request_review_comment": true,
+	"pull_request_target": true, "push": true, "registry_package": true,
+	"release": true, "repository_dispatch": true, "schedule": true, "status": true,
+	"watch": true, "workflow_call": true, "workflow_dispatch": true, "workflow_run": true,
+}
+
+// deprecatedCommands lists workflow commands GitHub Actions has deprecated in
+// favor of the GITHUB_OUTPUT/GITHUB_ENV/GITHUB_PATH environment files.
+var deprecatedCommands = []string{"::set-output", "::save-state", "::set-env", "::add-path"}
+
+var actionRefRE = regexp.MustCompile(`^([^/]+)/[^@]+@(.+)$`)
+var fullSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// Lint validates the workflow YAML in content and returns every problem it
+// finds. A returned error means content could not be parsed as YAML at all;
+// once parsing succeeds, problems are reported as Findings rather than errors.
+func Lint(content []byte) ([]Finding, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf("unable to parse workflow YAML: %w", err)
+	}
+	if len(root.Content) != 1 || root.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("invalid workflow YAML: expected a top-level mapping")
+	}
+	doc := root.Content[0]
+
+	var findings []Finding
+	findings = append(findings, lintEvents(doc)...)
+	findings = append(findings, lintJobNeeds(doc, collectJobNames(doc))...)
+	findings = append(findings, lintSteps(doc)...)
+	return findings, nil
+}
+
+// mapValue returns the value node paired with key in a YAML mapping node.
+func mapValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping == nil || mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func lintEvents(doc *yaml.Node) []Finding {
+	on := mapValue(doc, "on")
+	if on == nil {
+		return nil
+	}
+
+	var findings []Finding
+	check := func(nameNode *yaml.Node) {
+		if !knownEvents[nameNode.Value] {
+			findings = append(findings, Finding{
+				Rule:     "unknown-event",
+				Severity: SeverityError,
+				Message:  fmt.Sprintf("unknown event %q", nameNode.Value),
+				Line:     nameNode.Line,
+			})
+		}
+	}
+
+	switch on.Kind {
+	case yaml.ScalarNode:
+		check(on)
+	case yaml.SequenceNode:
+		for _, n := range on.Content {
+			check(n)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(on.Content); i += 2 {
+			check(on.Content[i])
+		}
+	}
+	return findings
+}
+
+func collectJobNames(doc *yaml.Node) map[string]bool {
+	jobs := mapValue(doc, "jobs")
+	names := map[string]bool{}
+	if jobs == nil || jobs.Kind != yaml.MappingNode {
+		return names
+	}
+	for i := 0; i+1 < len(jobs.Content); i += 2 {
+		names[jobs.Content[i].Value] = true
+	}
+	return names
+}
+
+func lintJobNeeds(doc *yaml.Node, jobNames map[string]bool) []Finding {
+	jobs := mapValue(doc, "jobs")
+	if jobs == nil || jobs.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var findings []Finding
+	for i := 0; i+1 < len(jobs.Content); i += 2 {
+		job := jobs.Content[i+1]
+		needs := mapValue(job, "needs")
+		if needs == nil {
+			continue
+		}
+
+		check := func(n *yaml.Node) {
+			if !jobNames[n.Value] {
+				findings = append(findings, Finding{
+					Rule:     "undefined-needs",
+					Severity: SeverityError,
+					Message:  fmt.Sprintf("job %q needs undefined job %q", jobs.Content[i].Value, n.Value),
+					Line:     n.Line,
+				})
+			}
+		}
+
+		switch needs.Kind {
+		case yaml.ScalarNode:
+			check(needs)
+		case yaml.SequenceNode:
+			for _, n := range needs.Content {
+				check(n)
+			}
+		}
+	}
+	return findings
+}
+
+func lintSteps(doc *yaml.Node) []Finding {
+	jobs := mapValue(doc, "jobs")
+	if jobs == nil || jobs.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var findings []Finding
+	for i := 0; i+1 < len(jobs.Content); i += 2 {
+		steps := mapValue(jobs.Content[i+1], "steps")
+		if steps == nil || steps.Kind != yaml.SequenceNode {
+			continue
+		}
+		for _, step := range steps.Content {
+			if uses := mapValue(step, "uses"); uses != nil {
+				findings = append(findings, lintActionPin(uses)...)
+			}
+			if run := mapValue(step, "run"); run != nil {
+				findings = append(findings, lintDeprecatedCommands(run)...)
+			}
+		}
+	}
+	return findings
+}
+
+func lintActionPin(uses *yaml.Node) []Finding {
+	m := actionRefRE.FindStringSubmatch(uses.Value)
+	if m == nil {
+		// not an owner/repo@ref reference (e.g. a local "./" action or a
+		// "docker://" image); pinning doesn't apply the same way.
+		return nil
+	}
+	owner, ref := m[1], m[2]
+	if owner == "actions" {
+		return nil
+	}
+	if fullSHA.MatchString(ref) {
+		return nil
+	}
+	return []Finding{{
+		Rule:     "unpinned-action",
+		Severity: SeverityWarning,
+		Message:  fmt.Sprintf("third-party action %q is not pinned to a full commit SHA", uses.Value),
+		Line:     uses.Line,
+	}}
+}
+
+func lintDeprecatedCommands(run *yaml.Node) []Finding {
+	var findings []Finding
+	for lineOffset, line := range strings.Split(run.Value, "\n") {
+		for _, cmd := range deprecatedCommands {
+			if strings.Contains(line, cmd) {
+				findings = append(findings, Finding{
+					Rule:     "deprecated-command",
+					Severity: SeverityWarning,
+					Message:  fmt.Sprintf("%q is deprecated; use the GITHUB_OUTPUT/GITHUB_ENV/GITHUB_PATH environment files instead", cmd),
+					Line:     run.Line + lineOffset,
+				})
+			}
+		}
+	}
+	return findings
+}
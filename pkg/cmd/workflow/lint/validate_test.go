@@ -0,0 +1,94 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint_clean(t *testing.T) {
+	findings, err := Lint([]byte(`
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v2
+`))
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestLint_unknownEvent(t *testing.T) {
+	findings, err := Lint([]byte(`
+on: [push, fake_event]
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo hi
+`))
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	assert.Equal(t, "unknown-event", findings[0].Rule)
+	assert.Equal(t, SeverityError, findings[0].Severity)
+	assert.Contains(t, findings[0].Message, "fake_event")
+}
+
+func TestLint_undefinedNeeds(t *testing.T) {
+	findings, err := Lint([]byte(`
+on: push
+jobs:
+  test:
+    needs: build
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo hi
+`))
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	assert.Equal(t, "undefined-needs", findings[0].Rule)
+	assert.Contains(t, findings[0].Message, `needs undefined job "build"`)
+}
+
+func TestLint_unpinnedAction(t *testing.T) {
+	findings, err := Lint([]byte(`
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v2
+      - uses: some-org/some-action@v1
+      - uses: some-org/pinned-action@1111111111111111111111111111111111111111
+      - uses: ./local-action
+`))
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	assert.Equal(t, "unpinned-action", findings[0].Rule)
+	assert.Equal(t, SeverityWarning, findings[0].Severity)
+	assert.Contains(t, findings[0].Message, "some-org/some-action@v1")
+}
+
+func TestLint_deprecatedCommand(t *testing.T) {
+	findings, err := Lint([]byte(`
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: |
+          echo "::set-output name=foo::bar"
+          echo "fine"
+`))
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	assert.Equal(t, "deprecated-command", findings[0].Rule)
+	assert.Contains(t, findings[0].Message, "::set-output")
+}
+
+func TestLint_invalidYAML(t *testing.T) {
+	_, err := Lint([]byte("not: [a, valid"))
+	assert.Error(t, err)
+}
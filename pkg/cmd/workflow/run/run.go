@@ -9,9 +9,11 @@ import (
 	"net/http"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -182,20 +184,49 @@ type InputAnswer struct {
 }
 
 func (ia *InputAnswer) WriteAnswer(name string, value interface{}) error {
-	if s, ok := value.(string); ok {
-		ia.providedInputs[name] = s
+	switch v := value.(type) {
+	case string:
+		ia.providedInputs[name] = v
 		return nil
-	}
-
-	// TODO i hate this; this is to make tests work:
-	if rv, ok := value.(reflect.Value); ok {
-		ia.providedInputs[name] = rv.String()
+	case bool:
+		ia.providedInputs[name] = strconv.FormatBool(v)
+		return nil
+	case core.OptionAnswer:
+		ia.providedInputs[name] = v.Value
+		return nil
+	case reflect.Value:
+		// TODO i hate this; this is to make tests work:
+		ia.providedInputs[name] = v.String()
 		return nil
 	}
 
 	return fmt.Errorf("unexpected value type: %v", value)
 }
 
+// inputPrompt returns the survey.Prompt that best matches input's declared type: a Confirm for
+// boolean inputs, a Select for choice inputs (using their declared options), and a plain text
+// Input for everything else (string, environment, or no type at all).
+func inputPrompt(name string, input WorkflowInput) survey.Prompt {
+	switch input.Type {
+	case "boolean":
+		return &survey.Confirm{
+			Message: name,
+			Default: input.Default == "true",
+		}
+	case "choice":
+		return &survey.Select{
+			Message: name,
+			Options: input.Options,
+			Default: input.Default,
+		}
+	default:
+		return &survey.Input{
+			Message: name,
+			Default: input.Default,
+		}
+	}
+}
+
 func collectInputs(yamlContent []byte) (map[string]string, error) {
 	inputs, err := findInputs(yamlContent)
 	if err != nil {
@@ -211,11 +242,8 @@ func collectInputs(yamlContent []byte) (map[string]string, error) {
 	qs := []*survey.Question{}
 	for inputName, input := range inputs {
 		q := &survey.Question{
-			Name: inputName,
-			Prompt: &survey.Input{
-				Message: inputName,
-				Default: input.Default,
-			},
+			Name:   inputName,
+			Prompt: inputPrompt(inputName, input),
 		}
 		if input.Required {
 			q.Validate = survey.Required
@@ -238,6 +266,32 @@ func collectInputs(yamlContent []byte) (map[string]string, error) {
 	return providedInputs, nil
 }
 
+// confirmDispatch summarizes the workflow_dispatch event about to be created and asks the user
+// to confirm it, so an interactively-entered typo doesn't trigger a run before they've seen it.
+func confirmDispatch(opts *RunOptions, workflow shared.Workflow, ref string, inputs map[string]string) (bool, error) {
+	cs := opts.IO.ColorScheme()
+
+	fmt.Fprintln(opts.IO.Out)
+	fmt.Fprintf(opts.IO.Out, "About to run %s on %s\n", cs.Cyan(workflow.Base()), cs.Bold(ref))
+	if len(inputs) == 0 {
+		fmt.Fprintln(opts.IO.Out, "No inputs")
+	} else {
+		names := make([]string, 0, len(inputs))
+		for name := range inputs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(opts.IO.Out, "  %s: %s\n", name, inputs[name])
+		}
+	}
+	fmt.Fprintln(opts.IO.Out)
+
+	var confirmed bool
+	err := prompt.Confirm("Dispatch this workflow?", &confirmed)
+	return confirmed, err
+}
+
 func runRun(opts *RunOptions) error {
 	c, err := opts.HttpClient()
 	if err != nil {
@@ -282,7 +336,7 @@ func runRun(opts *RunOptions) error {
 		if err != nil {
 			return fmt.Errorf("could not parse provided JSON: %w", err)
 		}
-	} else if opts.Prompt {
+	} else if opts.IO.CanPrompt() {
 		yamlContent, err := shared.GetWorkflowContent(client, repo, *workflow, ref)
 		if err != nil {
 			return fmt.Errorf("unable to fetch workflow file content: %w", err)
@@ -291,6 +345,14 @@ func runRun(opts *RunOptions) error {
 		if err != nil {
 			return err
 		}
+
+		confirmed, err := confirmDispatch(opts, *workflow, ref, providedInputs)
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
 	}
 
 	path := fmt.Sprintf("repos/%s/actions/workflows/%d/dispatches",
@@ -330,6 +392,8 @@ type WorkflowInput struct {
 	Required    bool
 	Default     string
 	Description string
+	Type        string
+	Options     []string
 }
 
 func findInputs(yamlContent []byte) (map[string]WorkflowInput, error) {
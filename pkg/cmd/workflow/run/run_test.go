@@ -558,12 +558,13 @@ jobs:
 			},
 			askStubs: func(as *prompt.AskStubber) {
 				as.StubPrompt("Select a workflow").AnswerDefault()
+				as.StubPrompt("Dispatch this workflow?").AnswerDefault()
 			},
 			wantBody: map[string]interface{}{
 				"inputs": map[string]interface{}{},
 				"ref":    "trunk",
 			},
-			wantOut: "✓ Created workflow_dispatch event for minimal.yml at trunk\n\nTo see runs for this workflow, try: gh run list --workflow=minimal.yml\n",
+			wantOut: "\nAbout to run minimal.yml on trunk\nNo inputs\n\n✓ Created workflow_dispatch event for minimal.yml at trunk\n\nTo see runs for this workflow, try: gh run list --workflow=minimal.yml\n",
 		},
 		{
 			name: "prompt",
@@ -597,6 +598,7 @@ jobs:
 				as.StubPrompt("Select a workflow").AnswerDefault()
 				as.StubPrompt("greeting").AnswerWith("hi")
 				as.StubPrompt("name").AnswerWith("scully")
+				as.StubPrompt("Dispatch this workflow?").AnswerDefault()
 			},
 			wantBody: map[string]interface{}{
 				"inputs": map[string]interface{}{
@@ -605,7 +607,7 @@ jobs:
 				},
 				"ref": "trunk",
 			},
-			wantOut: "✓ Created workflow_dispatch event for workflow.yml at trunk\n\nTo see runs for this workflow, try: gh run list --workflow=workflow.yml\n",
+			wantOut: "\nAbout to run workflow.yml on trunk\n  greeting: hi\n  name: scully\n\n✓ Created workflow_dispatch event for workflow.yml at trunk\n\nTo see runs for this workflow, try: gh run list --workflow=workflow.yml\n",
 		},
 	}
 
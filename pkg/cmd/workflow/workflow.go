@@ -3,6 +3,7 @@ package workflow
 import (
 	cmdDisable "github.com/cli/cli/v2/pkg/cmd/workflow/disable"
 	cmdEnable "github.com/cli/cli/v2/pkg/cmd/workflow/enable"
+	cmdLint "github.com/cli/cli/v2/pkg/cmd/workflow/lint"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/workflow/list"
 	cmdRun "github.com/cli/cli/v2/pkg/cmd/workflow/run"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/workflow/view"
@@ -26,6 +27,7 @@ func NewCmdWorkflow(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdDisable.NewCmdDisable(f, nil))
 	cmd.AddCommand(cmdView.NewCmdView(f, nil))
 	cmd.AddCommand(cmdRun.NewCmdRun(f, nil))
+	cmd.AddCommand(cmdLint.NewCmdLint(f, nil))
 
 	return cmd
 }
@@ -2,6 +2,7 @@ package cmdutil
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"github.com/cli/cli/v2/pkg/set"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
 )
 
 type JSONFlagError struct {
@@ -27,6 +29,7 @@ func AddJSONFlags(cmd *cobra.Command, exportTarget *Exporter, fields []string) {
 	f.StringSlice("json", nil, "Output JSON with the specified `fields`")
 	f.StringP("jq", "q", "", "Filter JSON output using a jq `expression`")
 	f.StringP("template", "t", "", "Format JSON output using a Go template")
+	f.String("output", "", "Output format: {json|yaml|tsv} (default \"json\")")
 
 	_ = cmd.RegisterFlagCompletionFunc("json", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		var results []string
@@ -89,22 +92,38 @@ func checkJSONFlags(cmd *cobra.Command) (*exportFormat, error) {
 	jsonFlag := f.Lookup("json")
 	jqFlag := f.Lookup("jq")
 	tplFlag := f.Lookup("template")
+	outputFlag := f.Lookup("output")
 	webFlag := f.Lookup("web")
 
 	if jsonFlag.Changed {
 		if webFlag != nil && webFlag.Changed {
 			return nil, errors.New("cannot use `--web` with `--json`")
 		}
+		var outputFormat string
+		if outputFlag != nil && outputFlag.Changed {
+			outputFormat = outputFlag.Value.String()
+			switch outputFormat {
+			case "json", "yaml", "tsv":
+			default:
+				return nil, fmt.Errorf("invalid value for `--output`: %q", outputFormat)
+			}
+			if outputFormat != "json" && (jqFlag.Changed || tplFlag.Changed) {
+				return nil, fmt.Errorf("cannot use `--output=%s` with `--jq` or `--template`", outputFormat)
+			}
+		}
 		jv := jsonFlag.Value.(pflag.SliceValue)
 		return &exportFormat{
 			fields:   jv.GetSlice(),
 			filter:   jqFlag.Value.String(),
 			template: tplFlag.Value.String(),
+			format:   outputFormat,
 		}, nil
 	} else if jqFlag.Changed {
 		return nil, errors.New("cannot use `--jq` without specifying `--json`")
 	} else if tplFlag.Changed {
 		return nil, errors.New("cannot use `--template` without specifying `--json`")
+	} else if outputFlag != nil && outputFlag.Changed {
+		return nil, errors.New("cannot use `--output` without specifying `--json`")
 	}
 	return nil, nil
 }
@@ -118,20 +137,33 @@ type exportFormat struct {
 	fields   []string
 	filter   string
 	template string
+	format   string
 }
 
 func (e *exportFormat) Fields() []string {
 	return e.fields
 }
 
-// Write serializes data into JSON output written to w. If the object passed as data implements exportable,
-// or if data is a map or slice of exportable object, ExportData() will be called on each object to obtain
-// raw data for serialization.
+// Write serializes data into JSON, YAML, or TSV output written to w, depending on the `--output`
+// flag. If the object passed as data implements exportable, or if data is a map or slice of
+// exportable object, ExportData() will be called on each object to obtain raw data for serialization.
 func (e *exportFormat) Write(ios *iostreams.IOStreams, data interface{}) error {
+	exported := e.exportData(reflect.ValueOf(data))
+
+	switch e.format {
+	case "yaml":
+		enc := yaml.NewEncoder(ios.Out)
+		enc.SetIndent(2)
+		defer enc.Close()
+		return enc.Encode(exported)
+	case "tsv":
+		return writeTSV(ios.Out, e.fields, exported)
+	}
+
 	buf := bytes.Buffer{}
 	encoder := json.NewEncoder(&buf)
 	encoder.SetEscapeHTML(false)
-	if err := encoder.Encode(e.exportData(reflect.ValueOf(data))); err != nil {
+	if err := encoder.Encode(exported); err != nil {
 		return err
 	}
 
@@ -148,6 +180,33 @@ func (e *exportFormat) Write(ios *iostreams.IOStreams, data interface{}) error {
 	return err
 }
 
+// writeTSV renders exported data as tab-separated values, one row per element of a top-level
+// slice and one column per requested field, in the order they were given to `--json`.
+func writeTSV(w io.Writer, fields []string, data interface{}) error {
+	rows, ok := data.([]interface{})
+	if !ok {
+		rows = []interface{}{data}
+	}
+
+	tw := csv.NewWriter(w)
+	tw.Comma = '\t'
+	for _, row := range rows {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		record := make([]string, len(fields))
+		for i, f := range fields {
+			record[i] = fmt.Sprintf("%v", m[f])
+		}
+		if err := tw.Write(record); err != nil {
+			return err
+		}
+	}
+	tw.Flush()
+	return tw.Error()
+}
+
 func (e *exportFormat) exportData(v reflect.Value) interface{} {
 	switch v.Kind() {
 	case reflect.Ptr, reflect.Interface:
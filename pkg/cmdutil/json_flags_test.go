@@ -91,6 +91,33 @@ func TestAddJSONFlags(t *testing.T) {
 				template: "{{.number}}",
 			},
 		},
+		{
+			name:   "with --output yaml",
+			fields: []string{"id", "number", "title"},
+			args:   []string{"--json", "number", "--output", "yaml"},
+			wantsExport: &exportFormat{
+				fields: []string{"number"},
+				format: "yaml",
+			},
+		},
+		{
+			name:       "invalid --output value",
+			fields:     []string{"id", "number"},
+			args:       []string{"--json", "number", "--output", "xml"},
+			wantsError: "invalid value for `--output`: \"xml\"",
+		},
+		{
+			name:       "cannot combine --output with --jq",
+			fields:     []string{"id", "number"},
+			args:       []string{"--json", "number", "--output", "tsv", "-q", ".number"},
+			wantsError: "cannot use `--output=tsv` with `--jq` or `--template`",
+		},
+		{
+			name:       "cannot use --output without --json",
+			fields:     []string{},
+			args:       []string{"--output", "yaml"},
+			wantsError: "cannot use `--output` without specifying `--json`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -176,6 +203,24 @@ func Test_exportFormat_Write(t *testing.T) {
 			wantW:   "hubot",
 			wantErr: false,
 		},
+		{
+			name:     "with --output yaml",
+			exporter: exportFormat{fields: []string{"field1", "field2"}, format: "yaml"},
+			args: args{
+				data: &exportableItem{"item1"},
+			},
+			wantW:   "field1: item1:field1\nfield2: item1:field2\n",
+			wantErr: false,
+		},
+		{
+			name:     "with --output tsv",
+			exporter: exportFormat{fields: []string{"field1", "field2"}, format: "tsv"},
+			args: args{
+				data: []exportableItem{{"item1"}, {"item2"}},
+			},
+			wantW:   "item1:field1\titem1:field2\nitem2:field1\titem2:field2\n",
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -24,15 +24,20 @@ type Extension interface {
 	UpdateAvailable() bool
 	IsBinary() bool
 	IsLocal() bool
+	VerificationStatus() string
 }
 
 //go:generate moq -rm -out manager_mock.go . ExtensionManager
 type ExtensionManager interface {
 	List(includeMetadata bool) []Extension
-	Install(ghrepo.Interface, string) error
+	Install(repo ghrepo.Interface, target string, skipVerification bool) error
 	InstallLocal(dir string) error
+	InstallFromLockFile() error
+	InstallFromTarball(tarballPath string) error
+	Package(dir, destPath string) error
 	Upgrade(name string, force bool) error
 	Remove(name string) error
 	Dispatch(args []string, stdin io.Reader, stdout, stderr io.Writer) (bool, error)
 	Create(name string, tmplType ExtTemplateType) error
+	Complete(extName string, args []string, toComplete string) ([]string, error)
 }
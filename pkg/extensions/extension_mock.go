@@ -13,43 +13,43 @@ var _ Extension = &ExtensionMock{}
 
 // ExtensionMock is a mock implementation of Extension.
 //
-// 	func TestSomethingThatUsesExtension(t *testing.T) {
+//	func TestSomethingThatUsesExtension(t *testing.T) {
 //
-// 		// make and configure a mocked Extension
-// 		mockedExtension := &ExtensionMock{
-// 			CurrentVersionFunc: func() string {
-// 				panic("mock out the CurrentVersion method")
-// 			},
-// 			IsBinaryFunc: func() bool {
-// 				panic("mock out the IsBinary method")
-// 			},
-// 			IsLocalFunc: func() bool {
-// 				panic("mock out the IsLocal method")
-// 			},
-// 			IsPinnedFunc: func() bool {
-// 				panic("mock out the IsPinned method")
-// 			},
-// 			NameFunc: func() string {
-// 				panic("mock out the Name method")
-// 			},
-// 			PathFunc: func() string {
-// 				panic("mock out the Path method")
-// 			},
-// 			PinFunc: func() string {
-// 				panic("mock out the Pin method")
-// 			},
-// 			URLFunc: func() string {
-// 				panic("mock out the URL method")
-// 			},
-// 			UpdateAvailableFunc: func() bool {
-// 				panic("mock out the UpdateAvailable method")
-// 			},
-// 		}
+//		// make and configure a mocked Extension
+//		mockedExtension := &ExtensionMock{
+//			CurrentVersionFunc: func() string {
+//				panic("mock out the CurrentVersion method")
+//			},
+//			IsBinaryFunc: func() bool {
+//				panic("mock out the IsBinary method")
+//			},
+//			IsLocalFunc: func() bool {
+//				panic("mock out the IsLocal method")
+//			},
+//			IsPinnedFunc: func() bool {
+//				panic("mock out the IsPinned method")
+//			},
+//			NameFunc: func() string {
+//				panic("mock out the Name method")
+//			},
+//			PathFunc: func() string {
+//				panic("mock out the Path method")
+//			},
+//			URLFunc: func() string {
+//				panic("mock out the URL method")
+//			},
+//			UpdateAvailableFunc: func() bool {
+//				panic("mock out the UpdateAvailable method")
+//			},
+//			VerificationStatusFunc: func() string {
+//				panic("mock out the VerificationStatus method")
+//			},
+//		}
 //
-// 		// use mockedExtension in code that requires Extension
-// 		// and then make assertions.
+//		// use mockedExtension in code that requires Extension
+//		// and then make assertions.
 //
-// 	}
+//	}
 type ExtensionMock struct {
 	// CurrentVersionFunc mocks the CurrentVersion method.
 	CurrentVersionFunc func() string
@@ -69,15 +69,15 @@ type ExtensionMock struct {
 	// PathFunc mocks the Path method.
 	PathFunc func() string
 
-	// PinFunc mocks the Pin method.
-	PinFunc func() string
-
 	// URLFunc mocks the URL method.
 	URLFunc func() string
 
 	// UpdateAvailableFunc mocks the UpdateAvailable method.
 	UpdateAvailableFunc func() bool
 
+	// VerificationStatusFunc mocks the VerificationStatus method.
+	VerificationStatusFunc func() string
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// CurrentVersion holds details about calls to the CurrentVersion method.
@@ -98,25 +98,25 @@ type ExtensionMock struct {
 		// Path holds details about calls to the Path method.
 		Path []struct {
 		}
-		// Pin holds details about calls to the Pin method.
-		Pin []struct {
-		}
 		// URL holds details about calls to the URL method.
 		URL []struct {
 		}
 		// UpdateAvailable holds details about calls to the UpdateAvailable method.
 		UpdateAvailable []struct {
 		}
+		// VerificationStatus holds details about calls to the VerificationStatus method.
+		VerificationStatus []struct {
+		}
 	}
-	lockCurrentVersion  sync.RWMutex
-	lockIsBinary        sync.RWMutex
-	lockIsLocal         sync.RWMutex
-	lockIsPinned        sync.RWMutex
-	lockName            sync.RWMutex
-	lockPath            sync.RWMutex
-	lockPin             sync.RWMutex
-	lockURL             sync.RWMutex
-	lockUpdateAvailable sync.RWMutex
+	lockCurrentVersion     sync.RWMutex
+	lockIsBinary           sync.RWMutex
+	lockIsLocal            sync.RWMutex
+	lockIsPinned           sync.RWMutex
+	lockName               sync.RWMutex
+	lockPath               sync.RWMutex
+	lockURL                sync.RWMutex
+	lockUpdateAvailable    sync.RWMutex
+	lockVerificationStatus sync.RWMutex
 }
 
 // CurrentVersion calls CurrentVersionFunc.
@@ -134,7 +134,8 @@ func (mock *ExtensionMock) CurrentVersion() string {
 
 // CurrentVersionCalls gets all the calls that were made to CurrentVersion.
 // Check the length with:
-//     len(mockedExtension.CurrentVersionCalls())
+//
+//	len(mockedExtension.CurrentVersionCalls())
 func (mock *ExtensionMock) CurrentVersionCalls() []struct {
 } {
 	var calls []struct {
@@ -160,7 +161,8 @@ func (mock *ExtensionMock) IsBinary() bool {
 
 // IsBinaryCalls gets all the calls that were made to IsBinary.
 // Check the length with:
-//     len(mockedExtension.IsBinaryCalls())
+//
+//	len(mockedExtension.IsBinaryCalls())
 func (mock *ExtensionMock) IsBinaryCalls() []struct {
 } {
 	var calls []struct {
@@ -186,7 +188,8 @@ func (mock *ExtensionMock) IsLocal() bool {
 
 // IsLocalCalls gets all the calls that were made to IsLocal.
 // Check the length with:
-//     len(mockedExtension.IsLocalCalls())
+//
+//	len(mockedExtension.IsLocalCalls())
 func (mock *ExtensionMock) IsLocalCalls() []struct {
 } {
 	var calls []struct {
@@ -212,7 +215,8 @@ func (mock *ExtensionMock) IsPinned() bool {
 
 // IsPinnedCalls gets all the calls that were made to IsPinned.
 // Check the length with:
-//     len(mockedExtension.IsPinnedCalls())
+//
+//	len(mockedExtension.IsPinnedCalls())
 func (mock *ExtensionMock) IsPinnedCalls() []struct {
 } {
 	var calls []struct {
@@ -238,7 +242,8 @@ func (mock *ExtensionMock) Name() string {
 
 // NameCalls gets all the calls that were made to Name.
 // Check the length with:
-//     len(mockedExtension.NameCalls())
+//
+//	len(mockedExtension.NameCalls())
 func (mock *ExtensionMock) NameCalls() []struct {
 } {
 	var calls []struct {
@@ -264,7 +269,8 @@ func (mock *ExtensionMock) Path() string {
 
 // PathCalls gets all the calls that were made to Path.
 // Check the length with:
-//     len(mockedExtension.PathCalls())
+//
+//	len(mockedExtension.PathCalls())
 func (mock *ExtensionMock) PathCalls() []struct {
 } {
 	var calls []struct {
@@ -275,32 +281,6 @@ func (mock *ExtensionMock) PathCalls() []struct {
 	return calls
 }
 
-// Pin calls PinFunc.
-func (mock *ExtensionMock) Pin() string {
-	if mock.PinFunc == nil {
-		panic("ExtensionMock.PinFunc: method is nil but Extension.Pin was just called")
-	}
-	callInfo := struct {
-	}{}
-	mock.lockPin.Lock()
-	mock.calls.Pin = append(mock.calls.Pin, callInfo)
-	mock.lockPin.Unlock()
-	return mock.PinFunc()
-}
-
-// PinCalls gets all the calls that were made to Pin.
-// Check the length with:
-//     len(mockedExtension.PinCalls())
-func (mock *ExtensionMock) PinCalls() []struct {
-} {
-	var calls []struct {
-	}
-	mock.lockPin.RLock()
-	calls = mock.calls.Pin
-	mock.lockPin.RUnlock()
-	return calls
-}
-
 // URL calls URLFunc.
 func (mock *ExtensionMock) URL() string {
 	if mock.URLFunc == nil {
@@ -316,7 +296,8 @@ func (mock *ExtensionMock) URL() string {
 
 // URLCalls gets all the calls that were made to URL.
 // Check the length with:
-//     len(mockedExtension.URLCalls())
+//
+//	len(mockedExtension.URLCalls())
 func (mock *ExtensionMock) URLCalls() []struct {
 } {
 	var calls []struct {
@@ -342,7 +323,8 @@ func (mock *ExtensionMock) UpdateAvailable() bool {
 
 // UpdateAvailableCalls gets all the calls that were made to UpdateAvailable.
 // Check the length with:
-//     len(mockedExtension.UpdateAvailableCalls())
+//
+//	len(mockedExtension.UpdateAvailableCalls())
 func (mock *ExtensionMock) UpdateAvailableCalls() []struct {
 } {
 	var calls []struct {
@@ -352,3 +334,30 @@ func (mock *ExtensionMock) UpdateAvailableCalls() []struct {
 	mock.lockUpdateAvailable.RUnlock()
 	return calls
 }
+
+// VerificationStatus calls VerificationStatusFunc.
+func (mock *ExtensionMock) VerificationStatus() string {
+	if mock.VerificationStatusFunc == nil {
+		panic("ExtensionMock.VerificationStatusFunc: method is nil but Extension.VerificationStatus was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockVerificationStatus.Lock()
+	mock.calls.VerificationStatus = append(mock.calls.VerificationStatus, callInfo)
+	mock.lockVerificationStatus.Unlock()
+	return mock.VerificationStatusFunc()
+}
+
+// VerificationStatusCalls gets all the calls that were made to VerificationStatus.
+// Check the length with:
+//
+//	len(mockedExtension.VerificationStatusCalls())
+func (mock *ExtensionMock) VerificationStatusCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockVerificationStatus.RLock()
+	calls = mock.calls.VerificationStatus
+	mock.lockVerificationStatus.RUnlock()
+	return calls
+}
@@ -15,38 +15,53 @@ var _ ExtensionManager = &ExtensionManagerMock{}
 
 // ExtensionManagerMock is a mock implementation of ExtensionManager.
 //
-// 	func TestSomethingThatUsesExtensionManager(t *testing.T) {
+//	func TestSomethingThatUsesExtensionManager(t *testing.T) {
 //
-// 		// make and configure a mocked ExtensionManager
-// 		mockedExtensionManager := &ExtensionManagerMock{
-// 			CreateFunc: func(name string, tmplType ExtTemplateType) error {
-// 				panic("mock out the Create method")
-// 			},
-// 			DispatchFunc: func(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (bool, error) {
-// 				panic("mock out the Dispatch method")
-// 			},
-// 			InstallFunc: func(interfaceMoqParam ghrepo.Interface, s string) error {
-// 				panic("mock out the Install method")
-// 			},
-// 			InstallLocalFunc: func(dir string) error {
-// 				panic("mock out the InstallLocal method")
-// 			},
-// 			ListFunc: func(includeMetadata bool) []Extension {
-// 				panic("mock out the List method")
-// 			},
-// 			RemoveFunc: func(name string) error {
-// 				panic("mock out the Remove method")
-// 			},
-// 			UpgradeFunc: func(name string, force bool) error {
-// 				panic("mock out the Upgrade method")
-// 			},
-// 		}
+//		// make and configure a mocked ExtensionManager
+//		mockedExtensionManager := &ExtensionManagerMock{
+//			CompleteFunc: func(extName string, args []string, toComplete string) ([]string, error) {
+//				panic("mock out the Complete method")
+//			},
+//			CreateFunc: func(name string, tmplType ExtTemplateType) error {
+//				panic("mock out the Create method")
+//			},
+//			DispatchFunc: func(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (bool, error) {
+//				panic("mock out the Dispatch method")
+//			},
+//			InstallFunc: func(repo ghrepo.Interface, target string, skipVerification bool) error {
+//				panic("mock out the Install method")
+//			},
+//			InstallFromLockFileFunc: func() error {
+//				panic("mock out the InstallFromLockFile method")
+//			},
+//			InstallFromTarballFunc: func(tarballPath string) error {
+//				panic("mock out the InstallFromTarball method")
+//			},
+//			InstallLocalFunc: func(dir string) error {
+//				panic("mock out the InstallLocal method")
+//			},
+//			ListFunc: func(includeMetadata bool) []Extension {
+//				panic("mock out the List method")
+//			},
+//			PackageFunc: func(dir string, destPath string) error {
+//				panic("mock out the Package method")
+//			},
+//			RemoveFunc: func(name string) error {
+//				panic("mock out the Remove method")
+//			},
+//			UpgradeFunc: func(name string, force bool) error {
+//				panic("mock out the Upgrade method")
+//			},
+//		}
 //
-// 		// use mockedExtensionManager in code that requires ExtensionManager
-// 		// and then make assertions.
+//		// use mockedExtensionManager in code that requires ExtensionManager
+//		// and then make assertions.
 //
-// 	}
+//	}
 type ExtensionManagerMock struct {
+	// CompleteFunc mocks the Complete method.
+	CompleteFunc func(extName string, args []string, toComplete string) ([]string, error)
+
 	// CreateFunc mocks the Create method.
 	CreateFunc func(name string, tmplType ExtTemplateType) error
 
@@ -54,7 +69,13 @@ type ExtensionManagerMock struct {
 	DispatchFunc func(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (bool, error)
 
 	// InstallFunc mocks the Install method.
-	InstallFunc func(interfaceMoqParam ghrepo.Interface, s string) error
+	InstallFunc func(repo ghrepo.Interface, target string, skipVerification bool) error
+
+	// InstallFromLockFileFunc mocks the InstallFromLockFile method.
+	InstallFromLockFileFunc func() error
+
+	// InstallFromTarballFunc mocks the InstallFromTarball method.
+	InstallFromTarballFunc func(tarballPath string) error
 
 	// InstallLocalFunc mocks the InstallLocal method.
 	InstallLocalFunc func(dir string) error
@@ -62,6 +83,9 @@ type ExtensionManagerMock struct {
 	// ListFunc mocks the List method.
 	ListFunc func(includeMetadata bool) []Extension
 
+	// PackageFunc mocks the Package method.
+	PackageFunc func(dir string, destPath string) error
+
 	// RemoveFunc mocks the Remove method.
 	RemoveFunc func(name string) error
 
@@ -70,6 +94,15 @@ type ExtensionManagerMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// Complete holds details about calls to the Complete method.
+		Complete []struct {
+			// ExtName is the extName argument value.
+			ExtName string
+			// Args is the args argument value.
+			Args []string
+			// ToComplete is the toComplete argument value.
+			ToComplete string
+		}
 		// Create holds details about calls to the Create method.
 		Create []struct {
 			// Name is the name argument value.
@@ -90,10 +123,20 @@ type ExtensionManagerMock struct {
 		}
 		// Install holds details about calls to the Install method.
 		Install []struct {
-			// InterfaceMoqParam is the interfaceMoqParam argument value.
-			InterfaceMoqParam ghrepo.Interface
-			// S is the s argument value.
-			S string
+			// Repo is the repo argument value.
+			Repo ghrepo.Interface
+			// Target is the target argument value.
+			Target string
+			// SkipVerification is the skipVerification argument value.
+			SkipVerification bool
+		}
+		// InstallFromLockFile holds details about calls to the InstallFromLockFile method.
+		InstallFromLockFile []struct {
+		}
+		// InstallFromTarball holds details about calls to the InstallFromTarball method.
+		InstallFromTarball []struct {
+			// TarballPath is the tarballPath argument value.
+			TarballPath string
 		}
 		// InstallLocal holds details about calls to the InstallLocal method.
 		InstallLocal []struct {
@@ -105,6 +148,13 @@ type ExtensionManagerMock struct {
 			// IncludeMetadata is the includeMetadata argument value.
 			IncludeMetadata bool
 		}
+		// Package holds details about calls to the Package method.
+		Package []struct {
+			// Dir is the dir argument value.
+			Dir string
+			// DestPath is the destPath argument value.
+			DestPath string
+		}
 		// Remove holds details about calls to the Remove method.
 		Remove []struct {
 			// Name is the name argument value.
@@ -118,13 +168,57 @@ type ExtensionManagerMock struct {
 			Force bool
 		}
 	}
-	lockCreate       sync.RWMutex
-	lockDispatch     sync.RWMutex
-	lockInstall      sync.RWMutex
-	lockInstallLocal sync.RWMutex
-	lockList         sync.RWMutex
-	lockRemove       sync.RWMutex
-	lockUpgrade      sync.RWMutex
+	lockComplete            sync.RWMutex
+	lockCreate              sync.RWMutex
+	lockDispatch            sync.RWMutex
+	lockInstall             sync.RWMutex
+	lockInstallFromLockFile sync.RWMutex
+	lockInstallFromTarball  sync.RWMutex
+	lockInstallLocal        sync.RWMutex
+	lockList                sync.RWMutex
+	lockPackage             sync.RWMutex
+	lockRemove              sync.RWMutex
+	lockUpgrade             sync.RWMutex
+}
+
+// Complete calls CompleteFunc.
+func (mock *ExtensionManagerMock) Complete(extName string, args []string, toComplete string) ([]string, error) {
+	if mock.CompleteFunc == nil {
+		panic("ExtensionManagerMock.CompleteFunc: method is nil but ExtensionManager.Complete was just called")
+	}
+	callInfo := struct {
+		ExtName    string
+		Args       []string
+		ToComplete string
+	}{
+		ExtName:    extName,
+		Args:       args,
+		ToComplete: toComplete,
+	}
+	mock.lockComplete.Lock()
+	mock.calls.Complete = append(mock.calls.Complete, callInfo)
+	mock.lockComplete.Unlock()
+	return mock.CompleteFunc(extName, args, toComplete)
+}
+
+// CompleteCalls gets all the calls that were made to Complete.
+// Check the length with:
+//
+//	len(mockedExtensionManager.CompleteCalls())
+func (mock *ExtensionManagerMock) CompleteCalls() []struct {
+	ExtName    string
+	Args       []string
+	ToComplete string
+} {
+	var calls []struct {
+		ExtName    string
+		Args       []string
+		ToComplete string
+	}
+	mock.lockComplete.RLock()
+	calls = mock.calls.Complete
+	mock.lockComplete.RUnlock()
+	return calls
 }
 
 // Create calls CreateFunc.
@@ -147,7 +241,8 @@ func (mock *ExtensionManagerMock) Create(name string, tmplType ExtTemplateType)
 
 // CreateCalls gets all the calls that were made to Create.
 // Check the length with:
-//     len(mockedExtensionManager.CreateCalls())
+//
+//	len(mockedExtensionManager.CreateCalls())
 func (mock *ExtensionManagerMock) CreateCalls() []struct {
 	Name     string
 	TmplType ExtTemplateType
@@ -186,7 +281,8 @@ func (mock *ExtensionManagerMock) Dispatch(args []string, stdin io.Reader, stdou
 
 // DispatchCalls gets all the calls that were made to Dispatch.
 // Check the length with:
-//     len(mockedExtensionManager.DispatchCalls())
+//
+//	len(mockedExtensionManager.DispatchCalls())
 func (mock *ExtensionManagerMock) DispatchCalls() []struct {
 	Args   []string
 	Stdin  io.Reader
@@ -206,33 +302,38 @@ func (mock *ExtensionManagerMock) DispatchCalls() []struct {
 }
 
 // Install calls InstallFunc.
-func (mock *ExtensionManagerMock) Install(interfaceMoqParam ghrepo.Interface, s string) error {
+func (mock *ExtensionManagerMock) Install(repo ghrepo.Interface, target string, skipVerification bool) error {
 	if mock.InstallFunc == nil {
 		panic("ExtensionManagerMock.InstallFunc: method is nil but ExtensionManager.Install was just called")
 	}
 	callInfo := struct {
-		InterfaceMoqParam ghrepo.Interface
-		S                 string
+		Repo             ghrepo.Interface
+		Target           string
+		SkipVerification bool
 	}{
-		InterfaceMoqParam: interfaceMoqParam,
-		S:                 s,
+		Repo:             repo,
+		Target:           target,
+		SkipVerification: skipVerification,
 	}
 	mock.lockInstall.Lock()
 	mock.calls.Install = append(mock.calls.Install, callInfo)
 	mock.lockInstall.Unlock()
-	return mock.InstallFunc(interfaceMoqParam, s)
+	return mock.InstallFunc(repo, target, skipVerification)
 }
 
 // InstallCalls gets all the calls that were made to Install.
 // Check the length with:
-//     len(mockedExtensionManager.InstallCalls())
+//
+//	len(mockedExtensionManager.InstallCalls())
 func (mock *ExtensionManagerMock) InstallCalls() []struct {
-	InterfaceMoqParam ghrepo.Interface
-	S                 string
+	Repo             ghrepo.Interface
+	Target           string
+	SkipVerification bool
 } {
 	var calls []struct {
-		InterfaceMoqParam ghrepo.Interface
-		S                 string
+		Repo             ghrepo.Interface
+		Target           string
+		SkipVerification bool
 	}
 	mock.lockInstall.RLock()
 	calls = mock.calls.Install
@@ -240,6 +341,65 @@ func (mock *ExtensionManagerMock) InstallCalls() []struct {
 	return calls
 }
 
+// InstallFromLockFile calls InstallFromLockFileFunc.
+func (mock *ExtensionManagerMock) InstallFromLockFile() error {
+	if mock.InstallFromLockFileFunc == nil {
+		panic("ExtensionManagerMock.InstallFromLockFileFunc: method is nil but ExtensionManager.InstallFromLockFile was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockInstallFromLockFile.Lock()
+	mock.calls.InstallFromLockFile = append(mock.calls.InstallFromLockFile, callInfo)
+	mock.lockInstallFromLockFile.Unlock()
+	return mock.InstallFromLockFileFunc()
+}
+
+// InstallFromLockFileCalls gets all the calls that were made to InstallFromLockFile.
+// Check the length with:
+//
+//	len(mockedExtensionManager.InstallFromLockFileCalls())
+func (mock *ExtensionManagerMock) InstallFromLockFileCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockInstallFromLockFile.RLock()
+	calls = mock.calls.InstallFromLockFile
+	mock.lockInstallFromLockFile.RUnlock()
+	return calls
+}
+
+// InstallFromTarball calls InstallFromTarballFunc.
+func (mock *ExtensionManagerMock) InstallFromTarball(tarballPath string) error {
+	if mock.InstallFromTarballFunc == nil {
+		panic("ExtensionManagerMock.InstallFromTarballFunc: method is nil but ExtensionManager.InstallFromTarball was just called")
+	}
+	callInfo := struct {
+		TarballPath string
+	}{
+		TarballPath: tarballPath,
+	}
+	mock.lockInstallFromTarball.Lock()
+	mock.calls.InstallFromTarball = append(mock.calls.InstallFromTarball, callInfo)
+	mock.lockInstallFromTarball.Unlock()
+	return mock.InstallFromTarballFunc(tarballPath)
+}
+
+// InstallFromTarballCalls gets all the calls that were made to InstallFromTarball.
+// Check the length with:
+//
+//	len(mockedExtensionManager.InstallFromTarballCalls())
+func (mock *ExtensionManagerMock) InstallFromTarballCalls() []struct {
+	TarballPath string
+} {
+	var calls []struct {
+		TarballPath string
+	}
+	mock.lockInstallFromTarball.RLock()
+	calls = mock.calls.InstallFromTarball
+	mock.lockInstallFromTarball.RUnlock()
+	return calls
+}
+
 // InstallLocal calls InstallLocalFunc.
 func (mock *ExtensionManagerMock) InstallLocal(dir string) error {
 	if mock.InstallLocalFunc == nil {
@@ -258,7 +418,8 @@ func (mock *ExtensionManagerMock) InstallLocal(dir string) error {
 
 // InstallLocalCalls gets all the calls that were made to InstallLocal.
 // Check the length with:
-//     len(mockedExtensionManager.InstallLocalCalls())
+//
+//	len(mockedExtensionManager.InstallLocalCalls())
 func (mock *ExtensionManagerMock) InstallLocalCalls() []struct {
 	Dir string
 } {
@@ -289,7 +450,8 @@ func (mock *ExtensionManagerMock) List(includeMetadata bool) []Extension {
 
 // ListCalls gets all the calls that were made to List.
 // Check the length with:
-//     len(mockedExtensionManager.ListCalls())
+//
+//	len(mockedExtensionManager.ListCalls())
 func (mock *ExtensionManagerMock) ListCalls() []struct {
 	IncludeMetadata bool
 } {
@@ -302,6 +464,42 @@ func (mock *ExtensionManagerMock) ListCalls() []struct {
 	return calls
 }
 
+// Package calls PackageFunc.
+func (mock *ExtensionManagerMock) Package(dir string, destPath string) error {
+	if mock.PackageFunc == nil {
+		panic("ExtensionManagerMock.PackageFunc: method is nil but ExtensionManager.Package was just called")
+	}
+	callInfo := struct {
+		Dir      string
+		DestPath string
+	}{
+		Dir:      dir,
+		DestPath: destPath,
+	}
+	mock.lockPackage.Lock()
+	mock.calls.Package = append(mock.calls.Package, callInfo)
+	mock.lockPackage.Unlock()
+	return mock.PackageFunc(dir, destPath)
+}
+
+// PackageCalls gets all the calls that were made to Package.
+// Check the length with:
+//
+//	len(mockedExtensionManager.PackageCalls())
+func (mock *ExtensionManagerMock) PackageCalls() []struct {
+	Dir      string
+	DestPath string
+} {
+	var calls []struct {
+		Dir      string
+		DestPath string
+	}
+	mock.lockPackage.RLock()
+	calls = mock.calls.Package
+	mock.lockPackage.RUnlock()
+	return calls
+}
+
 // Remove calls RemoveFunc.
 func (mock *ExtensionManagerMock) Remove(name string) error {
 	if mock.RemoveFunc == nil {
@@ -320,7 +518,8 @@ func (mock *ExtensionManagerMock) Remove(name string) error {
 
 // RemoveCalls gets all the calls that were made to Remove.
 // Check the length with:
-//     len(mockedExtensionManager.RemoveCalls())
+//
+//	len(mockedExtensionManager.RemoveCalls())
 func (mock *ExtensionManagerMock) RemoveCalls() []struct {
 	Name string
 } {
@@ -353,7 +552,8 @@ func (mock *ExtensionManagerMock) Upgrade(name string, force bool) error {
 
 // UpgradeCalls gets all the calls that were made to Upgrade.
 // Check the length with:
-//     len(mockedExtensionManager.UpgradeCalls())
+//
+//	len(mockedExtensionManager.UpgradeCalls())
 func (mock *ExtensionManagerMock) UpgradeCalls() []struct {
 	Name  string
 	Force bool
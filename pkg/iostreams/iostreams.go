@@ -59,6 +59,8 @@ type IOStreams struct {
 
 	neverPrompt bool
 
+	quiet bool
+
 	TempFileOverride *os.File
 }
 
@@ -237,12 +239,29 @@ func (s *IOStreams) SetNeverPrompt(v bool) {
 	s.neverPrompt = v
 }
 
+// GetQuiet reports whether non-essential output, such as progress updates and
+// informational messages, should be suppressed.
+func (s *IOStreams) GetQuiet() bool {
+	return s.quiet
+}
+
+func (s *IOStreams) SetQuiet(v bool) {
+	s.quiet = v
+}
+
 func (s *IOStreams) StartProgressIndicator() {
 	s.StartProgressIndicatorWithLabel("")
 }
 
+// ProgressIndicatorEnabled reports whether it's appropriate to render
+// transient terminal output such as spinners and progress bars: both
+// stdout and stderr need to be real terminals, not pipes or redirections.
+func (s *IOStreams) ProgressIndicatorEnabled() bool {
+	return s.progressIndicatorEnabled
+}
+
 func (s *IOStreams) StartProgressIndicatorWithLabel(label string) {
-	if !s.progressIndicatorEnabled {
+	if !s.progressIndicatorEnabled || s.quiet {
 		return
 	}
 
@@ -2,11 +2,25 @@ package markdown
 
 import (
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/charmbracelet/glamour"
 )
 
+// Glamour has no renderer for raw HTML blocks, so a <details><summary> section
+// is dropped along with its label. detailsOpenRE pulls the summary text out as
+// its own paragraph so it survives rendering; detailsCloseRE drops the now
+// unmatched closing tag rather than leaving it to be swallowed, with whatever
+// follows it, into another HTML block.
+var detailsOpenRE = regexp.MustCompile(`(?is)<details>\s*<summary>(.*?)</summary>`)
+var detailsCloseRE = regexp.MustCompile(`(?i)</details>`)
+
+func renderSummaries(text string) string {
+	text = detailsOpenRE.ReplaceAllString(text, "\n\n**$1**\n\n")
+	return detailsCloseRE.ReplaceAllString(text, "")
+}
+
 func WithoutIndentation() glamour.TermRendererOption {
 	overrides := []byte(`
 	  {
@@ -51,6 +65,7 @@ func Render(text string, opts ...glamour.TermRendererOption) (string, error) {
 	// Glamour rendering preserves carriage return characters in code blocks, but
 	// we need to ensure that no such characters are present in the output.
 	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = renderSummaries(text)
 
 	opts = append(opts, glamour.WithEmoji(), glamour.WithPreservedNewLines())
 	tr, err := glamour.NewTermRenderer(opts...)
@@ -49,6 +49,31 @@ func Test_Render(t *testing.T) {
 	}
 }
 
+func Test_renderSummaries(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "summary label is preserved",
+			input: "<details><summary>Click to expand</summary>\n\nhidden content\n\n</details>",
+			want:  "\n\n**Click to expand**\n\n\n\nhidden content\n\n",
+		},
+		{
+			name:  "no details block",
+			input: "plain text",
+			want:  "plain text",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, renderSummaries(tt.input))
+		})
+	}
+}
+
 type terminalThemer string
 
 func (tt terminalThemer) TerminalTheme() string {
@@ -12,6 +12,8 @@ import (
 const (
 	KindRepositories = "repositories"
 	KindIssues       = "issues"
+	KindCode         = "code"
+	KindCommits      = "commits"
 )
 
 type Query struct {
@@ -28,16 +30,26 @@ type Qualifiers struct {
 	Archived         *bool
 	Assignee         string
 	Author           string
+	AuthorDate       string
+	AuthorEmail      string
+	AuthorName       string
 	Base             string
 	Closed           string
 	Commenter        string
 	Comments         string
+	Committer        string
+	CommitterDate    string
+	CommitterEmail   string
+	CommitterName    string
 	Created          string
 	Draft            *bool
+	Extension        string
+	Filename         string
 	Followers        string
 	Fork             string
 	Forks            string
 	GoodFirstIssues  string
+	Hash             string
 	Head             string
 	HelpWantedIssues string
 	In               []string
@@ -48,10 +60,13 @@ type Qualifiers struct {
 	Language         string
 	License          []string
 	Mentions         string
+	Merge            *bool
 	Merged           string
 	Milestone        string
 	No               []string
 	Org              string
+	Parent           string
+	Path             string
 	Project          string
 	Pushed           string
 	Reactions        string
@@ -66,6 +81,7 @@ type Qualifiers struct {
 	Team             string
 	Topic            []string
 	Topics           string
+	Tree             string
 	Type             string
 	Updated          string
 }
@@ -134,8 +150,9 @@ func formatQualifiers(qs Qualifiers) []string {
 }
 
 func formatKeywords(ks []string) []string {
+	quoted := make([]string, len(ks))
 	for i, k := range ks {
-		ks[i] = quote(k)
+		quoted[i] = quote(k)
 	}
-	return ks
+	return quoted
 }
@@ -57,6 +57,22 @@ var IssueFields = []string{
 	"url",
 }
 
+var CodeFields = []string{
+	"name",
+	"path",
+	"repository",
+	"sha",
+	"url",
+}
+
+var CommitFields = []string{
+	"author",
+	"date",
+	"message",
+	"repo",
+	"sha",
+}
+
 type RepositoriesResult struct {
 	IncompleteResults bool         `json:"incomplete_results"`
 	Items             []Repository `json:"items"`
@@ -69,6 +85,18 @@ type IssuesResult struct {
 	Total             int     `json:"total_count"`
 }
 
+type CodeResult struct {
+	IncompleteResults bool       `json:"incomplete_results"`
+	Items             []CodeItem `json:"items"`
+	Total             int        `json:"total_count"`
+}
+
+type CommitsResult struct {
+	IncompleteResults bool     `json:"incomplete_results"`
+	Items             []Commit `json:"items"`
+	Total             int      `json:"total_count"`
+}
+
 type Repository struct {
 	CreatedAt       time.Time `json:"created_at"`
 	DefaultBranch   string    `json:"default_branch"`
@@ -136,6 +164,49 @@ type Issue struct {
 	UpdatedAt         time.Time        `json:"updated_at"`
 }
 
+type CodeItem struct {
+	Name       string         `json:"name"`
+	Path       string         `json:"path"`
+	SHA        string         `json:"sha"`
+	URL        string         `json:"html_url"`
+	Repository CodeRepository `json:"repository"`
+}
+
+type CodeRepository struct {
+	ID       string `json:"node_id"`
+	FullName string `json:"full_name"`
+	Private  bool   `json:"private"`
+	URL      string `json:"html_url"`
+}
+
+type Commit struct {
+	Commit     CommitDetails    `json:"commit"`
+	Author     User             `json:"author"`
+	Committer  User             `json:"committer"`
+	Repository CommitRepository `json:"repository"`
+	SHA        string           `json:"sha"`
+	URL        string           `json:"html_url"`
+}
+
+type CommitDetails struct {
+	Author    CommitUser `json:"author"`
+	Committer CommitUser `json:"committer"`
+	Message   string     `json:"message"`
+}
+
+type CommitUser struct {
+	Date  time.Time `json:"date"`
+	Email string    `json:"email"`
+	Name  string    `json:"name"`
+}
+
+type CommitRepository struct {
+	ID       string `json:"node_id"`
+	FullName string `json:"full_name"`
+	Private  bool   `json:"private"`
+	URL      string `json:"html_url"`
+}
+
 type PullRequestLinks struct {
 	URL string `json:"html_url"`
 }
@@ -225,6 +296,51 @@ func (issue Issue) ExportData(fields []string) map[string]interface{} {
 	return data
 }
 
+func (code CodeItem) ExportData(fields []string) map[string]interface{} {
+	v := reflect.ValueOf(code)
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "repository":
+			data[f] = map[string]interface{}{
+				"id":       code.Repository.ID,
+				"fullName": code.Repository.FullName,
+				"private":  code.Repository.Private,
+				"url":      code.Repository.URL,
+			}
+		default:
+			sf := fieldByName(v, f)
+			data[f] = sf.Interface()
+		}
+	}
+	return data
+}
+
+func (commit Commit) ExportData(fields []string) map[string]interface{} {
+	v := reflect.ValueOf(commit)
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "author":
+			data[f] = map[string]interface{}{
+				"name":  commit.Commit.Author.Name,
+				"email": commit.Commit.Author.Email,
+				"login": commit.Author.Login,
+			}
+		case "date":
+			data[f] = commit.Commit.Author.Date
+		case "message":
+			data[f] = commit.Commit.Message
+		case "repo":
+			data[f] = commit.Repository.FullName
+		default:
+			sf := fieldByName(v, f)
+			data[f] = sf.Interface()
+		}
+	}
+	return data
+}
+
 func fieldByName(v reflect.Value, field string) reflect.Value {
 	return v.FieldByNameFunc(func(s string) bool {
 		return strings.EqualFold(field, s)
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cli/cli/v2/internal/ghinstance"
 )
@@ -19,12 +20,19 @@ const (
 	sortKey    = "sort"
 )
 
+// paginationDelay is paced between successive pages of the same search to stay
+// under GitHub's search API secondary rate limit. It is a var so tests can zero
+// it out.
+var paginationDelay = time.Second
+
 var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
 var pageRE = regexp.MustCompile(`(\?|&)page=(\d*)`)
 var jsonTypeRE = regexp.MustCompile(`[/+]json($|;)`)
 
 //go:generate moq -rm -out searcher_mock.go . Searcher
 type Searcher interface {
+	Code(Query) (CodeResult, error)
+	Commits(Query) (CommitsResult, error)
 	Repositories(Query) (RepositoriesResult, error)
 	Issues(Query) (IssuesResult, error)
 	URL(Query) string
@@ -67,6 +75,9 @@ func (s searcher) Repositories(query Query) (RepositoriesResult, error) {
 		if query.Page == 0 {
 			break
 		}
+		if resp != nil {
+			time.Sleep(paginationDelay)
+		}
 		page := RepositoriesResult{}
 		resp, err = s.search(query, &page)
 		if err != nil {
@@ -80,6 +91,60 @@ func (s searcher) Repositories(query Query) (RepositoriesResult, error) {
 	return result, nil
 }
 
+func (s searcher) Code(query Query) (CodeResult, error) {
+	result := CodeResult{}
+	toRetrieve := query.Limit
+	var resp *http.Response
+	var err error
+	for toRetrieve > 0 {
+		query.Limit = min(toRetrieve, maxPerPage)
+		query.Page = nextPage(resp)
+		if query.Page == 0 {
+			break
+		}
+		if resp != nil {
+			time.Sleep(paginationDelay)
+		}
+		page := CodeResult{}
+		resp, err = s.search(query, &page)
+		if err != nil {
+			return result, err
+		}
+		result.IncompleteResults = page.IncompleteResults
+		result.Total = page.Total
+		result.Items = append(result.Items, page.Items...)
+		toRetrieve = toRetrieve - len(page.Items)
+	}
+	return result, nil
+}
+
+func (s searcher) Commits(query Query) (CommitsResult, error) {
+	result := CommitsResult{}
+	toRetrieve := query.Limit
+	var resp *http.Response
+	var err error
+	for toRetrieve > 0 {
+		query.Limit = min(toRetrieve, maxPerPage)
+		query.Page = nextPage(resp)
+		if query.Page == 0 {
+			break
+		}
+		if resp != nil {
+			time.Sleep(paginationDelay)
+		}
+		page := CommitsResult{}
+		resp, err = s.search(query, &page)
+		if err != nil {
+			return result, err
+		}
+		result.IncompleteResults = page.IncompleteResults
+		result.Total = page.Total
+		result.Items = append(result.Items, page.Items...)
+		toRetrieve = toRetrieve - len(page.Items)
+	}
+	return result, nil
+}
+
 func (s searcher) Issues(query Query) (IssuesResult, error) {
 	result := IssuesResult{}
 	toRetrieve := query.Limit
@@ -91,6 +156,9 @@ func (s searcher) Issues(query Query) (IssuesResult, error) {
 		if query.Page == 0 {
 			break
 		}
+		if resp != nil {
+			time.Sleep(paginationDelay)
+		}
 		page := IssuesResult{}
 		resp, err = s.search(query, &page)
 		if err != nil {
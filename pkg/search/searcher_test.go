@@ -3,6 +3,7 @@ package search
 import (
 	"net/http"
 	"net/url"
+	"os"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
@@ -10,6 +11,11 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestMain(m *testing.M) {
+	paginationDelay = 0
+	os.Exit(m.Run())
+}
+
 func TestSearcherRepositories(t *testing.T) {
 	query := Query{
 		Keywords: []string{"keyword"},
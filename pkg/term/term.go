@@ -0,0 +1,60 @@
+// Package term collects the small set of prompt, table, and markdown
+// helpers that gh's commands use to talk to a terminal, so that new
+// commands don't each reach for their own copy of survey.Select or a
+// bespoke table layout. A Go-based extension that vendors this module can
+// import it too and get the same look and feel as core commands; gh runs
+// extensions as separate executables with no RPC channel back into the gh
+// process, so this is only reachable by extensions written in Go today.
+package term
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/markdown"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/cli/cli/v2/utils"
+)
+
+// Confirm asks a yes/no question, defaulting to defaultValue if the user
+// just presses enter.
+func Confirm(message string, defaultValue bool) (bool, error) {
+	var result bool
+	err := prompt.SurveyAskOne(&survey.Confirm{
+		Message: message,
+		Default: defaultValue,
+	}, &result)
+	return result, err
+}
+
+// Select asks the user to choose one of options and returns its index.
+func Select(message string, options []string) (int, error) {
+	var result int
+	err := prompt.SurveyAskOne(&survey.Select{
+		Message: message,
+		Options: options,
+	}, &result)
+	return result, err
+}
+
+// Input asks a free-text question, defaulting to defaultValue if the user
+// just presses enter.
+func Input(message, defaultValue string) (string, error) {
+	var result string
+	err := prompt.SurveyAskOne(&survey.Input{
+		Message: message,
+		Default: defaultValue,
+	}, &result)
+	return result, err
+}
+
+// NewTablePrinter returns a table printer that lays out columns for a TTY
+// and falls back to tab-separated rows otherwise, matching io's settings.
+func NewTablePrinter(io *iostreams.IOStreams) utils.TablePrinter {
+	return utils.NewTablePrinter(io)
+}
+
+// RenderMarkdown renders text as markdown for display in a terminal,
+// wrapping it to width.
+func RenderMarkdown(text string, width int) (string, error) {
+	return markdown.Render(text, markdown.WithWrap(width))
+}
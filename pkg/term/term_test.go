@@ -0,0 +1,41 @@
+package term
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirm(t *testing.T) {
+	as := prompt.NewAskStubber(t)
+	as.StubPrompt("Continue?").AnswerWith(false)
+
+	result, err := Confirm("Continue?", true)
+	assert.NoError(t, err)
+	assert.False(t, result)
+}
+
+func TestSelect(t *testing.T) {
+	as := prompt.NewAskStubber(t)
+	as.StubPrompt("Pick one").AssertOptions([]string{"a", "b", "c"}).AnswerWith("b")
+
+	result, err := Select("Pick one", []string{"a", "b", "c"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result)
+}
+
+func TestInput(t *testing.T) {
+	as := prompt.NewAskStubber(t)
+	as.StubPrompt("Name?").AnswerWith("octocat")
+
+	result, err := Input("Name?", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "octocat", result)
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	out, err := RenderMarkdown("# hello", 80)
+	assert.NoError(t, err)
+	assert.Contains(t, out, "hello")
+}
@@ -2,8 +2,10 @@ package utils
 
 import (
 	"fmt"
+	"io"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -105,3 +107,31 @@ func IsDebugEnabled() (bool, string) {
 		return true, debugValue
 	}
 }
+
+// DebugLogFile returns the writer that GH_DEBUG output should be sent to. By default this is
+// fallback (typically the command's stderr), but it can be redirected to a file with GH_LOG_FILE
+// or the equivalent --log-file flag, which is useful for attaching diagnostics to a bug report.
+// The returned file, if any, is intentionally never closed: gh is a short-lived process and relies
+// on the OS to release the descriptor on exit.
+func DebugLogFile(fallback io.Writer) (io.Writer, error) {
+	path := os.Getenv("GH_LOG_FILE")
+	if path == "" {
+		return fallback, nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fallback, err
+	}
+	return f, nil
+}
+
+// DebugLogMaxBodyBytes returns the maximum number of bytes of a request or response body that
+// GH_DEBUG should print before truncating it, configurable via GH_DEBUG_MAX_BODY.
+func DebugLogMaxBodyBytes() int64 {
+	if v := os.Getenv("GH_DEBUG_MAX_BODY"); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil && n > 0 {
+			return n
+		}
+	}
+	return 10000
+}
@@ -1,6 +1,10 @@
 package utils
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 )
@@ -61,3 +65,47 @@ func TestFuzzyAgoAbbr(t *testing.T) {
 		}
 	}
 }
+
+func TestDebugLogFile(t *testing.T) {
+	fallback := &bytes.Buffer{}
+
+	out, err := DebugLogFile(fallback)
+	if err != nil {
+		t.Fatalf("DebugLogFile() unexpected error: %v", err)
+	}
+	if out != fallback {
+		t.Errorf("expected fallback writer when GH_LOG_FILE is unset")
+	}
+
+	logPath := filepath.Join(t.TempDir(), "gh-debug.log")
+	os.Setenv("GH_LOG_FILE", logPath)
+	defer os.Unsetenv("GH_LOG_FILE")
+
+	out, err = DebugLogFile(fallback)
+	if err != nil {
+		t.Fatalf("DebugLogFile() unexpected error: %v", err)
+	}
+	if _, err := out.Write([]byte("hello\n")); err != nil {
+		t.Fatalf("write to log file: %v", err)
+	}
+
+	got, err := ioutil.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("reading log file: %v", err)
+	}
+	if string(got) != "hello\n" {
+		t.Errorf("unexpected log file contents: %q", got)
+	}
+}
+
+func TestDebugLogMaxBodyBytes(t *testing.T) {
+	if got := DebugLogMaxBodyBytes(); got != 10000 {
+		t.Errorf("expected default of 10000, got %d", got)
+	}
+
+	os.Setenv("GH_DEBUG_MAX_BODY", "500")
+	defer os.Unsetenv("GH_DEBUG_MAX_BODY")
+	if got := DebugLogMaxBodyBytes(); got != 500 {
+		t.Errorf("expected 500, got %d", got)
+	}
+}